@@ -0,0 +1,33 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// migrate-scenarios converts the httpreplay scenario files recorded in the YAML format to the compact format
+//
+// Run with a command something like:
+//
+//	go run ./httpreplay/cmd/migrate-scenarios -dir internal/integrationtest/record
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
+)
+
+func main() {
+	var dir = flag.String("dir", "record", "Directory of the scenario files to migrate, the YAML files of its subdirectories are also migrated")
+	var keep = flag.Bool("keep", false, "Keep the YAML files along with the migrated files")
+	flag.Parse()
+
+	migrated, err := httpreplay.MigrateScenarios(*dir, *keep)
+	for _, fileName := range migrated {
+		log.Printf("Migrated %s", fileName)
+	}
+	if err != nil {
+		log.Printf("[ERROR]: %v", err)
+		os.Exit(1)
+	}
+	log.Printf("Migrated %d scenario files", len(migrated))
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// stand-in-server serves the recorded interactions of an httpreplay scenario as a local stand-in of the OCI APIs,
+// so that terraform runs against the provider binary can be exercised offline
+//
+// Run with a command something like:
+//
+//	go run ./httpreplay/cmd/stand-in-server -dir internal/integrationtest -scenario TestCoreVcnResource_basic
+//
+// then export the printed CLIENT_HOST_OVERRIDES value in the environment of terraform.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+
+	"github.com/terraform-providers/terraform-provider-oci/httpreplay"
+	"github.com/terraform-providers/terraform-provider-oci/internal/client"
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+)
+
+func main() {
+	var scenario = flag.String("scenario", "", "Name of the scenario to serve")
+	var dir = flag.String("dir", ".", "Directory of the 'record' directory of the scenario")
+	var address = flag.String("address", "127.0.0.1:0", "Address to listen on, a free port is used by default")
+	flag.Parse()
+
+	if *scenario == "" {
+		log.Printf("[ERROR]: the scenario is required")
+		flag.PrintDefaults()
+		os.Exit(1)
+	}
+	if err := os.Chdir(*dir); err != nil {
+		log.Printf("[ERROR]: %v", err)
+		os.Exit(1)
+	}
+	server, err := httpreplay.NewServerForScenario(*scenario)
+	if err != nil {
+		log.Printf("[ERROR]: unable to load scenario %s: %v", *scenario, err)
+		os.Exit(1)
+	}
+	listener, err := net.Listen("tcp", *address)
+	if err != nil {
+		log.Printf("[ERROR]: %v", err)
+		os.Exit(1)
+	}
+
+	url := "http://" + listener.Addr().String()
+	log.Printf("Serving scenario %s on %s", *scenario, url)
+	fmt.Printf("export %s='%s'\n", globalvar.ClientHostOverridesEnv, clientHostOverrides(url))
+
+	go func() {
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+		<-signals
+		for _, i := range server.UnusedInteractions() {
+			log.Printf("Interaction %d was never served: %s %s", i.Index, i.Request.Method, i.Request.URL)
+		}
+		os.Exit(0)
+	}()
+
+	if err = http.Serve(listener, server); err != nil {
+		log.Printf("[ERROR]: %v", err)
+		os.Exit(1)
+	}
+}
+
+// clientHostOverrides returns the client host overrides of all the SDK clients of the provider
+func clientHostOverrides(url string) string {
+	names := []string{client.WorkRequestClientName}
+	for name := range client.OracleClientRegistrationsVar.RegisteredClients {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	overrides := make([]string, len(names))
+	for idx, name := range names {
+		overrides[idx] = name + globalvar.EqualToOperatorDelimiter + url
+	}
+	return strings.Join(overrides, globalvar.ColonDelimiter)
+}
@@ -0,0 +1,312 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package httpreplay
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// File extensions of the scenario formats
+const (
+	legacyScenarioExtension  = ".yaml"
+	compactScenarioExtension = ".jsonl.gz"
+)
+
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// The compact format is a gzip-compressed JSON Lines file: the first line is the header of the scenario, each following
+// line is either a body or an interaction. A body is written once, before the first interaction that references it by id.
+type compactHeader struct {
+	Version int               `json:"version"`
+	Fields  map[string]string `json:"fields,omitempty"`
+}
+
+type compactLine struct {
+	Body        *compactBody        `json:"body,omitempty"`
+	Interaction *compactInteraction `json:"interaction,omitempty"`
+}
+
+type compactBody struct {
+	ID      int    `json:"id"`
+	Content string `json:"content"`
+}
+
+type compactInteraction struct {
+	Request  compactRequest  `json:"request"`
+	Response compactResponse `json:"response"`
+}
+
+type compactRequest struct {
+	Method  string      `json:"method"`
+	URL     string      `json:"url"`
+	Headers http.Header `json:"headers,omitempty"`
+	Form    url.Values  `json:"form,omitempty"`
+	Body    int         `json:"body,omitempty"`
+}
+
+type compactResponse struct {
+	Status   string      `json:"status"`
+	Code     int         `json:"code"`
+	Headers  http.Header `json:"headers,omitempty"`
+	Duration string      `json:"duration,omitempty"`
+	Body     int         `json:"body,omitempty"`
+}
+
+// decode reads the data of a scenario file in any of the supported formats, the format is detected from the content
+// and the version of the data
+func (s *Scenario) decode(data []byte) error {
+	if bytes.HasPrefix(data, gzipMagic) {
+		return s.decodeCompact(bytes.NewReader(data))
+	}
+
+	s.Version = 0
+	if err := yaml.Unmarshal(data, s); err != nil {
+		return err
+	}
+	switch s.Version {
+	case 0, scenarioFormatV1:
+		return s.migrateV1()
+	}
+	return fmt.Errorf("unsupported version %d of YAML scenario %s", s.Version, s.Name)
+}
+
+// migrateV1 upgrades a scenario loaded from the YAML format, the parsed bodies are computed again when they are replayed
+func (s *Scenario) migrateV1() error {
+	for index := range s.Interactions {
+		s.Interactions[index].Request.BodyParsed = nil
+		s.Interactions[index].Response.BodyParsed = nil
+	}
+	if s.Fields == nil {
+		s.Fields = make(map[string]string)
+	}
+	s.Version = scenarioFormatV2
+	return nil
+}
+
+func (s *Scenario) decodeCompact(reader io.Reader) error {
+	gzipReader, err := gzip.NewReader(reader)
+	if err != nil {
+		return err
+	}
+	defer gzipReader.Close()
+
+	decoder := json.NewDecoder(gzipReader)
+	var header compactHeader
+	if err = decoder.Decode(&header); err != nil {
+		return fmt.Errorf("invalid header of scenario %s: %v", s.Name, err)
+	}
+	if header.Version != scenarioFormatV2 {
+		return fmt.Errorf("unsupported version %d of compact scenario %s", header.Version, s.Name)
+	}
+	s.Version = header.Version
+	if header.Fields != nil {
+		s.Fields = header.Fields
+	}
+
+	lineNumber := 1
+	bodies := map[int]string{}
+	body := func(id int) (string, error) {
+		if id == 0 {
+			return "", nil
+		}
+		content, ok := bodies[id]
+		if !ok {
+			return "", fmt.Errorf("unknown body %d in scenario %s", id, s.Name)
+		}
+		return content, nil
+	}
+	for {
+		var line compactLine
+		lineNumber++
+		if err = decoder.Decode(&line); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("invalid line %d of scenario %s: %v", lineNumber, s.Name, err)
+		}
+
+		if line.Body != nil {
+			bodies[line.Body.ID] = line.Body.Content
+		}
+		if line.Interaction != nil {
+			i := Interaction{
+				Request: Request{
+					Method:  line.Interaction.Request.Method,
+					URL:     line.Interaction.Request.URL,
+					Headers: line.Interaction.Request.Headers,
+					Form:    line.Interaction.Request.Form,
+				},
+				Response: Response{
+					Status:   line.Interaction.Response.Status,
+					Code:     line.Interaction.Response.Code,
+					Headers:  line.Interaction.Response.Headers,
+					Duration: line.Interaction.Response.Duration,
+				},
+			}
+			// The empty headers and forms are omitted from the file
+			if i.Request.Headers == nil {
+				i.Request.Headers = http.Header{}
+			}
+			if i.Request.Form == nil {
+				i.Request.Form = url.Values{}
+			}
+			if i.Response.Headers == nil {
+				i.Response.Headers = http.Header{}
+			}
+			if i.Request.Body, err = body(line.Interaction.Request.Body); err != nil {
+				return err
+			}
+			if i.Response.Body, err = body(line.Interaction.Response.Body); err != nil {
+				return err
+			}
+			s.Interactions = append(s.Interactions, i)
+		}
+	}
+}
+
+// encodeCompact writes the scenario in the compact format, the identical bodies are only written once
+func (s *Scenario) encodeCompact(writer io.Writer) error {
+	gzipWriter := gzip.NewWriter(writer)
+	bufferedWriter := bufio.NewWriter(gzipWriter)
+	encoder := json.NewEncoder(bufferedWriter)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(compactHeader{Version: scenarioFormatV2, Fields: s.Fields}); err != nil {
+		return err
+	}
+
+	bodyIDs := map[string]int{}
+	bodyID := func(content string) (int, error) {
+		if content == "" {
+			return 0, nil
+		}
+		if id, ok := bodyIDs[content]; ok {
+			return id, nil
+		}
+		id := len(bodyIDs) + 1
+		bodyIDs[content] = id
+		return id, encoder.Encode(compactLine{Body: &compactBody{ID: id, Content: content}})
+	}
+
+	for _, i := range s.Interactions {
+		requestBody, err := bodyID(i.Request.Body)
+		if err != nil {
+			return err
+		}
+		responseBody, err := bodyID(i.Response.Body)
+		if err != nil {
+			return err
+		}
+		err = encoder.Encode(compactLine{Interaction: &compactInteraction{
+			Request: compactRequest{
+				Method:  i.Request.Method,
+				URL:     i.Request.URL,
+				Headers: i.Request.Headers,
+				Form:    i.Request.Form,
+				Body:    requestBody,
+			},
+			Response: compactResponse{
+				Status:   i.Response.Status,
+				Code:     i.Response.Code,
+				Headers:  i.Response.Headers,
+				Duration: i.Response.Duration,
+				Body:     responseBody,
+			},
+		}})
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := bufferedWriter.Flush(); err != nil {
+		return err
+	}
+	return gzipWriter.Close()
+}
+
+// writeFile writes the scenario in the compact format
+func (s *Scenario) writeFile(fileName string) error {
+	// Create directory for scenario if missing
+	if err := os.MkdirAll(filepath.Dir(fileName), 0755); err != nil {
+		return err
+	}
+
+	buffer := &bytes.Buffer{}
+	if err := s.encodeCompact(buffer); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(fileName, buffer.Bytes(), 0644)
+}
+
+// scenarioFileName returns the file of a scenario under the record directory, the compact format is used if both exist
+func scenarioFileName(name string) (string, error) {
+	fileName := "record/" + name + compactScenarioExtension
+	if _, err := os.Stat(fileName); err == nil || !os.IsNotExist(err) {
+		return fileName, err
+	}
+	fileName = "record/" + name + legacyScenarioExtension
+	_, err := os.Stat(fileName)
+	return fileName, err
+}
+
+// MigrateScenarioFile converts a scenario file in the YAML format to the compact format, the YAML file is removed unless keep is set
+// It returns the name of the compact file
+func MigrateScenarioFile(fileName string, keep bool) (string, error) {
+	if !strings.HasSuffix(fileName, legacyScenarioExtension) {
+		return "", fmt.Errorf("%s is not a YAML scenario file", fileName)
+	}
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return "", err
+	}
+
+	name := strings.TrimSuffix(filepath.Base(fileName), legacyScenarioExtension)
+	s := NewScenario(name)
+	if err = s.decode(data); err != nil {
+		return "", fmt.Errorf("unable to read scenario %s: %v", fileName, err)
+	}
+
+	compactFileName := strings.TrimSuffix(fileName, legacyScenarioExtension) + compactScenarioExtension
+	if err = s.writeFile(compactFileName); err != nil {
+		return "", err
+	}
+	if !keep {
+		if err = os.Remove(fileName); err != nil {
+			return compactFileName, err
+		}
+	}
+	return compactFileName, nil
+}
+
+// MigrateScenarios converts all the YAML scenario files under a directory to the compact format
+// It returns the names of the migrated files
+func MigrateScenarios(dir string, keep bool) ([]string, error) {
+	var migrated []string
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(path, legacyScenarioExtension) {
+			return nil
+		}
+		if _, err := MigrateScenarioFile(path, keep); err != nil {
+			return err
+		}
+		migrated = append(migrated, path)
+		return nil
+	})
+	return migrated, err
+}
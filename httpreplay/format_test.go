@@ -0,0 +1,174 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Run with a command something like:
+//   go test -run TestFormat
+
+package httpreplay
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const legacyScenario = `---
+version: 1
+interactions:
+- index: 0
+  uses: 0
+  request:
+    body: '{"displayName":"vcn"}'
+    bodyParsed:
+      displayName: vcn
+    form: {}
+    headers:
+      Content-Type:
+      - application/json
+    url: https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns
+    method: POST
+  response:
+    body: '{"id":"ocid1.vcn.oc1..a","lifecycleState":"AVAILABLE"}'
+    bodyParsed:
+      id: ocid1.vcn.oc1..a
+      lifecycleState: AVAILABLE
+    headers: {}
+    status: 200 OK
+    code: 200
+    duration: ""
+- index: 1
+  uses: 0
+  request:
+    body: ""
+    bodyParsed: null
+    form: {}
+    headers: {}
+    url: https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns/ocid1.vcn.oc1..a
+    method: GET
+  response:
+    body: '{"id":"ocid1.vcn.oc1..a","lifecycleState":"AVAILABLE"}'
+    bodyParsed:
+      id: ocid1.vcn.oc1..a
+      lifecycleState: AVAILABLE
+    headers: {}
+    status: 200 OK
+    code: 200
+    duration: ""
+fields:
+  vcn: vcn-1
+`
+
+func writeLegacyScenario(t *testing.T, name string) string {
+	fileName := "record/" + name + legacyScenarioExtension
+	if err := os.MkdirAll("record", 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(fileName, []byte(legacyScenario), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return fileName
+}
+
+func TestFormatLoadLegacy(t *testing.T) {
+	inTempDir(t)
+	writeLegacyScenario(t, "TestFormatLoadLegacy")
+
+	s, err := Load("TestFormatLoadLegacy")
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if s.Version != scenarioFormatV2 || len(s.Interactions) != 2 || s.Fields["vcn"] != "vcn-1" {
+		t.Fatalf("Unexpected scenario loaded from the YAML file: %+v", s)
+	}
+	if s.Interactions[0].Response.BodyParsed != nil {
+		t.Errorf("Expected the parsed bodies of the YAML file to be dropped")
+	}
+}
+
+func TestFormatSaveAndLoad(t *testing.T) {
+	inTempDir(t)
+	writeLegacyScenario(t, "TestFormatSaveAndLoad")
+
+	s, err := Load("TestFormatSaveAndLoad")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = s.Save(); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+	if _, err = os.Stat("record/TestFormatSaveAndLoad.yaml"); !os.IsNotExist(err) {
+		t.Errorf("Expected the YAML file to be replaced by the compact file")
+	}
+
+	data, err := ioutil.ReadFile("record/TestFormatSaveAndLoad.jsonl.gz")
+	if err != nil {
+		t.Fatal(err)
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	content, _ := ioutil.ReadAll(reader)
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	// header, 2 bodies shared by the interactions, 2 interactions
+	if len(lines) != 5 || !strings.HasPrefix(lines[0], `{"version":2,`) {
+		t.Errorf("Unexpected content of the compact file:\n%s", content)
+	}
+
+	loaded, err := Load("TestFormatSaveAndLoad")
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if !reflect.DeepEqual(loaded.Interactions, s.Interactions) || !reflect.DeepEqual(loaded.Fields, s.Fields) {
+		t.Errorf("Expected the compact file to load the saved scenario, got %+v", loaded)
+	}
+	if loaded.Interactions[1].Request.Body != "" || loaded.Interactions[1].Response.Body != loaded.Interactions[0].Response.Body {
+		t.Errorf("Unexpected bodies: %+v", loaded.Interactions)
+	}
+}
+
+func TestFormatUnsupportedVersion(t *testing.T) {
+	s := NewScenario("TestFormatUnsupportedVersion")
+	if err := s.decode([]byte("version: 3\n")); err == nil || !strings.Contains(err.Error(), "unsupported version 3") {
+		t.Errorf("Expected an unsupported version error, got %v", err)
+	}
+
+	buffer := &bytes.Buffer{}
+	writer := gzip.NewWriter(buffer)
+	writer.Write([]byte(`{"version":3}` + "\n"))
+	writer.Close()
+	if err := s.decode(buffer.Bytes()); err == nil || !strings.Contains(err.Error(), "unsupported version 3") {
+		t.Errorf("Expected an unsupported version error, got %v", err)
+	}
+}
+
+func TestFormatMigrateScenarios(t *testing.T) {
+	inTempDir(t)
+	writeLegacyScenario(t, "TestFormatMigrateScenarios")
+	if err := ioutil.WriteFile("record/notes.txt", []byte("not a scenario"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	migrated, err := MigrateScenarios("record", false)
+	if err != nil || !reflect.DeepEqual(migrated, []string{"record/TestFormatMigrateScenarios.yaml"}) {
+		t.Fatalf("Unexpected migrated files: %v, %v", migrated, err)
+	}
+	if _, err = os.Stat("record/TestFormatMigrateScenarios.yaml"); !os.IsNotExist(err) {
+		t.Errorf("Expected the YAML file to be removed")
+	}
+
+	s, err := Load("TestFormatMigrateScenarios")
+	if err != nil || len(s.Interactions) != 2 {
+		t.Fatalf("Expected the migrated scenario to be loaded, got %v, %v", s, err)
+	}
+	s.Matcher = matcher
+	i, err := s.GetInteraction(Request{Method: http.MethodGet, URL: "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns/ocid1.vcn.oc1..a"})
+	if err != nil || i.Index != 1 {
+		t.Errorf("Expected the migrated interactions to be replayed, got %v, %v", i, err)
+	}
+}
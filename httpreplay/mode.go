@@ -0,0 +1,205 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package httpreplay
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// ModeEnv is the environment variable that selects the mode of the recorder: bypass (default), record, replay or record-missing
+const ModeEnv = "HTTPREPLAY_MODE"
+
+var modeNames = map[Mode]string{
+	ModeDisabled:         "bypass",
+	ModeRecording:        "record",
+	ModeReplaying:        "replay",
+	ModeRecordingMissing: "record-missing",
+}
+
+func (m Mode) String() string {
+	if name, ok := modeNames[m]; ok {
+		return name
+	}
+	return fmt.Sprintf("Mode(%d)", int(m))
+}
+
+// ParseMode returns the mode with the given name, an empty name is the bypass mode
+func ParseMode(name string) (Mode, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return ModeDisabled, nil
+	}
+	for mode, modeName := range modeNames {
+		if name == modeName {
+			return mode, nil
+		}
+	}
+	return ModeDisabled, fmt.Errorf("invalid %s '%s', supported values: bypass, record, replay, record-missing", ModeEnv, name)
+}
+
+// buildTagMode is the mode selected by the `record` or `replay` build tag, which selected the mode before HTTPREPLAY_MODE
+// It is only used if HTTPREPLAY_MODE is not set, see mode_record.go and mode_replay.go
+var buildTagMode *Mode
+
+var currentMode struct {
+	once sync.Once
+	mode Mode
+	err  error
+}
+
+// selectMode returns the mode of the HTTPREPLAY_MODE value, or the mode of the build tag if the value is empty
+func selectMode(name string, buildTagMode *Mode) (Mode, error) {
+	mode, err := ParseMode(name)
+	if err != nil || buildTagMode == nil {
+		return mode, err
+	}
+	if strings.TrimSpace(name) == "" {
+		return *buildTagMode, nil
+	}
+	if mode != *buildTagMode {
+		return ModeDisabled, fmt.Errorf("%s '%s' conflicts with the '%s' build tag", ModeEnv, mode, *buildTagMode)
+	}
+	return mode, nil
+}
+
+// GetMode returns the mode selected by the environment, the mode is read once per process
+// The bypass mode is used if the mode is invalid, SetScenario and InstallRecorder return the error of the mode in that case
+// so that the tests are not run against the services instead
+func GetMode() Mode {
+	currentMode.once.Do(func() {
+		currentMode.mode, currentMode.err = selectMode(os.Getenv(ModeEnv), buildTagMode)
+		if currentMode.err != nil {
+			log.Printf("[ERROR] %v", currentMode.err)
+		}
+	})
+	return currentMode.mode
+}
+
+// modeError returns the error of the mode selected by the environment, if it is invalid
+func modeError() error {
+	GetMode()
+	return currentMode.err
+}
+
+// SetMode overrides the mode selected by the environment, it must be called before the scenarios are set
+func SetMode(mode Mode) {
+	currentMode.once.Do(func() {})
+	currentMode.mode = mode
+	currentMode.err = nil
+}
+
+var recorder *Recorder
+
+// SetScenario lets the recorder know which test is currently executing, and creates a new recorder for this scenario
+func SetScenario(name string) error {
+	if err := modeError(); err != nil {
+		return err
+	}
+	var err error
+	switch mode := GetMode(); mode {
+	case ModeDisabled:
+		debugLogf("Not recording. %s", name)
+	case ModeRecording:
+		if recorder, err = NewRecorderAsMode(name, ModeRecording); err == nil {
+			debugLogf("Making a new recorder '%s' success", name)
+		} else {
+			debugLogf("Making a new recorder '%s' failed, %v", name, err)
+		}
+	default:
+		if recorder, err = NewRecorderAsMode(name, mode); err == nil {
+			if mode == ModeReplaying {
+				// cleanup existing files in /tmp folder
+				RemoveContents("/tmp")
+			}
+			recorder.SetMatcher(matcher)
+			recorder.SetTransformer(recorder.scenario.transformer)
+			recorder.SetStrict(mode == ModeReplaying && IsStrict())
+		}
+	}
+	return err
+}
+
+// TestingT is the part of testing.TB used by UseScenario
+type TestingT interface {
+	Helper()
+	Errorf(format string, args ...interface{})
+	Fatalf(format string, args ...interface{})
+	Cleanup(func())
+}
+
+// UseScenario sets the scenario of the test and saves it once the test and its subtests end, it is an alternative to
+// calling SetScenario and SaveScenario. The test fails if the scenario can not be set or saved, or if some recorded
+// interactions were never replayed in strict replay mode
+func UseScenario(t TestingT, name string) {
+	t.Helper()
+	if err := SetScenario(name); err != nil {
+		t.Fatalf("%v", err)
+		return
+	}
+	t.Cleanup(func() {
+		if err := SaveScenario(); err != nil {
+			t.Errorf("%v", err)
+		}
+	})
+}
+
+// SaveScenario saves the recorded service calls for the current scenario
+func SaveScenario() error {
+	if recorder == nil {
+		return nil
+	}
+	debugLogf("Saving the recorder")
+	err := recorder.Stop()
+	recorder = nil
+	return err
+}
+
+// InstallRecorder puts the recording transport into the http client, then returns a type that is compatible with the SDK's HTTPRequestDispatcher
+// It does no-op in bypass mode.
+func InstallRecorder(client *http.Client) (HTTPRecordingClient, error) {
+	if err := modeError(); err != nil {
+		return nil, err
+	}
+	if GetMode() == ModeDisabled {
+		return client, nil
+	}
+	return InstallRecorderForRecodReplay(client, recorder)
+}
+
+// ShouldRetryImmediately returns true if replaying
+func ShouldRetryImmediately() bool {
+	return GetMode() == ModeReplaying
+}
+
+// ModeRecordReplay returns true in all the modes except bypass
+func ModeRecordReplay() bool {
+	return GetMode() != ModeDisabled
+}
+
+func RemoveContents(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	names, err := d.Readdirnames(-1)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if strings.Contains(name, ".yaml") {
+			err = os.RemoveAll(filepath.Join(dir, name))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
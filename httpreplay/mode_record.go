@@ -0,0 +1,13 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+//go:build record
+// +build record
+
+package httpreplay
+
+// The legacy `record` build tag selects the record mode if HTTPREPLAY_MODE is not set
+func init() {
+	mode := ModeRecording
+	buildTagMode = &mode
+}
@@ -0,0 +1,13 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+//go:build replay && !record
+// +build replay,!record
+
+package httpreplay
+
+// The legacy `replay` build tag selects the replay mode if HTTPREPLAY_MODE is not set
+func init() {
+	mode := ModeReplaying
+	buildTagMode = &mode
+}
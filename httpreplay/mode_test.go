@@ -0,0 +1,178 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Run with a command something like:
+//   go test -run TestMode
+
+package httpreplay
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestModeParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		mode    Mode
+		wantErr bool
+	}{
+		{"", ModeDisabled, false},
+		{"bypass", ModeDisabled, false},
+		{"record", ModeRecording, false},
+		{" Replay ", ModeReplaying, false},
+		{"record-missing", ModeRecordingMissing, false},
+		{"playback", ModeDisabled, true},
+	}
+	for _, test := range tests {
+		mode, err := ParseMode(test.name)
+		if mode != test.mode || (err != nil) != test.wantErr {
+			t.Errorf("ParseMode(%q) = %v, %v; expected %v, error: %v", test.name, mode, err, test.mode, test.wantErr)
+		}
+	}
+	if ModeRecordingMissing.String() != "record-missing" {
+		t.Errorf("Unexpected name of the record-missing mode: %s", ModeRecordingMissing)
+	}
+}
+
+func TestModeSelectBuildTag(t *testing.T) {
+	replay := ModeReplaying
+	tests := []struct {
+		name         string
+		buildTagMode *Mode
+		mode         Mode
+		wantErr      bool
+	}{
+		{"", nil, ModeDisabled, false},
+		{"record", nil, ModeRecording, false},
+		{"", &replay, ModeReplaying, false},
+		{"replay", &replay, ModeReplaying, false},
+		{"record", &replay, ModeDisabled, true},
+		{"playback", &replay, ModeDisabled, true},
+	}
+	for _, test := range tests {
+		mode, err := selectMode(test.name, test.buildTagMode)
+		if mode != test.mode || (err != nil) != test.wantErr {
+			t.Errorf("selectMode(%q, %v) = %v, %v; expected %v, error: %v", test.name, test.buildTagMode, mode, err, test.mode, test.wantErr)
+		}
+	}
+}
+
+func TestModeSetScenarioInvalidMode(t *testing.T) {
+	useMode(t, ModeDisabled)
+	currentMode.err = fmt.Errorf("invalid %s 'playback'", ModeEnv)
+
+	if err := SetScenario("TestModeSetScenarioInvalidMode"); err == nil {
+		t.Errorf("Expected an error when the mode is invalid")
+	}
+	if _, err := InstallRecorder(&http.Client{}); err == nil {
+		t.Errorf("Expected InstallRecorder to fail when the mode is invalid")
+	}
+	test := &fakeT{}
+	UseScenario(test, "TestModeSetScenarioInvalidMode")
+	if len(test.errors) != 1 || len(test.cleanups) != 0 {
+		t.Errorf("Expected the test to fail when the mode is invalid, got %v", test.errors)
+	}
+}
+
+// inTempDir runs the test in a temporary directory, the scenarios are saved under the `record` directory of the working directory
+func inTempDir(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(wd) })
+}
+
+func useMode(t *testing.T, mode Mode) {
+	previous := GetMode()
+	SetMode(mode)
+	t.Cleanup(func() { SetMode(previous) })
+}
+
+func runScenario(t *testing.T, name string, serverURL string, paths ...string) []string {
+	if err := SetScenario(name); err != nil {
+		t.Fatalf("SetScenario failed: %v", err)
+	}
+	client := &http.Client{Transport: http.DefaultTransport}
+	if _, err := InstallRecorder(client); err != nil {
+		t.Fatalf("InstallRecorder failed: %v", err)
+	}
+
+	var bodies []string
+	for _, path := range paths {
+		response, err := client.Post(serverURL+path, "application/json", strings.NewReader(`{"name": "test"}`))
+		if err != nil {
+			t.Fatalf("Request to %s failed: %v", path, err)
+		}
+		body, _ := ioutil.ReadAll(response.Body)
+		response.Body.Close()
+		// The replayed bodies are re-encoded, ignore the formatting of the bodies in the comparisons
+		bodies = append(bodies, strings.ReplaceAll(string(body), " ", ""))
+	}
+
+	if err := SaveScenario(); err != nil {
+		t.Fatalf("SaveScenario failed: %v", err)
+	}
+	return bodies
+}
+
+func TestModeRecordMissing(t *testing.T) {
+	inTempDir(t)
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		body, _ := ioutil.ReadAll(r.Body)
+		fmt.Fprintf(w, `{"body":%s,"path":"%s","request":%d}`, body, r.URL.Path, requests)
+	}))
+	defer server.Close()
+
+	useMode(t, ModeRecording)
+	recorded := runScenario(t, "TestModeRecordMissing", server.URL, "/first")
+	if requests != 1 {
+		t.Fatalf("Expected 1 request to be sent in record mode, got %d", requests)
+	}
+
+	// The recorded interaction is replayed once, the new and repeated requests are recorded
+	useMode(t, ModeRecordingMissing)
+	bodies := runScenario(t, "TestModeRecordMissing", server.URL, "/first", "/second", "/first")
+	if requests != 3 {
+		t.Errorf("Expected 2 new requests to be sent in record-missing mode, got %d", requests-1)
+	}
+	if bodies[0] != recorded[0] {
+		t.Errorf("Expected the recorded response %s to be replayed, got %s", recorded[0], bodies[0])
+	}
+	if !strings.Contains(bodies[1], `"request":2`) || !strings.Contains(bodies[1], `"name":"test"`) {
+		t.Errorf("Expected the missing interaction to be sent with its body, got %s", bodies[1])
+	}
+
+	// All the interactions are replayed from the scenario file afterwards
+	useMode(t, ModeReplaying)
+	bodies = runScenario(t, "TestModeRecordMissing", server.URL, "/first", "/second")
+	if requests != 3 {
+		t.Errorf("Expected no request to be sent in replay mode, got %d", requests-3)
+	}
+	if !strings.Contains(bodies[1], `"request":2`) {
+		t.Errorf("Expected the interaction recorded in record-missing mode to be replayed, got %s", bodies[1])
+	}
+}
+
+func TestModeBypass(t *testing.T) {
+	useMode(t, ModeDisabled)
+	client := &http.Client{Transport: http.DefaultTransport}
+	if _, err := InstallRecorder(client); err != nil || client.Transport != http.DefaultTransport {
+		t.Errorf("Expected the transport to be unchanged in bypass mode")
+	}
+	if ModeRecordReplay() || ShouldRetryImmediately() {
+		t.Errorf("Expected bypass mode to neither record nor replay")
+	}
+}
@@ -25,6 +25,8 @@ const (
 	ModeRecording Mode = iota
 	ModeReplaying
 	ModeDisabled
+	// ModeRecordingMissing replays the recorded interactions and records the requests that do not match any of them
+	ModeRecordingMissing
 )
 
 // Transformer converts a request and a saved interaction into a result.  The Interaction is passed by value to suggest that it should not be modified.
@@ -44,7 +46,7 @@ type roundTripperProxy struct {
 
 func (rtp *roundTripperProxy) RoundTrip(r *http.Request) (*http.Response, error) {
 	res, err := rtp.recorder.RoundTrip(r, rtp.chained)
-	if err != nil && err.Error() == "Requested interaction not found" {
+	if errors.Is(err, ErrInteractionNotFound) {
 		debugLogf("stop RoundTrip for err: %v", err)
 		panic(err)
 	}
@@ -70,6 +72,13 @@ type Recorder struct {
 
 	// count is for debug logging -- how many requests have been matched
 	count int
+
+	// replayable is the number of interactions loaded from the scenario file in record-missing mode
+	// Each of them is replayed once, the interactions recorded in this run are never replayed
+	replayable int
+
+	// strict requires the requests to exactly match the recorded interactions in replay mode
+	strict bool
 }
 
 // HookTransport makes a new transport and chains the one passed in with it, returning the new one
@@ -122,8 +131,14 @@ func (r *Recorder) invokeTransformer(req *http.Request) (*Interaction, *Response
 		Method:     req.Method,
 	}
 
-	i, err := r.scenario.GetInteraction(request)
-	if err != nil {
+	var i *Interaction
+	var err error
+	if r.strict {
+		if i, err = r.scenario.GetStrictInteraction(request); err != nil {
+			debugLogf("\t-> Returning error from invokeTransformer: %v", err)
+			return nil, nil, err
+		}
+	} else if i, err = r.scenario.GetInteraction(request); err != nil {
 		if err.Error() == "Requested interaction not found" {
 			debugLogf("\t-> Convert full path of request to find Interaction:")
 			i, err = r.scenario.GetInteractionWithFullPath(request)
@@ -204,7 +219,6 @@ func (r *Recorder) recordInteraction(req *http.Request, realTransport http.Round
 		return nil, nil, err
 	}
 
-	req.Header.Del("Authorization")
 	respBody, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
 		debugLogf("-=-=-=- Error from ioutil.ReadAll: %v", err)
@@ -227,7 +241,9 @@ func (r *Recorder) recordInteraction(req *http.Request, realTransport http.Round
 			Code:    resp.StatusCode,
 		},
 	}
-	r.scenario.AddInteraction(interaction)
+	// The scenario keeps a scrubbed copy, the requestor gets the actual response
+	scrubbed := r.scenario.scrubber.ScrubInteraction(*interaction)
+	r.scenario.AddInteraction(&scrubbed)
 
 	return interaction, &interaction.Response, nil
 }
@@ -237,6 +253,47 @@ func (r *Recorder) requestHandler(req *http.Request, realTransport http.RoundTri
 	if r.mode == ModeReplaying {
 		return r.invokeTransformer(req)
 	}
+	if r.mode == ModeRecordingMissing {
+		return r.replayOrRecordInteraction(req, realTransport)
+	}
+	return r.recordInteraction(req, realTransport)
+}
+
+// replayOrRecordInteraction returns the recorded interaction matching the request, or records a new interaction if there is none
+func (r *Recorder) replayOrRecordInteraction(req *http.Request, realTransport http.RoundTripper) (*Interaction, *Response, error) {
+	// The body is read to look up the recorded interactions, keep a copy to send the request if it is not found
+	var reqBody []byte
+	if req.Body != nil {
+		var err error
+		if reqBody, err = ioutil.ReadAll(req.Body); err != nil {
+			return nil, nil, err
+		}
+		req.Body.Close()
+	}
+	resetBody := func() {
+		if req.Body != nil {
+			req.Body = ioutil.NopCloser(bytes.NewReader(reqBody))
+		}
+		req.ContentLength = int64(len(reqBody))
+	}
+
+	resetBody()
+	if r.replayable > 0 {
+		interaction, response, err := r.invokeTransformer(req)
+		if err == nil && interaction.Index < r.replayable && r.scenario.Interactions[interaction.Index].Uses == 1 {
+			return interaction, response, nil
+		}
+		if err != nil && !errors.Is(err, ErrInteractionNotFound) {
+			return nil, nil, err
+		}
+	}
+
+	debugLogf("\t-> No recorded interaction for %s %s, recording it", req.Method, req.URL.String())
+	// The values of the placeholders learned from the replayed interactions keep their placeholders
+	mut.RLock()
+	r.scenario.scrubber.learnPlaceholders(r.scenario.Fields)
+	mut.RUnlock()
+	resetBody()
 	return r.recordInteraction(req, realTransport)
 }
 
@@ -300,6 +357,14 @@ func NewRecorderAsMode(scenarioName string, mode Mode) (*Recorder, error) {
 		if mode == ModeRecording {
 			// Create new scenario and enter in recording mode
 			s = NewScenario(scenarioName)
+		} else if mode == ModeRecordingMissing {
+			// Load scenario from file if it exists, the missing interactions are added to it
+			if s, err = Load(scenarioName); err != nil {
+				if !os.IsNotExist(err) {
+					return nil, err
+				}
+				s = NewScenario(scenarioName)
+			}
 		} else {
 			// Load scenario from file and enter replay mode
 			s, err = Load(scenarioName)
@@ -309,27 +374,52 @@ func NewRecorderAsMode(scenarioName string, mode Mode) (*Recorder, error) {
 			mode = ModeReplaying
 		}
 	}
+	if mode == ModeRecording || mode == ModeRecordingMissing {
+		// Scrub the secrets of the interactions before they are added to the scenario
+		if s.scrubber, err = NewScrubberFromEnv(); err != nil {
+			return nil, err
+		}
+	}
 
 	r := &Recorder{
 		mode:        mode,
 		scenario:    s,
 		transformer: defaultTransformer,
 	}
+	if mode == ModeRecordingMissing {
+		r.replayable = len(s.Interactions)
+		s.scrubber.seedPlaceholders(s.Interactions)
+	}
 
 	return r, nil
 }
 
 // Stop is used to stop the recorder and save any recorded interactions
+// In strict replay mode, it returns an error if some recorded interactions were never replayed
 func (r *Recorder) Stop() error {
-	if r.mode == ModeRecording {
+	if r.mode == ModeRecording || r.mode == ModeRecordingMissing {
 		if err := r.scenario.Save(); err != nil {
 			return err
 		}
 	}
+	if r.mode == ModeReplaying {
+		if unused := r.scenario.UnusedInteractions(); len(unused) > 0 {
+			err := &UnusedInteractionsError{Scenario: r.scenario.Name, Interactions: unused}
+			debugLogf("%v", err)
+			if r.strict {
+				return err
+			}
+		}
+	}
 
 	return nil
 }
 
+// SetStrict enables the strict matching of the requests in replay mode
+func (r *Recorder) SetStrict(strict bool) {
+	r.strict = strict
+}
+
 // RoundTrip implements the http.RoundTripper interface
 func (r *Recorder) RoundTrip(req *http.Request, realTransport http.RoundTripper) (*http.Response, error) {
 	if r.mode == ModeDisabled {
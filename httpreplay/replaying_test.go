@@ -68,14 +68,34 @@ func TestTransform(t *testing.T) {
 			ExpectedResponse: Response{Body: `{"displayName": "afterr (block storage)"}`},
 		},
 		transformTestCase{
-			Description: `Displayname adds " (block storage)" -> no change if first part different length`,
+			Description: `Displayname adds " (block storage)" -> first part replaced by a shorter value`,
 			Interaction: Interaction{
 				Request:  Request{Body: `{"displayName": "longerkey"}`},
 				Response: Response{Body: `{"displayName": "longerkey (block storage)"}`},
 			},
 			Request:          Request{Body: `{"displayName": "short"}`},
 			Response:         Response{Body: `{"displayName": "longerkey (block storage)"}`},
-			ExpectedResponse: Response{Body: `{"displayName": "longerkey (block storage)"}`},
+			ExpectedResponse: Response{Body: `{"displayName": "short (block storage)"}`},
+		},
+		transformTestCase{
+			Description: `Scrubbed displayName adds " (block storage)" -> scrubbed first part replaced by a shorter value`,
+			Interaction: Interaction{
+				Request:  Request{Body: `{"displayName": "scrubbed-display-name-1"}`},
+				Response: Response{Body: `{"displayName": "scrubbed-display-name-1 (block storage)"}`},
+			},
+			Request:          Request{Body: `{"displayName": "short"}`},
+			Response:         Response{Body: `{"displayName": "scrubbed-display-name-1 (block storage)"}`},
+			ExpectedResponse: Response{Body: `{"displayName": "short (block storage)"}`},
+		},
+		transformTestCase{
+			Description: `Change to a displayName in a list -> change in the listed value`,
+			Interaction: Interaction{
+				Request:  Request{Body: `{"displayName": "before"}`},
+				Response: Response{Body: `{"displayNames": ["before", "other"]}`},
+			},
+			Request:          Request{Body: `{"displayName": "after"}`},
+			Response:         Response{Body: `{"displayNames": ["before", "other"]}`},
+			ExpectedResponse: Response{Body: `{"displayNames": ["after", "other"]}`},
 		},
 	}
 
@@ -101,7 +121,7 @@ func TestTransform(t *testing.T) {
 		if err != nil {
 			return
 		}
-		transformer(&testCase.Request, testCase.Interaction, &testCase.Response)
+		NewScenario("TestTransform").transformer(&testCase.Request, testCase.Interaction, &testCase.Response)
 
 		// Re-marshal both responses to get them easily comparable
 		m := func(in interface{}, field string) string {
@@ -110,7 +130,7 @@ func TestTransform(t *testing.T) {
 			}
 			var result []byte
 			if result, err = json.Marshal(in); err != nil {
-				t.Error("unable to marshal test %v %v %v", testCase.Description, field, err)
+				t.Errorf("unable to marshal test %v %v %v", testCase.Description, field, err)
 			}
 			return string(result)
 		}
@@ -8,23 +8,22 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
-	"log"
 	"net/http"
 	"net/url"
 	"os"
-	"path/filepath"
 	"reflect"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
-
-	yaml "gopkg.in/yaml.v2"
 )
 
 // Scenario format versions
 const (
+	// YAML file with the parsed bodies
 	scenarioFormatV1 = 1
+	// Gzip-compressed JSON Lines file with deduplicated bodies
+	scenarioFormatV2 = 2
 )
 
 var (
@@ -98,7 +97,10 @@ func matcher(n int, r *Request, i *Request) bool {
 		return false
 	}
 	if rUrl != iUrl {
-		return false
+		// The values scrubbed from the recorded URL are learned when the request is replayed
+		if _, ok := matchScrubbed(iUrl, rUrl); !ok {
+			return false
+		}
 	}
 	return true
 }
@@ -133,6 +135,9 @@ type Scenario struct {
 
 	// Fields keeps track between old values(in recorded yaml file) and new values(in replay request)
 	Fields map[string]string
+
+	// Scrubs the interactions added to the scenario
+	scrubber *Scrubber `yaml:"-"`
 }
 
 // Implementations of sort.Interface to give us different orderings.
@@ -168,8 +173,8 @@ func (a byIndex) Less(i, j int) bool {
 func NewScenario(name string) *Scenario {
 	s := &Scenario{
 		Name:               name,
-		File:               fmt.Sprintf("%s.yaml", name),
-		Version:            scenarioFormatV1,
+		File:               name + compactScenarioExtension,
+		Version:            scenarioFormatV2,
 		Interactions:       make(Interactions, 0),
 		sortedInteractions: make(Interactions, 0),
 		Fields:             make(map[string]string),
@@ -178,10 +183,14 @@ func NewScenario(name string) *Scenario {
 	return s
 }
 
-// Load reads a scenario file from disk
+// Load reads a scenario file from disk, the scenarios recorded in a previous format are migrated to the current format
 func Load(name string) (*Scenario, error) {
 	s := NewScenario(name)
-	fileName := "record/" + s.File
+	fileName, err := scenarioFileName(name)
+	if err != nil {
+		debugLogf(err.Error())
+		return nil, err
+	}
 
 	data, err := ioutil.ReadFile(fileName)
 
@@ -190,7 +199,7 @@ func Load(name string) (*Scenario, error) {
 		return nil, err
 	}
 
-	err = yaml.Unmarshal(data, &s)
+	err = s.decode(data)
 	for index := range s.Interactions {
 		s.Interactions[index].Index = index
 	}
@@ -203,6 +212,7 @@ func Load(name string) (*Scenario, error) {
 var calls = 0
 
 func (s *Scenario) transformer(req *Request, i Interaction, res *Response) {
+	s.updateFieldMapFromURL(req, &i)
 	if req.BodyParsed != nil {
 		s.updateFieldMap(req, &i)
 	}
@@ -305,6 +315,8 @@ func (s *Scenario) GetInteractionWithQueryStringFromList(r Request, list []*Inte
 
 					if strings.EqualFold(strings.Join(m1SortedList, "_"), strings.Join(m2SortedList, "_")) {
 						credit++
+					} else if _, ok := matchScrubbed(m2List[i], m1List[i]); ok {
+						credit++
 					}
 				}
 			}
@@ -423,51 +435,18 @@ func (s *Scenario) Reset() {
 }
 
 // Save writes the scenario data on disk for future re-use
+// The scenario is written in the current format, the file of a previous format is removed
 func (s *Scenario) Save() error {
 	s.Mu.RLock()
 	defer s.Mu.RUnlock()
 
-	fileName := "record/" + s.File
-	// Create directory for scenario if missing
-	scenarioDir := filepath.Dir(fileName)
-	if _, err := os.Stat(scenarioDir); os.IsNotExist(err) {
-		if err = os.MkdirAll(scenarioDir, 0755); err != nil {
-			return err
-		}
-	}
-
-	// Marshal to YAML and save interactions
 	s.Reset()
-	data, err := yaml.Marshal(s)
-	if err != nil {
-		return err
-	}
-
-	f, err := os.Create(fileName)
-	if err != nil {
-		return err
-	}
-	defer func(f *os.File) {
-		err := f.Close()
-		if err != nil {
-			log.Fatal(err)
-		}
-	}(f)
-
-	// Honor the YAML structure specification
-	// http://www.yaml.org/spec/1.2/spec.html#id2760395
-	_, err = f.Write([]byte("---\n"))
-	if err != nil {
-		return err
-	}
-
-	_, err = f.Write(data)
-	if err != nil {
+	if err := s.writeFile("record/" + s.File); err != nil {
 		return err
 	}
 
-	_, err = f.Seek(0, 0)
-	if err != nil {
+	legacyFileName := "record/" + s.Name + legacyScenarioExtension
+	if err := os.Remove(legacyFileName); err != nil && !os.IsNotExist(err) {
 		return err
 	}
 
@@ -547,6 +526,31 @@ func (s *Scenario) updateFieldMap(req *Request, i *Interaction) {
 	}
 }
 
+// updateFieldMapFromURL learns the actual values of the placeholders scrubbed from the path and the query of the recorded URL,
+// so that they also replace the placeholders in the response
+func (s *Scenario) updateFieldMapFromURL(req *Request, i *Interaction) {
+	learn := func(recorded string, actual string) {
+		values, _ := matchScrubbed(recorded, actual)
+		for placeholder, value := range values {
+			s.Fields[placeholder] = value
+		}
+	}
+
+	learn(stripQuery(i.Request.URL), stripQuery(req.URL))
+	recordedURL, recordedErr := url.Parse(i.Request.URL)
+	actualURL, actualErr := url.Parse(req.URL)
+	if recordedErr != nil || actualErr != nil {
+		return
+	}
+	actualQuery := actualURL.Query()
+	for key, recordedValues := range recordedURL.Query() {
+		actualValues := actualQuery[key]
+		for idx := 0; idx < len(recordedValues) && idx < len(actualValues); idx++ {
+			learn(recordedValues[idx], actualValues[idx])
+		}
+	}
+}
+
 func (s *Scenario) updateInternalFieldMap(oldValue, newValue interface{}) {
 	if stringOldValue, ok := oldValue.(string); ok {
 		if stringNewValue, ok := newValue.(string); ok && strings.EqualFold(stringOldValue, stringNewValue) == false {
@@ -617,7 +621,7 @@ func (s *Scenario) updateBody(body jsonObj) {
 
 func (s *Scenario) bodyValueHandle(body jsonObj, val string, key string) {
 	for oldVal, changedVal := range s.Fields {
-		if len(changedVal) > 1 && len(val) >= len(changedVal) && strings.Contains(val, oldVal) {
+		if len(changedVal) > 1 && (len(val) >= len(changedVal) || isScrubbedPlaceholder(oldVal)) && strings.Contains(val, oldVal) {
 			body[key] = strings.Replace(val, oldVal, changedVal, -1)
 		}
 	}
@@ -625,7 +629,7 @@ func (s *Scenario) bodyValueHandle(body jsonObj, val string, key string) {
 
 func (s *Scenario) bodyArrayValueHandle(itemVal []interface{}, val string, index int) {
 	for oldVal, changedVal := range s.Fields {
-		if len(changedVal) > 1 && len(val) >= len(changedVal) && strings.Contains(val, oldVal) {
+		if len(changedVal) > 1 && (len(val) >= len(changedVal) || isScrubbedPlaceholder(oldVal)) && strings.Contains(val, oldVal) {
 			itemVal[index] = strings.Replace(val, oldVal, changedVal, -1)
 		}
 	}
@@ -0,0 +1,434 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package httpreplay
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	yaml "gopkg.in/yaml.v2"
+)
+
+// ScrubRulesEnv is the environment variable with the path of a YAML file of scrubbing rules, they are added to the default rules
+const ScrubRulesEnv = "HTTPREPLAY_SCRUB_RULES"
+
+const (
+	scrubbedHeaderValue = "scrubbed"
+	scrubbedBody        = "scrubbed-body"
+)
+
+// ScrubRules are the rules applied to the interactions before they are added to a scenario, so that the recordings
+// do not contain secrets nor personal information
+type ScrubRules struct {
+	// DisableDefaultRules only applies the rules of the file, instead of adding them to the default rules
+	DisableDefaultRules bool `yaml:"disable_default_rules"`
+
+	// Headers are the names of the request and response headers whose values are scrubbed
+	Headers []string `yaml:"headers"`
+
+	// JSONPaths are the paths of the values scrubbed from the JSON bodies, matched against the end of the path of the
+	// values: `adminPassword` matches the key at any depth, and `*` matches any key, e.g. `credentials.*.secret`
+	JSONPaths []string `yaml:"json_paths"`
+
+	// Patterns are the regular expressions scrubbed from the URLs, form values, header values and bodies
+	Patterns []ScrubPattern `yaml:"patterns"`
+
+	// BodyContentTypes are the content types of the bodies scrubbed entirely, e.g. wallets
+	BodyContentTypes []string `yaml:"body_content_types"`
+}
+
+// ScrubPattern is a regular expression scrubbed from the interactions, only the first group is scrubbed if the expression has groups
+type ScrubPattern struct {
+	// Name is used in the placeholders of the values, e.g. `scrubbed-ip-1`
+	Name  string `yaml:"name"`
+	Regex string `yaml:"regex"`
+
+	// Except are regular expressions of the matched values that are not scrubbed
+	Except []string `yaml:"except"`
+}
+
+var defaultScrubRules = ScrubRules{
+	Headers: []string{"Authorization", "opc-obo-token", "Cookie", "Set-Cookie", "x-content-sha256"},
+	JSONPaths: []string{
+		"adminPassword", "password", "passphrase", "privateKey", "privateKeyPassword",
+		"secretContent.content", "sshPrivateKey", "walletPassword", "tdeWalletPassword",
+	},
+	Patterns: []ScrubPattern{
+		{
+			Name:  "private-key",
+			Regex: `-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`,
+		},
+		{
+			Name:  "ocid",
+			Regex: `ocid1\.(?:tenancy|user)\.[\w\-]*\.[\w\-]*\.[\w\-]+`,
+		},
+		{
+			Name:  "ip",
+			Regex: `\b(?:(?:25[0-5]|2[0-4]\d|1?\d?\d)\.){3}(?:25[0-5]|2[0-4]\d|1?\d?\d)\b`,
+			// The private and reserved addresses do not identify a tenancy
+			Except: []string{`^10\.`, `^172\.(?:1[6-9]|2\d|3[01])\.`, `^192\.168\.`, `^127\.`, `^0\.`, `^169\.254\.`, `^255\.`},
+		},
+	},
+	BodyContentTypes: []string{"application/zip", "application/octet-stream", "application/x-pkcs12"},
+}
+
+type compiledScrubPattern struct {
+	name   string
+	regex  *regexp.Regexp
+	except []*regexp.Regexp
+}
+
+// Scrubber scrubs the interactions of a scenario, the same value is always replaced by the same placeholder so that
+// the values learned in the Fields of the scenario at replay time also replace the placeholders in the responses
+type Scrubber struct {
+	headers          map[string]bool
+	jsonPaths        [][]string
+	patterns         []compiledScrubPattern
+	bodyContentTypes map[string]bool
+
+	mutex        sync.Mutex
+	placeholders map[string]string
+	counters     map[string]int
+}
+
+// NewScrubber compiles the scrubbing rules
+func NewScrubber(rules ScrubRules) (*Scrubber, error) {
+	s := &Scrubber{
+		headers:          map[string]bool{},
+		bodyContentTypes: map[string]bool{},
+		placeholders:     map[string]string{},
+		counters:         map[string]int{},
+	}
+	for _, header := range rules.Headers {
+		s.headers[http.CanonicalHeaderKey(header)] = true
+	}
+	for _, path := range rules.JSONPaths {
+		s.jsonPaths = append(s.jsonPaths, strings.Split(strings.ToLower(path), "."))
+	}
+	for _, contentType := range rules.BodyContentTypes {
+		s.bodyContentTypes[strings.ToLower(contentType)] = true
+	}
+	for _, pattern := range rules.Patterns {
+		regex, err := regexp.Compile(pattern.Regex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid regex of scrub pattern '%s': %v", pattern.Name, err)
+		}
+		compiled := compiledScrubPattern{name: pattern.Name, regex: regex}
+		for _, except := range pattern.Except {
+			exceptRegex, err := regexp.Compile(except)
+			if err != nil {
+				return nil, fmt.Errorf("invalid except regex of scrub pattern '%s': %v", pattern.Name, err)
+			}
+			compiled.except = append(compiled.except, exceptRegex)
+		}
+		s.patterns = append(s.patterns, compiled)
+	}
+	return s, nil
+}
+
+// LoadScrubRules reads a YAML file of scrubbing rules and merges them with the default rules
+func LoadScrubRules(fileName string) (ScrubRules, error) {
+	var rules ScrubRules
+	data, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return rules, err
+	}
+	if err = yaml.UnmarshalStrict(data, &rules); err != nil {
+		return rules, fmt.Errorf("unable to parse scrub rules %s: %v", fileName, err)
+	}
+	if rules.DisableDefaultRules {
+		return rules, nil
+	}
+	return ScrubRules{
+		Headers:          append(append([]string{}, defaultScrubRules.Headers...), rules.Headers...),
+		JSONPaths:        append(append([]string{}, defaultScrubRules.JSONPaths...), rules.JSONPaths...),
+		Patterns:         append(append([]ScrubPattern{}, defaultScrubRules.Patterns...), rules.Patterns...),
+		BodyContentTypes: append(append([]string{}, defaultScrubRules.BodyContentTypes...), rules.BodyContentTypes...),
+	}, nil
+}
+
+// NewScrubberFromEnv returns a scrubber of the default rules and the rules of the file set in the environment
+func NewScrubberFromEnv() (*Scrubber, error) {
+	rules := defaultScrubRules
+	if fileName := os.Getenv(ScrubRulesEnv); fileName != "" {
+		var err error
+		if rules, err = LoadScrubRules(fileName); err != nil {
+			return nil, err
+		}
+	}
+	return NewScrubber(rules)
+}
+
+var scrubbedPlaceholderRegex = regexp.MustCompile(`^scrubbed-[\w\-]+-\d+$`)
+
+// isScrubbedPlaceholder returns true if the recorded value is the placeholder of a scrubbed value, it is always
+// replaced by the value learned at replay time whatever their lengths
+func isScrubbedPlaceholder(value string) bool {
+	return scrubbedPlaceholderRegex.MatchString(value)
+}
+
+var scrubbedPlaceholderInValueRegex = regexp.MustCompile(`scrubbed-[\w\-]+?-\d+`)
+
+// matchScrubbed matches an actual value against a recorded value whose placeholders stand for any value without the
+// separators of the URLs, e.g. a scrubbed OCID in the path, it returns the actual values of the placeholders
+func matchScrubbed(recorded string, actual string) (map[string]string, bool) {
+	return matchPlaceholders(recorded, actual, `([^/?&=#]+)`)
+}
+
+// matchScrubbedText matches an actual value against a recorded value of a body whose placeholders stand for any
+// non-empty value, e.g. a scrubbed password or private key
+func matchScrubbedText(recorded string, actual string) (map[string]string, bool) {
+	return matchPlaceholders(recorded, actual, `([\s\S]+?)`)
+}
+
+func matchPlaceholders(recorded string, actual string, valueExpr string) (map[string]string, bool) {
+	locations := scrubbedPlaceholderInValueRegex.FindAllStringIndex(recorded, -1)
+	if len(locations) == 0 {
+		return nil, false
+	}
+	expr := &strings.Builder{}
+	expr.WriteString("^")
+	placeholders := make([]string, 0, len(locations))
+	last := 0
+	for _, location := range locations {
+		expr.WriteString(regexp.QuoteMeta(recorded[last:location[0]]))
+		expr.WriteString(valueExpr)
+		placeholders = append(placeholders, recorded[location[0]:location[1]])
+		last = location[1]
+	}
+	expr.WriteString(regexp.QuoteMeta(recorded[last:]))
+	expr.WriteString("$")
+
+	submatches := regexp.MustCompile(expr.String()).FindStringSubmatch(actual)
+	if submatches == nil {
+		return nil, false
+	}
+	values := make(map[string]string, len(placeholders))
+	for idx, placeholder := range placeholders {
+		// The same placeholder always stands for the same value
+		if value, ok := values[placeholder]; ok && value != submatches[idx+1] {
+			return nil, false
+		}
+		values[placeholder] = submatches[idx+1]
+	}
+	return values, true
+}
+
+// placeholder returns the placeholder of a value, the same value always gets the same placeholder
+func (s *Scrubber) placeholder(name string, value string) string {
+	if value == "" {
+		return value
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if placeholder, ok := s.placeholders[value]; ok {
+		return placeholder
+	}
+	name = strings.ToLower(name)
+	s.counters[name]++
+	placeholder := fmt.Sprintf("scrubbed-%s-%d", name, s.counters[name])
+	s.placeholders[value] = placeholder
+	return placeholder
+}
+
+var scrubbedPlaceholderPartsRegex = regexp.MustCompile(`^scrubbed-([\w\-]+)-(\d+)$`)
+
+// seedPlaceholders continues the numbering of the placeholders of the interactions loaded from a scenario file, so that
+// the values scrubbed from the interactions added to the scenario do not get the placeholders of other values
+func (s *Scrubber) seedPlaceholders(interactions Interactions) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	seed := func(value string) {
+		for _, placeholder := range scrubbedPlaceholderInValueRegex.FindAllString(value, -1) {
+			parts := scrubbedPlaceholderPartsRegex.FindStringSubmatch(placeholder)
+			if counter, err := strconv.Atoi(parts[2]); err == nil && counter > s.counters[parts[1]] {
+				s.counters[parts[1]] = counter
+			}
+		}
+	}
+	seedValues := func(values map[string][]string) {
+		for _, items := range values {
+			for _, item := range items {
+				seed(item)
+			}
+		}
+	}
+	for _, i := range interactions {
+		seed(i.Request.URL)
+		seed(i.Request.Body)
+		seedValues(i.Request.Form)
+		seedValues(i.Request.Headers)
+		seed(i.Response.Body)
+		seedValues(i.Response.Headers)
+	}
+}
+
+// learnPlaceholders maps the values learned at replay time to the placeholders they replace, so that the same values
+// get the same placeholders in the interactions added to the scenario
+func (s *Scrubber) learnPlaceholders(fields map[string]string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for placeholder, value := range fields {
+		if value == "" || !isScrubbedPlaceholder(placeholder) {
+			continue
+		}
+		if _, ok := s.placeholders[value]; !ok {
+			s.placeholders[value] = placeholder
+		}
+	}
+}
+
+func (s *Scrubber) scrubString(value string) string {
+	for _, pattern := range s.patterns {
+		value = pattern.regex.ReplaceAllStringFunc(value, func(match string) string {
+			submatches := pattern.regex.FindStringSubmatch(match)
+			secret := match
+			if len(submatches) > 1 {
+				secret = submatches[1]
+			}
+			for _, except := range pattern.except {
+				if except.MatchString(secret) {
+					return match
+				}
+			}
+			return strings.Replace(match, secret, s.placeholder(pattern.name, secret), 1)
+		})
+	}
+	return value
+}
+
+func (s *Scrubber) scrubHeaders(headers http.Header) http.Header {
+	if headers == nil {
+		return nil
+	}
+	scrubbed := make(http.Header, len(headers))
+	for key, values := range headers {
+		scrubbedValues := make([]string, len(values))
+		for idx, value := range values {
+			if s.headers[http.CanonicalHeaderKey(key)] {
+				scrubbedValues[idx] = scrubbedHeaderValue
+			} else {
+				scrubbedValues[idx] = s.scrubString(value)
+			}
+		}
+		scrubbed[key] = scrubbedValues
+	}
+	return scrubbed
+}
+
+func (s *Scrubber) scrubForm(form url.Values) url.Values {
+	if form == nil {
+		return nil
+	}
+	scrubbed := make(url.Values, len(form))
+	for key, values := range form {
+		for _, value := range values {
+			scrubbed.Add(key, s.scrubString(value))
+		}
+	}
+	return scrubbed
+}
+
+func (s *Scrubber) matchesJSONPath(path []string) bool {
+	for _, jsonPath := range s.jsonPaths {
+		if len(jsonPath) > len(path) {
+			continue
+		}
+		suffix := path[len(path)-len(jsonPath):]
+		matches := true
+		for idx, key := range jsonPath {
+			if key != "*" && key != suffix[idx] {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
+
+// scrubJSON replaces the string values of the JSON paths, the array elements have the path of their array
+func (s *Scrubber) scrubJSON(value interface{}, path []string, scrubbed *bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		// The keys are sorted so that the same values get the same placeholders in every recording
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			v[key] = s.scrubJSON(v[key], append(path[:len(path):len(path)], strings.ToLower(key)), scrubbed)
+		}
+	case []interface{}:
+		for idx, item := range v {
+			v[idx] = s.scrubJSON(item, path, scrubbed)
+		}
+	case string:
+		if len(path) > 0 && s.matchesJSONPath(path) {
+			*scrubbed = true
+			return s.placeholder(path[len(path)-1], v)
+		}
+	}
+	return value
+}
+
+func (s *Scrubber) scrubBody(body string, headers http.Header) string {
+	if body == "" {
+		return body
+	}
+	if mediaType, _, err := mime.ParseMediaType(headers.Get("Content-Type")); err == nil && s.bodyContentTypes[strings.ToLower(mediaType)] {
+		return scrubbedBody
+	}
+
+	if len(s.jsonPaths) > 0 {
+		decoder := json.NewDecoder(strings.NewReader(body))
+		decoder.UseNumber()
+		var parsed interface{}
+		scrubbed := false
+		if err := decoder.Decode(&parsed); err == nil {
+			parsed = s.scrubJSON(parsed, nil, &scrubbed)
+		}
+		// The body is only re-encoded if values were scrubbed, to keep the recorded bodies as they were sent
+		if scrubbed {
+			buffer := &bytes.Buffer{}
+			encoder := json.NewEncoder(buffer)
+			encoder.SetEscapeHTML(false)
+			if err := encoder.Encode(parsed); err == nil {
+				body = strings.TrimSuffix(buffer.String(), "\n")
+			}
+		}
+	}
+	return s.scrubString(body)
+}
+
+// ScrubInteraction returns a scrubbed copy of the interaction, the interaction itself is not modified
+func (s *Scrubber) ScrubInteraction(i Interaction) Interaction {
+	if s == nil {
+		return i
+	}
+	scrubbed := i
+	scrubbed.Request.URL = s.scrubString(i.Request.URL)
+	scrubbed.Request.Form = s.scrubForm(i.Request.Form)
+	scrubbed.Request.Body = s.scrubBody(i.Request.Body, i.Request.Headers)
+	scrubbed.Request.Headers = s.scrubHeaders(i.Request.Headers)
+	scrubbed.Request.BodyParsed = nil
+	scrubbed.Response.Body = s.scrubBody(i.Response.Body, i.Response.Headers)
+	scrubbed.Response.Headers = s.scrubHeaders(i.Response.Headers)
+	scrubbed.Response.BodyParsed = nil
+	return scrubbed
+}
@@ -0,0 +1,233 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package httpreplay
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+)
+
+// Server is a local stand-in of the OCI APIs that serves the recorded interactions of a scenario
+//
+// The requests are matched with the recorded requests by method and path, whatever the host they were recorded for,
+// then by query parameters and body. The recorded interactions are served in the recorded order: a request gets the
+// first matching interaction that was not served yet, so that polling a resource or a work request goes through the
+// recorded lifecycle states. Once they were all served, the last matching interaction is served again, so that the
+// resource stays in its last recorded state. The reads are served from the interactions recorded before the next
+// recorded create, update or delete request, so that a resource does not reach the states of the next operations.
+//
+// Point the SDK clients of the provider at the server with the CLIENT_HOST_OVERRIDES environment variable.
+type Server struct {
+	scenario *Scenario
+	mutex    sync.Mutex
+
+	// lastOperation is the index of the last served interaction that is not a read
+	lastOperation int
+}
+
+// NewServer creates a stand-in server for the recorded interactions of a scenario
+func NewServer(scenario *Scenario) *Server {
+	return &Server{scenario: scenario, lastOperation: -1}
+}
+
+// NewServerForScenario loads a scenario from the record directory and creates a stand-in server for it
+func NewServerForScenario(name string) (*Server, error) {
+	scenario, err := Load(name)
+	if err != nil {
+		return nil, err
+	}
+	return NewServer(scenario), nil
+}
+
+// UnusedInteractions returns the recorded interactions that were never served
+func (s *Server) UnusedInteractions() []Interaction {
+	return s.scenario.UnusedInteractions()
+}
+
+// ServeHTTP implements the http.Handler interface
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeServerError(w, http.StatusBadRequest, "InvalidParameter", err.Error())
+		return
+	}
+	var bodyParsed interface{}
+	if len(body) != 0 {
+		bodyParsed, _ = unmarshal(body)
+	}
+	request := Request{
+		Body:       string(body),
+		BodyParsed: bodyParsed,
+		Headers:    r.Header,
+		URL:        r.URL.RequestURI(),
+		Method:     r.Method,
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	i := s.serveInteraction(request)
+	if i == nil {
+		debugLogf("-=-=-=- No recorded interaction for %s %s", r.Method, request.URL)
+		// The requests without interaction are not retried by the provider
+		writeServerError(w, http.StatusBadRequest, "NoRecordedInteraction",
+			fmt.Sprintf("no recorded interaction of scenario %s matches %s %s", s.scenario.Name, r.Method, request.URL))
+		return
+	}
+	debugLogf("-=-=-=- Serving interaction %d for %s %s", i.Index, r.Method, request.URL)
+
+	response := Response{Body: i.Response.Body}
+	if request.BodyParsed != nil {
+		i.Request.BodyParsed, _ = unmarshal([]byte(i.Request.Body))
+		s.scenario.updateFieldMap(&request, i)
+	}
+	if len(response.Body) > 0 && len(s.scenario.Fields) > 0 {
+		if response.BodyParsed, err = unmarshal([]byte(response.Body)); err == nil {
+			s.scenario.updateResFromFieldMap(&response)
+			if data, err := json.Marshal(response.BodyParsed); err == nil {
+				response.Body = string(data)
+			}
+		}
+	}
+
+	for key, values := range i.Response.Headers {
+		for _, value := range values {
+			w.Header().Add(key, value)
+		}
+	}
+	w.Header().Set("Content-Length", strconv.Itoa(len(response.Body)))
+	w.WriteHeader(i.Response.Code)
+	w.Write([]byte(response.Body))
+}
+
+// serveInteraction returns the best matching interaction of the request and counts its use, or nil if none matches
+func (s *Server) serveInteraction(r Request) *Interaction {
+	s.scenario.Mu.Lock()
+	defer s.scenario.Mu.Unlock()
+
+	candidates := s.scenario.candidateInteractions(r)
+	if len(candidates) == 0 {
+		// The identifiers of the request may replace recorded identifiers
+		if converted, err := s.scenario.ConverRequestWithFullPath(r); err == nil {
+			candidates = s.scenario.candidateInteractions(converted)
+		}
+	}
+
+	if isRead(r.Method) {
+		candidates = s.currentInteractions(candidates)
+	}
+
+	var served *Interaction
+	servedCredit := 0
+	for _, i := range candidates {
+		if credit := matchCredit(r, i); served == nil || isBetterInteraction(i, credit, served, servedCredit) {
+			served, servedCredit = i, credit
+		}
+	}
+	if served != nil {
+		s.scenario.updateUsageCount(served.Index)
+		if !isRead(served.Request.Method) {
+			s.lastOperation = served.Index
+		}
+	}
+	return served
+}
+
+func isRead(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+// currentInteractions returns the candidates recorded before the next operation that was not served yet,
+// or all the candidates if none of them was recorded before it
+func (s *Server) currentInteractions(candidates []*Interaction) []*Interaction {
+	nextOperation := len(s.scenario.Interactions)
+	for index := s.lastOperation + 1; index < len(s.scenario.Interactions); index++ {
+		if i := s.scenario.Interactions[index]; !isRead(i.Request.Method) && i.Uses == 0 {
+			nextOperation = index
+			break
+		}
+	}
+
+	var current []*Interaction
+	for _, i := range candidates {
+		if i.Index < nextOperation {
+			current = append(current, i)
+		}
+	}
+	if len(current) == 0 {
+		return candidates
+	}
+	return current
+}
+
+// isBetterInteraction returns true if an interaction should be served rather than the best interaction found so far
+// The interactions that were not served yet come first, then the interactions with the highest match credit,
+// then the first interaction that was not served yet or the last interaction that was served
+func isBetterInteraction(i *Interaction, credit int, best *Interaction, bestCredit int) bool {
+	if (i.Uses == 0) != (best.Uses == 0) {
+		return i.Uses == 0
+	}
+	if credit != bestCredit {
+		return credit > bestCredit
+	}
+	if i.Uses == 0 {
+		return i.Index < best.Index
+	}
+	return i.Index > best.Index
+}
+
+// candidateInteractions returns the interactions with the method and path of the request
+func (s *Scenario) candidateInteractions(r Request) []*Interaction {
+	requestURL, err := url.Parse(r.URL)
+	if err != nil {
+		return nil
+	}
+	var candidates []*Interaction
+	for index := range s.Interactions {
+		i := &s.Interactions[index]
+		recordedURL, err := url.Parse(i.Request.URL)
+		if err == nil && i.Request.Method == r.Method && recordedURL.Path == requestURL.Path {
+			candidates = append(candidates, i)
+		}
+	}
+	return candidates
+}
+
+// matchCredit returns the number of query parameters and body values of the request that match the recorded request
+func matchCredit(r Request, i *Interaction) int {
+	credit := 0
+	requestURL, requestErr := url.Parse(r.URL)
+	recordedURL, recordedErr := url.Parse(i.Request.URL)
+	if requestErr == nil && recordedErr == nil {
+		recordedQuery := recordedURL.Query()
+		for key, values := range requestURL.Query() {
+			if fmt.Sprint(recordedQuery[key]) == fmt.Sprint(values) {
+				credit++
+			}
+		}
+	}
+
+	if requestBody, ok := r.BodyParsed.(jsonObj); ok {
+		if recordedBody, ok := i.Request.BodyParsed.(jsonObj); ok {
+			credit += getBodyMatchCredit(recordedBody, requestBody)
+		} else if recordedBody, err := unmarshal([]byte(i.Request.Body)); err == nil {
+			if recordedObj, ok := recordedBody.(jsonObj); ok {
+				credit += getBodyMatchCredit(recordedObj, requestBody)
+			}
+		}
+	}
+	return credit
+}
+
+// writeServerError writes an error in the format of the OCI APIs
+func writeServerError(w http.ResponseWriter, status int, code string, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]string{"code": code, "message": message})
+}
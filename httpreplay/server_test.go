@@ -0,0 +1,98 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Run with a command something like:
+//   go test -run TestServer
+
+package httpreplay
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newServerScenario() *Scenario {
+	s := NewScenario("TestServer")
+	add := func(method string, url string, requestBody string, code int, responseBody string) {
+		s.AddInteraction(&Interaction{
+			Request: Request{Method: method, URL: "https://iaas.us-phoenix-1.oraclecloud.com" + url, Body: requestBody},
+			Response: Response{
+				Code:    code,
+				Status:  http.StatusText(code),
+				Headers: http.Header{"Content-Type": {"application/json"}, "Opc-Request-Id": {"recorded"}},
+				Body:    responseBody,
+			},
+		})
+	}
+	add("POST", "/20160918/vcns", `{"displayName":"vcn-recorded","cidrBlock":"10.0.0.0/16"}`, 200,
+		`{"id":"ocid1.vcn.oc1..a","displayName":"vcn-recorded","lifecycleState":"PROVISIONING"}`)
+	add("GET", "/20160918/workRequests/ocid1.workrequest.oc1..a", "", 200, `{"status":"IN_PROGRESS","percentComplete":50}`)
+	add("GET", "/20160918/workRequests/ocid1.workrequest.oc1..a", "", 200, `{"status":"SUCCEEDED","percentComplete":100}`)
+	add("GET", "/20160918/vcns/ocid1.vcn.oc1..a", "", 200, `{"id":"ocid1.vcn.oc1..a","lifecycleState":"PROVISIONING"}`)
+	add("GET", "/20160918/vcns/ocid1.vcn.oc1..a", "", 200, `{"id":"ocid1.vcn.oc1..a","lifecycleState":"AVAILABLE"}`)
+	add("GET", "/20160918/vcns?compartmentId=ocid1.compartment.oc1..a&displayName=other", "", 200, `[]`)
+	add("GET", "/20160918/vcns?compartmentId=ocid1.compartment.oc1..a&displayName=vcn-recorded", "", 200, `[{"id":"ocid1.vcn.oc1..a"}]`)
+	add("DELETE", "/20160918/vcns/ocid1.vcn.oc1..a", "", 204, "")
+	add("GET", "/20160918/vcns/ocid1.vcn.oc1..a", "", 404, `{"code":"NotAuthorizedOrNotFound"}`)
+	return s
+}
+
+func serverRequest(t *testing.T, url string, method string, path string, body string) (int, string) {
+	request, err := http.NewRequest(method, url+path, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer response.Body.Close()
+	data, _ := ioutil.ReadAll(response.Body)
+	if response.StatusCode < 300 && response.Header.Get("Opc-Request-Id") != "recorded" {
+		t.Errorf("Expected the recorded headers to be served, got %v", response.Header)
+	}
+	return response.StatusCode, strings.ReplaceAll(string(data), " ", "")
+}
+
+func TestServerLifecycle(t *testing.T) {
+	server := NewServer(newServerScenario())
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	tests := []struct {
+		method string
+		path   string
+		body   string
+		code   int
+		served string
+	}{
+		// The values of the request replace the recorded values in the responses
+		{"POST", "/20160918/vcns", `{"displayName":"vcn-test","cidrBlock":"10.0.0.0/16"}`, 200, `"displayName":"vcn-test"`},
+		// The work request and the resource go through the recorded states and stay in the last one
+		{"GET", "/20160918/workRequests/ocid1.workrequest.oc1..a", "", 200, `"status":"IN_PROGRESS"`},
+		{"GET", "/20160918/workRequests/ocid1.workrequest.oc1..a", "", 200, `"status":"SUCCEEDED"`},
+		{"GET", "/20160918/workRequests/ocid1.workrequest.oc1..a", "", 200, `"status":"SUCCEEDED"`},
+		{"GET", "/20160918/vcns/ocid1.vcn.oc1..a", "", 200, `"lifecycleState":"PROVISIONING"`},
+		{"GET", "/20160918/vcns/ocid1.vcn.oc1..a", "", 200, `"lifecycleState":"AVAILABLE"`},
+		{"GET", "/20160918/vcns/ocid1.vcn.oc1..a", "", 200, `"lifecycleState":"AVAILABLE"`},
+		{"GET", "/20160918/vcns?displayName=vcn-recorded&compartmentId=ocid1.compartment.oc1..a", "", 200, `[{"id":"ocid1.vcn.oc1..a"}]`},
+		{"DELETE", "/20160918/vcns/ocid1.vcn.oc1..a", "", 204, ""},
+		{"GET", "/20160918/vcns/ocid1.vcn.oc1..a", "", 404, `NotAuthorizedOrNotFound`},
+		{"GET", "/20160918/vcns/ocid1.vcn.oc1..a", "", 404, `NotAuthorizedOrNotFound`},
+		{"GET", "/20160918/subnets", "", 400, `"code":"NoRecordedInteraction"`},
+	}
+	for _, test := range tests {
+		code, body := serverRequest(t, httpServer.URL, test.method, test.path, test.body)
+		if code != test.code || !strings.Contains(body, test.served) {
+			t.Errorf("%s %s: expected %d with %s, got %d with %s", test.method, test.path, test.code, test.served, code, body)
+		}
+	}
+
+	unused := server.UnusedInteractions()
+	if len(unused) != 1 || unused[0].Index != 5 {
+		t.Errorf("Expected the list of the other VCNs to be reported as unused, got %v", unused)
+	}
+}
@@ -0,0 +1,300 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package httpreplay
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// StrictEnv is the environment variable that enables the strict replay mode: the requests must exactly match a
+// recorded interaction, and the recorded interactions that are not replayed are reported when the scenario is saved
+const StrictEnv = "HTTPREPLAY_STRICT"
+
+var strictMode struct {
+	once   sync.Once
+	strict bool
+}
+
+// IsStrict returns true if the strict replay mode is enabled by the environment, the setting is read once per process
+func IsStrict() bool {
+	strictMode.once.Do(func() {
+		if value := os.Getenv(StrictEnv); value != "" {
+			strict, err := strconv.ParseBool(value)
+			if err != nil {
+				debugLogf("invalid %s '%s', strict replay is disabled", StrictEnv, value)
+			}
+			strictMode.strict = strict
+		}
+	})
+	return strictMode.strict
+}
+
+// SetStrict overrides the strict replay setting of the environment, it must be called before the scenarios are set
+func SetStrict(strict bool) {
+	strictMode.once.Do(func() {})
+	strictMode.strict = strict
+}
+
+// MismatchError is returned in strict replay mode for a request that does not exactly match any recorded interaction
+type MismatchError struct {
+	Request Request
+
+	// Closest is the recorded interaction with the fewest differences with the request, nil if the scenario is empty
+	Closest *Interaction
+
+	// Diff are the differences between the closest recorded interaction and the request
+	Diff []string
+}
+
+func (e *MismatchError) Error() string {
+	message := fmt.Sprintf("%s: no recorded interaction exactly matches %s %s", ErrInteractionNotFound, e.Request.Method, e.Request.URL)
+	if e.Closest == nil {
+		return message + ", the scenario has no interactions"
+	}
+	return fmt.Sprintf("%s, closest recorded interaction %d (- recorded, + actual):\n%s", message, e.Closest.Index, strings.Join(e.Diff, "\n"))
+}
+
+// Unwrap allows errors.Is(err, ErrInteractionNotFound) to be true for a mismatch
+func (e *MismatchError) Unwrap() error {
+	return ErrInteractionNotFound
+}
+
+// UnusedInteractionsError is returned when a scenario is saved in strict replay mode and some recorded interactions were never replayed
+type UnusedInteractionsError struct {
+	Scenario     string
+	Interactions []Interaction
+}
+
+func (e *UnusedInteractionsError) Error() string {
+	lines := make([]string, len(e.Interactions))
+	for idx, i := range e.Interactions {
+		lines[idx] = fmt.Sprintf("  %d: %s %s", i.Index, i.Request.Method, i.Request.URL)
+	}
+	return fmt.Sprintf("%d recorded interactions of scenario %s were never used:\n%s", len(e.Interactions), e.Scenario, strings.Join(lines, "\n"))
+}
+
+// GetStrictInteraction retrieves the least used recorded interaction that exactly matches the method, URL, query and body of the request
+// The values learned in the Fields of the scenario are equal to the recorded values they replace
+func (s *Scenario) GetStrictInteraction(r Request) (*Interaction, error) {
+	s.Mu.Lock()
+	defer s.Mu.Unlock()
+	sort.Stable(byUsage(s.sortedInteractions))
+
+	var closest *Interaction
+	var closestDiff []string
+	for _, sorted := range s.sortedInteractions {
+		i := s.Interactions[sorted.Index]
+		diff := s.diffRequest(&i.Request, &r)
+		if len(diff) == 0 {
+			s.updateUsageCount(i.Index)
+			return &i, nil
+		}
+		if closest == nil || len(diff) < len(closestDiff) {
+			closest, closestDiff = &i, diff
+		}
+	}
+	return nil, &MismatchError{Request: r, Closest: closest, Diff: closestDiff}
+}
+
+// UnusedInteractions returns the recorded interactions that were never replayed
+func (s *Scenario) UnusedInteractions() []Interaction {
+	s.Mu.RLock()
+	defer s.Mu.RUnlock()
+	var unused []Interaction
+	for _, i := range s.Interactions {
+		if i.Uses == 0 {
+			unused = append(unused, i)
+		}
+	}
+	sort.Stable(byIndex(unused))
+	return unused
+}
+
+// diffRequest returns the differences between a recorded request and an actual request, one line per difference
+func (s *Scenario) diffRequest(recorded *Request, actual *Request) []string {
+	var diff []string
+	if recorded.Method != actual.Method {
+		diff = appendDiff(diff, "method", recorded.Method, actual.Method)
+	}
+
+	recordedURL, recordedErr := url.Parse(recorded.URL)
+	actualURL, actualErr := url.Parse(actual.URL)
+	if recordedErr != nil || actualErr != nil {
+		if !s.equalURLValue(recorded.URL, actual.URL) {
+			diff = appendDiff(diff, "url", recorded.URL, actual.URL)
+		}
+		return append(diff, s.diffBody(recorded.Body, actual.Body)...)
+	}
+	if !s.equalURLValue(recordedURL.Path, actualURL.Path) {
+		diff = appendDiff(diff, "path", recordedURL.Path, actualURL.Path)
+	}
+	diff = append(diff, s.diffQuery(recordedURL.Query(), actualURL.Query())...)
+	return append(diff, s.diffBody(recorded.Body, actual.Body)...)
+}
+
+func (s *Scenario) diffQuery(recorded url.Values, actual url.Values) []string {
+	var diff []string
+	for _, key := range unionKeys(recorded, actual) {
+		recordedValues, actualValues := recorded[key], actual[key]
+		equal := len(recordedValues) == len(actualValues)
+		for idx := 0; equal && idx < len(recordedValues); idx++ {
+			equal = s.equalURLValue(recordedValues[idx], actualValues[idx])
+		}
+		if !equal {
+			diff = appendDiff(diff, "query."+key, strings.Join(recordedValues, ","), strings.Join(actualValues, ","))
+		}
+	}
+	return diff
+}
+
+func (s *Scenario) diffBody(recorded string, actual string) []string {
+	if recorded == actual {
+		return nil
+	}
+	recordedParsed, recordedErr := unmarshal([]byte(recorded))
+	actualParsed, actualErr := unmarshal([]byte(actual))
+	if recordedErr != nil || actualErr != nil {
+		if s.equalString(recorded, actual) {
+			return nil
+		}
+		return appendDiff(nil, "body", recorded, actual)
+	}
+	return s.diffJSON("body", normalizeJSON(recordedParsed), normalizeJSON(actualParsed), nil)
+}
+
+// diffJSON compares two parsed JSON values, the keys of the objects and the indexes of the arrays are added to the path of the differences
+func (s *Scenario) diffJSON(path string, recorded interface{}, actual interface{}, diff []string) []string {
+	switch recordedValue := recorded.(type) {
+	case map[string]interface{}:
+		if actualValue, ok := actual.(map[string]interface{}); ok {
+			for _, key := range unionKeys(recordedValue, actualValue) {
+				diff = s.diffJSON(path+"."+key, recordedValue[key], actualValue[key], diff)
+			}
+			return diff
+		}
+	case []interface{}:
+		if actualValue, ok := actual.([]interface{}); ok {
+			for idx := 0; idx < len(recordedValue) || idx < len(actualValue); idx++ {
+				var recordedItem, actualItem interface{}
+				if idx < len(recordedValue) {
+					recordedItem = recordedValue[idx]
+				}
+				if idx < len(actualValue) {
+					actualItem = actualValue[idx]
+				}
+				diff = s.diffJSON(fmt.Sprintf("%s[%d]", path, idx), recordedItem, actualItem, diff)
+			}
+			return diff
+		}
+	case string:
+		if actualValue, ok := actual.(string); ok && s.equalString(recordedValue, actualValue) {
+			return diff
+		}
+	default:
+		if reflect.DeepEqual(recorded, actual) {
+			return diff
+		}
+	}
+	return appendDiff(diff, path, jsonString(recorded), jsonString(actual))
+}
+
+// equalString returns true if the values are equal, if the recorded value is replaced by the actual value in the Fields
+// of the scenario, or if the values scrubbed from the recorded body match any value
+func (s *Scenario) equalString(recorded string, actual string) bool {
+	if s.equalFields(recorded, actual) {
+		return true
+	}
+	_, ok := matchScrubbedText(recorded, actual)
+	return ok
+}
+
+// equalURLValue returns true if the values of the path or the query are equal, the values scrubbed from the recorded URL match any value
+func (s *Scenario) equalURLValue(recorded string, actual string) bool {
+	if s.equalFields(recorded, actual) {
+		return true
+	}
+	_, ok := matchScrubbed(recorded, actual)
+	return ok
+}
+
+// equalFields returns true if the values are equal, or if the recorded value is replaced by the actual value in the Fields of the scenario
+func (s *Scenario) equalFields(recorded string, actual string) bool {
+	if recorded == actual {
+		return true
+	}
+	for oldValue, newValue := range s.Fields {
+		if oldValue != "" && strings.Contains(recorded, oldValue) && strings.Replace(recorded, oldValue, newValue, -1) == actual {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeJSON converts the parsed objects and arrays to the generic JSON types, so that they can be compared
+func normalizeJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case jsonObj:
+		return normalizeJSON(map[string]interface{}(v))
+	case jsonArr:
+		items := make([]interface{}, len(v))
+		for idx, item := range v {
+			items[idx] = normalizeJSON(item)
+		}
+		return items
+	case jsonStr:
+		return string(v)
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[key] = normalizeJSON(item)
+		}
+		return result
+	case []interface{}:
+		items := make([]interface{}, len(v))
+		for idx, item := range v {
+			items[idx] = normalizeJSON(item)
+		}
+		return items
+	}
+	return value
+}
+
+func jsonString(value interface{}) string {
+	if value == nil {
+		return "<missing>"
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
+
+func appendDiff(diff []string, path string, recorded string, actual string) []string {
+	return append(diff, fmt.Sprintf("  %s:\n    - %s\n    + %s", path, recorded, actual))
+}
+
+// unionKeys returns the sorted keys of two maps
+func unionKeys(maps ...interface{}) []string {
+	seen := map[string]bool{}
+	var keys []string
+	for _, m := range maps {
+		for _, key := range reflect.ValueOf(m).MapKeys() {
+			if name := key.String(); !seen[name] {
+				seen[name] = true
+				keys = append(keys, name)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,243 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+// Run with a command something like:
+//   go test -run TestStrict
+
+package httpreplay
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newStrictScenario() *Scenario {
+	s := NewScenario("TestStrict")
+	s.AddInteraction(&Interaction{Request: Request{
+		Method: "POST",
+		URL:    "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns",
+		Body:   `{"displayName":"vcn","cidrBlocks":["10.0.0.0/16"]}`,
+	}})
+	s.AddInteraction(&Interaction{Request: Request{
+		Method: "GET",
+		URL:    "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns?compartmentId=ocid1.compartment.oc1..a&limit=10",
+	}})
+	return s
+}
+
+func TestStrictGetInteraction(t *testing.T) {
+	s := newStrictScenario()
+
+	i, err := s.GetStrictInteraction(Request{
+		Method: "GET",
+		URL:    "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns?limit=10&compartmentId=ocid1.compartment.oc1..a",
+	})
+	if err != nil || i.Index != 1 {
+		t.Fatalf("Expected the query parameters to match in any order, got %v, %v", i, err)
+	}
+
+	// The values learned in the Fields match the recorded values they replace
+	s.Fields["vcn"] = "vcn-replayed"
+	i, err = s.GetStrictInteraction(Request{
+		Method: "POST",
+		URL:    "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns",
+		Body:   `{"cidrBlocks": ["10.0.0.0/16"], "displayName": "vcn-replayed"}`,
+	})
+	if err != nil || i.Index != 0 {
+		t.Fatalf("Expected the body to match, got %v, %v", i, err)
+	}
+
+	if unused := s.UnusedInteractions(); len(unused) != 0 {
+		t.Errorf("Expected all the interactions to be used, got %v", unused)
+	}
+}
+
+func TestStrictMismatch(t *testing.T) {
+	s := newStrictScenario()
+
+	_, err := s.GetStrictInteraction(Request{
+		Method: "POST",
+		URL:    "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns",
+		Body:   `{"displayName":"vcn","cidrBlocks":["10.0.0.0/24"],"dnsLabel":"vcn"}`,
+	})
+	if !errors.Is(err, ErrInteractionNotFound) {
+		t.Fatalf("Expected a not found error, got %v", err)
+	}
+	var mismatch *MismatchError
+	if !errors.As(err, &mismatch) || mismatch.Closest == nil || mismatch.Closest.Index != 0 {
+		t.Fatalf("Expected the closest interaction to be the POST request, got %v", err)
+	}
+	expected := []string{
+		"  body.cidrBlocks[0]:\n    - \"10.0.0.0/16\"\n    + \"10.0.0.0/24\"",
+		"  body.dnsLabel:\n    - <missing>\n    + \"vcn\"",
+	}
+	if strings.Join(mismatch.Diff, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Unexpected diff:\n%s", strings.Join(mismatch.Diff, "\n"))
+	}
+
+	_, err = s.GetStrictInteraction(Request{
+		Method: "GET",
+		URL:    "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns?compartmentId=ocid1.compartment.oc1..a&limit=20",
+	})
+	if !errors.As(err, &mismatch) || !strings.Contains(err.Error(), "query.limit:\n    - 10\n    + 20") {
+		t.Errorf("Expected a diff of the query, got %v", err)
+	}
+
+	if _, err = NewScenario("TestStrictEmpty").GetStrictInteraction(Request{Method: "GET", URL: "/"}); err == nil ||
+		!strings.Contains(err.Error(), "the scenario has no interactions") {
+		t.Errorf("Expected an error for an empty scenario, got %v", err)
+	}
+}
+
+func TestStrictUnusedInteractions(t *testing.T) {
+	r := &Recorder{mode: ModeReplaying, scenario: newStrictScenario()}
+	r.scenario.Matcher = matcher
+	if _, err := r.scenario.GetInteraction(Request{Method: "POST", URL: "https://iaas.us-phoenix-1.oraclecloud.com/20160918/vcns"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := r.Stop(); err != nil {
+		t.Errorf("Expected the unused interactions to only be logged without strict mode, got %v", err)
+	}
+
+	r.SetStrict(true)
+	err := r.Stop()
+	var unused *UnusedInteractionsError
+	if !errors.As(err, &unused) || len(unused.Interactions) != 1 || unused.Interactions[0].Index != 1 {
+		t.Errorf("Expected the GET interaction to be reported as unused, got %v", err)
+	}
+}
+
+// fakeT records the errors reported by UseScenario and runs its cleanups on demand
+type fakeT struct {
+	errors   []string
+	cleanups []func()
+}
+
+func (f *fakeT) Helper() {}
+
+func (f *fakeT) Errorf(format string, args ...interface{}) {
+	f.errors = append(f.errors, fmt.Sprintf(format, args...))
+}
+
+func (f *fakeT) Fatalf(format string, args ...interface{}) {
+	f.errors = append(f.errors, fmt.Sprintf(format, args...))
+}
+
+func (f *fakeT) Cleanup(cleanup func()) {
+	f.cleanups = append(f.cleanups, cleanup)
+}
+
+func (f *fakeT) end() {
+	for idx := len(f.cleanups) - 1; idx >= 0; idx-- {
+		f.cleanups[idx]()
+	}
+}
+
+func TestStrictUseScenarioReportsUnusedInteractions(t *testing.T) {
+	inTempDir(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"path":"%s"}`, r.URL.Path)
+	}))
+	defer server.Close()
+
+	useMode(t, ModeRecording)
+	runScenario(t, "TestStrictSetScenario", server.URL, "/first", "/second")
+
+	previous := IsStrict()
+	SetStrict(true)
+	defer SetStrict(previous)
+	useMode(t, ModeReplaying)
+
+	// The scenario is saved when the test ends, the test fails if the recorded interactions were not all replayed
+	test := &fakeT{}
+	UseScenario(test, "TestStrictSetScenario")
+	if len(test.errors) != 0 {
+		t.Fatalf("UseScenario failed: %v", test.errors)
+	}
+	client := &http.Client{Transport: http.DefaultTransport}
+	if _, err := InstallRecorder(client); err != nil {
+		t.Fatalf("InstallRecorder failed: %v", err)
+	}
+	response, err := client.Post(server.URL+"/first", "application/json", strings.NewReader(`{"name": "test"}`))
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	response.Body.Close()
+
+	test.end()
+	if len(test.errors) != 1 || !strings.Contains(test.errors[0], "1 recorded interactions of scenario TestStrictSetScenario were never used") ||
+		!strings.Contains(test.errors[0], "/second") {
+		t.Errorf("Expected the unused interaction to be reported, got %v", test.errors)
+	}
+}
+
+func TestStrictReplayScrubbedBody(t *testing.T) {
+	inTempDir(t)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		fmt.Fprintf(w, `{"id":"ocid1.vcn.oc1..a","request":%s}`, body)
+	}))
+	defer server.Close()
+
+	post := func(tenancy string) (string, error) {
+		if err := SetScenario("TestStrictReplayScrubbedBody"); err != nil {
+			t.Fatalf("SetScenario failed: %v", err)
+		}
+		defer SaveScenario()
+		client := &http.Client{Transport: http.DefaultTransport}
+		if _, err := InstallRecorder(client); err != nil {
+			t.Fatalf("InstallRecorder failed: %v", err)
+		}
+		response, err := client.Post(server.URL+"/20160918/vcns", "application/json",
+			strings.NewReader(fmt.Sprintf(`{"compartmentId":"%s","displayName":"vcn"}`, tenancy)))
+		if err != nil {
+			return "", err
+		}
+		body, _ := ioutil.ReadAll(response.Body)
+		response.Body.Close()
+		return strings.ReplaceAll(string(body), " ", ""), nil
+	}
+
+	useMode(t, ModeRecording)
+	if _, err := post("ocid1.tenancy.oc1..aaaaaaaarecorded"); err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	s, err := Load("TestStrictReplayScrubbedBody")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if body := s.Interactions[0].Request.Body; !strings.Contains(body, `"compartmentId":"scrubbed-ocid-1"`) {
+		t.Fatalf("Expected the tenancy OCID to be scrubbed from the recorded body, got %s", body)
+	}
+
+	previous := IsStrict()
+	SetStrict(true)
+	defer SetStrict(previous)
+	useMode(t, ModeReplaying)
+
+	// The scrubbed OCID of the recorded body matches the OCID of the replayed request
+	replayedTenancy := "ocid1.tenancy.oc1..aaaaaaaareplayed"
+	replayed, err := post(replayedTenancy)
+	if err != nil {
+		t.Fatalf("Expected the request body to strictly match the scrubbed body, got %v", err)
+	}
+	if !strings.Contains(replayed, `"compartmentId":"`+replayedTenancy+`"`) {
+		t.Errorf("Expected the placeholder to be reversed at replay time, got %s", replayed)
+	}
+
+	// A different value of a field that is not scrubbed is still a mismatch
+	_, err = s.GetStrictInteraction(Request{
+		Method: "POST",
+		URL:    s.Interactions[0].Request.URL,
+		Body:   fmt.Sprintf(`{"compartmentId":"%s","displayName":"other"}`, replayedTenancy),
+	})
+	if !errors.Is(err, ErrInteractionNotFound) {
+		t.Errorf("Expected a mismatch for a body with a different field, got %v", err)
+	}
+}
@@ -3,183 +3,270 @@
 
 package acctest
 
+import (
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	tf_provider "github.com/terraform-providers/terraform-provider-oci/internal/provider"
+)
+
+/*
+DependencyGraph maps the sweepers of the resources referenced by the `_id` attributes of the resource schemas to the
+sweepers of the resources that reference them, which must be run first.
+For Example :
+
+	DependencyGraph["CoreVcn"] : ["CoreSubnet", "CoreRouteTable", ...]		// vcn_id
+	DependencyGraph["CoreSubnet"] : ["CoreInstance", "DatabaseDbSystem", ...]	// subnet_id, backup_subnet_id
+*/
 var DependencyGraph map[string][]string
 
+// dependencyProducerOverrides maps the `_id` attributes whose name does not end with the name of the resource producing
+// the IDs to the types of the producing resources, e.g. `network_entity_id` is the ID of a gateway or of a DRG. The
+// overrides of an attribute of a single resource type are keyed by `<type>.<attribute>`.
+var dependencyProducerOverrides = map[string][]string{
+	"manage_default_resource": {"oci_core_vcn"},
+	"network_entity":          {"oci_core_drg", "oci_core_internet_gateway", "oci_core_local_peering_gateway", "oci_core_nat_gateway", "oci_core_service_gateway"},
+	"nsx_edge_uplink1vlan":    {"oci_core_vlan"},
+	"nsx_edge_uplink2vlan":    {"oci_core_vlan"},
+	"parent_category":         {"oci_data_safe_sensitive_type"},
+	"private_end_point":       {"oci_database_management_db_management_private_endpoint"},
+	"saved_report":            {"oci_metering_computation_query"},
+	"table_name_or":           {"oci_nosql_table"},
+	"vnic":                    {"oci_core_vnic_attachment"},
+	"zone_name_or":            {"oci_dns_zone"},
+
+	"oci_data_safe_report_definition.parent":  {"oci_data_safe_report_definition"},
+	"oci_osmanagement_software_source.parent": {"oci_osmanagement_software_source"},
+}
+
+// ignoredDependencyAttributes are the `_id` attributes that do not make a resource depend on another resource of the
+// provider, the attributes of a single resource type are keyed by `<type>.<attribute>`
+var ignoredDependencyAttributes = map[string]bool{
+	// The IDs that are not produced by a resource of the provider
+	"bill_to_cust_account":   true,
+	"byoip":                  true,
+	"cloud_resource":         true,
+	"commit":                 true,
+	"compute":                true,
+	"cpe_device_shape":       true,
+	"curve":                  true,
+	"db_node":                true,
+	"db_server":              true,
+	"default_s3compartment":  true,
+	"deploy_plugins":         true,
+	"email":                  true,
+	"endpoint_service":       true,
+	"filter":                 true,
+	"idcs_open":              true,
+	"listing":                true,
+	"organization":           true,
+	"patch":                  true,
+	"provider_service":       true,
+	"resource":               true,
+	"service":                true,
+	"ship_to_cust_acct_role": true,
+	"ship_to_cust_acct_site": true,
+	"target_resource":        true,
+	"tax_payer":              true,
+	"tenancy":                true,
+	"tenant":                 true,
+	"training":               true,
+	"tunnel":                 true,
+	"volume_group_replica":   true,
+	"wallet_instrument":      true,
+	"wallet_transaction":     true,
+
+	// The resources that adopt an agent registered outside of Terraform, the attribute is the ID of the agent itself
+	"oci_database_migration_agent.agent":                  true,
+	"oci_management_agent_management_agent.managed_agent": true,
+
+	// The sources a resource is created from, e.g. the backup a database is restored from: they can be deleted before
+	// the resources created from them, and they reference these resources in turn
+	"oci_core_volume.volume_backup":                               true,
+	"oci_core_volume_group.volume_group_backup":                   true,
+	"oci_database_autonomous_database.autonomous_database_backup": true,
+	"oci_database_database.backup":                                true,
+	"oci_database_database_software_image.source_db_home":         true,
+	"oci_database_db_home.backup":                                 true,
+	"oci_database_db_home.database":                               true,
+	"oci_database_db_system.backup":                               true,
+	"oci_database_db_system.database":                             true,
+	"oci_file_storage_file_system.source_snapshot":                true,
+	"oci_golden_gate_deployment.deployment_backup":                true,
+	"oci_marketplace_listing_package_agreement.agreement":         true,
+	"oci_mysql_mysql_db_system.backup":                            true,
+
+	// The references that make a cycle with a more common reference, e.g. the route table of a gateway, which route tables route to
+	"oci_core_local_peering_gateway.route_table": true,
+	"oci_core_nat_gateway.public_ip":             true,
+	"oci_core_service_gateway.route_table":       true,
+}
+
 func InitDependencyGraph() {
-	DependencyGraph = make(map[string][]string)
-
-	DependencyGraph["agreement"] = append(DependencyGraph["agreement"], "MarketplaceAcceptedAgreement")
-	DependencyGraph["application"] = append(DependencyGraph["application"], "DataflowInvokeRun")
-	DependencyGraph["application"] = append(DependencyGraph["application"], "FunctionsFunction")
-	DependencyGraph["asset"] = append(DependencyGraph["asset"], "CoreVolumeBackupPolicyAssignment")
-	DependencyGraph["autonomousContainerDatabase"] = append(DependencyGraph["autonomousContainerDatabase"], "DatabaseAutonomousDatabase")
-	DependencyGraph["autonomousDatabase"] = append(DependencyGraph["autonomousDatabase"], "DatabaseAutonomousDatabaseBackup")
-	DependencyGraph["autonomousDatabase"] = append(DependencyGraph["autonomousDatabase"], "DatabaseAutonomousDatabaseInstanceWalletManagement")
-	DependencyGraph["autonomousDatabase"] = append(DependencyGraph["autonomousDatabase"], "DatabaseAutonomousDatabaseWallet")
-	DependencyGraph["autonomousExadataInfrastructure"] = append(DependencyGraph["autonomousExadataInfrastructure"], "DatabaseAutonomousContainerDatabase")
-	DependencyGraph["autonomousVmCluster"] = append(DependencyGraph["autonomousVmCluster"], "DatabaseAutonomousContainerDatabase")
-	DependencyGraph["backupDestination"] = append(DependencyGraph["backupDestination"], "DatabaseDbHome")
-	DependencyGraph["backupPolicy"] = append(DependencyGraph["backupPolicy"], "CoreBootVolume")
-	DependencyGraph["backupPolicy"] = append(DependencyGraph["backupPolicy"], "CoreVolume")
-	DependencyGraph["backupSubnet"] = append(DependencyGraph["backupSubnet"], "DatabaseCloudVmCluster")
-	DependencyGraph["backupSubnet"] = append(DependencyGraph["backupSubnet"], "DatabaseDbSystem")
-	DependencyGraph["blockchainPlatform"] = append(DependencyGraph["blockchainPlatform"], "BlockchainOsn")
-	DependencyGraph["blockchainPlatform"] = append(DependencyGraph["blockchainPlatform"], "BlockchainPeer")
-	DependencyGraph["bootVolume"] = append(DependencyGraph["bootVolume"], "CoreBootVolumeAttachment")
-	DependencyGraph["bootVolume"] = append(DependencyGraph["bootVolume"], "CoreBootVolumeBackup")
-	DependencyGraph["bootVolume"] = append(DependencyGraph["bootVolume"], "CoreInstance")
-	DependencyGraph["budget"] = append(DependencyGraph["budget"], "BudgetAlertRule")
-	DependencyGraph["catalog"] = append(DependencyGraph["catalog"], "DatacatalogConnection")
-	DependencyGraph["catalog"] = append(DependencyGraph["catalog"], "DatacatalogDataAsset")
-	DependencyGraph["cloudExadataInfrastructure"] = append(DependencyGraph["cloudExadataInfrastructure"], "DatabaseCloudVmCluster")
-	DependencyGraph["cluster"] = append(DependencyGraph["cluster"], "ContainerengineClusterKubeConfig")
-	DependencyGraph["cluster"] = append(DependencyGraph["cluster"], "ContainerengineNodePool")
-	DependencyGraph["configuration"] = append(DependencyGraph["configuration"], "MysqlMysqlDbSystem")
-	DependencyGraph["cpe"] = append(DependencyGraph["cpe"], "CoreIpSecConnection")
-	DependencyGraph["cpeDeviceShape"] = append(DependencyGraph["cpeDeviceShape"], "CoreCpe")
-	DependencyGraph["crossConnectGroup"] = append(DependencyGraph["crossConnectGroup"], "CoreCrossConnect")
-	DependencyGraph["database"] = append(DependencyGraph["database"], "DatabaseBackup")
-	DependencyGraph["database"] = append(DependencyGraph["database"], "DatabaseDataGuardAssociation")
-	DependencyGraph["dbHome"] = append(DependencyGraph["dbHome"], "DatabaseDatabase")
-	DependencyGraph["dbNode"] = append(DependencyGraph["dbNode"], "DatabaseDbNodeConsoleConnection")
-	DependencyGraph["dbSystem"] = append(DependencyGraph["dbSystem"], "DatabaseDbHome")
-	DependencyGraph["dbSystem"] = append(DependencyGraph["dbSystem"], "DatabaseMigration")
-	DependencyGraph["dbSystem"] = append(DependencyGraph["dbSystem"], "MysqlMysqlBackup")
-	DependencyGraph["dedicatedVmHost"] = append(DependencyGraph["dedicatedVmHost"], "CoreInstance")
-	DependencyGraph["dhcpOptions"] = append(DependencyGraph["dhcpOptions"], "CoreSubnet")
-	DependencyGraph["drg"] = append(DependencyGraph["drg"], "CoreDrgAttachment")
-	DependencyGraph["drg"] = append(DependencyGraph["drg"], "CoreIpSecConnection")
-	DependencyGraph["drg"] = append(DependencyGraph["drg"], "CoreRemotePeeringConnection")
-	DependencyGraph["exadataInfrastructure"] = append(DependencyGraph["exadataInfrastructure"], "DatabaseAutonomousVmCluster")
-	DependencyGraph["exadataInfrastructure"] = append(DependencyGraph["exadataInfrastructure"], "DatabaseExadataInfrastructureDownloadConfigFile")
-	DependencyGraph["exadataInfrastructure"] = append(DependencyGraph["exadataInfrastructure"], "DatabaseVmCluster")
-	DependencyGraph["exadataInfrastructure"] = append(DependencyGraph["exadataInfrastructure"], "DatabaseVmClusterNetwork")
-	DependencyGraph["exadataInfrastructure"] = append(DependencyGraph["exadataInfrastructure"], "DatabaseVmClusterNetworkDownloadConfigFile")
-	DependencyGraph["exadataInfrastructure"] = append(DependencyGraph["exadataInfrastructure"], "DatabaseVmClusterRecommendedNetwork")
-	DependencyGraph["exportSet"] = append(DependencyGraph["exportSet"], "FileStorageExport")
-	DependencyGraph["farCrossConnectOrCrossConnectGroup"] = append(DependencyGraph["farCrossConnectOrCrossConnectGroup"], "CoreCrossConnect")
-	DependencyGraph["federatedUser"] = append(DependencyGraph["federatedUser"], "BlockchainBlockchainPlatform")
-	DependencyGraph["fileSystem"] = append(DependencyGraph["fileSystem"], "FileStorageExport")
-	DependencyGraph["fileSystem"] = append(DependencyGraph["fileSystem"], "FileStorageSnapshot")
-	DependencyGraph["function"] = append(DependencyGraph["function"], "FunctionsInvokeFunction")
-	DependencyGraph["gateway"] = append(DependencyGraph["gateway"], "ApigatewayDeployment")
-	DependencyGraph["gateway"] = append(DependencyGraph["gateway"], "CoreVirtualCircuit")
-	DependencyGraph["Group"] = append(DependencyGraph["Group"], "IdentityIdpGroupMapping")
-	DependencyGraph["Group"] = append(DependencyGraph["Group"], "IdentityUserGroupMembership")
-	DependencyGraph["healthCheckMonitor"] = append(DependencyGraph["healthCheckMonitor"], "DnsSteeringPolicy")
-	DependencyGraph["identityProvider"] = append(DependencyGraph["identityProvider"], "IdentityIdpGroupMapping")
-	DependencyGraph["image"] = append(DependencyGraph["image"], "CoreComputeImageCapabilitySchema")
-	DependencyGraph["instance"] = append(DependencyGraph["instance"], "CoreBootVolumeAttachment")
-	DependencyGraph["instance"] = append(DependencyGraph["instance"], "CoreConsoleHistory")
-	DependencyGraph["instance"] = append(DependencyGraph["instance"], "CoreInstanceConsoleConnection")
-	DependencyGraph["instance"] = append(DependencyGraph["instance"], "CoreVolumeAttachment")
-	DependencyGraph["instanceConfiguration"] = append(DependencyGraph["instanceConfiguration"], "CoreInstancePool")
-	DependencyGraph["internetGateway"] = append(DependencyGraph["internetGateway"], "CoreRouteTable")
-	DependencyGraph["key"] = append(DependencyGraph["key"], "KmsDecryptedData")
-	DependencyGraph["key"] = append(DependencyGraph["key"], "KmsEncryptedData")
-	DependencyGraph["key"] = append(DependencyGraph["key"], "KmsGeneratedKey")
-	DependencyGraph["key"] = append(DependencyGraph["key"], "KmsKeyVersion")
-	DependencyGraph["key"] = append(DependencyGraph["key"], "VaultSecret")
-	DependencyGraph["kmsKey"] = append(DependencyGraph["kmsKey"], "ContainerengineCluster")
-	DependencyGraph["kmsKey"] = append(DependencyGraph["kmsKey"], "CoreBootVolume")
-	DependencyGraph["kmsKey"] = append(DependencyGraph["kmsKey"], "CoreVolume")
-	DependencyGraph["kmsKey"] = append(DependencyGraph["kmsKey"], "FileStorageFileSystem")
-	DependencyGraph["kmsKey"] = append(DependencyGraph["kmsKey"], "ObjectStorageBucket")
-	DependencyGraph["listing"] = append(DependencyGraph["listing"], "CoreAppCatalogSubscription")
-	DependencyGraph["listing"] = append(DependencyGraph["listing"], "MarketplaceAcceptedAgreement")
-	DependencyGraph["loadBalancer"] = append(DependencyGraph["loadBalancer"], "LoadBalancerBackend")
-	DependencyGraph["loadBalancer"] = append(DependencyGraph["loadBalancer"], "LoadBalancerBackendSet")
-	DependencyGraph["loadBalancer"] = append(DependencyGraph["loadBalancer"], "LoadBalancerCertificate")
-	DependencyGraph["loadBalancer"] = append(DependencyGraph["loadBalancer"], "LoadBalancerHostname")
-	DependencyGraph["loadBalancer"] = append(DependencyGraph["loadBalancer"], "LoadBalancerListener")
-	DependencyGraph["loadBalancer"] = append(DependencyGraph["loadBalancer"], "LoadBalancerPathRouteSet")
-	DependencyGraph["loadBalancer"] = append(DependencyGraph["loadBalancer"], "LoadBalancerRoutingPolicy")
-	DependencyGraph["loadBalancer"] = append(DependencyGraph["loadBalancer"], "LoadBalancerRuleSet")
-	DependencyGraph["loadBalancer"] = append(DependencyGraph["loadBalancer"], "LoadBalancerSslCipherSuite")
-	DependencyGraph["logGroup"] = append(DependencyGraph["logGroup"], "LoggingLog")
-	DependencyGraph["maintenanceRun"] = append(DependencyGraph["maintenanceRun"], "DatabaseMaintenanceRun")
-	DependencyGraph["managedAgent"] = append(DependencyGraph["managedAgent"], "ManagementAgentManagementAgent")
-	DependencyGraph["managedInstance"] = append(DependencyGraph["managedInstance"], "OsmanagementManagedInstanceManagement")
-	DependencyGraph["metricCompartment"] = append(DependencyGraph["metricCompartment"], "MonitoringAlarm")
-	DependencyGraph["model"] = append(DependencyGraph["model"], "DatascienceModelProvenance")
-	DependencyGraph["nearCrossConnectOrCrossConnectGroup"] = append(DependencyGraph["nearCrossConnectOrCrossConnectGroup"], "CoreCrossConnect")
-	DependencyGraph["networkSecurityGroup"] = append(DependencyGraph["networkSecurityGroup"], "CoreNetworkSecurityGroupSecurityRule")
-	DependencyGraph["nsxEdgeUplink1Vlan"] = append(DependencyGraph["nsxEdgeUplink1Vlan"], "OcvpSddc")
-	DependencyGraph["nsxEdgeUplink2Vlan"] = append(DependencyGraph["nsxEdgeUplink2Vlan"], "OcvpSddc")
-	DependencyGraph["nsxEdgeVTepVlan"] = append(DependencyGraph["nsxEdgeVTepVlan"], "OcvpSddc")
-	DependencyGraph["nsxVTepVlan"] = append(DependencyGraph["nsxVTepVlan"], "OcvpSddc")
-	DependencyGraph["parent"] = append(DependencyGraph["parent"], "OsmanagementSoftwareSource")
-	DependencyGraph["parentConfiguration"] = append(DependencyGraph["parentConfiguration"], "MysqlMysqlConfiguration")
-	DependencyGraph["policy"] = append(DependencyGraph["policy"], "CoreVolumeBackupPolicyAssignment")
-	DependencyGraph["privateEndpoint"] = append(DependencyGraph["privateEndpoint"], "DataflowApplication")
-	DependencyGraph["privateIp"] = append(DependencyGraph["privateIp"], "CorePublicIp")
-	DependencyGraph["project"] = append(DependencyGraph["project"], "DatascienceModel")
-	DependencyGraph["project"] = append(DependencyGraph["project"], "DatascienceNotebookSession")
-	DependencyGraph["providerService"] = append(DependencyGraph["providerService"], "CoreVirtualCircuit")
-	DependencyGraph["provisioningSubnet"] = append(DependencyGraph["provisioningSubnet"], "OcvpSddc")
-	DependencyGraph["routeTable"] = append(DependencyGraph["routeTable"], "CoreDrgAttachment")
-	DependencyGraph["routeTable"] = append(DependencyGraph["routeTable"], "CoreLocalPeeringGateway")
-	DependencyGraph["routeTable"] = append(DependencyGraph["routeTable"], "CoreServiceGateway")
-	DependencyGraph["routeTable"] = append(DependencyGraph["routeTable"], "CoreSubnet")
-	DependencyGraph["routeTable"] = append(DependencyGraph["routeTable"], "CoreVlan")
-	DependencyGraph["sddc"] = append(DependencyGraph["sddc"], "OcvpEsxiHost")
-	DependencyGraph["softwareSource"] = append(DependencyGraph["softwareSource"], "OsmanagementManagedInstanceManagement")
-	DependencyGraph["steeringPolicy"] = append(DependencyGraph["steeringPolicy"], "DnsSteeringPolicyAttachment")
-	DependencyGraph["streamPool"] = append(DependencyGraph["streamPool"], "StreamingStream")
-	DependencyGraph["subnet"] = append(DependencyGraph["subnet"], "ApigatewayGateway")
-	DependencyGraph["subnet"] = append(DependencyGraph["subnet"], "CoreInstance")
-	DependencyGraph["subnet"] = append(DependencyGraph["subnet"], "DataSafeDataSafePrivateEndpoint")
-	DependencyGraph["subnet"] = append(DependencyGraph["subnet"], "DatabaseAutonomousDatabase")
-	DependencyGraph["subnet"] = append(DependencyGraph["subnet"], "DatabaseAutonomousExadataInfrastructure")
-	DependencyGraph["subnet"] = append(DependencyGraph["subnet"], "DatabaseCloudVmCluster")
-	DependencyGraph["subnet"] = append(DependencyGraph["subnet"], "DatacatalogCatalogPrivateEndpoint")
-	DependencyGraph["subnet"] = append(DependencyGraph["subnet"], "DataflowPrivateEndpoint")
-	DependencyGraph["subnet"] = append(DependencyGraph["subnet"], "DataintegrationWorkspace")
-	DependencyGraph["subnet"] = append(DependencyGraph["subnet"], "FileStorageMountTarget")
-	DependencyGraph["subnet"] = append(DependencyGraph["subnet"], "MysqlMysqlDbSystem")
-	DependencyGraph["tableNameOr"] = append(DependencyGraph["tableNameOr"], "NosqlIndex")
-	DependencyGraph["tagDefinition"] = append(DependencyGraph["tagDefinition"], "IdentityTagDefault")
-	DependencyGraph["tagNamespace"] = append(DependencyGraph["tagNamespace"], "IdentityTag")
-	DependencyGraph["targetCompartment"] = append(DependencyGraph["targetCompartment"], "BudgetBudget")
-	DependencyGraph["tenancy"] = append(DependencyGraph["tenancy"], "IdentityRegionSubscription")
-	DependencyGraph["tenancy"] = append(DependencyGraph["tenancy"], "OceOceInstance")
-	DependencyGraph["tenant"] = append(DependencyGraph["tenant"], "MeteringComputationUsage")
-	DependencyGraph["topic"] = append(DependencyGraph["topic"], "OnsSubscription")
-	DependencyGraph["user"] = append(DependencyGraph["user"], "IdentityApiKey")
-	DependencyGraph["user"] = append(DependencyGraph["user"], "IdentityAuthToken")
-	DependencyGraph["user"] = append(DependencyGraph["user"], "IdentityCustomerSecretKey")
-	DependencyGraph["user"] = append(DependencyGraph["user"], "IdentitySmtpCredential")
-	DependencyGraph["user"] = append(DependencyGraph["user"], "IdentitySwiftPassword")
-	DependencyGraph["user"] = append(DependencyGraph["user"], "IdentityUiPassword")
-	DependencyGraph["user"] = append(DependencyGraph["user"], "IdentityUserGroupMembership")
-	DependencyGraph["vault"] = append(DependencyGraph["vault"], "VaultSecret")
-	DependencyGraph["vcn"] = append(DependencyGraph["vcn"], "ContainerengineCluster")
-	DependencyGraph["vcn"] = append(DependencyGraph["vcn"], "CoreDhcpOptions")
-	DependencyGraph["vcn"] = append(DependencyGraph["vcn"], "CoreDrgAttachment")
-	DependencyGraph["vcn"] = append(DependencyGraph["vcn"], "CoreInternetGateway")
-	DependencyGraph["vcn"] = append(DependencyGraph["vcn"], "CoreLocalPeeringGateway")
-	DependencyGraph["vcn"] = append(DependencyGraph["vcn"], "CoreNatGateway")
-	DependencyGraph["vcn"] = append(DependencyGraph["vcn"], "CoreNetworkSecurityGroup")
-	DependencyGraph["vcn"] = append(DependencyGraph["vcn"], "CoreRouteTable")
-	DependencyGraph["vcn"] = append(DependencyGraph["vcn"], "CoreSecurityList")
-	DependencyGraph["vcn"] = append(DependencyGraph["vcn"], "CoreServiceGateway")
-	DependencyGraph["vcn"] = append(DependencyGraph["vcn"], "CoreSubnet")
-	DependencyGraph["vcn"] = append(DependencyGraph["vcn"], "CoreVlan")
-	DependencyGraph["vcn"] = append(DependencyGraph["vcn"], "DataSafeDataSafePrivateEndpoint")
-	DependencyGraph["vcn"] = append(DependencyGraph["vcn"], "DataintegrationWorkspace")
-	DependencyGraph["vcn"] = append(DependencyGraph["vcn"], "LoadBalancerLoadBalancer")
-	DependencyGraph["vlan"] = append(DependencyGraph["vlan"], "CorePrivateIp")
-	DependencyGraph["vmClusterNetwork"] = append(DependencyGraph["vmClusterNetwork"], "DatabaseAutonomousVmCluster")
-	DependencyGraph["vmClusterNetwork"] = append(DependencyGraph["vmClusterNetwork"], "DatabaseVmCluster")
-	DependencyGraph["vmClusterNetwork"] = append(DependencyGraph["vmClusterNetwork"], "DatabaseVmClusterNetworkDownloadConfigFile")
-	DependencyGraph["vmotionVlan"] = append(DependencyGraph["vmotionVlan"], "OcvpSddc")
-	DependencyGraph["vnic"] = append(DependencyGraph["vnic"], "CorePrivateIp")
-	DependencyGraph["volume"] = append(DependencyGraph["volume"], "CoreVolumeAttachment")
-	DependencyGraph["volume"] = append(DependencyGraph["volume"], "CoreVolumeBackup")
-	DependencyGraph["volumeGroup"] = append(DependencyGraph["volumeGroup"], "CoreVolumeGroupBackup")
-	DependencyGraph["vsanVlan"] = append(DependencyGraph["vsanVlan"], "OcvpSddc")
-	DependencyGraph["vsphereVlan"] = append(DependencyGraph["vsphereVlan"], "OcvpSddc")
-	DependencyGraph["waasPolicy"] = append(DependencyGraph["waasPolicy"], "WaasPurgeCache")
-	DependencyGraph["zone"] = append(DependencyGraph["zone"], "DnsSteeringPolicyAttachment")
-	DependencyGraph["zoneNameOr"] = append(DependencyGraph["zoneNameOr"], "DnsRrset")
+	DependencyGraph, _ = buildDependencyGraph(tf_provider.ResourcesMap())
+}
+
+// UnresolvedDependencies returns the `_id` attributes of the resources of the provider whose producing resource is
+// unknown, by resource type
+func UnresolvedDependencies() map[string][]string {
+	_, unresolved := buildDependencyGraph(tf_provider.ResourcesMap())
+	return unresolved
+}
+
+// buildDependencyGraph walks the schemas of the resources, including the nested blocks, for the `_id` attributes set in
+// the configurations and resolves the resources producing the IDs
+// The graph is keyed by the sweepers of the producing resources, so that the resources producing IDs of attributes with
+// the same name, e.g. the `db_system_id` of the database and of the MySQL resources, do not depend on each other.
+// It returns the DependencyGraph and the attributes that could not be resolved by resource type
+func buildDependencyGraph(resources map[string]*schema.Resource) (map[string][]string, map[string][]string) {
+	resourceTypesBySuffix := map[string][]string{}
+	for resourceType := range resources {
+		words := strings.Split(strings.TrimPrefix(resourceType, "oci_"), "_")
+		for i := range words {
+			suffix := strings.Join(words[i:], "_")
+			resourceTypesBySuffix[suffix] = append(resourceTypesBySuffix[suffix], resourceType)
+		}
+	}
+
+	graph := map[string][]string{}
+	unresolved := map[string][]string{}
+	for resourceType, resource := range resources {
+		for _, attribute := range idAttributes(resource.Schema) {
+			producerTypes, ok := dependencyProducerTypes(attribute, resourceType, resourceTypesBySuffix)
+			if !ok {
+				unresolved[resourceType] = append(unresolved[resourceType], attribute+"_id")
+				continue
+			}
+
+			for _, producerType := range producerTypes {
+				if producerType == resourceType {
+					continue
+				}
+				producer := sweeperName(producerType)
+				graph[producer] = appendUnique(graph[producer], sweeperName(resourceType))
+			}
+		}
+	}
+
+	for _, values := range []map[string][]string{graph, unresolved} {
+		for key := range values {
+			sort.Strings(values[key])
+		}
+	}
+	return graph, unresolved
+}
+
+// idAttributes returns the names without the `_id` suffix of the attributes of a schema that reference a resource
+func idAttributes(resourceSchema map[string]*schema.Schema) []string {
+	attributes := map[string]bool{}
+	var walk func(map[string]*schema.Schema)
+	walk = func(s map[string]*schema.Schema) {
+		for name, attribute := range s {
+			if strings.HasSuffix(name, "_id") && name != "_id" && (attribute.Required || attribute.Optional) {
+				attributes[strings.TrimSuffix(name, "_id")] = true
+			}
+			if nested, ok := attribute.Elem.(*schema.Resource); ok {
+				walk(nested.Schema)
+			}
+		}
+	}
+	walk(resourceSchema)
+
+	var result []string
+	for attribute := range attributes {
+		result = append(result, attribute)
+	}
+	sort.Strings(result)
+	return result
+}
+
+// dependencyProducerTypes returns the types of the resources producing the IDs of an attribute: the resources whose
+// name ends with the longest suffix of the attribute name, e.g. `oci_core_subnet` for `backup_subnet_id`
+// When several resources have that suffix, the resources of the same service as the referencing resource are preferred.
+// It returns no resource type for the ignored attributes, and false if no resource produces the IDs.
+func dependencyProducerTypes(attribute string, referencingType string, resourceTypesBySuffix map[string][]string) ([]string, bool) {
+	if ignoredDependencyAttributes[referencingType+"."+attribute] || ignoredDependencyAttributes[attribute] {
+		return nil, true
+	}
+	if producerTypes, ok := dependencyProducerOverrides[referencingType+"."+attribute]; ok {
+		return producerTypes, true
+	}
+	if producerTypes, ok := dependencyProducerOverrides[attribute]; ok {
+		return producerTypes, true
+	}
+
+	words := strings.Split(attribute, "_")
+	for i := range words {
+		if candidates := resourceTypesBySuffix[strings.Join(words[i:], "_")]; len(candidates) > 0 {
+			return closestResourceTypes(candidates, referencingType), true
+		}
+	}
+	return nil, false
+}
+
+// closestResourceTypes returns the candidates other than the referencing resource type sharing the most leading words
+// with it, the shortest of them if there are several
+func closestResourceTypes(candidates []string, referencingType string) []string {
+	referencingWords := strings.Split(referencingType, "_")
+	sharedWords := func(resourceType string) int {
+		words := strings.Split(resourceType, "_")
+		shared := 0
+		for shared < len(words) && shared < len(referencingWords) && words[shared] == referencingWords[shared] {
+			shared++
+		}
+		return shared
+	}
+
+	var closest []string
+	for _, candidate := range candidates {
+		// A resource references other resources of its type rather than itself
+		if candidate == referencingType && len(candidates) > 1 {
+			continue
+		}
+		if len(closest) == 0 {
+			closest = []string{candidate}
+			continue
+		}
+		best := closest[0]
+		if sharedWords(candidate) != sharedWords(best) {
+			if sharedWords(candidate) > sharedWords(best) {
+				closest = []string{candidate}
+			}
+			continue
+		}
+		if len(candidate) < len(best) {
+			closest = []string{candidate}
+		} else if len(candidate) == len(best) {
+			closest = append(closest, candidate)
+		}
+	}
+	return closest
+}
+
+// sweeperName returns the name of the sweeper of a resource type, e.g. `CoreVcn` for `oci_core_vcn`
+func sweeperName(resourceType string) string {
+	name := snakeToCamelCase(strings.TrimPrefix(resourceType, "oci_"))
+	return strings.ToUpper(name[:1]) + name[1:]
+}
+
+// snakeToCamelCase returns the camel case of a snake case name, e.g. `backupSubnet` for `backup_subnet`
+func snakeToCamelCase(name string) string {
+	words := strings.Split(name, "_")
+	for i := 1; i < len(words); i++ {
+		if words[i] != "" {
+			words[i] = strings.ToUpper(words[i][:1]) + words[i][1:]
+		}
+	}
+	return strings.Join(words, "")
+}
+
+func appendUnique(values []string, value string) []string {
+	for _, existing := range values {
+		if existing == value {
+			return values
+		}
+	}
+	return append(values, value)
 }
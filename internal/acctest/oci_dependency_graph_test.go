@@ -0,0 +1,144 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/provider"
+)
+
+// A new resource with an `_id` attribute whose name does not end with the name of the resource producing the IDs
+// fails this test, add the attribute to dependencyProducerOverrides, or to ignoredDependencyAttributes if no resource
+// of the provider produces the IDs
+func TestUnitDependencyGraphUnresolvedDependencies(t *testing.T) {
+	if unresolved := UnresolvedDependencies(); len(unresolved) > 0 {
+		t.Errorf("the resources producing these `_id` attributes are unknown, add them to dependencyProducerOverrides or ignoredDependencyAttributes: %v", unresolved)
+	}
+}
+
+func TestUnitDependencyGraphOverrides(t *testing.T) {
+	resources := provider.ResourcesMap()
+	for attribute, producerTypes := range dependencyProducerOverrides {
+		for _, producerType := range producerTypes {
+			if _, ok := resources[producerType]; !ok {
+				t.Errorf("dependencyProducerOverrides[%s] = %s is not a resource of the provider", attribute, producerType)
+			}
+		}
+	}
+}
+
+func TestUnitBuildDependencyGraph(t *testing.T) {
+	idSchema := func(attributes ...string) map[string]*schema.Schema {
+		s := map[string]*schema.Schema{"display_name": {Type: schema.TypeString, Optional: true}}
+		for _, attribute := range attributes {
+			s[attribute] = &schema.Schema{Type: schema.TypeString, Required: true}
+		}
+		return s
+	}
+	resources := map[string]*schema.Resource{
+		"oci_core_vcn":    {Schema: idSchema()},
+		"oci_core_subnet": {Schema: idSchema("vcn_id", "dhcp_options_id")},
+		"oci_core_instance": {Schema: map[string]*schema.Schema{
+			"create_vnic_details": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Resource{Schema: idSchema("subnet_id")},
+			},
+			"image_id": {Type: schema.TypeString, Computed: true},
+		}},
+		"oci_core_dhcp_options":         {Schema: idSchema("vcn_id")},
+		"oci_core_default_dhcp_options": {Schema: idSchema("manage_default_resource_id")},
+		"oci_database_db_system":        {Schema: idSchema("backup_subnet_id", "tenancy_id")},
+		"oci_database_db_home":          {Schema: idSchema("db_system_id")},
+		"oci_mysql_mysql_db_system":     {Schema: idSchema()},
+		"oci_mysql_mysql_backup":        {Schema: idSchema("db_system_id")},
+		"oci_kms_key":                   {Schema: idSchema()},
+		"oci_kms_key_version":           {Schema: idSchema("key_id")},
+		"oci_things_thing":              {Schema: idSchema("gadget_id")},
+	}
+
+	graph, unresolved := buildDependencyGraph(resources)
+	// The database and the MySQL DB systems are not dependents of each other's backups and homes
+	wantGraph := map[string][]string{
+		"CoreVcn":            {"CoreDefaultDhcpOptions", "CoreDhcpOptions", "CoreSubnet"},
+		"CoreDhcpOptions":    {"CoreSubnet"},
+		"CoreSubnet":         {"CoreInstance", "DatabaseDbSystem"},
+		"DatabaseDbSystem":   {"DatabaseDbHome"},
+		"MysqlMysqlDbSystem": {"MysqlMysqlBackup"},
+		"KmsKey":             {"KmsKeyVersion"},
+	}
+	wantUnresolved := map[string][]string{
+		"oci_things_thing": {"gadget_id"},
+	}
+	if !reflect.DeepEqual(graph, wantGraph) {
+		t.Errorf("buildDependencyGraph() graph = %v, want %v", graph, wantGraph)
+	}
+	if !reflect.DeepEqual(unresolved, wantUnresolved) {
+		t.Errorf("buildDependencyGraph() unresolved = %v, want %v", unresolved, wantUnresolved)
+	}
+}
+
+// A resource referencing a resource that references it, directly or not, fails this test: the sweepers of a cycle can
+// not be ordered, fix the producers of the attributes in dependencyProducerOverrides or ignore them in ignoredDependencyAttributes
+func TestUnitDependencyGraphAcyclic(t *testing.T) {
+	graph, _ := buildDependencyGraph(provider.ResourcesMap())
+	if cycle := dependencyCycle(graph); cycle != nil {
+		t.Errorf("the sweeper dependency graph has a cycle: %s", strings.Join(cycle, " -> "))
+	}
+
+	if cycle := dependencyCycle(map[string][]string{"A": {"B"}, "B": {"C"}, "C": {"A"}}); len(cycle) != 4 {
+		t.Errorf("dependencyCycle() = %v, want the cycle A -> B -> C -> A", cycle)
+	}
+}
+
+// dependencyCycle returns the sweepers of a cycle of the graph, the first sweeper repeated at the end, or nil
+func dependencyCycle(graph map[string][]string) []string {
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := map[string]int{}
+	var path []string
+	var visit func(string) []string
+	visit = func(name string) []string {
+		switch state[name] {
+		case visiting:
+			for i, step := range path {
+				if step == name {
+					return append(append([]string{}, path[i:]...), name)
+				}
+			}
+		case visited:
+			return nil
+		}
+		state[name] = visiting
+		path = append(path, name)
+		for _, dependent := range graph[name] {
+			if cycle := visit(dependent); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+		return nil
+	}
+
+	var names []string
+	for name := range graph {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if cycle := visit(name); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"os"
 	"strings"
 	"testing"
 
@@ -45,10 +46,24 @@ var identityClientListAvailabilityDomains = func(client *oci_identity.IdentityCl
 
 // issue-routing-tag: terraform/default
 func TestMain(m *testing.M) {
+	// The sweepers are run in dependency order by the sweeper orchestrator rather than by the plugin SDK
+	if ran, err := RunSweepersFromFlags(); ran {
+		if err != nil {
+			log.Printf("[ERROR] %v", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
 	resource.TestMain(m)
 }
 
+// AddResourceIdToSweeperResourceIdMap records a resource listed by a sweeper, the resources listed again by the next pass
+// of RunSweepers are reported as left behind
 func AddResourceIdToSweeperResourceIdMap(compartmentId string, resourceType string, resourceId string) {
+	recordSweptResource(compartmentId, resourceType, resourceId)
+
+	sweepersLock.Lock()
+	defer sweepersLock.Unlock()
 	if _, ok := SweeperResourceCompartmentIdMap[compartmentId]; ok {
 		resourceCompartmentIdMap := SweeperResourceCompartmentIdMap[compartmentId]
 		if _, ok := resourceCompartmentIdMap[resourceType]; ok {
@@ -69,6 +84,8 @@ func AddResourceIdToSweeperResourceIdMap(compartmentId string, resourceType stri
 }
 
 func GetResourceIdsToSweep(compartmentId string, resourceName string) []string {
+	sweepersLock.Lock()
+	defer sweepersLock.Unlock()
 	if _, ok := SweeperResourceCompartmentIdMap[compartmentId]; ok {
 		resourceIdMap := SweeperResourceCompartmentIdMap[compartmentId]
 		if _, ok := resourceIdMap[resourceName]; ok {
@@ -0,0 +1,403 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+// Sweepers holds the sweepers registered with AddTestSweepers, keyed by name
+var Sweepers = map[string]*resource.Sweeper{}
+
+var (
+	sweepersLock sync.Mutex
+
+	// sweptResources holds the resources listed by the sweepers during the current pass of RunSweepers, see
+	// AddResourceIdToSweeperResourceIdMap. It is nil when the sweepers are not run by RunSweepers.
+	sweptResources map[SweeperLeftover]bool
+
+	// The sweepers are run again while the resources they list conflict with resources being deleted, for at most sweeperRetryTimeout
+	sweeperRetryDelay   = 30 * time.Second
+	sweeperRetryTimeout = 30 * time.Minute
+)
+
+// AddTestSweepers registers a sweeper with the sweeper orchestrator, and with the plugin SDK
+// The dependencies of the sweeper are the sweepers of the resources that reference the resources it deletes according
+// to DependencyGraph, they replace the dependencies set by the caller.
+func AddTestSweepers(name string, sweeper *resource.Sweeper) {
+	sweepersLock.Lock()
+	defer sweepersLock.Unlock()
+	sweeper.Dependencies = sweeperDependencies(name)
+	Sweepers[name] = sweeper
+	resource.AddTestSweepers(name, sweeper)
+}
+
+// SweeperResult is the result of a sweeper in a compartment
+type SweeperResult struct {
+	Sweeper     string
+	Compartment string
+	Attempts    int
+	Duration    time.Duration
+	Err         error
+}
+
+// SweeperLeftover is a resource that the sweepers could not delete, the resource type is the type the resource was
+// listed with by its sweeper, see AddResourceIdToSweeperResourceIdMap
+type SweeperLeftover struct {
+	Compartment  string
+	ResourceType string
+	ResourceId   string
+}
+
+// SweeperSummary is the outcome of RunSweepers
+type SweeperSummary struct {
+	// Levels are the sweepers in the order they were run, the sweepers of a level are run in parallel
+	Levels    [][]string
+	Results   []SweeperResult
+	Leftovers []SweeperLeftover
+	// Skipped are the sweepers that were not run by the last pass as a sweeper of a previous level failed
+	Skipped []string
+}
+
+// Failed returns the results of the sweepers that returned an error
+func (s *SweeperSummary) Failed() []SweeperResult {
+	var failed []SweeperResult
+	for _, result := range s.Results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+func (s *SweeperSummary) String() string {
+	lines := []string{fmt.Sprintf("Sweeper summary: %d sweeper runs, %d failed, %d sweepers not run, %d resources left behind", len(s.Results), len(s.Failed()), len(s.Skipped), len(s.Leftovers))}
+	for _, result := range s.Failed() {
+		lines = append(lines, fmt.Sprintf("  [FAILED] %s in compartment %s after %d runs: %v", result.Sweeper, result.Compartment, result.Attempts, result.Err))
+	}
+	for _, name := range s.Skipped {
+		lines = append(lines, fmt.Sprintf("  [NOT RUN] %s", name))
+	}
+	for _, leftover := range s.Leftovers {
+		lines = append(lines, fmt.Sprintf("  [LEFT BEHIND] %s %s in compartment %s", leftover.ResourceType, leftover.ResourceId, leftover.Compartment))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// DeleteSweeperResource deletes a resource listed by a sweeper. A resource that is already deleted is not an error, the
+// other errors are returned for the sweeper to aggregate and return, so that RunSweepers runs the sweeper again when
+// the deletion conflicted with the deletion of another resource.
+func DeleteSweeperResource(compartmentId string, resourceType string, resourceId string, deleteResource func() error) error {
+	err := deleteResource()
+	if failure, isServiceError := oci_common.IsServiceError(err); isServiceError && failure.GetHTTPStatusCode() == 404 {
+		log.Printf("[DEBUG] %s %s in compartment %s is already deleted", resourceType, resourceId, compartmentId)
+		return nil
+	}
+	return err
+}
+
+// isConflictError returns true if the error is a 409 returned by a service, or aggregates one
+func isConflictError(err error) bool {
+	var errs *multierror.Error
+	if errors.As(err, &errs) {
+		for _, e := range errs.Errors {
+			if isConflictError(e) {
+				return true
+			}
+		}
+		return false
+	}
+	var serviceError oci_common.ServiceError
+	return errors.As(err, &serviceError) && serviceError.GetHTTPStatusCode() == 409
+}
+
+// recordSweptResource records a resource listed by a sweeper during the current pass of RunSweepers
+func recordSweptResource(compartmentId string, resourceType string, resourceId string) {
+	sweepersLock.Lock()
+	defer sweepersLock.Unlock()
+	if sweptResources != nil {
+		sweptResources[SweeperLeftover{Compartment: compartmentId, ResourceType: resourceType, ResourceId: resourceId}] = true
+	}
+}
+
+// filterSweepers returns the sweepers whose name contains one of the comma-separated filters, along with the sweepers
+// that must be run before them
+func filterSweepers(filter string, sweepers map[string]*resource.Sweeper) map[string]*resource.Sweeper {
+	filters := strings.Split(strings.ToLower(filter), ",")
+	if len(filters) == 1 && filters[0] == "" {
+		return sweepers
+	}
+
+	filtered := map[string]*resource.Sweeper{}
+	var add func(name string)
+	add = func(name string) {
+		if _, ok := filtered[name]; ok {
+			return
+		}
+		sweeper, ok := sweepers[name]
+		if !ok {
+			return
+		}
+		filtered[name] = sweeper
+		for _, before := range sweepersBefore(name, sweepers) {
+			add(before)
+		}
+	}
+	for name := range sweepers {
+		for _, f := range filters {
+			if f != "" && strings.Contains(strings.ToLower(name), f) {
+				add(name)
+			}
+		}
+	}
+	return filtered
+}
+
+// sweeperDependencies returns the sweepers of the resources that reference the resources of a sweeper according to DependencyGraph
+func sweeperDependencies(name string) []string {
+	for producer, dependents := range DependencyGraph {
+		if strings.EqualFold(producer, name) {
+			return dependents
+		}
+	}
+	return nil
+}
+
+// sweepersBefore returns the registered sweepers that must be run before a sweeper: its dependencies, and the sweepers
+// of the resources that reference the resources it deletes according to DependencyGraph
+// The names of the graph are matched with the names of the sweepers regardless of case, e.g. `ObjectStorageBucket`
+// is the sweeper of `ObjectstorageBucket`.
+func sweepersBefore(name string, sweepers map[string]*resource.Sweeper) []string {
+	registered := map[string]string{}
+	for registeredName := range sweepers {
+		registered[strings.ToLower(registeredName)] = registeredName
+	}
+
+	before := map[string]bool{}
+	if sweeper, ok := sweepers[name]; ok {
+		for _, dependency := range sweeper.Dependencies {
+			before[strings.ToLower(dependency)] = true
+		}
+	}
+	for producer, dependents := range DependencyGraph {
+		if strings.EqualFold(producer, name) {
+			for _, dependent := range dependents {
+				before[strings.ToLower(dependent)] = true
+			}
+		}
+	}
+
+	var result []string
+	for dependency := range before {
+		if registeredName, ok := registered[dependency]; ok && registeredName != name {
+			result = append(result, registeredName)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// sweeperLevels sorts the sweepers topologically: the first level are the sweepers of the resources that no other
+// resource references, each following level only has sweepers whose dependents are in the previous levels
+// The sweepers of a dependency cycle are all added to the last level
+func sweeperLevels(sweepers map[string]*resource.Sweeper) [][]string {
+	pending := map[string][]string{}
+	for name := range sweepers {
+		pending[name] = sweepersBefore(name, sweepers)
+	}
+
+	var levels [][]string
+	done := map[string]bool{}
+	for len(pending) > 0 {
+		var level []string
+		for name, before := range pending {
+			ready := true
+			for _, dependency := range before {
+				if !done[dependency] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				level = append(level, name)
+			}
+		}
+		if len(level) == 0 {
+			for name := range pending {
+				level = append(level, name)
+			}
+			sort.Strings(level)
+			log.Printf("[WARN] dependency cycle between the sweepers %s, running them in parallel", strings.Join(level, ", "))
+		}
+		sort.Strings(level)
+		for _, name := range level {
+			done[name] = true
+			delete(pending, name)
+		}
+		levels = append(levels, level)
+	}
+	return levels
+}
+
+// RunSweepers runs the sweepers in the compartments, in dependency order
+// The sweepers of a level are run in parallel. Unless allowFailures is set, the next levels are not run once a sweeper
+// has failed. The sweepers are run again while they list resources that were not deleted by the previous pass, e.g. a
+// VCN whose deletion conflicted with the deletion of its subnets, or while they return conflict errors, until the
+// sweeperRetryTimeout. The resources listed by the last pass are reported as left behind. When the sweepers are stopped
+// by a failure, the resources listed by the failed pass are reported as left behind as well as they may not have been
+// deleted, along with the sweepers that were not run. The resources cached by the sweepers are cleared before each
+// pass, see GetResourceIdsToSweep, so that each pass lists the resources again.
+func RunSweepers(compartments []string, sweepers map[string]*resource.Sweeper, allowFailures bool) *SweeperSummary {
+	parallelism, err := strconv.Atoi(utils.GetEnvSettingWithDefault("sweep_parallelism", "4"))
+	if err != nil || parallelism < 1 {
+		parallelism = 1
+	}
+
+	summary := &SweeperSummary{Levels: sweeperLevels(sweepers)}
+	results := map[string]*SweeperResult{}
+	start := time.Now()
+	for pass := 1; ; pass++ {
+		sweepersLock.Lock()
+		sweptResources = map[SweeperLeftover]bool{}
+		SweeperResourceCompartmentIdMap = nil
+		sweepersLock.Unlock()
+
+		failed, conflicted, skipped := runSweeperPass(compartments, sweepers, summary.Levels, parallelism, allowFailures, results)
+
+		sweepersLock.Lock()
+		listed := sweptResources
+		sweptResources = nil
+		sweepersLock.Unlock()
+
+		// The first pass lists all the resources, the following passes only list the resources that were not deleted
+		retry := conflicted || len(listed) > 0
+		stopped := failed && !allowFailures
+		if !retry || stopped || time.Since(start)+sweeperRetryDelay > sweeperRetryTimeout {
+			if pass > 1 || stopped {
+				for leftover := range listed {
+					summary.Leftovers = append(summary.Leftovers, leftover)
+				}
+			}
+			summary.Skipped = skipped
+			break
+		}
+		log.Printf("[INFO] running the sweepers again in %s, pass %d listed %d resources", sweeperRetryDelay, pass, len(listed))
+		time.Sleep(sweeperRetryDelay)
+	}
+
+	for _, result := range results {
+		summary.Results = append(summary.Results, *result)
+	}
+	sort.Slice(summary.Results, func(i, j int) bool {
+		if summary.Results[i].Sweeper != summary.Results[j].Sweeper {
+			return summary.Results[i].Sweeper < summary.Results[j].Sweeper
+		}
+		return summary.Results[i].Compartment < summary.Results[j].Compartment
+	})
+	sort.Slice(summary.Leftovers, func(i, j int) bool {
+		return summary.Leftovers[i].ResourceId < summary.Leftovers[j].ResourceId
+	})
+	return summary
+}
+
+// runSweeperPass runs the levels of sweepers once and records their results, the results of a sweeper that was already
+// run are replaced. It returns whether a sweeper failed, whether a sweeper returned a conflict error, and the sweepers
+// of the levels that were not run after a failure.
+func runSweeperPass(compartments []string, sweepers map[string]*resource.Sweeper, levels [][]string, parallelism int, allowFailures bool, results map[string]*SweeperResult) (failed bool, conflicted bool, skipped []string) {
+	for idx, level := range levels {
+		log.Printf("[INFO] running sweeper level %d/%d: %s", idx+1, len(levels), strings.Join(level, ", "))
+
+		levelResults := make(chan SweeperResult)
+		semaphore := make(chan struct{}, parallelism)
+		var wg sync.WaitGroup
+		for _, compartment := range compartments {
+			for _, name := range level {
+				wg.Add(1)
+				go func(compartment string, name string) {
+					defer wg.Done()
+					semaphore <- struct{}{}
+					defer func() { <-semaphore }()
+
+					start := time.Now()
+					err := sweepers[name].F(compartment)
+					levelResults <- SweeperResult{Sweeper: name, Compartment: compartment, Duration: time.Since(start), Err: err}
+				}(compartment, name)
+			}
+		}
+		go func() {
+			wg.Wait()
+			close(levelResults)
+		}()
+
+		levelFailed := false
+		for result := range levelResults {
+			key := result.Sweeper + "@" + result.Compartment
+			if previous, ok := results[key]; ok {
+				result.Attempts = previous.Attempts
+				result.Duration += previous.Duration
+			}
+			result.Attempts++
+			results[key] = &result
+
+			switch {
+			case isConflictError(result.Err):
+				conflicted = true
+				log.Printf("[WARN] sweeper %s conflicted in compartment %s: %v", result.Sweeper, result.Compartment, result.Err)
+			case result.Err != nil:
+				levelFailed = true
+				log.Printf("[ERROR] sweeper %s failed in compartment %s: %v", result.Sweeper, result.Compartment, result.Err)
+			}
+		}
+		if levelFailed {
+			failed = true
+			if !allowFailures {
+				log.Printf("[ERROR] stopping the sweepers after the failures of level %d", idx+1)
+				for _, skippedLevel := range levels[idx+1:] {
+					skipped = append(skipped, skippedLevel...)
+				}
+				break
+			}
+		}
+	}
+	return failed, conflicted, skipped
+}
+
+// RunSweepersFromFlags runs the registered sweepers if the `-sweep` flag of the plugin SDK is set, with its compartments,
+// `-sweep-run` filter and `-sweep-allow-failures` setting. It returns false if the flag is not set.
+func RunSweepersFromFlags() (ran bool, err error) {
+	flag.Parse()
+	sweep := flagValue("sweep")
+	if sweep == "" {
+		return false, nil
+	}
+
+	allowFailures, _ := strconv.ParseBool(flagValue("sweep-allow-failures"))
+	summary := RunSweepers(strings.Split(sweep, ","), filterSweepers(flagValue("sweep-run"), Sweepers), allowFailures)
+	log.Printf("[INFO] %s", summary)
+	if failed := summary.Failed(); len(failed) > 0 {
+		return true, fmt.Errorf("%d sweeper runs failed", len(failed))
+	}
+	return true, nil
+}
+
+func flagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+	return ""
+}
@@ -0,0 +1,330 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package acctest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+type mockSweeperConflict struct{}
+
+func (err mockSweeperConflict) Error() string          { return "Service error:Conflict. http status code: 409" }
+func (err mockSweeperConflict) GetHTTPStatusCode() int { return 409 }
+func (err mockSweeperConflict) GetMessage() string     { return "Conflict" }
+func (err mockSweeperConflict) GetCode() string        { return "Conflict" }
+func (err mockSweeperConflict) GetOpcRequestID() string {
+	return "opc-request-id"
+}
+
+type mockSweeperServiceError struct {
+	statusCode int
+}
+
+func (err mockSweeperServiceError) Error() string {
+	return fmt.Sprintf("Service error. http status code: %d", err.statusCode)
+}
+func (err mockSweeperServiceError) GetHTTPStatusCode() int  { return err.statusCode }
+func (err mockSweeperServiceError) GetMessage() string      { return "NotFound" }
+func (err mockSweeperServiceError) GetCode() string         { return "NotFound" }
+func (err mockSweeperServiceError) GetOpcRequestID() string { return "opc-request-id" }
+
+func setUpSweeperGraph(t *testing.T) {
+	dependencyGraph := DependencyGraph
+	retryDelay := sweeperRetryDelay
+	retryTimeout := sweeperRetryTimeout
+	t.Cleanup(func() {
+		DependencyGraph = dependencyGraph
+		sweeperRetryDelay = retryDelay
+		sweeperRetryTimeout = retryTimeout
+	})
+	sweeperRetryDelay = 0
+
+	// The instances and the subnets reference the VCN, the instances reference the subnet
+	DependencyGraph = map[string][]string{
+		"CoreVcn":    {"CoreSubnet", "CoreInstance"},
+		"CoreSubnet": {"CoreInstance"},
+	}
+}
+
+func TestUnitSweeperLevels(t *testing.T) {
+	setUpSweeperGraph(t)
+
+	sweepers := map[string]*resource.Sweeper{
+		"CoreVcn":      {Name: "CoreVcn"},
+		"CoreSubnet":   {Name: "CoreSubnet"},
+		"CoreInstance": {Name: "CoreInstance"},
+		"Bucket":       {Name: "Bucket"},
+		"CoreRouteTable": {
+			Name:         "CoreRouteTable",
+			Dependencies: []string{"CoreSubnet"},
+		},
+	}
+	want := [][]string{
+		{"Bucket", "CoreInstance"},
+		{"CoreSubnet"},
+		{"CoreRouteTable", "CoreVcn"},
+	}
+	if got := sweeperLevels(sweepers); !reflect.DeepEqual(got, want) {
+		t.Errorf("sweeperLevels() = %v, want %v", got, want)
+	}
+
+	// A cycle does not prevent the sweepers from being run
+	sweepers["CoreInstance"].Dependencies = []string{"CoreVcn"}
+	defer func() { sweepers["CoreInstance"].Dependencies = nil }()
+	want = [][]string{
+		{"Bucket"},
+		{"CoreInstance", "CoreRouteTable", "CoreSubnet", "CoreVcn"},
+	}
+	if got := sweeperLevels(sweepers); !reflect.DeepEqual(got, want) {
+		t.Errorf("sweeperLevels() with a cycle = %v, want %v", got, want)
+	}
+}
+
+func TestUnitFilterSweepers(t *testing.T) {
+	setUpSweeperGraph(t)
+
+	sweepers := map[string]*resource.Sweeper{
+		"CoreVcn":      {Name: "CoreVcn"},
+		"CoreSubnet":   {Name: "CoreSubnet"},
+		"CoreInstance": {Name: "CoreInstance"},
+		"Bucket":       {Name: "Bucket"},
+	}
+	tests := []struct {
+		name   string
+		filter string
+		want   []string
+	}{
+		{"all", "", []string{"Bucket", "CoreInstance", "CoreSubnet", "CoreVcn"}},
+		{"dependents", "corevcn", []string{"CoreInstance", "CoreSubnet", "CoreVcn"}},
+		{"leaf", "Instance", []string{"CoreInstance"}},
+		{"several", "bucket,coresubnet", []string{"Bucket", "CoreInstance", "CoreSubnet"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for name := range filterSweepers(tt.filter, sweepers) {
+				got = append(got, name)
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("filterSweepers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// mockSweptResources are the resources of the sweepers of the tests, a resource is listed by its sweeper until it is deleted
+// The sweepers cache the resources they list and delete them with DeleteSweeperResource, like the sweepers of the resources
+type mockSweptResources struct {
+	mutex     sync.Mutex
+	existing  map[string]bool
+	order     []string
+	conflicts int
+	// undeletable returns true if a resource can not be deleted yet, its deletion conflicts
+	undeletable func(name string) bool
+}
+
+func (r *mockSweptResources) sweep(name string) func(string) error {
+	return func(compartment string) error {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+		if name == "CoreVcn" && r.conflicts > 0 {
+			r.conflicts--
+			return mockSweeperConflict{}
+		}
+		r.order = append(r.order, name+"@"+compartment)
+		ids := GetResourceIdsToSweep(compartment, name+"Id")
+		if ids == nil && r.existing[name] {
+			ids = []string{strings.ToLower(name) + "-1_ocid"}
+			AddResourceIdToSweeperResourceIdMap(compartment, name+"Id", ids[0])
+		}
+		var deleteErrors *multierror.Error
+		for _, id := range ids {
+			err := DeleteSweeperResource(compartment, name+"Id", id, func() error {
+				if !r.existing[name] {
+					return mockSweeperServiceError{404}
+				}
+				if r.undeletable != nil && r.undeletable(name) {
+					return mockSweeperConflict{}
+				}
+				delete(r.existing, name)
+				return nil
+			})
+			if err != nil {
+				deleteErrors = multierror.Append(deleteErrors, err)
+			}
+		}
+		return deleteErrors.ErrorOrNil()
+	}
+}
+
+func (r *mockSweptResources) sweepers(names ...string) map[string]*resource.Sweeper {
+	sweepers := map[string]*resource.Sweeper{}
+	for _, name := range names {
+		sweepers[name] = &resource.Sweeper{Name: name, F: r.sweep(name)}
+	}
+	return sweepers
+}
+
+func TestUnitRunSweepers(t *testing.T) {
+	setUpSweeperGraph(t)
+
+	// The VCN can not be deleted while the subnet is being deleted, it is deleted by the next pass
+	resources := &mockSweptResources{existing: map[string]bool{"CoreVcn": true, "CoreSubnet": true, "CoreInstance": true}}
+	subnetDeleted := false
+	resources.undeletable = func(name string) bool {
+		if name == "CoreSubnet" {
+			subnetDeleted = true
+		}
+		return name == "CoreVcn" && len(resources.order) <= 3
+	}
+	summary := RunSweepers([]string{"compartment-1_ocid"}, resources.sweepers("CoreVcn", "CoreSubnet", "CoreInstance"), false)
+
+	pass := []string{"CoreInstance@compartment-1_ocid", "CoreSubnet@compartment-1_ocid", "CoreVcn@compartment-1_ocid"}
+	if wantOrder := append(append(append([]string{}, pass...), pass...), pass...); !reflect.DeepEqual(resources.order, wantOrder) {
+		t.Errorf("RunSweepers() order = %v, want %v", resources.order, wantOrder)
+	}
+	if !subnetDeleted || len(resources.existing) != 0 {
+		t.Errorf("RunSweepers() left %v", resources.existing)
+	}
+	if len(summary.Failed()) != 0 || len(summary.Leftovers) != 0 || len(summary.Results) != 3 || summary.Results[2].Attempts != 3 {
+		t.Errorf("RunSweepers() summary = %+v, want the VCN to be deleted by the second pass", summary)
+	}
+}
+
+func TestUnitRunSweepersConflict(t *testing.T) {
+	setUpSweeperGraph(t)
+
+	resources := &mockSweptResources{existing: map[string]bool{}, conflicts: 2}
+	summary := RunSweepers([]string{"compartment-1_ocid"}, resources.sweepers("CoreVcn", "CoreSubnet"), false)
+	if len(summary.Failed()) != 0 || summary.Results[0].Attempts != 3 {
+		t.Errorf("RunSweepers() summary = %+v, want the conflicts of CoreVcn to be retried", summary)
+	}
+
+	// The conflicts are retried until the timeout
+	sweeperRetryTimeout = 0
+	resources.conflicts = 2
+	summary = RunSweepers([]string{"compartment-1_ocid"}, resources.sweepers("CoreVcn", "CoreSubnet"), false)
+	if failed := summary.Failed(); len(failed) != 1 || failed[0].Sweeper != "CoreVcn" || failed[0].Attempts != 1 {
+		t.Errorf("RunSweepers() summary = %+v, want the conflict of CoreVcn to fail after the timeout", summary)
+	}
+}
+
+func TestUnitRunSweepersLeftovers(t *testing.T) {
+	setUpSweeperGraph(t)
+	sweeperRetryTimeout = 100 * time.Millisecond
+	sweeperRetryDelay = 10 * time.Millisecond
+
+	resources := &mockSweptResources{existing: map[string]bool{"CoreSubnet": true, "CoreVcn": true}}
+	resources.undeletable = func(name string) bool { return name == "CoreSubnet" }
+	summary := RunSweepers([]string{"compartment-1_ocid"}, resources.sweepers("CoreVcn", "CoreSubnet"), false)
+
+	want := []SweeperLeftover{{Compartment: "compartment-1_ocid", ResourceType: "CoreSubnetId", ResourceId: "coresubnet-1_ocid"}}
+	if !reflect.DeepEqual(summary.Leftovers, want) {
+		t.Errorf("RunSweepers() leftovers = %+v, want %+v", summary.Leftovers, want)
+	}
+	if got := summary.String(); !strings.Contains(got, "[LEFT BEHIND] CoreSubnetId coresubnet-1_ocid in compartment compartment-1_ocid") {
+		t.Errorf("SweeperSummary.String() = %v, want the subnet left behind", got)
+	}
+}
+
+func TestUnitRunSweepersFailure(t *testing.T) {
+	setUpSweeperGraph(t)
+
+	ran := map[string]bool{}
+	var mutex sync.Mutex
+	sweepers := map[string]*resource.Sweeper{
+		"CoreVcn": {Name: "CoreVcn", F: func(compartment string) error {
+			mutex.Lock()
+			defer mutex.Unlock()
+			ran["CoreVcn"] = true
+			return nil
+		}},
+		"CoreSubnet": {Name: "CoreSubnet", F: func(compartment string) error {
+			AddResourceIdToSweeperResourceIdMap(compartment, "CoreSubnetId", "coresubnet-1_ocid")
+			return errors.New("subnets can not be deleted")
+		}},
+	}
+
+	summary := RunSweepers([]string{"compartment-1_ocid"}, sweepers, false)
+	if ran["CoreVcn"] {
+		t.Errorf("RunSweepers() ran CoreVcn after CoreSubnet failed")
+	}
+	if failed := summary.Failed(); len(failed) != 1 || failed[0].Sweeper != "CoreSubnet" {
+		t.Errorf("RunSweepers() summary = %+v, want CoreSubnet to fail", summary)
+	}
+
+	// The resources listed by the failed pass and the sweepers that were not run are reported
+	wantLeftovers := []SweeperLeftover{{Compartment: "compartment-1_ocid", ResourceType: "CoreSubnetId", ResourceId: "coresubnet-1_ocid"}}
+	if !reflect.DeepEqual(summary.Leftovers, wantLeftovers) {
+		t.Errorf("RunSweepers() leftovers = %+v, want %+v", summary.Leftovers, wantLeftovers)
+	}
+	if !reflect.DeepEqual(summary.Skipped, []string{"CoreVcn"}) {
+		t.Errorf("RunSweepers() skipped = %v, want CoreVcn", summary.Skipped)
+	}
+	if got := summary.String(); !strings.Contains(got, "1 sweepers not run, 1 resources left behind") || !strings.Contains(got, "[NOT RUN] CoreVcn") {
+		t.Errorf("SweeperSummary.String() = %v, want CoreVcn not run and the subnet left behind", got)
+	}
+
+	// The subnet is listed by every pass, it is not retried
+	sweeperRetryTimeout = 0
+	RunSweepers([]string{"compartment-1_ocid"}, sweepers, true)
+	if !ran["CoreVcn"] {
+		t.Errorf("RunSweepers() did not run CoreVcn when the failures are allowed")
+	}
+}
+
+func TestUnitDeleteSweeperResource(t *testing.T) {
+	if err := DeleteSweeperResource("compartment-1_ocid", "VcnId", "vcn-1_ocid", func() error { return mockSweeperServiceError{404} }); err != nil {
+		t.Errorf("DeleteSweeperResource() = %v, want a resource that is already deleted not to be an error", err)
+	}
+	if err := DeleteSweeperResource("compartment-1_ocid", "VcnId", "vcn-1_ocid", func() error { return mockSweeperConflict{} }); !isConflictError(err) {
+		t.Errorf("DeleteSweeperResource() = %v, want the conflict to be returned", err)
+	}
+}
+
+func TestUnitAddTestSweepers(t *testing.T) {
+	setUpSweeperGraph(t)
+	t.Cleanup(func() {
+		sweepersLock.Lock()
+		defer sweepersLock.Unlock()
+		delete(Sweepers, "CoreSubnet")
+	})
+
+	// The dependencies of the sweeper are taken from the graph
+	sweeper := &resource.Sweeper{Name: "CoreSubnet"}
+	AddTestSweepers("CoreSubnet", sweeper)
+	if !reflect.DeepEqual(sweeper.Dependencies, []string{"CoreInstance"}) || Sweepers["CoreSubnet"] != sweeper {
+		t.Errorf("AddTestSweepers() dependencies = %v, want the dependents of CoreSubnet", sweeper.Dependencies)
+	}
+}
+
+func TestUnitIsConflictError(t *testing.T) {
+	if !isConflictError(mockSweeperConflict{}) {
+		t.Errorf("Expected a 409 service error to be a conflict")
+	}
+	if !isConflictError(fmt.Errorf("delete failed: %w", mockSweeperConflict{})) {
+		t.Errorf("Expected a wrapped 409 service error to be a conflict")
+	}
+	// The OCIDs and the request ids of the other errors may contain 409
+	if isConflictError(fmt.Errorf("unable to delete ocid1.vcn.oc1.phx.aaaa409bbbb: opc-request-id: 409ABCDEF")) {
+		t.Errorf("Expected an error that is not a service error not to be a conflict")
+	}
+	if !isConflictError(multierror.Append(errors.New("not found"), mockSweeperConflict{})) {
+		t.Errorf("Expected the errors of a sweeper that aggregate a 409 service error to be a conflict")
+	}
+	if isConflictError(nil) {
+		t.Errorf("Expected no error not to be a conflict")
+	}
+}
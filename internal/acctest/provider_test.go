@@ -5,7 +5,12 @@ package acctest
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/rsa"
 	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
 	"io/ioutil"
 	"net/http"
 	"os"
@@ -98,7 +103,7 @@ func TestUnitBuildHttpClient(t *testing.T) {
 func TestUnitBuildClientConfigureFn(t *testing.T) {
 	configProvider := oci_common.DefaultConfigProvider()
 	httpClient := provider.BuildHttpClient()
-	configureClientFn, err := provider.BuildConfigureClientFn(configProvider, httpClient)
+	configureClientFn, err := provider.BuildConfigureClientFn(configProvider, httpClient, nil, nil)
 	assert.NoError(t, err)
 
 	baseClient := &oci_common.BaseClient{}
@@ -111,6 +116,72 @@ func TestUnitBuildClientConfigureFn(t *testing.T) {
 	assert.NotNil(t, tr.Proxy, "expected http.ProxyFromEnvironment fn")
 }
 
+// ensure a client whose configuration fails is returned with the error, and its requests fail with the error
+// issue-routing-tag: terraform/default
+func TestUnitGetClientConfigureError(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	privateKey := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+	configProvider := oci_common.NewRawConfigurationProvider("ocid1.tenancy.oc1..aaaa", "ocid1.user.oc1..aaaa", "us-phoenix-1", "fingerprint", privateKey, nil)
+
+	// Only the WorkRequestClient created by CreateSDKClients can be configured
+	configured := 0
+	clients := &tf_client.OracleClients{}
+	err = tf_client.CreateSDKClients(clients, configProvider, func(client *oci_common.BaseClient) error {
+		if configured++; configured > 1 {
+			return errors.New("invalid client certificate")
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+
+	client, err := clients.GetClientE("oci_identity.IdentityClient")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "unable to initialize 'oci_identity.IdentityClient' client: invalid client certificate")
+	}
+	assert.Same(t, client, clients.IdentityClient())
+	_, err = clients.IdentityClient().ListRegions(context.Background())
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "invalid client certificate")
+	}
+	assert.Equal(t, 2, configured, "expected the client to be created once")
+}
+
+// ensure the typed accessor of a client whose constructor fails returns a client whose requests fail with the error
+// issue-routing-tag: terraform/default
+func TestUnitGetClientConstructorError(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	privateKey := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+	configProvider := oci_common.NewRawConfigurationProvider("ocid1.tenancy.oc1..aaaa", "ocid1.user.oc1..aaaa", "us-phoenix-1", "fingerprint", privateKey, nil)
+
+	registration := tf_client.OracleClientRegistrationsVar.RegisteredClients["oci_identity.IdentityClient"]
+	defer func() {
+		tf_client.OracleClientRegistrationsVar.RegisteredClients["oci_identity.IdentityClient"] = registration
+	}()
+	tf_client.OracleClientRegistrationsVar.RegisteredClients["oci_identity.IdentityClient"] = &tf_client.OracleClient{
+		InitClientFn: func(oci_common.ConfigurationProvider, tf_client.ConfigureClient, tf_client.ServiceClientOverrides) (interface{}, error) {
+			return nil, errors.New("can not create client, bad configuration")
+		},
+	}
+
+	clients := &tf_client.OracleClients{}
+	err = tf_client.CreateSDKClients(clients, configProvider, func(*oci_common.BaseClient) error { return nil })
+	assert.NoError(t, err)
+
+	_, err = clients.GetClientE("oci_identity.IdentityClient")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "unable to initialize 'oci_identity.IdentityClient' client: can not create client, bad configuration")
+	}
+	client := clients.IdentityClient()
+	if assert.NotNil(t, client) {
+		_, err = client.ListRegions(context.Background())
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "can not create client, bad configuration")
+		}
+	}
+}
+
 // ensure custom certs can be added to the cert pool and expected http client settings are preserved
 // issue-routing-tag: terraform/default
 func TestUnitBuildClientConfigureFn_withCustomCert(t *testing.T) {
@@ -139,7 +210,7 @@ func TestUnitBuildClientConfigureFn_withCustomCert(t *testing.T) {
 	assert.Equal(t, tempCert.Name(), utils.GetEnvSettingWithBlankDefault(globalvar.CustomCertLocationEnv))
 	configProvider := oci_common.DefaultConfigProvider()
 	httpClient := provider.BuildHttpClient()
-	configureClientFn, err := provider.BuildConfigureClientFn(configProvider, httpClient)
+	configureClientFn, err := provider.BuildConfigureClientFn(configProvider, httpClient, nil, nil)
 	assert.NoError(t, err)
 
 	baseClient := &oci_common.BaseClient{}
@@ -168,7 +239,7 @@ func TestUnitBuildClientConfigureFn_acceptLocalCerts(t *testing.T) {
 	assert.Empty(t, utils.GetEnvSettingWithBlankDefault(globalvar.AcceptLocalCerts))
 	configProvider := oci_common.DefaultConfigProvider()
 	httpClient := provider.BuildHttpClient()
-	configureClientFn, _ := provider.BuildConfigureClientFn(configProvider, httpClient)
+	configureClientFn, _ := provider.BuildConfigureClientFn(configProvider, httpClient, nil, nil)
 	configureClientFn(&oci_common.BaseClient{})
 
 	tr := httpClient.Transport.(*http.Transport)
@@ -180,7 +251,7 @@ func TestUnitBuildClientConfigureFn_acceptLocalCerts(t *testing.T) {
 	os.Setenv(globalvar.AcceptLocalCerts, "")
 	configProvider = oci_common.DefaultConfigProvider()
 	httpClient = provider.BuildHttpClient()
-	configureClientFn, _ = provider.BuildConfigureClientFn(configProvider, httpClient)
+	configureClientFn, _ = provider.BuildConfigureClientFn(configProvider, httpClient, nil, nil)
 	configureClientFn(&oci_common.BaseClient{})
 
 	tr = httpClient.Transport.(*http.Transport)
@@ -190,7 +261,7 @@ func TestUnitBuildClientConfigureFn_acceptLocalCerts(t *testing.T) {
 	os.Setenv(globalvar.AcceptLocalCerts, "ftarlusee")
 	configProvider = oci_common.DefaultConfigProvider()
 	httpClient = provider.BuildHttpClient()
-	configureClientFn, _ = provider.BuildConfigureClientFn(configProvider, httpClient)
+	configureClientFn, _ = provider.BuildConfigureClientFn(configProvider, httpClient, nil, nil)
 	configureClientFn(&oci_common.BaseClient{})
 
 	tr = httpClient.Transport.(*http.Transport)
@@ -200,7 +271,7 @@ func TestUnitBuildClientConfigureFn_acceptLocalCerts(t *testing.T) {
 	os.Setenv(globalvar.AcceptLocalCerts, "false")
 	configProvider = oci_common.DefaultConfigProvider()
 	httpClient = provider.BuildHttpClient()
-	configureClientFn, _ = provider.BuildConfigureClientFn(configProvider, httpClient)
+	configureClientFn, _ = provider.BuildConfigureClientFn(configProvider, httpClient, nil, nil)
 	configureClientFn(&oci_common.BaseClient{})
 
 	tr = httpClient.Transport.(*http.Transport)
@@ -210,7 +281,7 @@ func TestUnitBuildClientConfigureFn_acceptLocalCerts(t *testing.T) {
 	os.Setenv(globalvar.AcceptLocalCerts, "true")
 	configProvider = oci_common.DefaultConfigProvider()
 	httpClient = provider.BuildHttpClient()
-	configureClientFn, _ = provider.BuildConfigureClientFn(configProvider, httpClient)
+	configureClientFn, _ = provider.BuildConfigureClientFn(configProvider, httpClient, nil, nil)
 	configureClientFn(&oci_common.BaseClient{})
 
 	tr = httpClient.Transport.(*http.Transport)
@@ -220,7 +291,7 @@ func TestUnitBuildClientConfigureFn_acceptLocalCerts(t *testing.T) {
 	os.Setenv(globalvar.AcceptLocalCerts, "1")
 	configProvider = oci_common.DefaultConfigProvider()
 	httpClient = provider.BuildHttpClient()
-	configureClientFn, _ = provider.BuildConfigureClientFn(configProvider, httpClient)
+	configureClientFn, _ = provider.BuildConfigureClientFn(configProvider, httpClient, nil, nil)
 	configureClientFn(&oci_common.BaseClient{})
 
 	tr = httpClient.Transport.(*http.Transport)
@@ -248,7 +319,7 @@ func TestUnitBuildClientConfigureFn_withDomainNameOverride(t *testing.T) {
 	assert.Equal(t, "0r4-c10ud.com", utils.GetEnvSettingWithBlankDefault(globalvar.DomainNameOverrideEnv))
 	configProvider := oci_common.DefaultConfigProvider()
 	httpClient := provider.BuildHttpClient()
-	configureClientFn, err := provider.BuildConfigureClientFn(configProvider, httpClient)
+	configureClientFn, err := provider.BuildConfigureClientFn(configProvider, httpClient, nil, nil)
 	assert.NoError(t, err)
 
 	baseClient := &oci_common.BaseClient{}
@@ -304,7 +375,7 @@ func TestUnitBuildClientConfigureFn_withDomainNameOverrideAndCorrectDomainName(t
 	assert.Equal(t, "oc.0r4-c10ud.com", utils.GetEnvSettingWithBlankDefault(globalvar.HasCorrectDomainNameEnv))
 	configProvider := oci_common.DefaultConfigProvider()
 	httpClient := provider.BuildHttpClient()
-	configureClientFn, err := provider.BuildConfigureClientFn(configProvider, httpClient)
+	configureClientFn, err := provider.BuildConfigureClientFn(configProvider, httpClient, nil, nil)
 	assert.NoError(t, err)
 
 	baseClient := &oci_common.BaseClient{}
@@ -354,7 +425,7 @@ func TestUnitBuildClientConfigureFn_interceptor(t *testing.T) {
 	assert.Equal(t, "true", utils.GetEnvSettingWithBlankDefault("use_obo_token"))
 	configProvider := oci_common.DefaultConfigProvider()
 	httpClient := provider.BuildHttpClient()
-	configureClientFn, err := provider.BuildConfigureClientFn(configProvider, httpClient)
+	configureClientFn, err := provider.BuildConfigureClientFn(configProvider, httpClient, nil, nil)
 	assert.NoError(t, err)
 
 	baseClient := &oci_common.BaseClient{}
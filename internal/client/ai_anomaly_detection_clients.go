@@ -30,5 +30,10 @@ func initAianomalydetectionAnomalyDetectionClient(configProvider oci_common.Conf
 }
 
 func (m *OracleClients) AnomalyDetectionClient() *oci_ai_anomaly_detection.AnomalyDetectionClient {
-	return m.GetClient("oci_ai_anomaly_detection.AnomalyDetectionClient").(*oci_ai_anomaly_detection.AnomalyDetectionClient)
+	if client, ok := m.GetClient("oci_ai_anomaly_detection.AnomalyDetectionClient").(*oci_ai_anomaly_detection.AnomalyDetectionClient); ok {
+		return client
+	}
+	client := &oci_ai_anomaly_detection.AnomalyDetectionClient{}
+	m.failedClient("oci_ai_anomaly_detection.AnomalyDetectionClient", &client.BaseClient)
+	return client
 }
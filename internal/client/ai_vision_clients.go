@@ -30,5 +30,10 @@ func initAivisionAiServiceVisionClient(configProvider oci_common.ConfigurationPr
 }
 
 func (m *OracleClients) AiServiceVisionClient() *oci_ai_vision.AIServiceVisionClient {
-	return m.GetClient("oci_ai_vision.AiServiceVisionClient").(*oci_ai_vision.AIServiceVisionClient)
+	if client, ok := m.GetClient("oci_ai_vision.AiServiceVisionClient").(*oci_ai_vision.AIServiceVisionClient); ok {
+		return client
+	}
+	client := &oci_ai_vision.AIServiceVisionClient{}
+	m.failedClient("oci_ai_vision.AiServiceVisionClient", &client.BaseClient)
+	return client
 }
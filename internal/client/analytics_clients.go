@@ -30,5 +30,10 @@ func initAnalyticsAnalyticsClient(configProvider oci_common.ConfigurationProvide
 }
 
 func (m *OracleClients) AnalyticsClient() *oci_analytics.AnalyticsClient {
-	return m.GetClient("oci_analytics.AnalyticsClient").(*oci_analytics.AnalyticsClient)
+	if client, ok := m.GetClient("oci_analytics.AnalyticsClient").(*oci_analytics.AnalyticsClient); ok {
+		return client
+	}
+	client := &oci_analytics.AnalyticsClient{}
+	m.failedClient("oci_analytics.AnalyticsClient", &client.BaseClient)
+	return client
 }
@@ -33,7 +33,12 @@ func initApigatewayApiGatewayClient(configProvider oci_common.ConfigurationProvi
 }
 
 func (m *OracleClients) ApiGatewayClient() *oci_apigateway.ApiGatewayClient {
-	return m.GetClient("oci_apigateway.ApiGatewayClient").(*oci_apigateway.ApiGatewayClient)
+	if client, ok := m.GetClient("oci_apigateway.ApiGatewayClient").(*oci_apigateway.ApiGatewayClient); ok {
+		return client
+	}
+	client := &oci_apigateway.ApiGatewayClient{}
+	m.failedClient("oci_apigateway.ApiGatewayClient", &client.BaseClient)
+	return client
 }
 
 func initApigatewayWorkRequestsClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -53,7 +58,12 @@ func initApigatewayWorkRequestsClient(configProvider oci_common.ConfigurationPro
 }
 
 func (m *OracleClients) ApigatewayWorkRequestsClient() *oci_apigateway.WorkRequestsClient {
-	return m.GetClient("oci_apigateway.WorkRequestsClient").(*oci_apigateway.WorkRequestsClient)
+	if client, ok := m.GetClient("oci_apigateway.WorkRequestsClient").(*oci_apigateway.WorkRequestsClient); ok {
+		return client
+	}
+	client := &oci_apigateway.WorkRequestsClient{}
+	m.failedClient("oci_apigateway.WorkRequestsClient", &client.BaseClient)
+	return client
 }
 
 func initApigatewayDeploymentClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -73,7 +83,12 @@ func initApigatewayDeploymentClient(configProvider oci_common.ConfigurationProvi
 }
 
 func (m *OracleClients) DeploymentClient() *oci_apigateway.DeploymentClient {
-	return m.GetClient("oci_apigateway.DeploymentClient").(*oci_apigateway.DeploymentClient)
+	if client, ok := m.GetClient("oci_apigateway.DeploymentClient").(*oci_apigateway.DeploymentClient); ok {
+		return client
+	}
+	client := &oci_apigateway.DeploymentClient{}
+	m.failedClient("oci_apigateway.DeploymentClient", &client.BaseClient)
+	return client
 }
 
 func initApigatewayGatewayClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -93,5 +108,10 @@ func initApigatewayGatewayClient(configProvider oci_common.ConfigurationProvider
 }
 
 func (m *OracleClients) GatewayClient() *oci_apigateway.GatewayClient {
-	return m.GetClient("oci_apigateway.GatewayClient").(*oci_apigateway.GatewayClient)
+	if client, ok := m.GetClient("oci_apigateway.GatewayClient").(*oci_apigateway.GatewayClient); ok {
+		return client
+	}
+	client := &oci_apigateway.GatewayClient{}
+	m.failedClient("oci_apigateway.GatewayClient", &client.BaseClient)
+	return client
 }
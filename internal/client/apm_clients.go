@@ -30,5 +30,10 @@ func initApmcontrolplaneApmDomainClient(configProvider oci_common.ConfigurationP
 }
 
 func (m *OracleClients) ApmDomainClient() *oci_apm.ApmDomainClient {
-	return m.GetClient("oci_apm.ApmDomainClient").(*oci_apm.ApmDomainClient)
+	if client, ok := m.GetClient("oci_apm.ApmDomainClient").(*oci_apm.ApmDomainClient); ok {
+		return client
+	}
+	client := &oci_apm.ApmDomainClient{}
+	m.failedClient("oci_apm.ApmDomainClient", &client.BaseClient)
+	return client
 }
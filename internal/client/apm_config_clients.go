@@ -30,5 +30,10 @@ func initApmconfigConfigClient(configProvider oci_common.ConfigurationProvider,
 }
 
 func (m *OracleClients) ConfigClient() *oci_apm_config.ConfigClient {
-	return m.GetClient("oci_apm_config.ConfigClient").(*oci_apm_config.ConfigClient)
+	if client, ok := m.GetClient("oci_apm_config.ConfigClient").(*oci_apm_config.ConfigClient); ok {
+		return client
+	}
+	client := &oci_apm_config.ConfigClient{}
+	m.failedClient("oci_apm_config.ConfigClient", &client.BaseClient)
+	return client
 }
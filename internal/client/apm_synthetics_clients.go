@@ -30,5 +30,10 @@ func initApmsyntheticsApmSyntheticClient(configProvider oci_common.Configuration
 }
 
 func (m *OracleClients) ApmSyntheticClient() *oci_apm_synthetics.ApmSyntheticClient {
-	return m.GetClient("oci_apm_synthetics.ApmSyntheticClient").(*oci_apm_synthetics.ApmSyntheticClient)
+	if client, ok := m.GetClient("oci_apm_synthetics.ApmSyntheticClient").(*oci_apm_synthetics.ApmSyntheticClient); ok {
+		return client
+	}
+	client := &oci_apm_synthetics.ApmSyntheticClient{}
+	m.failedClient("oci_apm_synthetics.ApmSyntheticClient", &client.BaseClient)
+	return client
 }
@@ -30,5 +30,10 @@ func initAppmgmtcontrolAppmgmtControlClient(configProvider oci_common.Configurat
 }
 
 func (m *OracleClients) AppmgmtControlClient() *oci_appmgmt_control.AppmgmtControlClient {
-	return m.GetClient("oci_appmgmt_control.AppmgmtControlClient").(*oci_appmgmt_control.AppmgmtControlClient)
+	if client, ok := m.GetClient("oci_appmgmt_control.AppmgmtControlClient").(*oci_appmgmt_control.AppmgmtControlClient); ok {
+		return client
+	}
+	client := &oci_appmgmt_control.AppmgmtControlClient{}
+	m.failedClient("oci_appmgmt_control.AppmgmtControlClient", &client.BaseClient)
+	return client
 }
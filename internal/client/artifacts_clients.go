@@ -30,5 +30,10 @@ func initArtifactsArtifactsClient(configProvider oci_common.ConfigurationProvide
 }
 
 func (m *OracleClients) ArtifactsClient() *oci_artifacts.ArtifactsClient {
-	return m.GetClient("oci_artifacts.ArtifactsClient").(*oci_artifacts.ArtifactsClient)
+	if client, ok := m.GetClient("oci_artifacts.ArtifactsClient").(*oci_artifacts.ArtifactsClient); ok {
+		return client
+	}
+	client := &oci_artifacts.ArtifactsClient{}
+	m.failedClient("oci_artifacts.ArtifactsClient", &client.BaseClient)
+	return client
 }
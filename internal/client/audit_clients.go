@@ -30,5 +30,10 @@ func initAuditAuditClient(configProvider oci_common.ConfigurationProvider, confi
 }
 
 func (m *OracleClients) AuditClient() *oci_audit.AuditClient {
-	return m.GetClient("oci_audit.AuditClient").(*oci_audit.AuditClient)
+	if client, ok := m.GetClient("oci_audit.AuditClient").(*oci_audit.AuditClient); ok {
+		return client
+	}
+	client := &oci_audit.AuditClient{}
+	m.failedClient("oci_audit.AuditClient", &client.BaseClient)
+	return client
 }
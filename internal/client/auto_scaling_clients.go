@@ -30,5 +30,10 @@ func initAutoscalingAutoScalingClient(configProvider oci_common.ConfigurationPro
 }
 
 func (m *OracleClients) AutoScalingClient() *oci_auto_scaling.AutoScalingClient {
-	return m.GetClient("oci_auto_scaling.AutoScalingClient").(*oci_auto_scaling.AutoScalingClient)
+	if client, ok := m.GetClient("oci_auto_scaling.AutoScalingClient").(*oci_auto_scaling.AutoScalingClient); ok {
+		return client
+	}
+	client := &oci_auto_scaling.AutoScalingClient{}
+	m.failedClient("oci_auto_scaling.AutoScalingClient", &client.BaseClient)
+	return client
 }
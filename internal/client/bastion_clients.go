@@ -30,5 +30,10 @@ func initBastionBastionClient(configProvider oci_common.ConfigurationProvider, c
 }
 
 func (m *OracleClients) BastionClient() *oci_bastion.BastionClient {
-	return m.GetClient("oci_bastion.BastionClient").(*oci_bastion.BastionClient)
+	if client, ok := m.GetClient("oci_bastion.BastionClient").(*oci_bastion.BastionClient); ok {
+		return client
+	}
+	client := &oci_bastion.BastionClient{}
+	m.failedClient("oci_bastion.BastionClient", &client.BaseClient)
+	return client
 }
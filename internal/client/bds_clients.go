@@ -30,5 +30,10 @@ func initBdsBdsClient(configProvider oci_common.ConfigurationProvider, configure
 }
 
 func (m *OracleClients) BdsClient() *oci_bds.BdsClient {
-	return m.GetClient("oci_bds.BdsClient").(*oci_bds.BdsClient)
+	if client, ok := m.GetClient("oci_bds.BdsClient").(*oci_bds.BdsClient); ok {
+		return client
+	}
+	client := &oci_bds.BdsClient{}
+	m.failedClient("oci_bds.BdsClient", &client.BaseClient)
+	return client
 }
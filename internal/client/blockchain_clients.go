@@ -30,5 +30,10 @@ func initBlockchainBlockchainPlatformClient(configProvider oci_common.Configurat
 }
 
 func (m *OracleClients) BlockchainPlatformClient() *oci_blockchain.BlockchainPlatformClient {
-	return m.GetClient("oci_blockchain.BlockchainPlatformClient").(*oci_blockchain.BlockchainPlatformClient)
+	if client, ok := m.GetClient("oci_blockchain.BlockchainPlatformClient").(*oci_blockchain.BlockchainPlatformClient); ok {
+		return client
+	}
+	client := &oci_blockchain.BlockchainPlatformClient{}
+	m.failedClient("oci_blockchain.BlockchainPlatformClient", &client.BaseClient)
+	return client
 }
@@ -30,5 +30,10 @@ func initBudgetBudgetClient(configProvider oci_common.ConfigurationProvider, con
 }
 
 func (m *OracleClients) BudgetClient() *oci_budget.BudgetClient {
-	return m.GetClient("oci_budget.BudgetClient").(*oci_budget.BudgetClient)
+	if client, ok := m.GetClient("oci_budget.BudgetClient").(*oci_budget.BudgetClient); ok {
+		return client
+	}
+	client := &oci_budget.BudgetClient{}
+	m.failedClient("oci_budget.BudgetClient", &client.BaseClient)
+	return client
 }
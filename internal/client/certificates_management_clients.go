@@ -30,5 +30,10 @@ func initCertificatesmanagementCertificatesManagementClient(configProvider oci_c
 }
 
 func (m *OracleClients) CertificatesManagementClient() *oci_certificates_management.CertificatesManagementClient {
-	return m.GetClient("oci_certificates_management.CertificatesManagementClient").(*oci_certificates_management.CertificatesManagementClient)
+	if client, ok := m.GetClient("oci_certificates_management.CertificatesManagementClient").(*oci_certificates_management.CertificatesManagementClient); ok {
+		return client
+	}
+	client := &oci_certificates_management.CertificatesManagementClient{}
+	m.failedClient("oci_certificates_management.CertificatesManagementClient", &client.BaseClient)
+	return client
 }
@@ -30,5 +30,10 @@ func initCloudguardCloudGuardClient(configProvider oci_common.ConfigurationProvi
 }
 
 func (m *OracleClients) CloudGuardClient() *oci_cloud_guard.CloudGuardClient {
-	return m.GetClient("oci_cloud_guard.CloudGuardClient").(*oci_cloud_guard.CloudGuardClient)
+	if client, ok := m.GetClient("oci_cloud_guard.CloudGuardClient").(*oci_cloud_guard.CloudGuardClient); ok {
+		return client
+	}
+	client := &oci_cloud_guard.CloudGuardClient{}
+	m.failedClient("oci_cloud_guard.CloudGuardClient", &client.BaseClient)
+	return client
 }
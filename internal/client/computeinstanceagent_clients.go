@@ -31,7 +31,12 @@ func initComputeinstanceagentPluginClient(configProvider oci_common.Configuratio
 }
 
 func (m *OracleClients) PluginClient() *oci_computeinstanceagent.PluginClient {
-	return m.GetClient("oci_computeinstanceagent.PluginClient").(*oci_computeinstanceagent.PluginClient)
+	if client, ok := m.GetClient("oci_computeinstanceagent.PluginClient").(*oci_computeinstanceagent.PluginClient); ok {
+		return client
+	}
+	client := &oci_computeinstanceagent.PluginClient{}
+	m.failedClient("oci_computeinstanceagent.PluginClient", &client.BaseClient)
+	return client
 }
 
 func initComputeinstanceagentPluginconfigClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -51,5 +56,10 @@ func initComputeinstanceagentPluginconfigClient(configProvider oci_common.Config
 }
 
 func (m *OracleClients) PluginconfigClient() *oci_computeinstanceagent.PluginconfigClient {
-	return m.GetClient("oci_computeinstanceagent.PluginconfigClient").(*oci_computeinstanceagent.PluginconfigClient)
+	if client, ok := m.GetClient("oci_computeinstanceagent.PluginconfigClient").(*oci_computeinstanceagent.PluginconfigClient); ok {
+		return client
+	}
+	client := &oci_computeinstanceagent.PluginconfigClient{}
+	m.failedClient("oci_computeinstanceagent.PluginconfigClient", &client.BaseClient)
+	return client
 }
@@ -30,5 +30,10 @@ func initContainerengineContainerEngineClient(configProvider oci_common.Configur
 }
 
 func (m *OracleClients) ContainerEngineClient() *oci_containerengine.ContainerEngineClient {
-	return m.GetClient("oci_containerengine.ContainerEngineClient").(*oci_containerengine.ContainerEngineClient)
+	if client, ok := m.GetClient("oci_containerengine.ContainerEngineClient").(*oci_containerengine.ContainerEngineClient); ok {
+		return client
+	}
+	client := &oci_containerengine.ContainerEngineClient{}
+	m.failedClient("oci_containerengine.ContainerEngineClient", &client.BaseClient)
+	return client
 }
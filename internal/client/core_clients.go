@@ -33,7 +33,12 @@ func initCoreBlockstorageClient(configProvider oci_common.ConfigurationProvider,
 }
 
 func (m *OracleClients) BlockstorageClient() *oci_core.BlockstorageClient {
-	return m.GetClient("oci_core.BlockstorageClient").(*oci_core.BlockstorageClient)
+	if client, ok := m.GetClient("oci_core.BlockstorageClient").(*oci_core.BlockstorageClient); ok {
+		return client
+	}
+	client := &oci_core.BlockstorageClient{}
+	m.failedClient("oci_core.BlockstorageClient", &client.BaseClient)
+	return client
 }
 
 func initCoreComputeClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -53,7 +58,12 @@ func initCoreComputeClient(configProvider oci_common.ConfigurationProvider, conf
 }
 
 func (m *OracleClients) ComputeClient() *oci_core.ComputeClient {
-	return m.GetClient("oci_core.ComputeClient").(*oci_core.ComputeClient)
+	if client, ok := m.GetClient("oci_core.ComputeClient").(*oci_core.ComputeClient); ok {
+		return client
+	}
+	client := &oci_core.ComputeClient{}
+	m.failedClient("oci_core.ComputeClient", &client.BaseClient)
+	return client
 }
 
 func initCoreComputeManagementClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -73,7 +83,12 @@ func initCoreComputeManagementClient(configProvider oci_common.ConfigurationProv
 }
 
 func (m *OracleClients) ComputeManagementClient() *oci_core.ComputeManagementClient {
-	return m.GetClient("oci_core.ComputeManagementClient").(*oci_core.ComputeManagementClient)
+	if client, ok := m.GetClient("oci_core.ComputeManagementClient").(*oci_core.ComputeManagementClient); ok {
+		return client
+	}
+	client := &oci_core.ComputeManagementClient{}
+	m.failedClient("oci_core.ComputeManagementClient", &client.BaseClient)
+	return client
 }
 
 func initCoreVirtualNetworkClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -93,5 +108,10 @@ func initCoreVirtualNetworkClient(configProvider oci_common.ConfigurationProvide
 }
 
 func (m *OracleClients) VirtualNetworkClient() *oci_core.VirtualNetworkClient {
-	return m.GetClient("oci_core.VirtualNetworkClient").(*oci_core.VirtualNetworkClient)
+	if client, ok := m.GetClient("oci_core.VirtualNetworkClient").(*oci_core.VirtualNetworkClient); ok {
+		return client
+	}
+	client := &oci_core.VirtualNetworkClient{}
+	m.failedClient("oci_core.VirtualNetworkClient", &client.BaseClient)
+	return client
 }
@@ -31,7 +31,12 @@ func initDataconnectivityDataConnectivityManagementClient(configProvider oci_com
 }
 
 func (m *OracleClients) DataConnectivityManagementClient() *oci_data_connectivity.DataConnectivityManagementClient {
-	return m.GetClient("oci_data_connectivity.DataConnectivityManagementClient").(*oci_data_connectivity.DataConnectivityManagementClient)
+	if client, ok := m.GetClient("oci_data_connectivity.DataConnectivityManagementClient").(*oci_data_connectivity.DataConnectivityManagementClient); ok {
+		return client
+	}
+	client := &oci_data_connectivity.DataConnectivityManagementClient{}
+	m.failedClient("oci_data_connectivity.DataConnectivityManagementClient", &client.BaseClient)
+	return client
 }
 
 func initDataconnectivityNetworkValidationClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -51,5 +56,10 @@ func initDataconnectivityNetworkValidationClient(configProvider oci_common.Confi
 }
 
 func (m *OracleClients) NetworkValidationClient() *oci_data_connectivity.NetworkValidationClient {
-	return m.GetClient("oci_data_connectivity.NetworkValidationClient").(*oci_data_connectivity.NetworkValidationClient)
+	if client, ok := m.GetClient("oci_data_connectivity.NetworkValidationClient").(*oci_data_connectivity.NetworkValidationClient); ok {
+		return client
+	}
+	client := &oci_data_connectivity.NetworkValidationClient{}
+	m.failedClient("oci_data_connectivity.NetworkValidationClient", &client.BaseClient)
+	return client
 }
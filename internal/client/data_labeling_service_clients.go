@@ -30,5 +30,10 @@ func initDatalabelingserviceDataLabelingManagementClient(configProvider oci_comm
 }
 
 func (m *OracleClients) DataLabelingManagementClient() *oci_data_labeling_service.DataLabelingManagementClient {
-	return m.GetClient("oci_data_labeling_service.DataLabelingManagementClient").(*oci_data_labeling_service.DataLabelingManagementClient)
+	if client, ok := m.GetClient("oci_data_labeling_service.DataLabelingManagementClient").(*oci_data_labeling_service.DataLabelingManagementClient); ok {
+		return client
+	}
+	client := &oci_data_labeling_service.DataLabelingManagementClient{}
+	m.failedClient("oci_data_labeling_service.DataLabelingManagementClient", &client.BaseClient)
+	return client
 }
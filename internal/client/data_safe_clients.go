@@ -30,5 +30,10 @@ func initDatasafeDataSafeClient(configProvider oci_common.ConfigurationProvider,
 }
 
 func (m *OracleClients) DataSafeClient() *oci_data_safe.DataSafeClient {
-	return m.GetClient("oci_data_safe.DataSafeClient").(*oci_data_safe.DataSafeClient)
+	if client, ok := m.GetClient("oci_data_safe.DataSafeClient").(*oci_data_safe.DataSafeClient); ok {
+		return client
+	}
+	client := &oci_data_safe.DataSafeClient{}
+	m.failedClient("oci_data_safe.DataSafeClient", &client.BaseClient)
+	return client
 }
@@ -30,5 +30,10 @@ func initDatabaseDatabaseClient(configProvider oci_common.ConfigurationProvider,
 }
 
 func (m *OracleClients) DatabaseClient() *oci_database.DatabaseClient {
-	return m.GetClient("oci_database.DatabaseClient").(*oci_database.DatabaseClient)
+	if client, ok := m.GetClient("oci_database.DatabaseClient").(*oci_database.DatabaseClient); ok {
+		return client
+	}
+	client := &oci_database.DatabaseClient{}
+	m.failedClient("oci_database.DatabaseClient", &client.BaseClient)
+	return client
 }
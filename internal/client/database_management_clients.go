@@ -31,7 +31,12 @@ func initDatabasemanagementDbManagementClient(configProvider oci_common.Configur
 }
 
 func (m *OracleClients) DbManagementClient() *oci_database_management.DbManagementClient {
-	return m.GetClient("oci_database_management.DbManagementClient").(*oci_database_management.DbManagementClient)
+	if client, ok := m.GetClient("oci_database_management.DbManagementClient").(*oci_database_management.DbManagementClient); ok {
+		return client
+	}
+	client := &oci_database_management.DbManagementClient{}
+	m.failedClient("oci_database_management.DbManagementClient", &client.BaseClient)
+	return client
 }
 
 func initDatabasemanagementSqlTuningClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -51,5 +56,10 @@ func initDatabasemanagementSqlTuningClient(configProvider oci_common.Configurati
 }
 
 func (m *OracleClients) SqlTuningClient() *oci_database_management.SqlTuningClient {
-	return m.GetClient("oci_database_management.SqlTuningClient").(*oci_database_management.SqlTuningClient)
+	if client, ok := m.GetClient("oci_database_management.SqlTuningClient").(*oci_database_management.SqlTuningClient); ok {
+		return client
+	}
+	client := &oci_database_management.SqlTuningClient{}
+	m.failedClient("oci_database_management.SqlTuningClient", &client.BaseClient)
+	return client
 }
@@ -30,5 +30,10 @@ func initDatabasemigrationDatabaseMigrationClient(configProvider oci_common.Conf
 }
 
 func (m *OracleClients) DatabaseMigrationClient() *oci_database_migration.DatabaseMigrationClient {
-	return m.GetClient("oci_database_migration.DatabaseMigrationClient").(*oci_database_migration.DatabaseMigrationClient)
+	if client, ok := m.GetClient("oci_database_migration.DatabaseMigrationClient").(*oci_database_migration.DatabaseMigrationClient); ok {
+		return client
+	}
+	client := &oci_database_migration.DatabaseMigrationClient{}
+	m.failedClient("oci_database_migration.DatabaseMigrationClient", &client.BaseClient)
+	return client
 }
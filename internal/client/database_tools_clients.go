@@ -30,5 +30,10 @@ func initDatabasetoolsDatabaseToolsClient(configProvider oci_common.Configuratio
 }
 
 func (m *OracleClients) DatabaseToolsClient() *oci_database_tools.DatabaseToolsClient {
-	return m.GetClient("oci_database_tools.DatabaseToolsClient").(*oci_database_tools.DatabaseToolsClient)
+	if client, ok := m.GetClient("oci_database_tools.DatabaseToolsClient").(*oci_database_tools.DatabaseToolsClient); ok {
+		return client
+	}
+	client := &oci_database_tools.DatabaseToolsClient{}
+	m.failedClient("oci_database_tools.DatabaseToolsClient", &client.BaseClient)
+	return client
 }
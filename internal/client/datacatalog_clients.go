@@ -30,5 +30,10 @@ func initDatacatalogDataCatalogClient(configProvider oci_common.ConfigurationPro
 }
 
 func (m *OracleClients) DataCatalogClient() *oci_datacatalog.DataCatalogClient {
-	return m.GetClient("oci_datacatalog.DataCatalogClient").(*oci_datacatalog.DataCatalogClient)
+	if client, ok := m.GetClient("oci_datacatalog.DataCatalogClient").(*oci_datacatalog.DataCatalogClient); ok {
+		return client
+	}
+	client := &oci_datacatalog.DataCatalogClient{}
+	m.failedClient("oci_datacatalog.DataCatalogClient", &client.BaseClient)
+	return client
 }
@@ -30,5 +30,10 @@ func initDataflowDataFlowClient(configProvider oci_common.ConfigurationProvider,
 }
 
 func (m *OracleClients) DataFlowClient() *oci_dataflow.DataFlowClient {
-	return m.GetClient("oci_dataflow.DataFlowClient").(*oci_dataflow.DataFlowClient)
+	if client, ok := m.GetClient("oci_dataflow.DataFlowClient").(*oci_dataflow.DataFlowClient); ok {
+		return client
+	}
+	client := &oci_dataflow.DataFlowClient{}
+	m.failedClient("oci_dataflow.DataFlowClient", &client.BaseClient)
+	return client
 }
@@ -30,5 +30,10 @@ func initDataintegrationDataIntegrationClient(configProvider oci_common.Configur
 }
 
 func (m *OracleClients) DataIntegrationClient() *oci_dataintegration.DataIntegrationClient {
-	return m.GetClient("oci_dataintegration.DataIntegrationClient").(*oci_dataintegration.DataIntegrationClient)
+	if client, ok := m.GetClient("oci_dataintegration.DataIntegrationClient").(*oci_dataintegration.DataIntegrationClient); ok {
+		return client
+	}
+	client := &oci_dataintegration.DataIntegrationClient{}
+	m.failedClient("oci_dataintegration.DataIntegrationClient", &client.BaseClient)
+	return client
 }
@@ -30,5 +30,10 @@ func initDatascienceDataScienceClient(configProvider oci_common.ConfigurationPro
 }
 
 func (m *OracleClients) DataScienceClient() *oci_datascience.DataScienceClient {
-	return m.GetClient("oci_datascience.DataScienceClient").(*oci_datascience.DataScienceClient)
+	if client, ok := m.GetClient("oci_datascience.DataScienceClient").(*oci_datascience.DataScienceClient); ok {
+		return client
+	}
+	client := &oci_datascience.DataScienceClient{}
+	m.failedClient("oci_datascience.DataScienceClient", &client.BaseClient)
+	return client
 }
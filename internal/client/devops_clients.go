@@ -30,5 +30,10 @@ func initDevopsDevopsClient(configProvider oci_common.ConfigurationProvider, con
 }
 
 func (m *OracleClients) DevopsClient() *oci_devops.DevopsClient {
-	return m.GetClient("oci_devops.DevopsClient").(*oci_devops.DevopsClient)
+	if client, ok := m.GetClient("oci_devops.DevopsClient").(*oci_devops.DevopsClient); ok {
+		return client
+	}
+	client := &oci_devops.DevopsClient{}
+	m.failedClient("oci_devops.DevopsClient", &client.BaseClient)
+	return client
 }
@@ -30,5 +30,10 @@ func initDnsDnsClient(configProvider oci_common.ConfigurationProvider, configure
 }
 
 func (m *OracleClients) DnsClient() *oci_dns.DnsClient {
-	return m.GetClient("oci_dns.DnsClient").(*oci_dns.DnsClient)
+	if client, ok := m.GetClient("oci_dns.DnsClient").(*oci_dns.DnsClient); ok {
+		return client
+	}
+	client := &oci_dns.DnsClient{}
+	m.failedClient("oci_dns.DnsClient", &client.BaseClient)
+	return client
 }
@@ -30,5 +30,10 @@ func initEmailEmailClient(configProvider oci_common.ConfigurationProvider, confi
 }
 
 func (m *OracleClients) EmailClient() *oci_email.EmailClient {
-	return m.GetClient("oci_email.EmailClient").(*oci_email.EmailClient)
+	if client, ok := m.GetClient("oci_email.EmailClient").(*oci_email.EmailClient); ok {
+		return client
+	}
+	client := &oci_email.EmailClient{}
+	m.failedClient("oci_email.EmailClient", &client.BaseClient)
+	return client
 }
@@ -30,5 +30,10 @@ func initEventsEventsClient(configProvider oci_common.ConfigurationProvider, con
 }
 
 func (m *OracleClients) EventsClient() *oci_events.EventsClient {
-	return m.GetClient("oci_events.EventsClient").(*oci_events.EventsClient)
+	if client, ok := m.GetClient("oci_events.EventsClient").(*oci_events.EventsClient); ok {
+		return client
+	}
+	client := &oci_events.EventsClient{}
+	m.failedClient("oci_events.EventsClient", &client.BaseClient)
+	return client
 }
@@ -30,5 +30,10 @@ func initFilestorageFileStorageClient(configProvider oci_common.ConfigurationPro
 }
 
 func (m *OracleClients) FileStorageClient() *oci_file_storage.FileStorageClient {
-	return m.GetClient("oci_file_storage.FileStorageClient").(*oci_file_storage.FileStorageClient)
+	if client, ok := m.GetClient("oci_file_storage.FileStorageClient").(*oci_file_storage.FileStorageClient); ok {
+		return client
+	}
+	client := &oci_file_storage.FileStorageClient{}
+	m.failedClient("oci_file_storage.FileStorageClient", &client.BaseClient)
+	return client
 }
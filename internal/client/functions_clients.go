@@ -31,7 +31,12 @@ func initFunctionsFunctionsInvokeClient(configProvider oci_common.ConfigurationP
 }
 
 func (m *OracleClients) FunctionsInvokeClient() *oci_functions.FunctionsInvokeClient {
-	return m.GetClient("oci_functions.FunctionsInvokeClient").(*oci_functions.FunctionsInvokeClient)
+	if client, ok := m.GetClient("oci_functions.FunctionsInvokeClient").(*oci_functions.FunctionsInvokeClient); ok {
+		return client
+	}
+	client := &oci_functions.FunctionsInvokeClient{}
+	m.failedClient("oci_functions.FunctionsInvokeClient", &client.BaseClient)
+	return client
 }
 
 func initFunctionsFunctionsManagementClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -51,5 +56,10 @@ func initFunctionsFunctionsManagementClient(configProvider oci_common.Configurat
 }
 
 func (m *OracleClients) FunctionsManagementClient() *oci_functions.FunctionsManagementClient {
-	return m.GetClient("oci_functions.FunctionsManagementClient").(*oci_functions.FunctionsManagementClient)
+	if client, ok := m.GetClient("oci_functions.FunctionsManagementClient").(*oci_functions.FunctionsManagementClient); ok {
+		return client
+	}
+	client := &oci_functions.FunctionsManagementClient{}
+	m.failedClient("oci_functions.FunctionsManagementClient", &client.BaseClient)
+	return client
 }
@@ -30,5 +30,10 @@ func initGenericartifactscontentGenericArtifactsContentClient(configProvider oci
 }
 
 func (m *OracleClients) GenericArtifactsContentClient() *oci_generic_artifacts_content.GenericArtifactsContentClient {
-	return m.GetClient("oci_generic_artifacts_content.GenericArtifactsContentClient").(*oci_generic_artifacts_content.GenericArtifactsContentClient)
+	if client, ok := m.GetClient("oci_generic_artifacts_content.GenericArtifactsContentClient").(*oci_generic_artifacts_content.GenericArtifactsContentClient); ok {
+		return client
+	}
+	client := &oci_generic_artifacts_content.GenericArtifactsContentClient{}
+	m.failedClient("oci_generic_artifacts_content.GenericArtifactsContentClient", &client.BaseClient)
+	return client
 }
@@ -30,5 +30,10 @@ func initGoldengateGoldenGateClient(configProvider oci_common.ConfigurationProvi
 }
 
 func (m *OracleClients) GoldenGateClient() *oci_golden_gate.GoldenGateClient {
-	return m.GetClient("oci_golden_gate.GoldenGateClient").(*oci_golden_gate.GoldenGateClient)
+	if client, ok := m.GetClient("oci_golden_gate.GoldenGateClient").(*oci_golden_gate.GoldenGateClient); ok {
+		return client
+	}
+	client := &oci_golden_gate.GoldenGateClient{}
+	m.failedClient("oci_golden_gate.GoldenGateClient", &client.BaseClient)
+	return client
 }
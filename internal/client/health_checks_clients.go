@@ -30,5 +30,10 @@ func initHealthchecksHealthChecksClient(configProvider oci_common.ConfigurationP
 }
 
 func (m *OracleClients) HealthChecksClient() *oci_health_checks.HealthChecksClient {
-	return m.GetClient("oci_health_checks.HealthChecksClient").(*oci_health_checks.HealthChecksClient)
+	if client, ok := m.GetClient("oci_health_checks.HealthChecksClient").(*oci_health_checks.HealthChecksClient); ok {
+		return client
+	}
+	client := &oci_health_checks.HealthChecksClient{}
+	m.failedClient("oci_health_checks.HealthChecksClient", &client.BaseClient)
+	return client
 }
@@ -30,5 +30,10 @@ func initIdentityIdentityClient(configProvider oci_common.ConfigurationProvider,
 }
 
 func (m *OracleClients) IdentityClient() *oci_identity.IdentityClient {
-	return m.GetClient("oci_identity.IdentityClient").(*oci_identity.IdentityClient)
+	if client, ok := m.GetClient("oci_identity.IdentityClient").(*oci_identity.IdentityClient); ok {
+		return client
+	}
+	client := &oci_identity.IdentityClient{}
+	m.failedClient("oci_identity.IdentityClient", &client.BaseClient)
+	return client
 }
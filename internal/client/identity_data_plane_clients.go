@@ -30,5 +30,10 @@ func initIdentitydataplaneDataplaneClient(configProvider oci_common.Configuratio
 }
 
 func (m *OracleClients) DataplaneClient() *oci_identity_data_plane.DataplaneClient {
-	return m.GetClient("oci_identity_data_plane.DataplaneClient").(*oci_identity_data_plane.DataplaneClient)
+	if client, ok := m.GetClient("oci_identity_data_plane.DataplaneClient").(*oci_identity_data_plane.DataplaneClient); ok {
+		return client
+	}
+	client := &oci_identity_data_plane.DataplaneClient{}
+	m.failedClient("oci_identity_data_plane.DataplaneClient", &client.BaseClient)
+	return client
 }
@@ -30,5 +30,10 @@ func initIntegrationIntegrationInstanceClient(configProvider oci_common.Configur
 }
 
 func (m *OracleClients) IntegrationInstanceClient() *oci_integration.IntegrationInstanceClient {
-	return m.GetClient("oci_integration.IntegrationInstanceClient").(*oci_integration.IntegrationInstanceClient)
+	if client, ok := m.GetClient("oci_integration.IntegrationInstanceClient").(*oci_integration.IntegrationInstanceClient); ok {
+		return client
+	}
+	client := &oci_integration.IntegrationInstanceClient{}
+	m.failedClient("oci_integration.IntegrationInstanceClient", &client.BaseClient)
+	return client
 }
@@ -30,5 +30,10 @@ func initJmsJavaManagementServiceClient(configProvider oci_common.ConfigurationP
 }
 
 func (m *OracleClients) JavaManagementServiceClient() *oci_jms.JavaManagementServiceClient {
-	return m.GetClient("oci_jms.JavaManagementServiceClient").(*oci_jms.JavaManagementServiceClient)
+	if client, ok := m.GetClient("oci_jms.JavaManagementServiceClient").(*oci_jms.JavaManagementServiceClient); ok {
+		return client
+	}
+	client := &oci_jms.JavaManagementServiceClient{}
+	m.failedClient("oci_jms.JavaManagementServiceClient", &client.BaseClient)
+	return client
 }
@@ -32,7 +32,12 @@ func initKeymanagementKmsCryptoClient(configProvider oci_common.ConfigurationPro
 }
 
 func (m *OracleClients) KmsCryptoClient() *oci_kms.KmsCryptoClient {
-	return m.GetClient("oci_kms.KmsCryptoClient").(*oci_kms.KmsCryptoClient)
+	if client, ok := m.GetClient("oci_kms.KmsCryptoClient").(*oci_kms.KmsCryptoClient); ok {
+		return client
+	}
+	client := &oci_kms.KmsCryptoClient{}
+	m.failedClient("oci_kms.KmsCryptoClient", &client.BaseClient)
+	return client
 }
 
 func initKeymanagementKmsManagementClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -52,7 +57,12 @@ func initKeymanagementKmsManagementClient(configProvider oci_common.Configuratio
 }
 
 func (m *OracleClients) KmsManagementClient() *oci_kms.KmsManagementClient {
-	return m.GetClient("oci_kms.KmsManagementClient").(*oci_kms.KmsManagementClient)
+	if client, ok := m.GetClient("oci_kms.KmsManagementClient").(*oci_kms.KmsManagementClient); ok {
+		return client
+	}
+	client := &oci_kms.KmsManagementClient{}
+	m.failedClient("oci_kms.KmsManagementClient", &client.BaseClient)
+	return client
 }
 
 func initKeymanagementKmsVaultClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -72,5 +82,10 @@ func initKeymanagementKmsVaultClient(configProvider oci_common.ConfigurationProv
 }
 
 func (m *OracleClients) KmsVaultClient() *oci_kms.KmsVaultClient {
-	return m.GetClient("oci_kms.KmsVaultClient").(*oci_kms.KmsVaultClient)
+	if client, ok := m.GetClient("oci_kms.KmsVaultClient").(*oci_kms.KmsVaultClient); ok {
+		return client
+	}
+	client := &oci_kms.KmsVaultClient{}
+	m.failedClient("oci_kms.KmsVaultClient", &client.BaseClient)
+	return client
 }
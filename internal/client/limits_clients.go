@@ -31,7 +31,12 @@ func initLimitsLimitsClient(configProvider oci_common.ConfigurationProvider, con
 }
 
 func (m *OracleClients) LimitsClient() *oci_limits.LimitsClient {
-	return m.GetClient("oci_limits.LimitsClient").(*oci_limits.LimitsClient)
+	if client, ok := m.GetClient("oci_limits.LimitsClient").(*oci_limits.LimitsClient); ok {
+		return client
+	}
+	client := &oci_limits.LimitsClient{}
+	m.failedClient("oci_limits.LimitsClient", &client.BaseClient)
+	return client
 }
 
 func initLimitsQuotasClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -51,5 +56,10 @@ func initLimitsQuotasClient(configProvider oci_common.ConfigurationProvider, con
 }
 
 func (m *OracleClients) QuotasClient() *oci_limits.QuotasClient {
-	return m.GetClient("oci_limits.QuotasClient").(*oci_limits.QuotasClient)
+	if client, ok := m.GetClient("oci_limits.QuotasClient").(*oci_limits.QuotasClient); ok {
+		return client
+	}
+	client := &oci_limits.QuotasClient{}
+	m.failedClient("oci_limits.QuotasClient", &client.BaseClient)
+	return client
 }
@@ -30,5 +30,10 @@ func initLoadbalancerLoadBalancerClient(configProvider oci_common.ConfigurationP
 }
 
 func (m *OracleClients) LoadBalancerClient() *oci_load_balancer.LoadBalancerClient {
-	return m.GetClient("oci_load_balancer.LoadBalancerClient").(*oci_load_balancer.LoadBalancerClient)
+	if client, ok := m.GetClient("oci_load_balancer.LoadBalancerClient").(*oci_load_balancer.LoadBalancerClient); ok {
+		return client
+	}
+	client := &oci_load_balancer.LoadBalancerClient{}
+	m.failedClient("oci_load_balancer.LoadBalancerClient", &client.BaseClient)
+	return client
 }
@@ -30,5 +30,10 @@ func initLoganalyticsLogAnalyticsClient(configProvider oci_common.ConfigurationP
 }
 
 func (m *OracleClients) LogAnalyticsClient() *oci_log_analytics.LogAnalyticsClient {
-	return m.GetClient("oci_log_analytics.LogAnalyticsClient").(*oci_log_analytics.LogAnalyticsClient)
+	if client, ok := m.GetClient("oci_log_analytics.LogAnalyticsClient").(*oci_log_analytics.LogAnalyticsClient); ok {
+		return client
+	}
+	client := &oci_log_analytics.LogAnalyticsClient{}
+	m.failedClient("oci_log_analytics.LogAnalyticsClient", &client.BaseClient)
+	return client
 }
@@ -30,5 +30,10 @@ func initLoggingLoggingManagementClient(configProvider oci_common.ConfigurationP
 }
 
 func (m *OracleClients) LoggingManagementClient() *oci_logging.LoggingManagementClient {
-	return m.GetClient("oci_logging.LoggingManagementClient").(*oci_logging.LoggingManagementClient)
+	if client, ok := m.GetClient("oci_logging.LoggingManagementClient").(*oci_logging.LoggingManagementClient); ok {
+		return client
+	}
+	client := &oci_logging.LoggingManagementClient{}
+	m.failedClient("oci_logging.LoggingManagementClient", &client.BaseClient)
+	return client
 }
@@ -30,5 +30,10 @@ func initManagementagentManagementAgentClient(configProvider oci_common.Configur
 }
 
 func (m *OracleClients) ManagementAgentClient() *oci_management_agent.ManagementAgentClient {
-	return m.GetClient("oci_management_agent.ManagementAgentClient").(*oci_management_agent.ManagementAgentClient)
+	if client, ok := m.GetClient("oci_management_agent.ManagementAgentClient").(*oci_management_agent.ManagementAgentClient); ok {
+		return client
+	}
+	client := &oci_management_agent.ManagementAgentClient{}
+	m.failedClient("oci_management_agent.ManagementAgentClient", &client.BaseClient)
+	return client
 }
@@ -30,5 +30,10 @@ func initManagementdashboardDashxApisClient(configProvider oci_common.Configurat
 }
 
 func (m *OracleClients) DashxApisClient() *oci_management_dashboard.DashxApisClient {
-	return m.GetClient("oci_management_dashboard.DashxApisClient").(*oci_management_dashboard.DashxApisClient)
+	if client, ok := m.GetClient("oci_management_dashboard.DashxApisClient").(*oci_management_dashboard.DashxApisClient); ok {
+		return client
+	}
+	client := &oci_management_dashboard.DashxApisClient{}
+	m.failedClient("oci_management_dashboard.DashxApisClient", &client.BaseClient)
+	return client
 }
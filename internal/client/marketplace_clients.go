@@ -30,5 +30,10 @@ func initMarketplaceMarketplaceClient(configProvider oci_common.ConfigurationPro
 }
 
 func (m *OracleClients) MarketplaceClient() *oci_marketplace.MarketplaceClient {
-	return m.GetClient("oci_marketplace.MarketplaceClient").(*oci_marketplace.MarketplaceClient)
+	if client, ok := m.GetClient("oci_marketplace.MarketplaceClient").(*oci_marketplace.MarketplaceClient); ok {
+		return client
+	}
+	client := &oci_marketplace.MarketplaceClient{}
+	m.failedClient("oci_marketplace.MarketplaceClient", &client.BaseClient)
+	return client
 }
@@ -30,5 +30,10 @@ func initUsageapiUsageapiClient(configProvider oci_common.ConfigurationProvider,
 }
 
 func (m *OracleClients) UsageapiClient() *oci_metering_computation.UsageapiClient {
-	return m.GetClient("oci_metering_computation.UsageapiClient").(*oci_metering_computation.UsageapiClient)
+	if client, ok := m.GetClient("oci_metering_computation.UsageapiClient").(*oci_metering_computation.UsageapiClient); ok {
+		return client
+	}
+	client := &oci_metering_computation.UsageapiClient{}
+	m.failedClient("oci_metering_computation.UsageapiClient", &client.BaseClient)
+	return client
 }
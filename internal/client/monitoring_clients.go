@@ -30,5 +30,10 @@ func initMonitoringMonitoringClient(configProvider oci_common.ConfigurationProvi
 }
 
 func (m *OracleClients) MonitoringClient() *oci_monitoring.MonitoringClient {
-	return m.GetClient("oci_monitoring.MonitoringClient").(*oci_monitoring.MonitoringClient)
+	if client, ok := m.GetClient("oci_monitoring.MonitoringClient").(*oci_monitoring.MonitoringClient); ok {
+		return client
+	}
+	client := &oci_monitoring.MonitoringClient{}
+	m.failedClient("oci_monitoring.MonitoringClient", &client.BaseClient)
+	return client
 }
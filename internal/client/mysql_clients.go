@@ -34,7 +34,12 @@ func initMysqlChannelsClient(configProvider oci_common.ConfigurationProvider, co
 }
 
 func (m *OracleClients) ChannelsClient() *oci_mysql.ChannelsClient {
-	return m.GetClient("oci_mysql.ChannelsClient").(*oci_mysql.ChannelsClient)
+	if client, ok := m.GetClient("oci_mysql.ChannelsClient").(*oci_mysql.ChannelsClient); ok {
+		return client
+	}
+	client := &oci_mysql.ChannelsClient{}
+	m.failedClient("oci_mysql.ChannelsClient", &client.BaseClient)
+	return client
 }
 
 func initMysqlDbBackupsClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -54,7 +59,12 @@ func initMysqlDbBackupsClient(configProvider oci_common.ConfigurationProvider, c
 }
 
 func (m *OracleClients) DbBackupsClient() *oci_mysql.DbBackupsClient {
-	return m.GetClient("oci_mysql.DbBackupsClient").(*oci_mysql.DbBackupsClient)
+	if client, ok := m.GetClient("oci_mysql.DbBackupsClient").(*oci_mysql.DbBackupsClient); ok {
+		return client
+	}
+	client := &oci_mysql.DbBackupsClient{}
+	m.failedClient("oci_mysql.DbBackupsClient", &client.BaseClient)
+	return client
 }
 
 func initMysqlDbSystemClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -74,7 +84,12 @@ func initMysqlDbSystemClient(configProvider oci_common.ConfigurationProvider, co
 }
 
 func (m *OracleClients) DbSystemClient() *oci_mysql.DbSystemClient {
-	return m.GetClient("oci_mysql.DbSystemClient").(*oci_mysql.DbSystemClient)
+	if client, ok := m.GetClient("oci_mysql.DbSystemClient").(*oci_mysql.DbSystemClient); ok {
+		return client
+	}
+	client := &oci_mysql.DbSystemClient{}
+	m.failedClient("oci_mysql.DbSystemClient", &client.BaseClient)
+	return client
 }
 
 func initMysqlWorkRequestsClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -94,7 +109,12 @@ func initMysqlWorkRequestsClient(configProvider oci_common.ConfigurationProvider
 }
 
 func (m *OracleClients) WorkRequestsClient() *oci_mysql.WorkRequestsClient {
-	return m.GetClient("oci_mysql.WorkRequestsClient").(*oci_mysql.WorkRequestsClient)
+	if client, ok := m.GetClient("oci_mysql.WorkRequestsClient").(*oci_mysql.WorkRequestsClient); ok {
+		return client
+	}
+	client := &oci_mysql.WorkRequestsClient{}
+	m.failedClient("oci_mysql.WorkRequestsClient", &client.BaseClient)
+	return client
 }
 
 func initMysqlMysqlaasClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -114,5 +134,10 @@ func initMysqlMysqlaasClient(configProvider oci_common.ConfigurationProvider, co
 }
 
 func (m *OracleClients) MysqlaasClient() *oci_mysql.MysqlaasClient {
-	return m.GetClient("oci_mysql.MysqlaasClient").(*oci_mysql.MysqlaasClient)
+	if client, ok := m.GetClient("oci_mysql.MysqlaasClient").(*oci_mysql.MysqlaasClient); ok {
+		return client
+	}
+	client := &oci_mysql.MysqlaasClient{}
+	m.failedClient("oci_mysql.MysqlaasClient", &client.BaseClient)
+	return client
 }
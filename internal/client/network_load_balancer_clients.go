@@ -30,5 +30,10 @@ func initNetworkloadbalancerNetworkLoadBalancerClient(configProvider oci_common.
 }
 
 func (m *OracleClients) NetworkLoadBalancerClient() *oci_network_load_balancer.NetworkLoadBalancerClient {
-	return m.GetClient("oci_network_load_balancer.NetworkLoadBalancerClient").(*oci_network_load_balancer.NetworkLoadBalancerClient)
+	if client, ok := m.GetClient("oci_network_load_balancer.NetworkLoadBalancerClient").(*oci_network_load_balancer.NetworkLoadBalancerClient); ok {
+		return client
+	}
+	client := &oci_network_load_balancer.NetworkLoadBalancerClient{}
+	m.failedClient("oci_network_load_balancer.NetworkLoadBalancerClient", &client.BaseClient)
+	return client
 }
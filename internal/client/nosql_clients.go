@@ -30,5 +30,10 @@ func initNosqlNosqlClient(configProvider oci_common.ConfigurationProvider, confi
 }
 
 func (m *OracleClients) NosqlClient() *oci_nosql.NosqlClient {
-	return m.GetClient("oci_nosql.NosqlClient").(*oci_nosql.NosqlClient)
+	if client, ok := m.GetClient("oci_nosql.NosqlClient").(*oci_nosql.NosqlClient); ok {
+		return client
+	}
+	client := &oci_nosql.NosqlClient{}
+	m.failedClient("oci_nosql.NosqlClient", &client.BaseClient)
+	return client
 }
@@ -30,5 +30,10 @@ func initObjectstorageObjectStorageClient(configProvider oci_common.Configuratio
 }
 
 func (m *OracleClients) ObjectStorageClient() *oci_object_storage.ObjectStorageClient {
-	return m.GetClient("oci_object_storage.ObjectStorageClient").(*oci_object_storage.ObjectStorageClient)
+	if client, ok := m.GetClient("oci_object_storage.ObjectStorageClient").(*oci_object_storage.ObjectStorageClient); ok {
+		return client
+	}
+	client := &oci_object_storage.ObjectStorageClient{}
+	m.failedClient("oci_object_storage.ObjectStorageClient", &client.BaseClient)
+	return client
 }
@@ -30,5 +30,10 @@ func initOceOceInstanceClient(configProvider oci_common.ConfigurationProvider, c
 }
 
 func (m *OracleClients) OceInstanceClient() *oci_oce.OceInstanceClient {
-	return m.GetClient("oci_oce.OceInstanceClient").(*oci_oce.OceInstanceClient)
+	if client, ok := m.GetClient("oci_oce.OceInstanceClient").(*oci_oce.OceInstanceClient); ok {
+		return client
+	}
+	client := &oci_oce.OceInstanceClient{}
+	m.failedClient("oci_oce.OceInstanceClient", &client.BaseClient)
+	return client
 }
@@ -32,7 +32,12 @@ func initOcvpEsxiHostClient(configProvider oci_common.ConfigurationProvider, con
 }
 
 func (m *OracleClients) EsxiHostClient() *oci_ocvp.EsxiHostClient {
-	return m.GetClient("oci_ocvp.EsxiHostClient").(*oci_ocvp.EsxiHostClient)
+	if client, ok := m.GetClient("oci_ocvp.EsxiHostClient").(*oci_ocvp.EsxiHostClient); ok {
+		return client
+	}
+	client := &oci_ocvp.EsxiHostClient{}
+	m.failedClient("oci_ocvp.EsxiHostClient", &client.BaseClient)
+	return client
 }
 
 func initOcvpWorkRequestClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -52,7 +57,12 @@ func initOcvpWorkRequestClient(configProvider oci_common.ConfigurationProvider,
 }
 
 func (m *OracleClients) OcvpWorkRequestClient() *oci_ocvp.WorkRequestClient {
-	return m.GetClient("oci_ocvp.WorkRequestClient").(*oci_ocvp.WorkRequestClient)
+	if client, ok := m.GetClient("oci_ocvp.WorkRequestClient").(*oci_ocvp.WorkRequestClient); ok {
+		return client
+	}
+	client := &oci_ocvp.WorkRequestClient{}
+	m.failedClient("oci_ocvp.WorkRequestClient", &client.BaseClient)
+	return client
 }
 
 func initOcvpSddcClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -72,5 +82,10 @@ func initOcvpSddcClient(configProvider oci_common.ConfigurationProvider, configu
 }
 
 func (m *OracleClients) SddcClient() *oci_ocvp.SddcClient {
-	return m.GetClient("oci_ocvp.SddcClient").(*oci_ocvp.SddcClient)
+	if client, ok := m.GetClient("oci_ocvp.SddcClient").(*oci_ocvp.SddcClient); ok {
+		return client
+	}
+	client := &oci_ocvp.SddcClient{}
+	m.failedClient("oci_ocvp.SddcClient", &client.BaseClient)
+	return client
 }
@@ -30,5 +30,10 @@ func initOdaOdaClient(configProvider oci_common.ConfigurationProvider, configure
 }
 
 func (m *OracleClients) OdaClient() *oci_oda.OdaClient {
-	return m.GetClient("oci_oda.OdaClient").(*oci_oda.OdaClient)
+	if client, ok := m.GetClient("oci_oda.OdaClient").(*oci_oda.OdaClient); ok {
+		return client
+	}
+	client := &oci_oda.OdaClient{}
+	m.failedClient("oci_oda.OdaClient", &client.BaseClient)
+	return client
 }
@@ -31,7 +31,12 @@ func initOnsNotificationControlPlaneClient(configProvider oci_common.Configurati
 }
 
 func (m *OracleClients) NotificationControlPlaneClient() *oci_ons.NotificationControlPlaneClient {
-	return m.GetClient("oci_ons.NotificationControlPlaneClient").(*oci_ons.NotificationControlPlaneClient)
+	if client, ok := m.GetClient("oci_ons.NotificationControlPlaneClient").(*oci_ons.NotificationControlPlaneClient); ok {
+		return client
+	}
+	client := &oci_ons.NotificationControlPlaneClient{}
+	m.failedClient("oci_ons.NotificationControlPlaneClient", &client.BaseClient)
+	return client
 }
 
 func initOnsNotificationDataPlaneClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -51,5 +56,10 @@ func initOnsNotificationDataPlaneClient(configProvider oci_common.ConfigurationP
 }
 
 func (m *OracleClients) NotificationDataPlaneClient() *oci_ons.NotificationDataPlaneClient {
-	return m.GetClient("oci_ons.NotificationDataPlaneClient").(*oci_ons.NotificationDataPlaneClient)
+	if client, ok := m.GetClient("oci_ons.NotificationDataPlaneClient").(*oci_ons.NotificationDataPlaneClient); ok {
+		return client
+	}
+	client := &oci_ons.NotificationDataPlaneClient{}
+	m.failedClient("oci_ons.NotificationDataPlaneClient", &client.BaseClient)
+	return client
 }
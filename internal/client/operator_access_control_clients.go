@@ -33,7 +33,12 @@ func initOperatoraccesscontrolAccessRequestsClient(configProvider oci_common.Con
 }
 
 func (m *OracleClients) AccessRequestsClient() *oci_operator_access_control.AccessRequestsClient {
-	return m.GetClient("oci_operator_access_control.AccessRequestsClient").(*oci_operator_access_control.AccessRequestsClient)
+	if client, ok := m.GetClient("oci_operator_access_control.AccessRequestsClient").(*oci_operator_access_control.AccessRequestsClient); ok {
+		return client
+	}
+	client := &oci_operator_access_control.AccessRequestsClient{}
+	m.failedClient("oci_operator_access_control.AccessRequestsClient", &client.BaseClient)
+	return client
 }
 
 func initOperatoraccesscontrolOperatorActionsClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -53,7 +58,12 @@ func initOperatoraccesscontrolOperatorActionsClient(configProvider oci_common.Co
 }
 
 func (m *OracleClients) OperatorActionsClient() *oci_operator_access_control.OperatorActionsClient {
-	return m.GetClient("oci_operator_access_control.OperatorActionsClient").(*oci_operator_access_control.OperatorActionsClient)
+	if client, ok := m.GetClient("oci_operator_access_control.OperatorActionsClient").(*oci_operator_access_control.OperatorActionsClient); ok {
+		return client
+	}
+	client := &oci_operator_access_control.OperatorActionsClient{}
+	m.failedClient("oci_operator_access_control.OperatorActionsClient", &client.BaseClient)
+	return client
 }
 
 func initOperatoraccesscontrolOperatorControlClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -73,7 +83,12 @@ func initOperatoraccesscontrolOperatorControlClient(configProvider oci_common.Co
 }
 
 func (m *OracleClients) OperatorControlClient() *oci_operator_access_control.OperatorControlClient {
-	return m.GetClient("oci_operator_access_control.OperatorControlClient").(*oci_operator_access_control.OperatorControlClient)
+	if client, ok := m.GetClient("oci_operator_access_control.OperatorControlClient").(*oci_operator_access_control.OperatorControlClient); ok {
+		return client
+	}
+	client := &oci_operator_access_control.OperatorControlClient{}
+	m.failedClient("oci_operator_access_control.OperatorControlClient", &client.BaseClient)
+	return client
 }
 
 func initOperatoraccesscontrolOperatorControlAssignmentClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -93,5 +108,10 @@ func initOperatoraccesscontrolOperatorControlAssignmentClient(configProvider oci
 }
 
 func (m *OracleClients) OperatorControlAssignmentClient() *oci_operator_access_control.OperatorControlAssignmentClient {
-	return m.GetClient("oci_operator_access_control.OperatorControlAssignmentClient").(*oci_operator_access_control.OperatorControlAssignmentClient)
+	if client, ok := m.GetClient("oci_operator_access_control.OperatorControlAssignmentClient").(*oci_operator_access_control.OperatorControlAssignmentClient); ok {
+		return client
+	}
+	client := &oci_operator_access_control.OperatorControlAssignmentClient{}
+	m.failedClient("oci_operator_access_control.OperatorControlAssignmentClient", &client.BaseClient)
+	return client
 }
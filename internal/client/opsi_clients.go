@@ -29,5 +29,10 @@ func initOperationsinsightsOperationsInsightsClient(configProvider oci_common.Co
 }
 
 func (m *OracleClients) OperationsInsightsClient() *oci_opsi.OperationsInsightsClient {
-	return m.GetClient("oci_opsi.OperationsInsightsClient").(*oci_opsi.OperationsInsightsClient)
+	if client, ok := m.GetClient("oci_opsi.OperationsInsightsClient").(*oci_opsi.OperationsInsightsClient); ok {
+		return client
+	}
+	client := &oci_opsi.OperationsInsightsClient{}
+	m.failedClient("oci_opsi.OperationsInsightsClient", &client.BaseClient)
+	return client
 }
@@ -30,5 +30,10 @@ func initOptimizerOptimizerClient(configProvider oci_common.ConfigurationProvide
 }
 
 func (m *OracleClients) OptimizerClient() *oci_optimizer.OptimizerClient {
-	return m.GetClient("oci_optimizer.OptimizerClient").(*oci_optimizer.OptimizerClient)
+	if client, ok := m.GetClient("oci_optimizer.OptimizerClient").(*oci_optimizer.OptimizerClient); ok {
+		return client
+	}
+	client := &oci_optimizer.OptimizerClient{}
+	m.failedClient("oci_optimizer.OptimizerClient", &client.BaseClient)
+	return client
 }
@@ -31,7 +31,12 @@ func initOsmanagementEventClient(configProvider oci_common.ConfigurationProvider
 }
 
 func (m *OracleClients) EventClient() *oci_osmanagement.EventClient {
-	return m.GetClient("oci_osmanagement.EventClient").(*oci_osmanagement.EventClient)
+	if client, ok := m.GetClient("oci_osmanagement.EventClient").(*oci_osmanagement.EventClient); ok {
+		return client
+	}
+	client := &oci_osmanagement.EventClient{}
+	m.failedClient("oci_osmanagement.EventClient", &client.BaseClient)
+	return client
 }
 
 func initOsmanagementOsManagementClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -51,5 +56,10 @@ func initOsmanagementOsManagementClient(configProvider oci_common.ConfigurationP
 }
 
 func (m *OracleClients) OsManagementClient() *oci_osmanagement.OsManagementClient {
-	return m.GetClient("oci_osmanagement.OsManagementClient").(*oci_osmanagement.OsManagementClient)
+	if client, ok := m.GetClient("oci_osmanagement.OsManagementClient").(*oci_osmanagement.OsManagementClient); ok {
+		return client
+	}
+	client := &oci_osmanagement.OsManagementClient{}
+	m.failedClient("oci_osmanagement.OsManagementClient", &client.BaseClient)
+	return client
 }
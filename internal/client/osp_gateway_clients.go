@@ -31,7 +31,12 @@ func initOspgatewayInvoiceServiceClient(configProvider oci_common.ConfigurationP
 }
 
 func (m *OracleClients) InvoiceServiceClient() *oci_osp_gateway.InvoiceServiceClient {
-	return m.GetClient("oci_osp_gateway.InvoiceServiceClient").(*oci_osp_gateway.InvoiceServiceClient)
+	if client, ok := m.GetClient("oci_osp_gateway.InvoiceServiceClient").(*oci_osp_gateway.InvoiceServiceClient); ok {
+		return client
+	}
+	client := &oci_osp_gateway.InvoiceServiceClient{}
+	m.failedClient("oci_osp_gateway.InvoiceServiceClient", &client.BaseClient)
+	return client
 }
 
 func initOspgatewaySubscriptionServiceClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -51,5 +56,10 @@ func initOspgatewaySubscriptionServiceClient(configProvider oci_common.Configura
 }
 
 func (m *OracleClients) SubscriptionServiceClient() *oci_osp_gateway.SubscriptionServiceClient {
-	return m.GetClient("oci_osp_gateway.SubscriptionServiceClient").(*oci_osp_gateway.SubscriptionServiceClient)
+	if client, ok := m.GetClient("oci_osp_gateway.SubscriptionServiceClient").(*oci_osp_gateway.SubscriptionServiceClient); ok {
+		return client
+	}
+	client := &oci_osp_gateway.SubscriptionServiceClient{}
+	m.failedClient("oci_osp_gateway.SubscriptionServiceClient", &client.BaseClient)
+	return client
 }
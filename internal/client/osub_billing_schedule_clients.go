@@ -30,5 +30,10 @@ func initOsubbillingscheduleBillingScheduleClient(configProvider oci_common.Conf
 }
 
 func (m *OracleClients) BillingScheduleClient() *oci_osub_billing_schedule.BillingScheduleClient {
-	return m.GetClient("oci_osub_billing_schedule.BillingScheduleClient").(*oci_osub_billing_schedule.BillingScheduleClient)
+	if client, ok := m.GetClient("oci_osub_billing_schedule.BillingScheduleClient").(*oci_osub_billing_schedule.BillingScheduleClient); ok {
+		return client
+	}
+	client := &oci_osub_billing_schedule.BillingScheduleClient{}
+	m.failedClient("oci_osub_billing_schedule.BillingScheduleClient", &client.BaseClient)
+	return client
 }
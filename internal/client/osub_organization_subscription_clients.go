@@ -30,5 +30,10 @@ func initOsuborganizationsubscriptionOrganizationSubscriptionClient(configProvid
 }
 
 func (m *OracleClients) OrganizationSubscriptionClient() *oci_osub_organization_subscription.OrganizationSubscriptionClient {
-	return m.GetClient("oci_osub_organization_subscription.OrganizationSubscriptionClient").(*oci_osub_organization_subscription.OrganizationSubscriptionClient)
+	if client, ok := m.GetClient("oci_osub_organization_subscription.OrganizationSubscriptionClient").(*oci_osub_organization_subscription.OrganizationSubscriptionClient); ok {
+		return client
+	}
+	client := &oci_osub_organization_subscription.OrganizationSubscriptionClient{}
+	m.failedClient("oci_osub_organization_subscription.OrganizationSubscriptionClient", &client.BaseClient)
+	return client
 }
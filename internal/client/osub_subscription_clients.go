@@ -32,7 +32,12 @@ func initOsubsubscriptionCommitmentClient(configProvider oci_common.Configuratio
 }
 
 func (m *OracleClients) CommitmentClient() *oci_osub_subscription.CommitmentClient {
-	return m.GetClient("oci_osub_subscription.CommitmentClient").(*oci_osub_subscription.CommitmentClient)
+	if client, ok := m.GetClient("oci_osub_subscription.CommitmentClient").(*oci_osub_subscription.CommitmentClient); ok {
+		return client
+	}
+	client := &oci_osub_subscription.CommitmentClient{}
+	m.failedClient("oci_osub_subscription.CommitmentClient", &client.BaseClient)
+	return client
 }
 
 func initOsubsubscriptionRatecardClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -52,7 +57,12 @@ func initOsubsubscriptionRatecardClient(configProvider oci_common.ConfigurationP
 }
 
 func (m *OracleClients) RatecardClient() *oci_osub_subscription.RatecardClient {
-	return m.GetClient("oci_osub_subscription.RatecardClient").(*oci_osub_subscription.RatecardClient)
+	if client, ok := m.GetClient("oci_osub_subscription.RatecardClient").(*oci_osub_subscription.RatecardClient); ok {
+		return client
+	}
+	client := &oci_osub_subscription.RatecardClient{}
+	m.failedClient("oci_osub_subscription.RatecardClient", &client.BaseClient)
+	return client
 }
 
 func initOsubsubscriptionSubscriptionClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -72,5 +82,10 @@ func initOsubsubscriptionSubscriptionClient(configProvider oci_common.Configurat
 }
 
 func (m *OracleClients) SubscriptionClient() *oci_osub_subscription.SubscriptionClient {
-	return m.GetClient("oci_osub_subscription.SubscriptionClient").(*oci_osub_subscription.SubscriptionClient)
+	if client, ok := m.GetClient("oci_osub_subscription.SubscriptionClient").(*oci_osub_subscription.SubscriptionClient); ok {
+		return client
+	}
+	client := &oci_osub_subscription.SubscriptionClient{}
+	m.failedClient("oci_osub_subscription.SubscriptionClient", &client.BaseClient)
+	return client
 }
@@ -30,5 +30,10 @@ func initOsubusageComputedUsageClient(configProvider oci_common.ConfigurationPro
 }
 
 func (m *OracleClients) ComputedUsageClient() *oci_osub_usage.ComputedUsageClient {
-	return m.GetClient("oci_osub_usage.ComputedUsageClient").(*oci_osub_usage.ComputedUsageClient)
+	if client, ok := m.GetClient("oci_osub_usage.ComputedUsageClient").(*oci_osub_usage.ComputedUsageClient); ok {
+		return client
+	}
+	client := &oci_osub_usage.ComputedUsageClient{}
+	m.failedClient("oci_osub_usage.ComputedUsageClient", &client.BaseClient)
+	return client
 }
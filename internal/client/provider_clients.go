@@ -5,7 +5,9 @@ package client
 
 import (
 	"fmt"
+	"net/http"
 	"strings"
+	"sync"
 
 	oci_functions "github.com/oracle/oci-go-sdk/v65/functions"
 
@@ -30,6 +32,9 @@ func RegisterOracleClient(name string, client *OracleClient) {
 	OracleClientRegistrationsVar.RegisteredClients[name] = client
 }
 
+// WorkRequestClientName is the name of the work request client shared by the services in the client host overrides
+const WorkRequestClientName = "oci_work_requests.WorkRequestClient"
+
 type ConfigureClient func(client *oci_common.BaseClient) error
 
 var ConfigureClientVar ConfigureClient // global fn ref used to configure all clients initially and others later on
@@ -52,17 +57,140 @@ type OracleClients struct {
 	Configuration     map[string]string
 	SdkClientMap      map[string]interface{}
 	WorkRequestClient *oci_work_requests.WorkRequestClient
+
+	// DefaultFreeformTags and DefaultDefinedTags are the tags of the `default_tags` block of the provider, they are added
+	// to the tags of every resource whose configuration does not set the same keys
+	DefaultFreeformTags map[string]interface{}
+	DefaultDefinedTags  map[string]interface{}
+
+	// SDK clients are created on first use with the configuration provider and client configuration passed to CreateSDKClients
+	sdkClientMapLock    sync.Mutex
+	lazyClients         map[string]*lazyClient
+	configProvider      oci_common.ConfigurationProvider
+	configureClient     ConfigureClient
+	clientHostOverrides map[string]string
+}
+
+// lazyClient creates an SDK client once, the clients with different names are created concurrently
+type lazyClient struct {
+	once   sync.Once
+	client interface{}
+	err    error
 }
 
+// GetClient returns the SDK client registered with the given name, creating it the first time it is requested
+// The typed client accessors can not return an error, so the requests of a client whose initialization failed return
+// the initialization error, see GetClientE and failedClient.
 func (m *OracleClients) GetClient(name string) interface{} {
-	return m.SdkClientMap[name]
+	client, _ := m.GetClientE(name)
+	return client
+}
+
+// GetClientE is GetClient, it also returns the error of the initialization of the client
+func (m *OracleClients) GetClientE(name string) (interface{}, error) {
+	m.sdkClientMapLock.Lock()
+	if client, exists := m.SdkClientMap[name]; exists {
+		m.sdkClientMapLock.Unlock()
+		return client, nil
+	}
+
+	// Clients that were not set up by CreateSDKClients can not be created lazily
+	if m.configProvider == nil || m.configureClient == nil {
+		m.sdkClientMapLock.Unlock()
+		return nil, fmt.Errorf("unable to initialize '%s' client: the SDK clients were not created", name)
+	}
+
+	if m.lazyClients == nil {
+		m.lazyClients = map[string]*lazyClient{}
+	}
+	lazy, exists := m.lazyClients[name]
+	if !exists {
+		lazy = &lazyClient{}
+		m.lazyClients[name] = lazy
+	}
+	m.sdkClientMapLock.Unlock()
+
+	lazy.once.Do(func() {
+		// The configuration provider was validated by CreateSDKClients, a client that is created but can not be
+		// configured is kept with every request failing with the configuration error
+		var configureErr error
+		configureClient := func(client *oci_common.BaseClient) error {
+			if configureErr = m.configureClient(client); configureErr != nil {
+				configureErr = fmt.Errorf("unable to initialize '%s' client: %v", name, configureErr)
+				failRequests(client, configureErr)
+			}
+			return nil
+		}
+
+		client, err := m.initClient(name, configureClient)
+		if err == nil {
+			err = configureErr
+		} else {
+			err = fmt.Errorf("unable to initialize '%s' client: %v", name, err)
+		}
+		lazy.client, lazy.err = client, err
+		if err != nil {
+			utils.Logf("[ERROR] %v", err)
+			return
+		}
+
+		m.sdkClientMapLock.Lock()
+		defer m.sdkClientMapLock.Unlock()
+		if m.SdkClientMap == nil {
+			m.SdkClientMap = map[string]interface{}{}
+		}
+		m.SdkClientMap[name] = client
+	})
+	return lazy.client, lazy.err
+}
+
+// failedClient sets up the typed client returned by an accessor for a client that could not be created, its requests
+// return the initialization error rather than the accessor panicking
+func (m *OracleClients) failedClient(name string, client *oci_common.BaseClient) {
+	_, err := m.GetClientE(name)
+	if err == nil {
+		err = fmt.Errorf("unable to initialize '%s' client: the registered client has an unexpected type", name)
+	}
+	failRequests(client, err)
+}
+
+// failRequests makes every request of a client fail with the given error
+func failRequests(client *oci_common.BaseClient, err error) {
+	client.UserAgent = globalvar.DefaultUserAgentProviderName // the user agent is checked before the requests are intercepted
+	client.Interceptor = func(*http.Request) error {
+		return err
+	}
+}
+
+func (m *OracleClients) initClient(serviceName string, configureClient ConfigureClient) (interface{}, error) {
+	if OracleClientRegistrationsVar == nil {
+		return nil, fmt.Errorf("there are no registered clients")
+	}
+
+	clientRegistration, exists := OracleClientRegistrationsVar.RegisteredClients[serviceName]
+	if !exists || clientRegistration.InitClientFn == nil {
+		return nil, fmt.Errorf("'%s' is not a registered client", serviceName)
+	}
+
+	serviceClientOverrides := ServiceClientOverrides{}
+	// apply client host override
+	if host, ok := m.clientHostOverrides[serviceName]; ok {
+		serviceClientOverrides.HostUrlOverride = host
+	}
+
+	utils.Debugf("[DEBUG] initializing '%s' client", serviceName)
+	return clientRegistration.InitClientFn(m.configProvider, configureClient, serviceClientOverrides)
 }
 
 // The following clients require special endpoint information that is only known at Terraform apply time; so they
 // Create duplicate clients reusing the same Configuration provider as the initialized client and adding the endpoint
 // here.
 func (m *OracleClients) FunctionsInvokeClientWithEndpoint(endpoint string) (*oci_functions.FunctionsInvokeClient, error) {
-	if client, err := oci_functions.NewFunctionsInvokeClientWithConfigurationProvider(*m.FunctionsInvokeClient().ConfigurationProvider(), endpoint); err == nil {
+	configProvider, err := m.clientConfigurationProvider("oci_functions.FunctionsInvokeClient", m.FunctionsInvokeClient().ConfigurationProvider())
+	if err != nil {
+		return nil, err
+	}
+	if client, err := oci_functions.NewFunctionsInvokeClientWithConfigurationProvider(configProvider, endpoint); err == nil {
 		if err = ConfigureClientVar(&client.BaseClient); err != nil {
 			return nil, err
 		}
@@ -72,7 +200,11 @@ func (m *OracleClients) FunctionsInvokeClientWithEndpoint(endpoint string) (*oci
 	}
 }
 func (m *OracleClients) KmsCryptoClientWithEndpoint(endpoint string) (*oci_kms.KmsCryptoClient, error) {
-	if client, err := oci_kms.NewKmsCryptoClientWithConfigurationProvider(*m.KmsCryptoClient().ConfigurationProvider(), endpoint); err == nil {
+	configProvider, err := m.clientConfigurationProvider("oci_kms.KmsCryptoClient", m.KmsCryptoClient().ConfigurationProvider())
+	if err != nil {
+		return nil, err
+	}
+	if client, err := oci_kms.NewKmsCryptoClientWithConfigurationProvider(configProvider, endpoint); err == nil {
 		if err = ConfigureClientVar(&client.BaseClient); err != nil {
 			return nil, err
 		}
@@ -83,7 +215,11 @@ func (m *OracleClients) KmsCryptoClientWithEndpoint(endpoint string) (*oci_kms.K
 }
 
 func (m *OracleClients) KmsManagementClientWithEndpoint(endpoint string) (*oci_kms.KmsManagementClient, error) {
-	if client, err := oci_kms.NewKmsManagementClientWithConfigurationProvider(*m.KmsManagementClient().ConfigurationProvider(), endpoint); err == nil {
+	configProvider, err := m.clientConfigurationProvider("oci_kms.KmsManagementClient", m.KmsManagementClient().ConfigurationProvider())
+	if err != nil {
+		return nil, err
+	}
+	if client, err := oci_kms.NewKmsManagementClientWithConfigurationProvider(configProvider, endpoint); err == nil {
 		if err = ConfigureClientVar(&client.BaseClient); err != nil {
 			return nil, err
 		}
@@ -93,6 +229,17 @@ func (m *OracleClients) KmsManagementClientWithEndpoint(endpoint string) (*oci_k
 	}
 }
 
+// clientConfigurationProvider returns the configuration provider of an initialized client, or its initialization error
+func (m *OracleClients) clientConfigurationProvider(name string, configProvider *oci_common.ConfigurationProvider) (oci_common.ConfigurationProvider, error) {
+	if configProvider != nil {
+		return *configProvider, nil
+	}
+	if _, err := m.GetClientE(name); err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("unable to initialize '%s' client: the client has no configuration provider", name)
+}
+
 func getClientHostOverrides() map[string]string {
 	// Get the host URL override for clients
 	clientHostOverrides := make(map[string]string)
@@ -117,24 +264,18 @@ func CreateSDKClients(clients *OracleClients, configProvider oci_common.Configur
 		return fmt.Errorf("there are no clients to Create")
 	}
 
-	clientHostOverrides := getClientHostOverrides()
 	for serviceName, clientRegistration := range OracleClientRegistrationsVar.RegisteredClients {
-		if clientRegistration.InitClientFn != nil {
-			serviceClientOverrides := ServiceClientOverrides{}
-			// apply client host override
-			if host, ok := clientHostOverrides[serviceName]; ok {
-				serviceClientOverrides.HostUrlOverride = host
-			}
-
-			clients.SdkClientMap[serviceName], err = clientRegistration.InitClientFn(configProvider, configureClient, serviceClientOverrides)
-			if err != nil {
-				return err
-			}
-		} else {
+		if clientRegistration.InitClientFn == nil {
 			return fmt.Errorf("unable to initialize '%s' client", serviceName)
 		}
 	}
 
+	// The configuration and authentication errors are returned here rather than when the SDK clients are first used
+	if valid, err := oci_common.IsConfigurationProviderValid(configProvider); !valid {
+		return err
+	}
+
+	clientHostOverrides := getClientHostOverrides()
 	workRequestClient, err := oci_work_requests.NewWorkRequestClientWithConfigurationProvider(configProvider)
 	if err != nil {
 		return
@@ -143,7 +284,18 @@ func CreateSDKClients(clients *OracleClients, configProvider oci_common.Configur
 	if err != nil {
 		return
 	}
+	// apply client host override
+	if host, ok := clientHostOverrides[WorkRequestClientName]; ok {
+		workRequestClient.Host = host
+	}
 	clients.WorkRequestClient = &workRequestClient
 
+	// The registered SDK clients are created on first use by GetClient
+	clients.sdkClientMapLock.Lock()
+	defer clients.sdkClientMapLock.Unlock()
+	clients.configProvider = configProvider
+	clients.configureClient = configureClient
+	clients.clientHostOverrides = clientHostOverrides
+
 	return
 }
@@ -30,5 +30,10 @@ func initResourcemanagerResourceManagerClient(configProvider oci_common.Configur
 }
 
 func (m *OracleClients) ResourceManagerClient() *oci_resourcemanager.ResourceManagerClient {
-	return m.GetClient("oci_resourcemanager.ResourceManagerClient").(*oci_resourcemanager.ResourceManagerClient)
+	if client, ok := m.GetClient("oci_resourcemanager.ResourceManagerClient").(*oci_resourcemanager.ResourceManagerClient); ok {
+		return client
+	}
+	client := &oci_resourcemanager.ResourceManagerClient{}
+	m.failedClient("oci_resourcemanager.ResourceManagerClient", &client.BaseClient)
+	return client
 }
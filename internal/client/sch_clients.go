@@ -30,5 +30,10 @@ func initSchServiceConnectorClient(configProvider oci_common.ConfigurationProvid
 }
 
 func (m *OracleClients) ServiceConnectorClient() *oci_sch.ServiceConnectorClient {
-	return m.GetClient("oci_sch.ServiceConnectorClient").(*oci_sch.ServiceConnectorClient)
+	if client, ok := m.GetClient("oci_sch.ServiceConnectorClient").(*oci_sch.ServiceConnectorClient); ok {
+		return client
+	}
+	client := &oci_sch.ServiceConnectorClient{}
+	m.failedClient("oci_sch.ServiceConnectorClient", &client.BaseClient)
+	return client
 }
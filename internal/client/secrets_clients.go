@@ -30,5 +30,10 @@ func initSecretsSecretsClient(configProvider oci_common.ConfigurationProvider, c
 }
 
 func (m *OracleClients) SecretsClient() *oci_secrets.SecretsClient {
-	return m.GetClient("oci_secrets.SecretsClient").(*oci_secrets.SecretsClient)
+	if client, ok := m.GetClient("oci_secrets.SecretsClient").(*oci_secrets.SecretsClient); ok {
+		return client
+	}
+	client := &oci_secrets.SecretsClient{}
+	m.failedClient("oci_secrets.SecretsClient", &client.BaseClient)
+	return client
 }
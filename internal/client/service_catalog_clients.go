@@ -30,5 +30,10 @@ func initServicecatalogServiceCatalogClient(configProvider oci_common.Configurat
 }
 
 func (m *OracleClients) ServiceCatalogClient() *oci_service_catalog.ServiceCatalogClient {
-	return m.GetClient("oci_service_catalog.ServiceCatalogClient").(*oci_service_catalog.ServiceCatalogClient)
+	if client, ok := m.GetClient("oci_service_catalog.ServiceCatalogClient").(*oci_service_catalog.ServiceCatalogClient); ok {
+		return client
+	}
+	client := &oci_service_catalog.ServiceCatalogClient{}
+	m.failedClient("oci_service_catalog.ServiceCatalogClient", &client.BaseClient)
+	return client
 }
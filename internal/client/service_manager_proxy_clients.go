@@ -30,5 +30,10 @@ func initServicemanagerproxyServiceManagerProxyClient(configProvider oci_common.
 }
 
 func (m *OracleClients) ServiceManagerProxyClient() *oci_service_manager_proxy.ServiceManagerProxyClient {
-	return m.GetClient("oci_service_manager_proxy.ServiceManagerProxyClient").(*oci_service_manager_proxy.ServiceManagerProxyClient)
+	if client, ok := m.GetClient("oci_service_manager_proxy.ServiceManagerProxyClient").(*oci_service_manager_proxy.ServiceManagerProxyClient); ok {
+		return client
+	}
+	client := &oci_service_manager_proxy.ServiceManagerProxyClient{}
+	m.failedClient("oci_service_manager_proxy.ServiceManagerProxyClient", &client.BaseClient)
+	return client
 }
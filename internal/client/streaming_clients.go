@@ -30,5 +30,10 @@ func initStreamingStreamAdminClient(configProvider oci_common.ConfigurationProvi
 }
 
 func (m *OracleClients) StreamAdminClient() *oci_streaming.StreamAdminClient {
-	return m.GetClient("oci_streaming.StreamAdminClient").(*oci_streaming.StreamAdminClient)
+	if client, ok := m.GetClient("oci_streaming.StreamAdminClient").(*oci_streaming.StreamAdminClient); ok {
+		return client
+	}
+	client := &oci_streaming.StreamAdminClient{}
+	m.failedClient("oci_streaming.StreamAdminClient", &client.BaseClient)
+	return client
 }
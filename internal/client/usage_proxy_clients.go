@@ -30,5 +30,10 @@ func initUsageRewardsClient(configProvider oci_common.ConfigurationProvider, con
 }
 
 func (m *OracleClients) RewardsClient() *oci_usage_proxy.RewardsClient {
-	return m.GetClient("oci_usage_proxy.RewardsClient").(*oci_usage_proxy.RewardsClient)
+	if client, ok := m.GetClient("oci_usage_proxy.RewardsClient").(*oci_usage_proxy.RewardsClient); ok {
+		return client
+	}
+	client := &oci_usage_proxy.RewardsClient{}
+	m.failedClient("oci_usage_proxy.RewardsClient", &client.BaseClient)
+	return client
 }
@@ -30,5 +30,10 @@ func initVaultVaultsClient(configProvider oci_common.ConfigurationProvider, conf
 }
 
 func (m *OracleClients) VaultsClient() *oci_vault.VaultsClient {
-	return m.GetClient("oci_vault.VaultsClient").(*oci_vault.VaultsClient)
+	if client, ok := m.GetClient("oci_vault.VaultsClient").(*oci_vault.VaultsClient); ok {
+		return client
+	}
+	client := &oci_vault.VaultsClient{}
+	m.failedClient("oci_vault.VaultsClient", &client.BaseClient)
+	return client
 }
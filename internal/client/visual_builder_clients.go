@@ -30,5 +30,10 @@ func initVisualbuilderVbInstanceClient(configProvider oci_common.ConfigurationPr
 }
 
 func (m *OracleClients) VbInstanceClient() *oci_visual_builder.VbInstanceClient {
-	return m.GetClient("oci_visual_builder.VbInstanceClient").(*oci_visual_builder.VbInstanceClient)
+	if client, ok := m.GetClient("oci_visual_builder.VbInstanceClient").(*oci_visual_builder.VbInstanceClient); ok {
+		return client
+	}
+	client := &oci_visual_builder.VbInstanceClient{}
+	m.failedClient("oci_visual_builder.VbInstanceClient", &client.BaseClient)
+	return client
 }
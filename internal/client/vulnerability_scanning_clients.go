@@ -30,5 +30,10 @@ func initVulnerabilityscanningVulnerabilityScanningClient(configProvider oci_com
 }
 
 func (m *OracleClients) VulnerabilityScanningClient() *oci_vulnerability_scanning.VulnerabilityScanningClient {
-	return m.GetClient("oci_vulnerability_scanning.VulnerabilityScanningClient").(*oci_vulnerability_scanning.VulnerabilityScanningClient)
+	if client, ok := m.GetClient("oci_vulnerability_scanning.VulnerabilityScanningClient").(*oci_vulnerability_scanning.VulnerabilityScanningClient); ok {
+		return client
+	}
+	client := &oci_vulnerability_scanning.VulnerabilityScanningClient{}
+	m.failedClient("oci_vulnerability_scanning.VulnerabilityScanningClient", &client.BaseClient)
+	return client
 }
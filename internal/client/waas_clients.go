@@ -31,7 +31,12 @@ func initWaasRedirectClient(configProvider oci_common.ConfigurationProvider, con
 }
 
 func (m *OracleClients) RedirectClient() *oci_waas.RedirectClient {
-	return m.GetClient("oci_waas.RedirectClient").(*oci_waas.RedirectClient)
+	if client, ok := m.GetClient("oci_waas.RedirectClient").(*oci_waas.RedirectClient); ok {
+		return client
+	}
+	client := &oci_waas.RedirectClient{}
+	m.failedClient("oci_waas.RedirectClient", &client.BaseClient)
+	return client
 }
 
 func initWaasWaasClient(configProvider oci_common.ConfigurationProvider, configureClient ConfigureClient, serviceClientOverrides ServiceClientOverrides) (interface{}, error) {
@@ -51,5 +56,10 @@ func initWaasWaasClient(configProvider oci_common.ConfigurationProvider, configu
 }
 
 func (m *OracleClients) WaasClient() *oci_waas.WaasClient {
-	return m.GetClient("oci_waas.WaasClient").(*oci_waas.WaasClient)
+	if client, ok := m.GetClient("oci_waas.WaasClient").(*oci_waas.WaasClient); ok {
+		return client
+	}
+	client := &oci_waas.WaasClient{}
+	m.failedClient("oci_waas.WaasClient", &client.BaseClient)
+	return client
 }
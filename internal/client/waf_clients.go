@@ -30,5 +30,10 @@ func initWafWafClient(configProvider oci_common.ConfigurationProvider, configure
 }
 
 func (m *OracleClients) WafClient() *oci_waf.WafClient {
-	return m.GetClient("oci_waf.WafClient").(*oci_waf.WafClient)
+	if client, ok := m.GetClient("oci_waf.WafClient").(*oci_waf.WafClient); ok {
+		return client
+	}
+	client := &oci_waf.WafClient{}
+	m.failedClient("oci_waf.WafClient", &client.BaseClient)
+	return client
 }
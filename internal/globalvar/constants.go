@@ -23,6 +23,10 @@ const (
 	ClientHostOverridesEnv                = "CLIENT_HOST_OVERRIDES"
 	CustomCertLocationEnv                 = "custom_cert_location"
 	AcceptLocalCerts                      = "accept_local_certs"
+	ErrorFormatEnv                        = "error_format"
+	SuggestionsFileEnv                    = "suggestions_file"
+	OtlpTracesFileEnv                     = "otlp_traces_file"
+	OtlpTracesEndpointEnv                 = "otlp_traces_endpoint"
 
 	AuthAttrName                 = "auth"
 	TenancyOcidAttrName          = "tenancy_ocid"
@@ -39,6 +43,35 @@ const (
 	OboTokenPath                 = "obo_token_path"
 	ConfigFileProfileAttrName    = "config_file_profile"
 	DefinedTagsToIgnore          = "ignore_defined_tags"
+	HttpClientAttrName           = "http_client"
+	RateLimitAttrName            = "rate_limit"
+	RetryPolicyAttrName          = "retry_policy"
+	DefaultTagsAttrName          = "default_tags"
+
+	// Attributes of the http_client block
+	ProxyUrlAttrName                     = "proxy_url"
+	RequestTimeoutSecondsAttrName        = "request_timeout_seconds"
+	ConnectionTimeoutSecondsAttrName     = "connection_timeout_seconds"
+	TLSHandshakeTimeoutSecondsAttrName   = "tls_handshake_timeout_seconds"
+	MaxIdleConnectionsAttrName           = "max_idle_connections"
+	MaxIdleConnectionsPerHostAttrName    = "max_idle_connections_per_host"
+	IdleConnectionTimeoutSecondsAttrName = "idle_connection_timeout_seconds"
+	DisableKeepAlivesAttrName            = "disable_keep_alives"
+
+	// Attributes of the rate_limit blocks
+	RateLimitServiceAttrName           = "service"
+	RateLimitRequestsPerSecondAttrName = "requests_per_second"
+	RateLimitBurstAttrName             = "burst"
+
+	// Attributes of the retry_policy blocks
+	RetryPolicyServiceAttrName              = "service"
+	RetryPolicyRetryDurationSecondsAttrName = "retry_duration_seconds"
+	RetryPolicyMaxAttemptsAttrName          = "max_attempts"
+	RetryPolicyRetriableStatusCodesAttrName = "retriable_status_codes"
+
+	// Attributes of the default_tags block
+	DefaultTagsFreeformTagsAttrName = "freeform_tags"
+	DefaultTagsDefinedTagsAttrName  = "defined_tags"
 
 	DefaultConfigFileName    = "config"
 	DefaultConfigDirName     = ".oci"
@@ -51,6 +84,14 @@ const (
 	DefaultStateFilename            = "terraform.tfstate"
 	VarsFile                        = "vars.tf"
 	ProviderFile                    = "provider.tf"
+	ImportFile                      = "import.tf"
+	DriftReportFile                 = "drift_report.json"
+	ModulesFile                     = "modules.tf"
+	ModulesDir                      = "modules"
+	OutputsFile                     = "outputs.tf"
+	CheckpointDir                   = "checkpoint"
+	InventoryFile                   = "inventory.json"
+	TfvarsExampleFile               = "terraform.tfvars.example"
 	MissingRequiredAttributeWarning = `
 
 Warning: There are one or more 'Required' attributes for which a value could not be discovered.
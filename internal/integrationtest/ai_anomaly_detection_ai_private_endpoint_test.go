@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -264,10 +265,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("AiAnomalyDetectionAiPrivateEndpoint") {
-		resource.AddTestSweepers("AiAnomalyDetectionAiPrivateEndpoint", &resource.Sweeper{
-			Name:         "AiAnomalyDetectionAiPrivateEndpoint",
-			Dependencies: acctest.DependencyGraph["aiPrivateEndpoint"],
-			F:            sweepAiAnomalyDetectionAiPrivateEndpointResource,
+		acctest.AddTestSweepers("AiAnomalyDetectionAiPrivateEndpoint", &resource.Sweeper{
+			Name: "AiAnomalyDetectionAiPrivateEndpoint",
+			F:    sweepAiAnomalyDetectionAiPrivateEndpointResource,
 		})
 	}
 }
@@ -278,6 +278,7 @@ func sweepAiAnomalyDetectionAiPrivateEndpointResource(compartment string) error
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, aiPrivateEndpointId := range aiPrivateEndpointIds {
 		if ok := acctest.SweeperDefaultResourceId[aiPrivateEndpointId]; !ok {
 			deleteAiPrivateEndpointRequest := oci_ai_anomaly_detection.DeleteAiPrivateEndpointRequest{}
@@ -285,16 +286,20 @@ func sweepAiAnomalyDetectionAiPrivateEndpointResource(compartment string) error
 			deleteAiPrivateEndpointRequest.AiPrivateEndpointId = &aiPrivateEndpointId
 
 			deleteAiPrivateEndpointRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "ai_anomaly_detection")
-			_, error := anomalyDetectionClient.DeleteAiPrivateEndpoint(context.Background(), deleteAiPrivateEndpointRequest)
+			error := acctest.DeleteSweeperResource(compartment, "AiPrivateEndpointId", aiPrivateEndpointId, func() error {
+				_, err := anomalyDetectionClient.DeleteAiPrivateEndpoint(context.Background(), deleteAiPrivateEndpointRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting AiPrivateEndpoint %s %s, It is possible that the resource is already deleted. Please verify manually \n", aiPrivateEndpointId, error)
+				fmt.Printf("Error deleting AiPrivateEndpoint %s %s \n", aiPrivateEndpointId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &aiPrivateEndpointId, aiPrivateEndpointSweepWaitCondition, time.Duration(3*time.Minute),
 				aiPrivateEndpointSweepResponseFetchOperation, "ai_anomaly_detection", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getAiPrivateEndpointIds(compartment string) ([]string, error) {
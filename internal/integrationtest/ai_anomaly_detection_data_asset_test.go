@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -353,10 +354,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("AiAnomalyDetectionDataAsset") {
-		resource.AddTestSweepers("AiAnomalyDetectionDataAsset", &resource.Sweeper{
-			Name:         "AiAnomalyDetectionDataAsset",
-			Dependencies: acctest.DependencyGraph["dataAsset"],
-			F:            sweepAiAnomalyDetectionDataAssetResource,
+		acctest.AddTestSweepers("AiAnomalyDetectionDataAsset", &resource.Sweeper{
+			Name: "AiAnomalyDetectionDataAsset",
+			F:    sweepAiAnomalyDetectionDataAssetResource,
 		})
 	}
 }
@@ -367,6 +367,7 @@ func sweepAiAnomalyDetectionDataAssetResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, dataAssetId := range dataAssetIds {
 		if ok := acctest.SweeperDefaultResourceId[dataAssetId]; !ok {
 			deleteDataAssetRequest := oci_ai_anomaly_detection.DeleteDataAssetRequest{}
@@ -374,16 +375,20 @@ func sweepAiAnomalyDetectionDataAssetResource(compartment string) error {
 			deleteDataAssetRequest.DataAssetId = &dataAssetId
 
 			deleteDataAssetRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "ai_anomaly_detection")
-			_, error := anomalyDetectionClient.DeleteDataAsset(context.Background(), deleteDataAssetRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DataAssetId", dataAssetId, func() error {
+				_, err := anomalyDetectionClient.DeleteDataAsset(context.Background(), deleteDataAssetRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting DataAsset %s %s, It is possible that the resource is already deleted. Please verify manually \n", dataAssetId, error)
+				fmt.Printf("Error deleting DataAsset %s %s \n", dataAssetId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &dataAssetId, dataAssetSweepWaitCondition, time.Duration(3*time.Minute),
 				dataAssetSweepResponseFetchOperation, "ai_anomaly_detection", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDataAssetIds(compartment string) ([]string, error) {
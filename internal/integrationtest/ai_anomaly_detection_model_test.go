@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -301,10 +302,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("AiAnomalyDetectionModel") {
-		resource.AddTestSweepers("AiAnomalyDetectionModel", &resource.Sweeper{
-			Name:         "AiAnomalyDetectionModel",
-			Dependencies: acctest.DependencyGraph["model"],
-			F:            sweepAiAnomalyDetectionModelResource,
+		acctest.AddTestSweepers("AiAnomalyDetectionModel", &resource.Sweeper{
+			Name: "AiAnomalyDetectionModel",
+			F:    sweepAiAnomalyDetectionModelResource,
 		})
 	}
 }
@@ -315,6 +315,7 @@ func sweepAiAnomalyDetectionModelResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, modelId := range modelIds {
 		if ok := acctest.SweeperDefaultResourceId[modelId]; !ok {
 			deleteModelRequest := oci_ai_anomaly_detection.DeleteModelRequest{}
@@ -322,16 +323,20 @@ func sweepAiAnomalyDetectionModelResource(compartment string) error {
 			deleteModelRequest.ModelId = &modelId
 
 			deleteModelRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "ai_anomaly_detection")
-			_, error := anomalyDetectionClient.DeleteModel(context.Background(), deleteModelRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ModelId", modelId, func() error {
+				_, err := anomalyDetectionClient.DeleteModel(context.Background(), deleteModelRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Model %s %s, It is possible that the resource is already deleted. Please verify manually \n", modelId, error)
+				fmt.Printf("Error deleting Model %s %s \n", modelId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &modelId, aiAnomalyDetectionModelSweepWaitCondition, time.Duration(3*time.Minute),
 				aiAnomalyDetectionModelSweepResponseFetchOperation, "ai_anomaly_detection", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func aiAnomalyDetectionGetModelIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -261,10 +262,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("AiAnomalyDetectionProject") {
-		resource.AddTestSweepers("AiAnomalyDetectionProject", &resource.Sweeper{
-			Name:         "AiAnomalyDetectionProject",
-			Dependencies: acctest.DependencyGraph["project"],
-			F:            sweepAiAnomalyDetectionProjectResource,
+		acctest.AddTestSweepers("AiAnomalyDetectionProject", &resource.Sweeper{
+			Name: "AiAnomalyDetectionProject",
+			F:    sweepAiAnomalyDetectionProjectResource,
 		})
 	}
 }
@@ -275,6 +275,7 @@ func sweepAiAnomalyDetectionProjectResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, projectId := range projectIds {
 		if ok := acctest.SweeperDefaultResourceId[projectId]; !ok {
 			deleteProjectRequest := oci_ai_anomaly_detection.DeleteProjectRequest{}
@@ -282,16 +283,20 @@ func sweepAiAnomalyDetectionProjectResource(compartment string) error {
 			deleteProjectRequest.ProjectId = &projectId
 
 			deleteProjectRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "ai_anomaly_detection")
-			_, error := anomalyDetectionClient.DeleteProject(context.Background(), deleteProjectRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ProjectId", projectId, func() error {
+				_, err := anomalyDetectionClient.DeleteProject(context.Background(), deleteProjectRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Project %s %s, It is possible that the resource is already deleted. Please verify manually \n", projectId, error)
+				fmt.Printf("Error deleting Project %s %s \n", projectId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &projectId, aiAnomalyDetectionProjectSweepWaitCondition, time.Duration(3*time.Minute),
 				aiAnomalyDetectionProjectSweepResponseFetchOperation, "ai_anomaly_detection", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func aiAnomalyDetectionGetProjectIds(compartment string) ([]string, error) {
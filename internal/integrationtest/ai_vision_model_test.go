@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -326,10 +327,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("AiVisionModel") {
-		resource.AddTestSweepers("AiVisionModel", &resource.Sweeper{
-			Name:         "AiVisionModel",
-			Dependencies: acctest.DependencyGraph["model"],
-			F:            sweepAiVisionModelResource,
+		acctest.AddTestSweepers("AiVisionModel", &resource.Sweeper{
+			Name: "AiVisionModel",
+			F:    sweepAiVisionModelResource,
 		})
 	}
 }
@@ -340,6 +340,7 @@ func sweepAiVisionModelResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, modelId := range modelIds {
 		if ok := acctest.SweeperDefaultResourceId[modelId]; !ok {
 			deleteModelRequest := oci_ai_vision.DeleteModelRequest{}
@@ -347,16 +348,20 @@ func sweepAiVisionModelResource(compartment string) error {
 			deleteModelRequest.ModelId = &modelId
 
 			deleteModelRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "ai_vision")
-			_, error := aiServiceVisionClient.DeleteModel(context.Background(), deleteModelRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ModelId", modelId, func() error {
+				_, err := aiServiceVisionClient.DeleteModel(context.Background(), deleteModelRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Model %s %s, It is possible that the resource is already deleted. Please verify manually \n", modelId, error)
+				fmt.Printf("Error deleting Model %s %s \n", modelId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &modelId, visionModelSweepWaitCondition, time.Duration(3*time.Minute),
 				visionModelSweepResponseFetchOperation, "ai_vision", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getVisionModelIds(compartment string) ([]string, error) {
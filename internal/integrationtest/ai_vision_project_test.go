@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -260,10 +261,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("AiVisionProject") {
-		resource.AddTestSweepers("AiVisionProject", &resource.Sweeper{
-			Name:         "AiVisionProject",
-			Dependencies: acctest.DependencyGraph["project"],
-			F:            sweepAiVisionProjectResource,
+		acctest.AddTestSweepers("AiVisionProject", &resource.Sweeper{
+			Name: "AiVisionProject",
+			F:    sweepAiVisionProjectResource,
 		})
 	}
 }
@@ -274,6 +274,7 @@ func sweepAiVisionProjectResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, projectId := range projectIds {
 		if ok := acctest.SweeperDefaultResourceId[projectId]; !ok {
 			deleteProjectRequest := oci_ai_vision.DeleteProjectRequest{}
@@ -281,16 +282,20 @@ func sweepAiVisionProjectResource(compartment string) error {
 			deleteProjectRequest.ProjectId = &projectId
 
 			deleteProjectRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "ai_vision")
-			_, error := aiServiceVisionClient.DeleteProject(context.Background(), deleteProjectRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ProjectId", projectId, func() error {
+				_, err := aiServiceVisionClient.DeleteProject(context.Background(), deleteProjectRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Project %s %s, It is possible that the resource is already deleted. Please verify manually \n", projectId, error)
+				fmt.Printf("Error deleting Project %s %s \n", projectId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &projectId, visionProjectSweepWaitCondition, time.Duration(3*time.Minute),
 				visionProjectSweepResponseFetchOperation, "ai_vision", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getVisionProjectIds(compartment string) ([]string, error) {
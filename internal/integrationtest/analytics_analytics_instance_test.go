@@ -17,6 +17,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -483,10 +484,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("AnalyticsAnalyticsInstance") {
-		resource.AddTestSweepers("AnalyticsAnalyticsInstance", &resource.Sweeper{
-			Name:         "AnalyticsAnalyticsInstance",
-			Dependencies: acctest.DependencyGraph["analyticsInstance"],
-			F:            sweepAnalyticsAnalyticsInstanceResource,
+		acctest.AddTestSweepers("AnalyticsAnalyticsInstance", &resource.Sweeper{
+			Name: "AnalyticsAnalyticsInstance",
+			F:    sweepAnalyticsAnalyticsInstanceResource,
 		})
 	}
 }
@@ -497,6 +497,7 @@ func sweepAnalyticsAnalyticsInstanceResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, analyticsInstanceId := range analyticsInstanceIds {
 		if ok := acctest.SweeperDefaultResourceId[analyticsInstanceId]; !ok {
 			deleteAnalyticsInstanceRequest := oci_analytics.DeleteAnalyticsInstanceRequest{}
@@ -504,16 +505,20 @@ func sweepAnalyticsAnalyticsInstanceResource(compartment string) error {
 			deleteAnalyticsInstanceRequest.AnalyticsInstanceId = &analyticsInstanceId
 
 			deleteAnalyticsInstanceRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "analytics")
-			_, error := analyticsClient.DeleteAnalyticsInstance(context.Background(), deleteAnalyticsInstanceRequest)
+			error := acctest.DeleteSweeperResource(compartment, "AnalyticsInstanceId", analyticsInstanceId, func() error {
+				_, err := analyticsClient.DeleteAnalyticsInstance(context.Background(), deleteAnalyticsInstanceRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting AnalyticsInstance %s %s, It is possible that the resource is already deleted. Please verify manually \n", analyticsInstanceId, error)
+				fmt.Printf("Error deleting AnalyticsInstance %s %s \n", analyticsInstanceId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &analyticsInstanceId, analyticsInstanceSweepWaitCondition, time.Duration(3*time.Minute),
 				analyticsInstanceSweepResponseFetchOperation, "analytics", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getAnalyticsInstanceIds(compartment string) ([]string, error) {
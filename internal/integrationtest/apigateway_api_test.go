@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -253,10 +254,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("ApigatewayApi") {
-		resource.AddTestSweepers("ApigatewayApi", &resource.Sweeper{
-			Name:         "ApigatewayApi",
-			Dependencies: acctest.DependencyGraph["api"],
-			F:            sweepApigatewayApiResource,
+		acctest.AddTestSweepers("ApigatewayApi", &resource.Sweeper{
+			Name: "ApigatewayApi",
+			F:    sweepApigatewayApiResource,
 		})
 	}
 }
@@ -267,6 +267,7 @@ func sweepApigatewayApiResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, apiId := range apiIds {
 		if ok := acctest.SweeperDefaultResourceId[apiId]; !ok {
 			deleteApiRequest := oci_apigateway.DeleteApiRequest{}
@@ -274,16 +275,20 @@ func sweepApigatewayApiResource(compartment string) error {
 			deleteApiRequest.ApiId = &apiId
 
 			deleteApiRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "apigateway")
-			_, error := apiGatewayClient.DeleteApi(context.Background(), deleteApiRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ApiId", apiId, func() error {
+				_, err := apiGatewayClient.DeleteApi(context.Background(), deleteApiRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Api %s %s, It is possible that the resource is already deleted. Please verify manually \n", apiId, error)
+				fmt.Printf("Error deleting Api %s %s \n", apiId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &apiId, apiSweepWaitCondition, time.Duration(3*time.Minute),
 				apiSweepResponseFetchOperation, "apigateway", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getApiIds(compartment string) ([]string, error) {
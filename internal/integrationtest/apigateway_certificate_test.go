@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -287,10 +288,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("ApigatewayCertificate") {
-		resource.AddTestSweepers("ApigatewayCertificate", &resource.Sweeper{
-			Name:         "ApigatewayCertificate",
-			Dependencies: acctest.DependencyGraph["certificate"],
-			F:            sweepApigatewayCertificateResource,
+		acctest.AddTestSweepers("ApigatewayCertificate", &resource.Sweeper{
+			Name: "ApigatewayCertificate",
+			F:    sweepApigatewayCertificateResource,
 		})
 	}
 }
@@ -301,6 +301,7 @@ func sweepApigatewayCertificateResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, certificateId := range certificateIds {
 		if ok := acctest.SweeperDefaultResourceId[certificateId]; !ok {
 			deleteCertificateRequest := oci_apigateway.DeleteCertificateRequest{}
@@ -308,16 +309,20 @@ func sweepApigatewayCertificateResource(compartment string) error {
 			deleteCertificateRequest.CertificateId = &certificateId
 
 			deleteCertificateRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "apigateway")
-			_, error := apiGatewayClient.DeleteCertificate(context.Background(), deleteCertificateRequest)
+			error := acctest.DeleteSweeperResource(compartment, "CertificateId", certificateId, func() error {
+				_, err := apiGatewayClient.DeleteCertificate(context.Background(), deleteCertificateRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Certificate %s %s, It is possible that the resource is already deleted. Please verify manually \n", certificateId, error)
+				fmt.Printf("Error deleting Certificate %s %s \n", certificateId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &certificateId, apiGatewayCertificateSweepWaitCondition, time.Duration(3*time.Minute),
 				apiGatewayCertificateSweepResponseFetchOperation, "apigateway", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getApiGatewayCertificateIds(compartment string) ([]string, error) {
@@ -14,6 +14,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/resourcediscovery"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -937,10 +938,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("ApigatewayDeployment") {
-		resource.AddTestSweepers("ApigatewayDeployment", &resource.Sweeper{
-			Name:         "ApigatewayDeployment",
-			Dependencies: acctest.DependencyGraph["deployment"],
-			F:            sweepApigatewayDeploymentResource,
+		acctest.AddTestSweepers("ApigatewayDeployment", &resource.Sweeper{
+			Name: "ApigatewayDeployment",
+			F:    sweepApigatewayDeploymentResource,
 		})
 	}
 }
@@ -951,6 +951,7 @@ func sweepApigatewayDeploymentResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, deploymentId := range deploymentIds {
 		if ok := acctest.SweeperDefaultResourceId[deploymentId]; !ok {
 			deleteDeploymentRequest := oci_apigateway.DeleteDeploymentRequest{}
@@ -958,16 +959,20 @@ func sweepApigatewayDeploymentResource(compartment string) error {
 			deleteDeploymentRequest.DeploymentId = &deploymentId
 
 			deleteDeploymentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "apigateway")
-			_, error := deploymentClient.DeleteDeployment(context.Background(), deleteDeploymentRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DeploymentId", deploymentId, func() error {
+				_, err := deploymentClient.DeleteDeployment(context.Background(), deleteDeploymentRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Deployment %s %s, It is possible that the resource is already deleted. Please verify manually \n", deploymentId, error)
+				fmt.Printf("Error deleting Deployment %s %s \n", deploymentId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &deploymentId, deploymentSweepWaitCondition, time.Duration(3*time.Minute),
 				deploymentSweepResponseFetchOperation, "apigateway", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDeploymentIds(compartment string) ([]string, error) {
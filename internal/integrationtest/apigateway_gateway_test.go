@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -375,10 +376,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("ApigatewayGateway") {
-		resource.AddTestSweepers("ApigatewayGateway", &resource.Sweeper{
-			Name:         "ApigatewayGateway",
-			Dependencies: acctest.DependencyGraph["gateway"],
-			F:            sweepApigatewayGatewayResource,
+		acctest.AddTestSweepers("ApigatewayGateway", &resource.Sweeper{
+			Name: "ApigatewayGateway",
+			F:    sweepApigatewayGatewayResource,
 		})
 	}
 }
@@ -389,6 +389,7 @@ func sweepApigatewayGatewayResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, gatewayId := range gatewayIds {
 		if ok := acctest.SweeperDefaultResourceId[gatewayId]; !ok {
 			deleteGatewayRequest := oci_apigateway.DeleteGatewayRequest{}
@@ -396,16 +397,20 @@ func sweepApigatewayGatewayResource(compartment string) error {
 			deleteGatewayRequest.GatewayId = &gatewayId
 
 			deleteGatewayRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "apigateway")
-			_, error := gatewayClient.DeleteGateway(context.Background(), deleteGatewayRequest)
+			error := acctest.DeleteSweeperResource(compartment, "GatewayId", gatewayId, func() error {
+				_, err := gatewayClient.DeleteGateway(context.Background(), deleteGatewayRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Gateway %s %s, It is possible that the resource is already deleted. Please verify manually \n", gatewayId, error)
+				fmt.Printf("Error deleting Gateway %s %s \n", gatewayId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &gatewayId, gatewaySweepWaitCondition, time.Duration(3*time.Minute),
 				gatewaySweepResponseFetchOperation, "apigateway", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getGatewayIds(compartment string) ([]string, error) {
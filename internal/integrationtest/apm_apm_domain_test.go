@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -274,10 +275,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("ApmApmDomain") {
-		resource.AddTestSweepers("ApmApmDomain", &resource.Sweeper{
-			Name:         "ApmApmDomain",
-			Dependencies: acctest.DependencyGraph["apmDomain"],
-			F:            sweepApmApmDomainResource,
+		acctest.AddTestSweepers("ApmApmDomain", &resource.Sweeper{
+			Name: "ApmApmDomain",
+			F:    sweepApmApmDomainResource,
 		})
 	}
 }
@@ -288,6 +288,7 @@ func sweepApmApmDomainResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, apmDomainId := range apmDomainIds {
 		if ok := acctest.SweeperDefaultResourceId[apmDomainId]; !ok {
 			deleteApmDomainRequest := oci_apm.DeleteApmDomainRequest{}
@@ -295,16 +296,20 @@ func sweepApmApmDomainResource(compartment string) error {
 			deleteApmDomainRequest.ApmDomainId = &apmDomainId
 
 			deleteApmDomainRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "apm")
-			_, error := apmDomainClient.DeleteApmDomain(context.Background(), deleteApmDomainRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ApmDomainId", apmDomainId, func() error {
+				_, err := apmDomainClient.DeleteApmDomain(context.Background(), deleteApmDomainRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ApmDomain %s %s, It is possible that the resource is already deleted. Please verify manually \n", apmDomainId, error)
+				fmt.Printf("Error deleting ApmDomain %s %s \n", apmDomainId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &apmDomainId, apmDomainSweepWaitCondition, time.Duration(3*time.Minute),
 				apmDomainSweepResponseFetchOperation, "apm", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getApmDomainIds(compartment string) ([]string, error) {
@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -274,10 +275,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("ApmConfigApdex") {
-		resource.AddTestSweepers("ApmConfigApdex", &resource.Sweeper{
-			Name:         "ApmConfigApdex",
-			Dependencies: acctest.DependencyGraph["config"],
-			F:            sweepApmConfigApdexResource,
+		acctest.AddTestSweepers("ApmConfigApdex", &resource.Sweeper{
+			Name: "ApmConfigApdex",
+			F:    sweepApmConfigApdexResource,
 		})
 	}
 }
@@ -288,6 +288,7 @@ func sweepApmConfigApdexResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, configId := range configIds {
 		if ok := acctest.SweeperDefaultResourceId[configId]; !ok {
 			deleteConfigRequest := oci_apm_config.DeleteConfigRequest{}
@@ -295,14 +296,18 @@ func sweepApmConfigApdexResource(compartment string) error {
 			deleteConfigRequest.ConfigId = &configId
 
 			deleteConfigRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "apm_config")
-			_, error := configClient.DeleteConfig(context.Background(), deleteConfigRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ConfigId", configId, func() error {
+				_, err := configClient.DeleteConfig(context.Background(), deleteConfigRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Config %s %s, It is possible that the resource is already deleted. Please verify manually \n", configId, error)
+				fmt.Printf("Error deleting Config %s %s \n", configId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getApdexIds(compartment string) ([]string, error) {
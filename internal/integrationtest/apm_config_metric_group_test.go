@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -256,10 +257,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("ApmConfigMetricGroup") {
-		resource.AddTestSweepers("ApmConfigMetricGroup", &resource.Sweeper{
-			Name:         "ApmConfigMetricGroup",
-			Dependencies: acctest.DependencyGraph["config"],
-			F:            sweepApmConfigMetricGroupResource,
+		acctest.AddTestSweepers("ApmConfigMetricGroup", &resource.Sweeper{
+			Name: "ApmConfigMetricGroup",
+			F:    sweepApmConfigMetricGroupResource,
 		})
 	}
 }
@@ -270,6 +270,7 @@ func sweepApmConfigMetricGroupResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, configId := range configIds {
 		if ok := acctest.SweeperDefaultResourceId[configId]; !ok {
 			deleteConfigRequest := oci_apm_config.DeleteConfigRequest{}
@@ -277,14 +278,18 @@ func sweepApmConfigMetricGroupResource(compartment string) error {
 			deleteConfigRequest.ConfigId = &configId
 
 			deleteConfigRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "apm_config")
-			_, error := configClient.DeleteConfig(context.Background(), deleteConfigRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ConfigId", configId, func() error {
+				_, err := configClient.DeleteConfig(context.Background(), deleteConfigRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Config %s %s, It is possible that the resource is already deleted. Please verify manually \n", configId, error)
+				fmt.Printf("Error deleting Config %s %s \n", configId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getMetricGroupIds(compartment string) ([]string, error) {
@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -317,10 +318,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("ApmSyntheticsMonitor") {
-		resource.AddTestSweepers("ApmSyntheticsMonitor", &resource.Sweeper{
-			Name:         "ApmSyntheticsMonitor",
-			Dependencies: acctest.DependencyGraph["monitor"],
-			F:            sweepApmSyntheticsMonitorResource,
+		acctest.AddTestSweepers("ApmSyntheticsMonitor", &resource.Sweeper{
+			Name: "ApmSyntheticsMonitor",
+			F:    sweepApmSyntheticsMonitorResource,
 		})
 	}
 }
@@ -331,6 +331,7 @@ func sweepApmSyntheticsMonitorResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, monitorId := range monitorIds {
 		if ok := acctest.SweeperDefaultResourceId[monitorId]; !ok {
 			deleteMonitorRequest := oci_apm_synthetics.DeleteMonitorRequest{}
@@ -338,14 +339,18 @@ func sweepApmSyntheticsMonitorResource(compartment string) error {
 			deleteMonitorRequest.MonitorId = &monitorId
 
 			deleteMonitorRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "apm_synthetics")
-			_, error := apmSyntheticClient.DeleteMonitor(context.Background(), deleteMonitorRequest)
+			error := acctest.DeleteSweeperResource(compartment, "MonitorId", monitorId, func() error {
+				_, err := apmSyntheticClient.DeleteMonitor(context.Background(), deleteMonitorRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Monitor %s %s, It is possible that the resource is already deleted. Please verify manually \n", monitorId, error)
+				fmt.Printf("Error deleting Monitor %s %s \n", monitorId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getMonitorIds(compartment string) ([]string, error) {
@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -256,10 +257,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("ApmSyntheticsScript") {
-		resource.AddTestSweepers("ApmSyntheticsScript", &resource.Sweeper{
-			Name:         "ApmSyntheticsScript",
-			Dependencies: acctest.DependencyGraph["script"],
-			F:            sweepApmSyntheticsScriptResource,
+		acctest.AddTestSweepers("ApmSyntheticsScript", &resource.Sweeper{
+			Name: "ApmSyntheticsScript",
+			F:    sweepApmSyntheticsScriptResource,
 		})
 	}
 }
@@ -270,6 +270,7 @@ func sweepApmSyntheticsScriptResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, scriptId := range scriptIds {
 		if ok := acctest.SweeperDefaultResourceId[scriptId]; !ok {
 			deleteScriptRequest := oci_apm_synthetics.DeleteScriptRequest{}
@@ -277,14 +278,18 @@ func sweepApmSyntheticsScriptResource(compartment string) error {
 			deleteScriptRequest.ScriptId = &scriptId
 
 			deleteScriptRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "apm_synthetics")
-			_, error := apmSyntheticClient.DeleteScript(context.Background(), deleteScriptRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ScriptId", scriptId, func() error {
+				_, err := apmSyntheticClient.DeleteScript(context.Background(), deleteScriptRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Script %s %s, It is possible that the resource is already deleted. Please verify manually \n", scriptId, error)
+				fmt.Printf("Error deleting Script %s %s \n", scriptId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getScriptIds(compartment string) ([]string, error) {
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -217,10 +218,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("ArtifactsContainerImageSignature") {
-		resource.AddTestSweepers("ArtifactsContainerImageSignature", &resource.Sweeper{
-			Name:         "ArtifactsContainerImageSignature",
-			Dependencies: acctest.DependencyGraph["containerImageSignature"],
-			F:            sweepArtifactsContainerImageSignatureResource,
+		acctest.AddTestSweepers("ArtifactsContainerImageSignature", &resource.Sweeper{
+			Name: "ArtifactsContainerImageSignature",
+			F:    sweepArtifactsContainerImageSignatureResource,
 		})
 	}
 }
@@ -231,19 +231,24 @@ func sweepArtifactsContainerImageSignatureResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, containerImageSignatureId := range containerImageSignatureIds {
 		if ok := acctest.SweeperDefaultResourceId[containerImageSignatureId]; !ok {
 			deleteContainerImageSignatureRequest := oci_artifacts.DeleteContainerImageSignatureRequest{}
 
 			deleteContainerImageSignatureRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "artifacts")
-			_, error := artifactsClient.DeleteContainerImageSignature(context.Background(), deleteContainerImageSignatureRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ContainerImageSignatureId", containerImageSignatureId, func() error {
+				_, err := artifactsClient.DeleteContainerImageSignature(context.Background(), deleteContainerImageSignatureRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ContainerImageSignature %s %s, It is possible that the resource is already deleted. Please verify manually \n", containerImageSignatureId, error)
+				fmt.Printf("Error deleting ContainerImageSignature %s %s \n", containerImageSignatureId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getContainerImageSignatureIds(compartment string) ([]string, error) {
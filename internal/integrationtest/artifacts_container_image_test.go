@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -108,10 +109,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("ArtifactsContainerImage") {
-		resource.AddTestSweepers("ArtifactsContainerImage", &resource.Sweeper{
-			Name:         "ArtifactsContainerImage",
-			Dependencies: acctest.DependencyGraph["containerImage"],
-			F:            sweepArtifactsContainerImageResource,
+		acctest.AddTestSweepers("ArtifactsContainerImage", &resource.Sweeper{
+			Name: "ArtifactsContainerImage",
+			F:    sweepArtifactsContainerImageResource,
 		})
 	}
 }
@@ -122,21 +122,26 @@ func sweepArtifactsContainerImageResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, containerImageId := range containerImageIds {
 		if ok := acctest.SweeperDefaultResourceId[containerImageId]; !ok {
 			deleteContainerImageRequest := oci_artifacts.DeleteContainerImageRequest{}
 
 			deleteContainerImageRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "artifacts")
-			_, error := artifactsClient.DeleteContainerImage(context.Background(), deleteContainerImageRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ContainerImageId", containerImageId, func() error {
+				_, err := artifactsClient.DeleteContainerImage(context.Background(), deleteContainerImageRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ContainerImage %s %s, It is possible that the resource is already deleted. Please verify manually \n", containerImageId, error)
+				fmt.Printf("Error deleting ContainerImage %s %s \n", containerImageId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &containerImageId, containerImageSweepWaitCondition, time.Duration(3*time.Minute),
 				containerImageSweepResponseFetchOperation, "artifacts", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getContainerImageIds(compartment string) ([]string, error) {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -304,10 +305,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("ArtifactsContainerRepository") {
-		resource.AddTestSweepers("ArtifactsContainerRepository", &resource.Sweeper{
-			Name:         "ArtifactsContainerRepository",
-			Dependencies: acctest.DependencyGraph["containerRepository"],
-			F:            sweepArtifactsContainerRepositoryResource,
+		acctest.AddTestSweepers("ArtifactsContainerRepository", &resource.Sweeper{
+			Name: "ArtifactsContainerRepository",
+			F:    sweepArtifactsContainerRepositoryResource,
 		})
 	}
 }
@@ -318,21 +318,26 @@ func sweepArtifactsContainerRepositoryResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, containerRepositoryId := range containerRepositoryIds {
 		if ok := acctest.SweeperDefaultResourceId[containerRepositoryId]; !ok {
 			deleteContainerRepositoryRequest := oci_artifacts.DeleteContainerRepositoryRequest{}
 
 			deleteContainerRepositoryRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "artifacts")
-			_, error := artifactsClient.DeleteContainerRepository(context.Background(), deleteContainerRepositoryRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ContainerRepositoryId", containerRepositoryId, func() error {
+				_, err := artifactsClient.DeleteContainerRepository(context.Background(), deleteContainerRepositoryRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ContainerRepository %s %s, It is possible that the resource is already deleted. Please verify manually \n", containerRepositoryId, error)
+				fmt.Printf("Error deleting ContainerRepository %s %s \n", containerRepositoryId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &containerRepositoryId, containerRepositorySweepWaitCondition, time.Duration(3*time.Minute),
 				containerRepositorySweepResponseFetchOperation, "artifacts", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getContainerRepositoryIds(compartment string) ([]string, error) {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -208,10 +209,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("ArtifactsGenericArtifact") {
-		resource.AddTestSweepers("ArtifactsGenericArtifact", &resource.Sweeper{
-			Name:         "ArtifactsGenericArtifact",
-			Dependencies: acctest.DependencyGraph["genericArtifact"],
-			F:            sweepArtifactsGenericArtifactResource,
+		acctest.AddTestSweepers("ArtifactsGenericArtifact", &resource.Sweeper{
+			Name: "ArtifactsGenericArtifact",
+			F:    sweepArtifactsGenericArtifactResource,
 		})
 	}
 }
@@ -222,21 +222,26 @@ func sweepArtifactsGenericArtifactResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, genericArtifactId := range genericArtifactIds {
 		if ok := acctest.SweeperDefaultResourceId[genericArtifactId]; !ok {
 			deleteGenericArtifactRequest := oci_artifacts.DeleteGenericArtifactRequest{}
 
 			deleteGenericArtifactRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "artifacts")
-			_, error := artifactsClient.DeleteGenericArtifact(context.Background(), deleteGenericArtifactRequest)
+			error := acctest.DeleteSweeperResource(compartment, "GenericArtifactId", genericArtifactId, func() error {
+				_, err := artifactsClient.DeleteGenericArtifact(context.Background(), deleteGenericArtifactRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting GenericArtifact %s %s, It is possible that the resource is already deleted. Please verify manually \n", genericArtifactId, error)
+				fmt.Printf("Error deleting GenericArtifact %s %s \n", genericArtifactId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &genericArtifactId, genericArtifactSweepWaitCondition, time.Duration(3*time.Minute),
 				genericArtifactSweepResponseFetchOperation, "artifacts", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getGenericArtifactIds(compartment string) ([]string, error) {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -273,10 +274,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("ArtifactsRepository") {
-		resource.AddTestSweepers("ArtifactsRepository", &resource.Sweeper{
-			Name:         "ArtifactsRepository",
-			Dependencies: acctest.DependencyGraph["repository"],
-			F:            sweepArtifactsRepositoryResource,
+		acctest.AddTestSweepers("ArtifactsRepository", &resource.Sweeper{
+			Name: "ArtifactsRepository",
+			F:    sweepArtifactsRepositoryResource,
 		})
 	}
 }
@@ -287,6 +287,7 @@ func sweepArtifactsRepositoryResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, repositoryId := range repositoryIds {
 		if ok := acctest.SweeperDefaultResourceId[repositoryId]; !ok {
 			deleteRepositoryRequest := oci_artifacts.DeleteRepositoryRequest{}
@@ -294,16 +295,20 @@ func sweepArtifactsRepositoryResource(compartment string) error {
 			deleteRepositoryRequest.RepositoryId = &repositoryId
 
 			deleteRepositoryRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "artifacts")
-			_, error := artifactsClient.DeleteRepository(context.Background(), deleteRepositoryRequest)
+			error := acctest.DeleteSweeperResource(compartment, "RepositoryId", repositoryId, func() error {
+				_, err := artifactsClient.DeleteRepository(context.Background(), deleteRepositoryRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Repository %s %s, It is possible that the resource is already deleted. Please verify manually \n", repositoryId, error)
+				fmt.Printf("Error deleting Repository %s %s \n", repositoryId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &repositoryId, repositorySweepWaitCondition, time.Duration(3*time.Minute),
 				repositorySweepResponseFetchOperation, "artifacts", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getRepositoryIds(compartment string) ([]string, error) {
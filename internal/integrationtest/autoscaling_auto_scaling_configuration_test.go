@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -483,10 +484,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("AutoScalingAutoScalingConfiguration") {
-		resource.AddTestSweepers("AutoScalingAutoScalingConfiguration", &resource.Sweeper{
-			Name:         "AutoScalingAutoScalingConfiguration",
-			Dependencies: acctest.DependencyGraph["autoScalingConfiguration"],
-			F:            sweepAutoScalingAutoScalingConfigurationResource,
+		acctest.AddTestSweepers("AutoScalingAutoScalingConfiguration", &resource.Sweeper{
+			Name: "AutoScalingAutoScalingConfiguration",
+			F:    sweepAutoScalingAutoScalingConfigurationResource,
 		})
 	}
 }
@@ -497,6 +497,7 @@ func sweepAutoScalingAutoScalingConfigurationResource(compartment string) error
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, autoScalingConfigurationId := range autoScalingConfigurationIds {
 		if ok := acctest.SweeperDefaultResourceId[autoScalingConfigurationId]; !ok {
 			deleteAutoScalingConfigurationRequest := oci_auto_scaling.DeleteAutoScalingConfigurationRequest{}
@@ -504,14 +505,18 @@ func sweepAutoScalingAutoScalingConfigurationResource(compartment string) error
 			deleteAutoScalingConfigurationRequest.AutoScalingConfigurationId = &autoScalingConfigurationId
 
 			deleteAutoScalingConfigurationRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "auto_scaling")
-			_, error := autoScalingClient.DeleteAutoScalingConfiguration(context.Background(), deleteAutoScalingConfigurationRequest)
+			error := acctest.DeleteSweeperResource(compartment, "AutoScalingConfigurationId", autoScalingConfigurationId, func() error {
+				_, err := autoScalingClient.DeleteAutoScalingConfiguration(context.Background(), deleteAutoScalingConfigurationRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting AutoScalingConfiguration %s %s, It is possible that the resource is already deleted. Please verify manually \n", autoScalingConfigurationId, error)
+				fmt.Printf("Error deleting AutoScalingConfiguration %s %s \n", autoScalingConfigurationId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getAutoScalingConfigurationIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -297,10 +298,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("BastionBastion") {
-		resource.AddTestSweepers("BastionBastion", &resource.Sweeper{
-			Name:         "BastionBastion",
-			Dependencies: acctest.DependencyGraph["bastion"],
-			F:            sweepBastionBastionResource,
+		acctest.AddTestSweepers("BastionBastion", &resource.Sweeper{
+			Name: "BastionBastion",
+			F:    sweepBastionBastionResource,
 		})
 	}
 }
@@ -311,6 +311,7 @@ func sweepBastionBastionResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, bastionId := range bastionIds {
 		if ok := acctest.SweeperDefaultResourceId[bastionId]; !ok {
 			deleteBastionRequest := oci_bastion.DeleteBastionRequest{}
@@ -318,16 +319,20 @@ func sweepBastionBastionResource(compartment string) error {
 			deleteBastionRequest.BastionId = &bastionId
 
 			deleteBastionRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "bastion")
-			_, error := bastionClient.DeleteBastion(context.Background(), deleteBastionRequest)
+			error := acctest.DeleteSweeperResource(compartment, "BastionId", bastionId, func() error {
+				_, err := bastionClient.DeleteBastion(context.Background(), deleteBastionRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Bastion %s %s, It is possible that the resource is already deleted. Please verify manually \n", bastionId, error)
+				fmt.Printf("Error deleting Bastion %s %s \n", bastionId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &bastionId, bastionSweepWaitCondition, time.Duration(3*time.Minute),
 				bastionSweepResponseFetchOperation, "bastion", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getBastionIds(compartment string) ([]string, error) {
@@ -17,6 +17,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -360,10 +361,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("BastionSession") {
-		resource.AddTestSweepers("BastionSession", &resource.Sweeper{
-			Name:         "BastionSession",
-			Dependencies: acctest.DependencyGraph["session"],
-			F:            sweepBastionSessionResource,
+		acctest.AddTestSweepers("BastionSession", &resource.Sweeper{
+			Name: "BastionSession",
+			F:    sweepBastionSessionResource,
 		})
 	}
 }
@@ -374,6 +374,7 @@ func sweepBastionSessionResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, sessionId := range sessionIds {
 		if ok := acctest.SweeperDefaultResourceId[sessionId]; !ok {
 			deleteSessionRequest := oci_bastion.DeleteSessionRequest{}
@@ -381,16 +382,20 @@ func sweepBastionSessionResource(compartment string) error {
 			deleteSessionRequest.SessionId = &sessionId
 
 			deleteSessionRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "bastion")
-			_, error := bastionClient.DeleteSession(context.Background(), deleteSessionRequest)
+			error := acctest.DeleteSweeperResource(compartment, "SessionId", sessionId, func() error {
+				_, err := bastionClient.DeleteSession(context.Background(), deleteSessionRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Session %s %s, It is possible that the resource is already deleted. Please verify manually \n", sessionId, error)
+				fmt.Printf("Error deleting Session %s %s \n", sessionId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &sessionId, sessionSweepWaitCondition, time.Duration(3*time.Minute),
 				sessionSweepResponseFetchOperation, "bastion", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getSessionIds(compartment string) ([]string, error) {
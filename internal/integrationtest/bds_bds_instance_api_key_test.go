@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -230,10 +231,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("BdsBdsInstanceApiKey") {
-		resource.AddTestSweepers("BdsBdsInstanceApiKey", &resource.Sweeper{
-			Name:         "BdsBdsInstanceApiKey",
-			Dependencies: acctest.DependencyGraph["bdsInstanceApiKey"],
-			F:            sweepBdsBdsInstanceApiKeyResource,
+		acctest.AddTestSweepers("BdsBdsInstanceApiKey", &resource.Sweeper{
+			Name: "BdsBdsInstanceApiKey",
+			F:    sweepBdsBdsInstanceApiKeyResource,
 		})
 	}
 }
@@ -244,21 +244,26 @@ func sweepBdsBdsInstanceApiKeyResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, bdsInstanceApiKeyId := range bdsInstanceApiKeyIds {
 		if ok := acctest.SweeperDefaultResourceId[bdsInstanceApiKeyId]; !ok {
 			deleteBdsApiKeyRequest := oci_bds.DeleteBdsApiKeyRequest{}
 
 			deleteBdsApiKeyRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "bds")
-			_, error := bdsClient.DeleteBdsApiKey(context.Background(), deleteBdsApiKeyRequest)
+			error := acctest.DeleteSweeperResource(compartment, "BdsInstanceApiKeyId", bdsInstanceApiKeyId, func() error {
+				_, err := bdsClient.DeleteBdsApiKey(context.Background(), deleteBdsApiKeyRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting BdsInstanceApiKey %s %s, It is possible that the resource is already deleted. Please verify manually \n", bdsInstanceApiKeyId, error)
+				fmt.Printf("Error deleting BdsInstanceApiKey %s %s \n", bdsInstanceApiKeyId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &bdsInstanceApiKeyId, bdsInstanceApiKeySweepWaitCondition, time.Duration(3*time.Minute),
 				bdsInstanceApiKeySweepResponseFetchOperation, "bds", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getBdsInstanceApiKeyIds(compartment string) ([]string, error) {
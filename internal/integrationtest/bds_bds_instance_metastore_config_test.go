@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -271,10 +272,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("BdsBdsInstanceMetastoreConfig") {
-		resource.AddTestSweepers("BdsBdsInstanceMetastoreConfig", &resource.Sweeper{
-			Name:         "BdsBdsInstanceMetastoreConfig",
-			Dependencies: acctest.DependencyGraph["bdsInstanceMetastoreConfig"],
-			F:            sweepBdsBdsInstanceMetastoreConfigResource,
+		acctest.AddTestSweepers("BdsBdsInstanceMetastoreConfig", &resource.Sweeper{
+			Name: "BdsBdsInstanceMetastoreConfig",
+			F:    sweepBdsBdsInstanceMetastoreConfigResource,
 		})
 	}
 }
@@ -285,21 +285,26 @@ func sweepBdsBdsInstanceMetastoreConfigResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, bdsInstanceMetastoreConfigId := range bdsInstanceMetastoreConfigIds {
 		if ok := acctest.SweeperDefaultResourceId[bdsInstanceMetastoreConfigId]; !ok {
 			deleteBdsMetastoreConfigurationRequest := oci_bds.DeleteBdsMetastoreConfigurationRequest{}
 
 			deleteBdsMetastoreConfigurationRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "bds")
-			_, error := bdsClient.DeleteBdsMetastoreConfiguration(context.Background(), deleteBdsMetastoreConfigurationRequest)
+			error := acctest.DeleteSweeperResource(compartment, "BdsInstanceMetastoreConfigId", bdsInstanceMetastoreConfigId, func() error {
+				_, err := bdsClient.DeleteBdsMetastoreConfiguration(context.Background(), deleteBdsMetastoreConfigurationRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting BdsInstanceMetastoreConfig %s %s, It is possible that the resource is already deleted. Please verify manually \n", bdsInstanceMetastoreConfigId, error)
+				fmt.Printf("Error deleting BdsInstanceMetastoreConfig %s %s \n", bdsInstanceMetastoreConfigId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &bdsInstanceMetastoreConfigId, bdsInstanceMetastoreConfigSweepWaitCondition, time.Duration(3*time.Minute),
 				bdsInstanceMetastoreConfigSweepResponseFetchOperation, "bds", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getBdsInstanceMetastoreConfigIds(compartment string) ([]string, error) {
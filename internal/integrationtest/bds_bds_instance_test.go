@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -413,10 +414,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("BdsBdsInstance") {
-		resource.AddTestSweepers("BdsBdsInstance", &resource.Sweeper{
-			Name:         "BdsBdsInstance",
-			Dependencies: acctest.DependencyGraph["bdsInstance"],
-			F:            sweepBdsBdsInstanceResource,
+		acctest.AddTestSweepers("BdsBdsInstance", &resource.Sweeper{
+			Name: "BdsBdsInstance",
+			F:    sweepBdsBdsInstanceResource,
 		})
 	}
 }
@@ -427,6 +427,7 @@ func sweepBdsBdsInstanceResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, bdsInstanceId := range bdsInstanceIds {
 		if ok := acctest.SweeperDefaultResourceId[bdsInstanceId]; !ok {
 			deleteBdsInstanceRequest := oci_bds.DeleteBdsInstanceRequest{}
@@ -434,16 +435,20 @@ func sweepBdsBdsInstanceResource(compartment string) error {
 			deleteBdsInstanceRequest.BdsInstanceId = &bdsInstanceId
 
 			deleteBdsInstanceRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "bds")
-			_, error := bdsClient.DeleteBdsInstance(context.Background(), deleteBdsInstanceRequest)
+			error := acctest.DeleteSweeperResource(compartment, "BdsInstanceId", bdsInstanceId, func() error {
+				_, err := bdsClient.DeleteBdsInstance(context.Background(), deleteBdsInstanceRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting BdsInstance %s %s, It is possible that the resource is already deleted. Please verify manually \n", bdsInstanceId, error)
+				fmt.Printf("Error deleting BdsInstance %s %s \n", bdsInstanceId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &bdsInstanceId, bdsInstanceSweepWaitCondition, time.Duration(3*time.Minute),
 				bdsInstanceSweepResponseFetchOperation, "bds", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getBdsInstanceIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -414,10 +415,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("BdsBdsInstanceOdh") {
-		resource.AddTestSweepers("BdsBdsInstanceOdh", &resource.Sweeper{
-			Name:         "BdsBdsInstanceOdh",
-			Dependencies: acctest.DependencyGraph["bdsInstanceOdh"],
-			F:            sweepBdsBdsInstanceOdhResource,
+		acctest.AddTestSweepers("BdsBdsInstanceOdh", &resource.Sweeper{
+			Name: "BdsBdsInstanceOdh",
+			F:    sweepBdsBdsInstanceOdhResource,
 		})
 	}
 }
@@ -428,6 +428,7 @@ func sweepBdsBdsInstanceOdhResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, bdsInstanceOdhId := range bdsInstanceOdhIds {
 		if ok := acctest.SweeperDefaultResourceId[bdsInstanceOdhId]; !ok {
 			deleteBdsInstanceRequest := oci_bds.DeleteBdsInstanceRequest{}
@@ -435,16 +436,20 @@ func sweepBdsBdsInstanceOdhResource(compartment string) error {
 			deleteBdsInstanceRequest.BdsInstanceId = &bdsInstanceOdhId
 
 			deleteBdsInstanceRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "bds")
-			_, error := bdsClient.DeleteBdsInstance(context.Background(), deleteBdsInstanceRequest)
+			error := acctest.DeleteSweeperResource(compartment, "BdsInstanceOdhId", bdsInstanceOdhId, func() error {
+				_, err := bdsClient.DeleteBdsInstance(context.Background(), deleteBdsInstanceRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting BdsInstance %s %s, It is possible that the resource is already deleted. Please verify manually \n", bdsInstanceOdhId, error)
+				fmt.Printf("Error deleting BdsInstance %s %s \n", bdsInstanceOdhId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &bdsInstanceOdhId, bdsInstanceOdhSweepWaitCondition, time.Duration(3*time.Minute),
 				bdsInstanceOdhSweepResponseFetchOperation, "bds", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getBdsInstanceOdhIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -334,10 +335,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("BlockchainBlockchainPlatform") {
-		resource.AddTestSweepers("BlockchainBlockchainPlatform", &resource.Sweeper{
-			Name:         "BlockchainBlockchainPlatform",
-			Dependencies: acctest.DependencyGraph["blockchainPlatform"],
-			F:            sweepBlockchainBlockchainPlatformResource,
+		acctest.AddTestSweepers("BlockchainBlockchainPlatform", &resource.Sweeper{
+			Name: "BlockchainBlockchainPlatform",
+			F:    sweepBlockchainBlockchainPlatformResource,
 		})
 	}
 }
@@ -348,6 +348,7 @@ func sweepBlockchainBlockchainPlatformResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, blockchainPlatformId := range blockchainPlatformIds {
 		if ok := acctest.SweeperDefaultResourceId[blockchainPlatformId]; !ok {
 			deleteBlockchainPlatformRequest := oci_blockchain.DeleteBlockchainPlatformRequest{}
@@ -355,16 +356,20 @@ func sweepBlockchainBlockchainPlatformResource(compartment string) error {
 			deleteBlockchainPlatformRequest.BlockchainPlatformId = &blockchainPlatformId
 
 			deleteBlockchainPlatformRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "blockchain")
-			_, error := blockchainPlatformClient.DeleteBlockchainPlatform(context.Background(), deleteBlockchainPlatformRequest)
+			error := acctest.DeleteSweeperResource(compartment, "BlockchainPlatformId", blockchainPlatformId, func() error {
+				_, err := blockchainPlatformClient.DeleteBlockchainPlatform(context.Background(), deleteBlockchainPlatformRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting BlockchainPlatform %s %s, It is possible that the resource is already deleted. Please verify manually \n", blockchainPlatformId, error)
+				fmt.Printf("Error deleting BlockchainPlatform %s %s \n", blockchainPlatformId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &blockchainPlatformId, blockchainPlatformSweepWaitCondition, time.Duration(3*time.Minute),
 				blockchainPlatformSweepResponseFetchOperation, "blockchain", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getBlockchainPlatformIds(compartment string) ([]string, error) {
@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -238,10 +239,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("BlockchainOsn") {
-		resource.AddTestSweepers("BlockchainOsn", &resource.Sweeper{
-			Name:         "BlockchainOsn",
-			Dependencies: acctest.DependencyGraph["osn"],
-			F:            sweepBlockchainOsnResource,
+		acctest.AddTestSweepers("BlockchainOsn", &resource.Sweeper{
+			Name: "BlockchainOsn",
+			F:    sweepBlockchainOsnResource,
 		})
 	}
 }
@@ -252,6 +252,7 @@ func sweepBlockchainOsnResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, osnId := range osnIds {
 		if ok := acctest.SweeperDefaultResourceId[osnId]; !ok {
 			deleteOsnRequest := oci_blockchain.DeleteOsnRequest{}
@@ -259,14 +260,18 @@ func sweepBlockchainOsnResource(compartment string) error {
 			deleteOsnRequest.OsnId = &osnId
 
 			deleteOsnRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "blockchain")
-			_, error := blockchainPlatformClient.DeleteOsn(context.Background(), deleteOsnRequest)
+			error := acctest.DeleteSweeperResource(compartment, "OsnId", osnId, func() error {
+				_, err := blockchainPlatformClient.DeleteOsn(context.Background(), deleteOsnRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Osn %s %s, It is possible that the resource is already deleted. Please verify manually \n", osnId, error)
+				fmt.Printf("Error deleting Osn %s %s \n", osnId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getOsnIds(compartment string) ([]string, error) {
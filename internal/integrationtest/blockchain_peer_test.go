@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -250,10 +251,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("BlockchainPeer") {
-		resource.AddTestSweepers("BlockchainPeer", &resource.Sweeper{
-			Name:         "BlockchainPeer",
-			Dependencies: acctest.DependencyGraph["peer"],
-			F:            sweepBlockchainPeerResource,
+		acctest.AddTestSweepers("BlockchainPeer", &resource.Sweeper{
+			Name: "BlockchainPeer",
+			F:    sweepBlockchainPeerResource,
 		})
 	}
 }
@@ -264,6 +264,7 @@ func sweepBlockchainPeerResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, peerId := range peerIds {
 		if ok := acctest.SweeperDefaultResourceId[peerId]; !ok {
 			deletePeerRequest := oci_blockchain.DeletePeerRequest{}
@@ -271,14 +272,18 @@ func sweepBlockchainPeerResource(compartment string) error {
 			deletePeerRequest.PeerId = &peerId
 
 			deletePeerRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "blockchain")
-			_, error := blockchainPlatformClient.DeletePeer(context.Background(), deletePeerRequest)
+			error := acctest.DeleteSweeperResource(compartment, "PeerId", peerId, func() error {
+				_, err := blockchainPlatformClient.DeletePeer(context.Background(), deletePeerRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Peer %s %s, It is possible that the resource is already deleted. Please verify manually \n", peerId, error)
+				fmt.Printf("Error deleting Peer %s %s \n", peerId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getPeerIds(compartment string) ([]string, error) {
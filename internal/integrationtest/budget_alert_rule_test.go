@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -286,10 +287,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("BudgetAlertRule") {
-		resource.AddTestSweepers("BudgetAlertRule", &resource.Sweeper{
-			Name:         "BudgetAlertRule",
-			Dependencies: acctest.DependencyGraph["alertRule"],
-			F:            sweepBudgetAlertRuleResource,
+		acctest.AddTestSweepers("BudgetAlertRule", &resource.Sweeper{
+			Name: "BudgetAlertRule",
+			F:    sweepBudgetAlertRuleResource,
 		})
 	}
 }
@@ -300,6 +300,7 @@ func sweepBudgetAlertRuleResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, alertRuleId := range alertRuleIds {
 		if ok := acctest.SweeperDefaultResourceId[alertRuleId]; !ok {
 			deleteAlertRuleRequest := oci_budget.DeleteAlertRuleRequest{}
@@ -307,14 +308,18 @@ func sweepBudgetAlertRuleResource(compartment string) error {
 			deleteAlertRuleRequest.AlertRuleId = &alertRuleId
 
 			deleteAlertRuleRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "budget")
-			_, error := budgetClient.DeleteAlertRule(context.Background(), deleteAlertRuleRequest)
+			error := acctest.DeleteSweeperResource(compartment, "AlertRuleId", alertRuleId, func() error {
+				_, err := budgetClient.DeleteAlertRule(context.Background(), deleteAlertRuleRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting AlertRule %s %s, It is possible that the resource is already deleted. Please verify manually \n", alertRuleId, error)
+				fmt.Printf("Error deleting AlertRule %s %s \n", alertRuleId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getAlertRuleIds(compartment string) ([]string, error) {
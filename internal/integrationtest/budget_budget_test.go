@@ -14,6 +14,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -454,10 +455,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("BudgetBudget") {
-		resource.AddTestSweepers("BudgetBudget", &resource.Sweeper{
-			Name:         "BudgetBudget",
-			Dependencies: acctest.DependencyGraph["budget"],
-			F:            sweepBudgetBudgetResource,
+		acctest.AddTestSweepers("BudgetBudget", &resource.Sweeper{
+			Name: "BudgetBudget",
+			F:    sweepBudgetBudgetResource,
 		})
 	}
 }
@@ -470,6 +470,7 @@ func sweepBudgetBudgetResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, budgetId := range budgetIds {
 		if ok := acctest.SweeperDefaultResourceId[budgetId]; !ok {
 			deleteBudgetRequest := oci_budget.DeleteBudgetRequest{}
@@ -477,14 +478,18 @@ func sweepBudgetBudgetResource(compartment string) error {
 			deleteBudgetRequest.BudgetId = &budgetId
 
 			deleteBudgetRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "budget")
-			_, error := budgetClient.DeleteBudget(context.Background(), deleteBudgetRequest)
+			error := acctest.DeleteSweeperResource(compartment, "BudgetId", budgetId, func() error {
+				_, err := budgetClient.DeleteBudget(context.Background(), deleteBudgetRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Budget %s %s, It is possible that the resource is already deleted. Please verify manually \n", budgetId, error)
+				fmt.Printf("Error deleting Budget %s %s \n", budgetId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getBudgetIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -289,10 +290,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CertificatesManagementCaBundle") {
-		resource.AddTestSweepers("CertificatesManagementCaBundle", &resource.Sweeper{
-			Name:         "CertificatesManagementCaBundle",
-			Dependencies: acctest.DependencyGraph["caBundle"],
-			F:            sweepCertificatesManagementCaBundleResource,
+		acctest.AddTestSweepers("CertificatesManagementCaBundle", &resource.Sweeper{
+			Name: "CertificatesManagementCaBundle",
+			F:    sweepCertificatesManagementCaBundleResource,
 		})
 	}
 }
@@ -303,6 +303,7 @@ func sweepCertificatesManagementCaBundleResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, caBundleId := range caBundleIds {
 		if ok := acctest.SweeperDefaultResourceId[caBundleId]; !ok {
 			deleteCaBundleRequest := oci_certificates_management.DeleteCaBundleRequest{}
@@ -310,16 +311,20 @@ func sweepCertificatesManagementCaBundleResource(compartment string) error {
 			deleteCaBundleRequest.CaBundleId = &caBundleId
 
 			deleteCaBundleRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "certificates_management")
-			_, error := certificatesManagementClient.DeleteCaBundle(context.Background(), deleteCaBundleRequest)
+			error := acctest.DeleteSweeperResource(compartment, "CaBundleId", caBundleId, func() error {
+				_, err := certificatesManagementClient.DeleteCaBundle(context.Background(), deleteCaBundleRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting CaBundle %s %s, It is possible that the resource is already deleted. Please verify manually \n", caBundleId, error)
+				fmt.Printf("Error deleting CaBundle %s %s \n", caBundleId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &caBundleId, caBundleSweepWaitCondition, time.Duration(3*time.Minute),
 				caBundleSweepResponseFetchOperation, "certificates_management", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getCaBundleIds(compartment string) ([]string, error) {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -270,10 +271,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CloudGuardDataMaskRule") {
-		resource.AddTestSweepers("CloudGuardDataMaskRule", &resource.Sweeper{
-			Name:         "CloudGuardDataMaskRule",
-			Dependencies: acctest.DependencyGraph["dataMaskRule"],
-			F:            sweepCloudGuardDataMaskRuleResource,
+		acctest.AddTestSweepers("CloudGuardDataMaskRule", &resource.Sweeper{
+			Name: "CloudGuardDataMaskRule",
+			F:    sweepCloudGuardDataMaskRuleResource,
 		})
 	}
 }
@@ -284,6 +284,7 @@ func sweepCloudGuardDataMaskRuleResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, dataMaskRuleId := range dataMaskRuleIds {
 		if ok := acctest.SweeperDefaultResourceId[dataMaskRuleId]; !ok {
 			deleteDataMaskRuleRequest := oci_cloud_guard.DeleteDataMaskRuleRequest{}
@@ -291,16 +292,20 @@ func sweepCloudGuardDataMaskRuleResource(compartment string) error {
 			deleteDataMaskRuleRequest.DataMaskRuleId = &dataMaskRuleId
 
 			deleteDataMaskRuleRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "cloud_guard")
-			_, error := cloudGuardClient.DeleteDataMaskRule(context.Background(), deleteDataMaskRuleRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DataMaskRuleId", dataMaskRuleId, func() error {
+				_, err := cloudGuardClient.DeleteDataMaskRule(context.Background(), deleteDataMaskRuleRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting DataMaskRule %s %s, It is possible that the resource is already deleted. Please verify manually \n", dataMaskRuleId, error)
+				fmt.Printf("Error deleting DataMaskRule %s %s \n", dataMaskRuleId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &dataMaskRuleId, dataMaskRuleSweepWaitCondition, time.Duration(3*time.Minute),
 				dataMaskRuleSweepResponseFetchOperation, "cloud_guard", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDataMaskRuleIds(compartment string) ([]string, error) {
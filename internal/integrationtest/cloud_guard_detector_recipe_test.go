@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -399,10 +400,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CloudGuardDetectorRecipe") {
-		resource.AddTestSweepers("CloudGuardDetectorRecipe", &resource.Sweeper{
-			Name:         "CloudGuardDetectorRecipe",
-			Dependencies: acctest.DependencyGraph["detectorRecipe"],
-			F:            sweepCloudGuardDetectorRecipeResource,
+		acctest.AddTestSweepers("CloudGuardDetectorRecipe", &resource.Sweeper{
+			Name: "CloudGuardDetectorRecipe",
+			F:    sweepCloudGuardDetectorRecipeResource,
 		})
 	}
 }
@@ -413,6 +413,7 @@ func sweepCloudGuardDetectorRecipeResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, detectorRecipeId := range detectorRecipeIds {
 		if ok := acctest.SweeperDefaultResourceId[detectorRecipeId]; !ok {
 			deleteDetectorRecipeRequest := oci_cloud_guard.DeleteDetectorRecipeRequest{}
@@ -420,16 +421,20 @@ func sweepCloudGuardDetectorRecipeResource(compartment string) error {
 			deleteDetectorRecipeRequest.DetectorRecipeId = &detectorRecipeId
 
 			deleteDetectorRecipeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "cloud_guard")
-			_, error := cloudGuardClient.DeleteDetectorRecipe(context.Background(), deleteDetectorRecipeRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DetectorRecipeId", detectorRecipeId, func() error {
+				_, err := cloudGuardClient.DeleteDetectorRecipe(context.Background(), deleteDetectorRecipeRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting DetectorRecipe %s %s, It is possible that the resource is already deleted. Please verify manually \n", detectorRecipeId, error)
+				fmt.Printf("Error deleting DetectorRecipe %s %s \n", detectorRecipeId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &detectorRecipeId, detectorRecipeSweepWaitCondition, time.Duration(3*time.Minute),
 				detectorRecipeSweepResponseFetchOperation, "cloud_guard", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDetectorRecipeIds(compartment string) ([]string, error) {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -281,10 +282,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CloudGuardManagedList") {
-		resource.AddTestSweepers("CloudGuardManagedList", &resource.Sweeper{
-			Name:         "CloudGuardManagedList",
-			Dependencies: acctest.DependencyGraph["managedList"],
-			F:            sweepCloudGuardManagedListResource,
+		acctest.AddTestSweepers("CloudGuardManagedList", &resource.Sweeper{
+			Name: "CloudGuardManagedList",
+			F:    sweepCloudGuardManagedListResource,
 		})
 	}
 }
@@ -295,6 +295,7 @@ func sweepCloudGuardManagedListResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, managedListId := range managedListIds {
 		if ok := acctest.SweeperDefaultResourceId[managedListId]; !ok {
 			deleteManagedListRequest := oci_cloud_guard.DeleteManagedListRequest{}
@@ -302,16 +303,20 @@ func sweepCloudGuardManagedListResource(compartment string) error {
 			deleteManagedListRequest.ManagedListId = &managedListId
 
 			deleteManagedListRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "cloud_guard")
-			_, error := cloudGuardClient.DeleteManagedList(context.Background(), deleteManagedListRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ManagedListId", managedListId, func() error {
+				_, err := cloudGuardClient.DeleteManagedList(context.Background(), deleteManagedListRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ManagedList %s %s, It is possible that the resource is already deleted. Please verify manually \n", managedListId, error)
+				fmt.Printf("Error deleting ManagedList %s %s \n", managedListId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &managedListId, managedListSweepWaitCondition, time.Duration(3*time.Minute),
 				managedListSweepResponseFetchOperation, "cloud_guard", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getManagedListIds(compartment string) ([]string, error) {
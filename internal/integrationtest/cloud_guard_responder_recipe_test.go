@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -318,10 +319,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CloudGuardResponderRecipe") {
-		resource.AddTestSweepers("CloudGuardResponderRecipe", &resource.Sweeper{
-			Name:         "CloudGuardResponderRecipe",
-			Dependencies: acctest.DependencyGraph["responderRecipe"],
-			F:            sweepCloudGuardResponderRecipeResource,
+		acctest.AddTestSweepers("CloudGuardResponderRecipe", &resource.Sweeper{
+			Name: "CloudGuardResponderRecipe",
+			F:    sweepCloudGuardResponderRecipeResource,
 		})
 	}
 }
@@ -332,6 +332,7 @@ func sweepCloudGuardResponderRecipeResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, responderRecipeId := range responderRecipeIds {
 		if ok := acctest.SweeperDefaultResourceId[responderRecipeId]; !ok {
 			deleteResponderRecipeRequest := oci_cloud_guard.DeleteResponderRecipeRequest{}
@@ -339,16 +340,20 @@ func sweepCloudGuardResponderRecipeResource(compartment string) error {
 			deleteResponderRecipeRequest.ResponderRecipeId = &responderRecipeId
 
 			deleteResponderRecipeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "cloud_guard")
-			_, error := cloudGuardClient.DeleteResponderRecipe(context.Background(), deleteResponderRecipeRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ResponderRecipeId", responderRecipeId, func() error {
+				_, err := cloudGuardClient.DeleteResponderRecipe(context.Background(), deleteResponderRecipeRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ResponderRecipe %s %s, It is possible that the resource is already deleted. Please verify manually \n", responderRecipeId, error)
+				fmt.Printf("Error deleting ResponderRecipe %s %s \n", responderRecipeId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &responderRecipeId, responderRecipeSweepWaitCondition, time.Duration(3*time.Minute),
 				responderRecipeSweepResponseFetchOperation, "cloud_guard", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getResponderRecipeIds(compartment string) ([]string, error) {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -424,10 +425,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CloudGuardTarget") {
-		resource.AddTestSweepers("CloudGuardTarget", &resource.Sweeper{
-			Name:         "CloudGuardTarget",
-			Dependencies: acctest.DependencyGraph["target"],
-			F:            sweepCloudGuardTargetResource,
+		acctest.AddTestSweepers("CloudGuardTarget", &resource.Sweeper{
+			Name: "CloudGuardTarget",
+			F:    sweepCloudGuardTargetResource,
 		})
 	}
 }
@@ -438,6 +438,7 @@ func sweepCloudGuardTargetResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, targetId := range targetIds {
 		if ok := acctest.SweeperDefaultResourceId[targetId]; !ok {
 			deleteTargetRequest := oci_cloud_guard.DeleteTargetRequest{}
@@ -445,16 +446,20 @@ func sweepCloudGuardTargetResource(compartment string) error {
 			deleteTargetRequest.TargetId = &targetId
 
 			deleteTargetRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "cloud_guard")
-			_, error := cloudGuardClient.DeleteTarget(context.Background(), deleteTargetRequest)
+			error := acctest.DeleteSweeperResource(compartment, "TargetId", targetId, func() error {
+				_, err := cloudGuardClient.DeleteTarget(context.Background(), deleteTargetRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Target %s %s, It is possible that the resource is already deleted. Please verify manually \n", targetId, error)
+				fmt.Printf("Error deleting Target %s %s \n", targetId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &targetId, targetSweepWaitCondition, time.Duration(3*time.Minute),
 				targetSweepResponseFetchOperation, "cloud_guard", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getTargetIds(compartment string) ([]string, error) {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -334,10 +335,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("ContainerengineCluster") {
-		resource.AddTestSweepers("ContainerengineCluster", &resource.Sweeper{
-			Name:         "ContainerengineCluster",
-			Dependencies: acctest.DependencyGraph["cluster"],
-			F:            sweepContainerengineClusterResource,
+		acctest.AddTestSweepers("ContainerengineCluster", &resource.Sweeper{
+			Name: "ContainerengineCluster",
+			F:    sweepContainerengineClusterResource,
 		})
 	}
 }
@@ -348,6 +348,7 @@ func sweepContainerengineClusterResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, clusterId := range clusterIds {
 		if ok := acctest.SweeperDefaultResourceId[clusterId]; !ok {
 			deleteClusterRequest := oci_containerengine.DeleteClusterRequest{}
@@ -355,16 +356,20 @@ func sweepContainerengineClusterResource(compartment string) error {
 			deleteClusterRequest.ClusterId = &clusterId
 
 			deleteClusterRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "containerengine")
-			_, error := containerEngineClient.DeleteCluster(context.Background(), deleteClusterRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ClusterId", clusterId, func() error {
+				_, err := containerEngineClient.DeleteCluster(context.Background(), deleteClusterRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Cluster %s %s, It is possible that the resource is already deleted. Please verify manually \n", clusterId, error)
+				fmt.Printf("Error deleting Cluster %s %s \n", clusterId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &clusterId, clusterSweepWaitCondition, time.Duration(3*time.Minute),
 				clusterSweepResponseFetchOperation, "containerengine", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getClusterIds(compartment string) ([]string, error) {
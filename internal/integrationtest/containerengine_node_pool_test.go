@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -350,10 +351,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("ContainerengineNodePool") {
-		resource.AddTestSweepers("ContainerengineNodePool", &resource.Sweeper{
-			Name:         "ContainerengineNodePool",
-			Dependencies: acctest.DependencyGraph["nodePool"],
-			F:            sweepContainerengineNodePoolResource,
+		acctest.AddTestSweepers("ContainerengineNodePool", &resource.Sweeper{
+			Name: "ContainerengineNodePool",
+			F:    sweepContainerengineNodePoolResource,
 		})
 	}
 }
@@ -364,6 +364,7 @@ func sweepContainerengineNodePoolResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, nodePoolId := range nodePoolIds {
 		if ok := acctest.SweeperDefaultResourceId[nodePoolId]; !ok {
 			deleteNodePoolRequest := oci_containerengine.DeleteNodePoolRequest{}
@@ -371,14 +372,18 @@ func sweepContainerengineNodePoolResource(compartment string) error {
 			deleteNodePoolRequest.NodePoolId = &nodePoolId
 
 			deleteNodePoolRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "containerengine")
-			_, error := containerEngineClient.DeleteNodePool(context.Background(), deleteNodePoolRequest)
+			error := acctest.DeleteSweeperResource(compartment, "NodePoolId", nodePoolId, func() error {
+				_, err := containerEngineClient.DeleteNodePool(context.Background(), deleteNodePoolRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting NodePool %s %s, It is possible that the resource is already deleted. Please verify manually \n", nodePoolId, error)
+				fmt.Printf("Error deleting NodePool %s %s \n", nodePoolId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getNodePoolIds(compartment string) ([]string, error) {
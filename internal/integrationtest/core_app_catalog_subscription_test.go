@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -189,10 +190,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreAppCatalogSubscription") {
-		resource.AddTestSweepers("CoreAppCatalogSubscription", &resource.Sweeper{
-			Name:         "CoreAppCatalogSubscription",
-			Dependencies: acctest.DependencyGraph["appCatalogSubscription"],
-			F:            sweepCoreAppCatalogSubscriptionResource,
+		acctest.AddTestSweepers("CoreAppCatalogSubscription", &resource.Sweeper{
+			Name: "CoreAppCatalogSubscription",
+			F:    sweepCoreAppCatalogSubscriptionResource,
 		})
 	}
 }
@@ -203,19 +203,24 @@ func sweepCoreAppCatalogSubscriptionResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, appCatalogSubscriptionId := range appCatalogSubscriptionIds {
 		if ok := acctest.SweeperDefaultResourceId[appCatalogSubscriptionId]; !ok {
 			deleteAppCatalogSubscriptionRequest := oci_core.DeleteAppCatalogSubscriptionRequest{}
 
 			deleteAppCatalogSubscriptionRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := computeClient.DeleteAppCatalogSubscription(context.Background(), deleteAppCatalogSubscriptionRequest)
+			error := acctest.DeleteSweeperResource(compartment, "AppCatalogSubscriptionId", appCatalogSubscriptionId, func() error {
+				_, err := computeClient.DeleteAppCatalogSubscription(context.Background(), deleteAppCatalogSubscriptionRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting AppCatalogSubscription %s %s, It is possible that the resource is already deleted. Please verify manually \n", appCatalogSubscriptionId, error)
+				fmt.Printf("Error deleting AppCatalogSubscription %s %s \n", appCatalogSubscriptionId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getAppCatalogSubscriptionIds(compartment string) ([]string, error) {
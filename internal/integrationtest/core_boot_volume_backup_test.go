@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -272,10 +273,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreBootVolumeBackup") {
-		resource.AddTestSweepers("CoreBootVolumeBackup", &resource.Sweeper{
-			Name:         "CoreBootVolumeBackup",
-			Dependencies: acctest.DependencyGraph["bootVolumeBackup"],
-			F:            sweepCoreBootVolumeBackupResource,
+		acctest.AddTestSweepers("CoreBootVolumeBackup", &resource.Sweeper{
+			Name: "CoreBootVolumeBackup",
+			F:    sweepCoreBootVolumeBackupResource,
 		})
 	}
 }
@@ -286,6 +286,7 @@ func sweepCoreBootVolumeBackupResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, bootVolumeBackupId := range bootVolumeBackupIds {
 		if ok := acctest.SweeperDefaultResourceId[bootVolumeBackupId]; !ok {
 			deleteBootVolumeBackupRequest := oci_core.DeleteBootVolumeBackupRequest{}
@@ -293,16 +294,20 @@ func sweepCoreBootVolumeBackupResource(compartment string) error {
 			deleteBootVolumeBackupRequest.BootVolumeBackupId = &bootVolumeBackupId
 
 			deleteBootVolumeBackupRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := blockstorageClient.DeleteBootVolumeBackup(context.Background(), deleteBootVolumeBackupRequest)
+			error := acctest.DeleteSweeperResource(compartment, "BootVolumeBackupId", bootVolumeBackupId, func() error {
+				_, err := blockstorageClient.DeleteBootVolumeBackup(context.Background(), deleteBootVolumeBackupRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting BootVolumeBackup %s %s, It is possible that the resource is already deleted. Please verify manually \n", bootVolumeBackupId, error)
+				fmt.Printf("Error deleting BootVolumeBackup %s %s \n", bootVolumeBackupId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &bootVolumeBackupId, bootVolumeBackupSweepWaitCondition, time.Duration(3*time.Minute),
 				bootVolumeBackupSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getBootVolumeBackupIds(compartment string) ([]string, error) {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -366,10 +367,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreBootVolume") {
-		resource.AddTestSweepers("CoreBootVolume", &resource.Sweeper{
-			Name:         "CoreBootVolume",
-			Dependencies: acctest.DependencyGraph["bootVolume"],
-			F:            sweepCoreBootVolumeResource,
+		acctest.AddTestSweepers("CoreBootVolume", &resource.Sweeper{
+			Name: "CoreBootVolume",
+			F:    sweepCoreBootVolumeResource,
 		})
 	}
 }
@@ -380,6 +380,7 @@ func sweepCoreBootVolumeResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, bootVolumeId := range bootVolumeIds {
 		if ok := acctest.SweeperDefaultResourceId[bootVolumeId]; !ok {
 			deleteBootVolumeRequest := oci_core.DeleteBootVolumeRequest{}
@@ -387,16 +388,20 @@ func sweepCoreBootVolumeResource(compartment string) error {
 			deleteBootVolumeRequest.BootVolumeId = &bootVolumeId
 
 			deleteBootVolumeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := blockstorageClient.DeleteBootVolume(context.Background(), deleteBootVolumeRequest)
+			error := acctest.DeleteSweeperResource(compartment, "BootVolumeId", bootVolumeId, func() error {
+				_, err := blockstorageClient.DeleteBootVolume(context.Background(), deleteBootVolumeRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting BootVolume %s %s, It is possible that the resource is already deleted. Please verify manually \n", bootVolumeId, error)
+				fmt.Printf("Error deleting BootVolume %s %s \n", bootVolumeId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &bootVolumeId, bootVolumeSweepWaitCondition, time.Duration(3*time.Minute),
 				bootVolumeSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getBootVolumeIds(compartment string) ([]string, error) {
@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -87,10 +88,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreByoipRange") {
-		resource.AddTestSweepers("CoreByoipRange", &resource.Sweeper{
-			Name:         "CoreByoipRange",
-			Dependencies: acctest.DependencyGraph["byoipRange"],
-			F:            sweepCoreByoipRangeResource,
+		acctest.AddTestSweepers("CoreByoipRange", &resource.Sweeper{
+			Name: "CoreByoipRange",
+			F:    sweepCoreByoipRangeResource,
 		})
 	}
 }
@@ -101,6 +101,7 @@ func sweepCoreByoipRangeResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, byoipRangeId := range byoipRangeIds {
 		if ok := acctest.SweeperDefaultResourceId[byoipRangeId]; !ok {
 			deleteByoipRangeRequest := oci_core.DeleteByoipRangeRequest{}
@@ -108,16 +109,20 @@ func sweepCoreByoipRangeResource(compartment string) error {
 			deleteByoipRangeRequest.ByoipRangeId = &byoipRangeId
 
 			deleteByoipRangeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := virtualNetworkClient.DeleteByoipRange(context.Background(), deleteByoipRangeRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ByoipRangeId", byoipRangeId, func() error {
+				_, err := virtualNetworkClient.DeleteByoipRange(context.Background(), deleteByoipRangeRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ByoipRange %s %s, It is possible that the resource is already deleted. Please verify manually \n", byoipRangeId, error)
+				fmt.Printf("Error deleting ByoipRange %s %s \n", byoipRangeId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &byoipRangeId, byoipRangeSweepWaitCondition, time.Duration(3*time.Minute),
 				byoipRangeSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getByoipRangeIds(compartment string) ([]string, error) {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -409,10 +410,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreClusterNetwork") {
-		resource.AddTestSweepers("CoreClusterNetwork", &resource.Sweeper{
-			Name:         "CoreClusterNetwork",
-			Dependencies: acctest.DependencyGraph["clusterNetwork"],
-			F:            sweepCoreClusterNetworkResource,
+		acctest.AddTestSweepers("CoreClusterNetwork", &resource.Sweeper{
+			Name: "CoreClusterNetwork",
+			F:    sweepCoreClusterNetworkResource,
 		})
 	}
 }
@@ -423,6 +423,7 @@ func sweepCoreClusterNetworkResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, clusterNetworkId := range clusterNetworkIds {
 		if ok := acctest.SweeperDefaultResourceId[clusterNetworkId]; !ok {
 			terminateClusterNetworkRequest := oci_core.TerminateClusterNetworkRequest{}
@@ -430,9 +431,13 @@ func sweepCoreClusterNetworkResource(compartment string) error {
 			terminateClusterNetworkRequest.ClusterNetworkId = &clusterNetworkId
 
 			terminateClusterNetworkRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := computeManagementClient.TerminateClusterNetwork(context.Background(), terminateClusterNetworkRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ClusterNetworkId", clusterNetworkId, func() error {
+				_, err := computeManagementClient.TerminateClusterNetwork(context.Background(), terminateClusterNetworkRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ClusterNetwork %s %s, It is possible that the resource is already deleted. Please verify manually \n", clusterNetworkId, error)
+				fmt.Printf("Error deleting ClusterNetwork %s %s \n", clusterNetworkId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &clusterNetworkId, clusterNetworkSweepWaitCondition,
@@ -440,7 +445,7 @@ func sweepCoreClusterNetworkResource(compartment string) error {
 				clusterNetworkSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getClusterNetworkIds(compartment string) ([]string, error) {
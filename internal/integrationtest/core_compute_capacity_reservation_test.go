@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -326,10 +327,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreComputeCapacityReservation") {
-		resource.AddTestSweepers("CoreComputeCapacityReservation", &resource.Sweeper{
-			Name:         "CoreComputeCapacityReservation",
-			Dependencies: acctest.DependencyGraph["computeCapacityReservation"],
-			F:            sweepCoreComputeCapacityReservationResource,
+		acctest.AddTestSweepers("CoreComputeCapacityReservation", &resource.Sweeper{
+			Name: "CoreComputeCapacityReservation",
+			F:    sweepCoreComputeCapacityReservationResource,
 		})
 	}
 }
@@ -340,21 +340,26 @@ func sweepCoreComputeCapacityReservationResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, computeCapacityReservationId := range computeCapacityReservationIds {
 		if ok := acctest.SweeperDefaultResourceId[computeCapacityReservationId]; !ok {
 			deleteComputeCapacityReservationRequest := oci_core.DeleteComputeCapacityReservationRequest{}
 
 			deleteComputeCapacityReservationRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := computeClient.DeleteComputeCapacityReservation(context.Background(), deleteComputeCapacityReservationRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ComputeCapacityReservationId", computeCapacityReservationId, func() error {
+				_, err := computeClient.DeleteComputeCapacityReservation(context.Background(), deleteComputeCapacityReservationRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ComputeCapacityReservation %s %s, It is possible that the resource is already deleted. Please verify manually \n", computeCapacityReservationId, error)
+				fmt.Printf("Error deleting ComputeCapacityReservation %s %s \n", computeCapacityReservationId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &computeCapacityReservationId, computeCapacityReservationSweepWaitCondition, time.Duration(3*time.Minute),
 				computeCapacityReservationSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getComputeCapacityReservationIds(compartment string) ([]string, error) {
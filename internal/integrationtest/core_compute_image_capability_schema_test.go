@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -310,10 +311,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreComputeImageCapabilitySchema") {
-		resource.AddTestSweepers("CoreComputeImageCapabilitySchema", &resource.Sweeper{
-			Name:         "CoreComputeImageCapabilitySchema",
-			Dependencies: acctest.DependencyGraph["computeImageCapabilitySchema"],
-			F:            sweepCoreComputeImageCapabilitySchemaResource,
+		acctest.AddTestSweepers("CoreComputeImageCapabilitySchema", &resource.Sweeper{
+			Name: "CoreComputeImageCapabilitySchema",
+			F:    sweepCoreComputeImageCapabilitySchemaResource,
 		})
 	}
 }
@@ -324,6 +324,7 @@ func sweepCoreComputeImageCapabilitySchemaResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, computeImageCapabilitySchemaId := range computeImageCapabilitySchemaIds {
 		if ok := acctest.SweeperDefaultResourceId[computeImageCapabilitySchemaId]; !ok {
 			deleteComputeImageCapabilitySchemaRequest := oci_core.DeleteComputeImageCapabilitySchemaRequest{}
@@ -331,14 +332,18 @@ func sweepCoreComputeImageCapabilitySchemaResource(compartment string) error {
 			deleteComputeImageCapabilitySchemaRequest.ComputeImageCapabilitySchemaId = &computeImageCapabilitySchemaId
 
 			deleteComputeImageCapabilitySchemaRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := computeClient.DeleteComputeImageCapabilitySchema(context.Background(), deleteComputeImageCapabilitySchemaRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ComputeImageCapabilitySchemaId", computeImageCapabilitySchemaId, func() error {
+				_, err := computeClient.DeleteComputeImageCapabilitySchema(context.Background(), deleteComputeImageCapabilitySchemaRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ComputeImageCapabilitySchema %s %s, It is possible that the resource is already deleted. Please verify manually \n", computeImageCapabilitySchemaId, error)
+				fmt.Printf("Error deleting ComputeImageCapabilitySchema %s %s \n", computeImageCapabilitySchemaId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getComputeImageCapabilitySchemaIds(compartment string) ([]string, error) {
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -210,10 +211,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreConsoleHistory") {
-		resource.AddTestSweepers("CoreConsoleHistory", &resource.Sweeper{
-			Name:         "CoreConsoleHistory",
-			Dependencies: acctest.DependencyGraph["consoleHistory"],
-			F:            sweepCoreConsoleHistoryResource,
+		acctest.AddTestSweepers("CoreConsoleHistory", &resource.Sweeper{
+			Name: "CoreConsoleHistory",
+			F:    sweepCoreConsoleHistoryResource,
 		})
 	}
 }
@@ -224,6 +224,7 @@ func sweepCoreConsoleHistoryResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, consoleHistoryId := range consoleHistoryIds {
 		if ok := acctest.SweeperDefaultResourceId[consoleHistoryId]; !ok {
 			deleteConsoleHistoryRequest := oci_core.DeleteConsoleHistoryRequest{}
@@ -231,14 +232,18 @@ func sweepCoreConsoleHistoryResource(compartment string) error {
 			deleteConsoleHistoryRequest.InstanceConsoleHistoryId = &consoleHistoryId
 
 			deleteConsoleHistoryRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := computeClient.DeleteConsoleHistory(context.Background(), deleteConsoleHistoryRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ConsoleHistoryId", consoleHistoryId, func() error {
+				_, err := computeClient.DeleteConsoleHistory(context.Background(), deleteConsoleHistoryRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ConsoleHistory %s %s, It is possible that the resource is already deleted. Please verify manually \n", consoleHistoryId, error)
+				fmt.Printf("Error deleting ConsoleHistory %s %s \n", consoleHistoryId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getConsoleHistoryIds(compartment string) ([]string, error) {
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -230,10 +231,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreCpe") {
-		resource.AddTestSweepers("CoreCpe", &resource.Sweeper{
-			Name:         "CoreCpe",
-			Dependencies: acctest.DependencyGraph["cpe"],
-			F:            sweepCoreCpeResource,
+		acctest.AddTestSweepers("CoreCpe", &resource.Sweeper{
+			Name: "CoreCpe",
+			F:    sweepCoreCpeResource,
 		})
 	}
 }
@@ -244,6 +244,7 @@ func sweepCoreCpeResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, cpeId := range cpeIds {
 		if ok := acctest.SweeperDefaultResourceId[cpeId]; !ok {
 			deleteCpeRequest := oci_core.DeleteCpeRequest{}
@@ -251,14 +252,18 @@ func sweepCoreCpeResource(compartment string) error {
 			deleteCpeRequest.CpeId = &cpeId
 
 			deleteCpeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := virtualNetworkClient.DeleteCpe(context.Background(), deleteCpeRequest)
+			error := acctest.DeleteSweeperResource(compartment, "CpeId", cpeId, func() error {
+				_, err := virtualNetworkClient.DeleteCpe(context.Background(), deleteCpeRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Cpe %s %s, It is possible that the resource is already deleted. Please verify manually \n", cpeId, error)
+				fmt.Printf("Error deleting Cpe %s %s \n", cpeId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getCpeIds(compartment string) ([]string, error) {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -320,10 +321,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreCrossConnectGroup") {
-		resource.AddTestSweepers("CoreCrossConnectGroup", &resource.Sweeper{
-			Name:         "CoreCrossConnectGroup",
-			Dependencies: acctest.DependencyGraph["crossConnectGroup"],
-			F:            sweepCoreCrossConnectGroupResource,
+		acctest.AddTestSweepers("CoreCrossConnectGroup", &resource.Sweeper{
+			Name: "CoreCrossConnectGroup",
+			F:    sweepCoreCrossConnectGroupResource,
 		})
 	}
 }
@@ -334,6 +334,7 @@ func sweepCoreCrossConnectGroupResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, crossConnectGroupId := range crossConnectGroupIds {
 		if ok := acctest.SweeperDefaultResourceId[crossConnectGroupId]; !ok {
 			deleteCrossConnectGroupRequest := oci_core.DeleteCrossConnectGroupRequest{}
@@ -341,16 +342,20 @@ func sweepCoreCrossConnectGroupResource(compartment string) error {
 			deleteCrossConnectGroupRequest.CrossConnectGroupId = &crossConnectGroupId
 
 			deleteCrossConnectGroupRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := virtualNetworkClient.DeleteCrossConnectGroup(context.Background(), deleteCrossConnectGroupRequest)
+			error := acctest.DeleteSweeperResource(compartment, "CrossConnectGroupId", crossConnectGroupId, func() error {
+				_, err := virtualNetworkClient.DeleteCrossConnectGroup(context.Background(), deleteCrossConnectGroupRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting CrossConnectGroup %s %s, It is possible that the resource is already deleted. Please verify manually \n", crossConnectGroupId, error)
+				fmt.Printf("Error deleting CrossConnectGroup %s %s \n", crossConnectGroupId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &crossConnectGroupId, crossConnectGroupSweepWaitCondition, time.Duration(3*time.Minute),
 				crossConnectGroupSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getCrossConnectGroupIds(compartment string) ([]string, error) {
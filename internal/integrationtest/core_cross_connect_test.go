@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -340,10 +341,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreCrossConnect") {
-		resource.AddTestSweepers("CoreCrossConnect", &resource.Sweeper{
-			Name:         "CoreCrossConnect",
-			Dependencies: acctest.DependencyGraph["crossConnect"],
-			F:            sweepCoreCrossConnectResource,
+		acctest.AddTestSweepers("CoreCrossConnect", &resource.Sweeper{
+			Name: "CoreCrossConnect",
+			F:    sweepCoreCrossConnectResource,
 		})
 	}
 }
@@ -354,6 +354,7 @@ func sweepCoreCrossConnectResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, crossConnectId := range crossConnectIds {
 		if ok := acctest.SweeperDefaultResourceId[crossConnectId]; !ok {
 			deleteCrossConnectRequest := oci_core.DeleteCrossConnectRequest{}
@@ -361,16 +362,20 @@ func sweepCoreCrossConnectResource(compartment string) error {
 			deleteCrossConnectRequest.CrossConnectId = &crossConnectId
 
 			deleteCrossConnectRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := virtualNetworkClient.DeleteCrossConnect(context.Background(), deleteCrossConnectRequest)
+			error := acctest.DeleteSweeperResource(compartment, "CrossConnectId", crossConnectId, func() error {
+				_, err := virtualNetworkClient.DeleteCrossConnect(context.Background(), deleteCrossConnectRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting CrossConnect %s %s, It is possible that the resource is already deleted. Please verify manually \n", crossConnectId, error)
+				fmt.Printf("Error deleting CrossConnect %s %s \n", crossConnectId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &crossConnectId, crossConnectSweepWaitCondition, time.Duration(3*time.Minute),
 				crossConnectSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getCrossConnectIds(compartment string) ([]string, error) {
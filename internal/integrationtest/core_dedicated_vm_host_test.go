@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -300,10 +301,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreDedicatedVmHost") {
-		resource.AddTestSweepers("CoreDedicatedVmHost", &resource.Sweeper{
-			Name:         "CoreDedicatedVmHost",
-			Dependencies: acctest.DependencyGraph["dedicatedVmHost"],
-			F:            sweepCoreDedicatedVmHostResource,
+		acctest.AddTestSweepers("CoreDedicatedVmHost", &resource.Sweeper{
+			Name: "CoreDedicatedVmHost",
+			F:    sweepCoreDedicatedVmHostResource,
 		})
 	}
 }
@@ -314,6 +314,7 @@ func sweepCoreDedicatedVmHostResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, dedicatedVmHostId := range dedicatedVmHostIds {
 		if ok := acctest.SweeperDefaultResourceId[dedicatedVmHostId]; !ok {
 			deleteDedicatedVmHostRequest := oci_core.DeleteDedicatedVmHostRequest{}
@@ -321,16 +322,20 @@ func sweepCoreDedicatedVmHostResource(compartment string) error {
 			deleteDedicatedVmHostRequest.DedicatedVmHostId = &dedicatedVmHostId
 
 			deleteDedicatedVmHostRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := computeClient.DeleteDedicatedVmHost(context.Background(), deleteDedicatedVmHostRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DedicatedVmHostId", dedicatedVmHostId, func() error {
+				_, err := computeClient.DeleteDedicatedVmHost(context.Background(), deleteDedicatedVmHostRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting DedicatedVmHost %s %s, It is possible that the resource is already deleted. Please verify manually \n", dedicatedVmHostId, error)
+				fmt.Printf("Error deleting DedicatedVmHost %s %s \n", dedicatedVmHostId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &dedicatedVmHostId, dedicatedVmHostSweepWaitCondition, time.Duration(3*time.Minute),
 				dedicatedVmHostSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDedicatedVmHostIds(compartment string) ([]string, error) {
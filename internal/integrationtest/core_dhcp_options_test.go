@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -302,10 +303,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreDhcpOptions") {
-		resource.AddTestSweepers("CoreDhcpOptions", &resource.Sweeper{
-			Name:         "CoreDhcpOptions",
-			Dependencies: acctest.DependencyGraph["dhcpOptions"],
-			F:            sweepCoreDhcpOptionsResource,
+		acctest.AddTestSweepers("CoreDhcpOptions", &resource.Sweeper{
+			Name: "CoreDhcpOptions",
+			F:    sweepCoreDhcpOptionsResource,
 		})
 	}
 }
@@ -316,6 +316,7 @@ func sweepCoreDhcpOptionsResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, dhcpOptionsId := range dhcpOptionsIds {
 		if ok := acctest.SweeperDefaultResourceId[dhcpOptionsId]; !ok {
 			deleteDhcpOptionsRequest := oci_core.DeleteDhcpOptionsRequest{}
@@ -323,16 +324,20 @@ func sweepCoreDhcpOptionsResource(compartment string) error {
 			deleteDhcpOptionsRequest.DhcpId = &dhcpOptionsId
 
 			deleteDhcpOptionsRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := virtualNetworkClient.DeleteDhcpOptions(context.Background(), deleteDhcpOptionsRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DhcpOptionsId", dhcpOptionsId, func() error {
+				_, err := virtualNetworkClient.DeleteDhcpOptions(context.Background(), deleteDhcpOptionsRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting DhcpOptions %s %s, It is possible that the resource is already deleted. Please verify manually \n", dhcpOptionsId, error)
+				fmt.Printf("Error deleting DhcpOptions %s %s \n", dhcpOptionsId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &dhcpOptionsId, dhcpOptionsSweepWaitCondition, time.Duration(3*time.Minute),
 				dhcpOptionsSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDhcpOptionsIds(compartment string) ([]string, error) {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -355,10 +356,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreDrgAttachment") {
-		resource.AddTestSweepers("CoreDrgAttachment", &resource.Sweeper{
-			Name:         "CoreDrgAttachment",
-			Dependencies: acctest.DependencyGraph["drgAttachment"],
-			F:            sweepCoreDrgAttachmentResource,
+		acctest.AddTestSweepers("CoreDrgAttachment", &resource.Sweeper{
+			Name: "CoreDrgAttachment",
+			F:    sweepCoreDrgAttachmentResource,
 		})
 	}
 }
@@ -369,6 +369,7 @@ func sweepCoreDrgAttachmentResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, drgAttachmentId := range drgAttachmentIds {
 		if ok := acctest.SweeperDefaultResourceId[drgAttachmentId]; !ok {
 			deleteDrgAttachmentRequest := oci_core.DeleteDrgAttachmentRequest{}
@@ -376,16 +377,20 @@ func sweepCoreDrgAttachmentResource(compartment string) error {
 			deleteDrgAttachmentRequest.DrgAttachmentId = &drgAttachmentId
 
 			deleteDrgAttachmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := virtualNetworkClient.DeleteDrgAttachment(context.Background(), deleteDrgAttachmentRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DrgAttachmentId", drgAttachmentId, func() error {
+				_, err := virtualNetworkClient.DeleteDrgAttachment(context.Background(), deleteDrgAttachmentRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting DrgAttachment %s %s, It is possible that the resource is already deleted. Please verify manually \n", drgAttachmentId, error)
+				fmt.Printf("Error deleting DrgAttachment %s %s \n", drgAttachmentId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &drgAttachmentId, drgAttachmentSweepWaitCondition, time.Duration(3*time.Minute),
 				drgAttachmentSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDrgAttachmentIds(compartment string) ([]string, error) {
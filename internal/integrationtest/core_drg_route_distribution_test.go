@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -241,10 +242,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreDrgRouteDistribution") {
-		resource.AddTestSweepers("CoreDrgRouteDistribution", &resource.Sweeper{
-			Name:         "CoreDrgRouteDistribution",
-			Dependencies: acctest.DependencyGraph["drgRouteDistribution"],
-			F:            sweepCoreDrgRouteDistributionResource,
+		acctest.AddTestSweepers("CoreDrgRouteDistribution", &resource.Sweeper{
+			Name: "CoreDrgRouteDistribution",
+			F:    sweepCoreDrgRouteDistributionResource,
 		})
 	}
 }
@@ -255,6 +255,7 @@ func sweepCoreDrgRouteDistributionResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, drgRouteDistributionId := range drgRouteDistributionIds {
 		if ok := acctest.SweeperDefaultResourceId[drgRouteDistributionId]; !ok {
 			deleteDrgRouteDistributionRequest := oci_core.DeleteDrgRouteDistributionRequest{}
@@ -262,16 +263,20 @@ func sweepCoreDrgRouteDistributionResource(compartment string) error {
 			deleteDrgRouteDistributionRequest.DrgRouteDistributionId = &drgRouteDistributionId
 
 			deleteDrgRouteDistributionRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := virtualNetworkClient.DeleteDrgRouteDistribution(context.Background(), deleteDrgRouteDistributionRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DrgRouteDistributionId", drgRouteDistributionId, func() error {
+				_, err := virtualNetworkClient.DeleteDrgRouteDistribution(context.Background(), deleteDrgRouteDistributionRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting DrgRouteDistribution %s %s, It is possible that the resource is already deleted. Please verify manually \n", drgRouteDistributionId, error)
+				fmt.Printf("Error deleting DrgRouteDistribution %s %s \n", drgRouteDistributionId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &drgRouteDistributionId, drgRouteDistributionSweepWaitCondition, time.Duration(3*time.Minute),
 				drgRouteDistributionSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDrgRouteDistributionIds(compartment string) ([]string, error) {
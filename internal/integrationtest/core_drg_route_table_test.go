@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -284,10 +285,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreDrgRouteTable") {
-		resource.AddTestSweepers("CoreDrgRouteTable", &resource.Sweeper{
-			Name:         "CoreDrgRouteTable",
-			Dependencies: acctest.DependencyGraph["drgRouteTable"],
-			F:            sweepCoreDrgRouteTableResource,
+		acctest.AddTestSweepers("CoreDrgRouteTable", &resource.Sweeper{
+			Name: "CoreDrgRouteTable",
+			F:    sweepCoreDrgRouteTableResource,
 		})
 	}
 }
@@ -298,6 +298,7 @@ func sweepCoreDrgRouteTableResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, drgRouteTableId := range drgRouteTableIds {
 		if ok := acctest.SweeperDefaultResourceId[drgRouteTableId]; !ok {
 			deleteDrgRouteTableRequest := oci_core.DeleteDrgRouteTableRequest{}
@@ -305,16 +306,20 @@ func sweepCoreDrgRouteTableResource(compartment string) error {
 			deleteDrgRouteTableRequest.DrgRouteTableId = &drgRouteTableId
 
 			deleteDrgRouteTableRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := virtualNetworkClient.DeleteDrgRouteTable(context.Background(), deleteDrgRouteTableRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DrgRouteTableId", drgRouteTableId, func() error {
+				_, err := virtualNetworkClient.DeleteDrgRouteTable(context.Background(), deleteDrgRouteTableRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting DrgRouteTable %s %s, It is possible that the resource is already deleted. Please verify manually \n", drgRouteTableId, error)
+				fmt.Printf("Error deleting DrgRouteTable %s %s \n", drgRouteTableId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &drgRouteTableId, drgRouteTableSweepWaitCondition, time.Duration(3*time.Minute),
 				drgRouteTableSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDrgRouteTableIds(compartment string) ([]string, error) {
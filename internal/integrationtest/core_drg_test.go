@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -232,10 +233,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreDrg") {
-		resource.AddTestSweepers("CoreDrg", &resource.Sweeper{
-			Name:         "CoreDrg",
-			Dependencies: acctest.DependencyGraph["drg"],
-			F:            sweepCoreDrgResource,
+		acctest.AddTestSweepers("CoreDrg", &resource.Sweeper{
+			Name: "CoreDrg",
+			F:    sweepCoreDrgResource,
 		})
 	}
 }
@@ -246,6 +246,7 @@ func sweepCoreDrgResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, drgId := range drgIds {
 		if ok := acctest.SweeperDefaultResourceId[drgId]; !ok {
 			deleteDrgRequest := oci_core.DeleteDrgRequest{}
@@ -253,16 +254,20 @@ func sweepCoreDrgResource(compartment string) error {
 			deleteDrgRequest.DrgId = &drgId
 
 			deleteDrgRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := virtualNetworkClient.DeleteDrg(context.Background(), deleteDrgRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DrgId", drgId, func() error {
+				_, err := virtualNetworkClient.DeleteDrg(context.Background(), deleteDrgRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Drg %s %s, It is possible that the resource is already deleted. Please verify manually \n", drgId, error)
+				fmt.Printf("Error deleting Drg %s %s \n", drgId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &drgId, drgSweepWaitCondition, time.Duration(3*time.Minute),
 				drgSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDrgIds(compartment string) ([]string, error) {
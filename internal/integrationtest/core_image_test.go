@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -307,10 +308,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreImage") {
-		resource.AddTestSweepers("CoreImage", &resource.Sweeper{
-			Name:         "CoreImage",
-			Dependencies: acctest.DependencyGraph["image"],
-			F:            sweepCoreImageResource,
+		acctest.AddTestSweepers("CoreImage", &resource.Sweeper{
+			Name: "CoreImage",
+			F:    sweepCoreImageResource,
 		})
 	}
 }
@@ -321,6 +321,7 @@ func sweepCoreImageResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, imageId := range imageIds {
 		if ok := acctest.SweeperDefaultResourceId[imageId]; !ok {
 			deleteImageRequest := oci_core.DeleteImageRequest{}
@@ -328,16 +329,20 @@ func sweepCoreImageResource(compartment string) error {
 			deleteImageRequest.ImageId = &imageId
 
 			deleteImageRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := computeClient.DeleteImage(context.Background(), deleteImageRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ImageId", imageId, func() error {
+				_, err := computeClient.DeleteImage(context.Background(), deleteImageRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Image %s %s, It is possible that the resource is already deleted. Please verify manually \n", imageId, error)
+				fmt.Printf("Error deleting Image %s %s \n", imageId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &imageId, imageSweepWaitCondition, time.Duration(3*time.Minute),
 				imageSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getImageIds(compartment string) ([]string, error) {
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -710,10 +711,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreInstanceConfiguration") {
-		resource.AddTestSweepers("CoreInstanceConfiguration", &resource.Sweeper{
-			Name:         "CoreInstanceConfiguration",
-			Dependencies: acctest.DependencyGraph["instanceConfiguration"],
-			F:            sweepCoreInstanceConfigurationResource,
+		acctest.AddTestSweepers("CoreInstanceConfiguration", &resource.Sweeper{
+			Name: "CoreInstanceConfiguration",
+			F:    sweepCoreInstanceConfigurationResource,
 		})
 	}
 }
@@ -724,6 +724,7 @@ func sweepCoreInstanceConfigurationResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, instanceConfigurationId := range instanceConfigurationIds {
 		if ok := acctest.SweeperDefaultResourceId[instanceConfigurationId]; !ok {
 			deleteInstanceConfigurationRequest := oci_core.DeleteInstanceConfigurationRequest{}
@@ -731,14 +732,18 @@ func sweepCoreInstanceConfigurationResource(compartment string) error {
 			deleteInstanceConfigurationRequest.InstanceConfigurationId = &instanceConfigurationId
 
 			deleteInstanceConfigurationRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := computeManagementClient.DeleteInstanceConfiguration(context.Background(), deleteInstanceConfigurationRequest)
+			error := acctest.DeleteSweeperResource(compartment, "InstanceConfigurationId", instanceConfigurationId, func() error {
+				_, err := computeManagementClient.DeleteInstanceConfiguration(context.Background(), deleteInstanceConfigurationRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting InstanceConfiguration %s %s, It is possible that the resource is already deleted. Please verify manually \n", instanceConfigurationId, error)
+				fmt.Printf("Error deleting InstanceConfiguration %s %s \n", instanceConfigurationId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getInstanceConfigurationIds(compartment string) ([]string, error) {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -209,10 +210,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreInstanceConsoleConnection") {
-		resource.AddTestSweepers("CoreInstanceConsoleConnection", &resource.Sweeper{
-			Name:         "CoreInstanceConsoleConnection",
-			Dependencies: acctest.DependencyGraph["instanceConsoleConnection"],
-			F:            sweepCoreInstanceConsoleConnectionResource,
+		acctest.AddTestSweepers("CoreInstanceConsoleConnection", &resource.Sweeper{
+			Name: "CoreInstanceConsoleConnection",
+			F:    sweepCoreInstanceConsoleConnectionResource,
 		})
 	}
 }
@@ -223,6 +223,7 @@ func sweepCoreInstanceConsoleConnectionResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, instanceConsoleConnectionId := range instanceConsoleConnectionIds {
 		if ok := acctest.SweeperDefaultResourceId[instanceConsoleConnectionId]; !ok {
 			deleteInstanceConsoleConnectionRequest := oci_core.DeleteInstanceConsoleConnectionRequest{}
@@ -230,16 +231,20 @@ func sweepCoreInstanceConsoleConnectionResource(compartment string) error {
 			deleteInstanceConsoleConnectionRequest.InstanceConsoleConnectionId = &instanceConsoleConnectionId
 
 			deleteInstanceConsoleConnectionRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := computeClient.DeleteInstanceConsoleConnection(context.Background(), deleteInstanceConsoleConnectionRequest)
+			error := acctest.DeleteSweeperResource(compartment, "InstanceConsoleConnectionId", instanceConsoleConnectionId, func() error {
+				_, err := computeClient.DeleteInstanceConsoleConnection(context.Background(), deleteInstanceConsoleConnectionRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting InstanceConsoleConnection %s %s, It is possible that the resource is already deleted. Please verify manually \n", instanceConsoleConnectionId, error)
+				fmt.Printf("Error deleting InstanceConsoleConnection %s %s \n", instanceConsoleConnectionId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &instanceConsoleConnectionId, instanceConsoleConnectionSweepWaitCondition, time.Duration(3*time.Minute),
 				instanceConsoleConnectionSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getInstanceConsoleConnectionIds(compartment string) ([]string, error) {
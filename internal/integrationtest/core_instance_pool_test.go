@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -557,10 +558,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreInstancePool") {
-		resource.AddTestSweepers("CoreInstancePool", &resource.Sweeper{
-			Name:         "CoreInstancePool",
-			Dependencies: acctest.DependencyGraph["instancePool"],
-			F:            sweepCoreInstancePoolResource,
+		acctest.AddTestSweepers("CoreInstancePool", &resource.Sweeper{
+			Name: "CoreInstancePool",
+			F:    sweepCoreInstancePoolResource,
 		})
 	}
 }
@@ -571,6 +571,7 @@ func sweepCoreInstancePoolResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, instancePoolId := range instancePoolIds {
 		if ok := acctest.SweeperDefaultResourceId[instancePoolId]; !ok {
 			terminateInstancePoolRequest := oci_core.TerminateInstancePoolRequest{}
@@ -578,16 +579,20 @@ func sweepCoreInstancePoolResource(compartment string) error {
 			terminateInstancePoolRequest.InstancePoolId = &instancePoolId
 
 			terminateInstancePoolRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := computeManagementClient.TerminateInstancePool(context.Background(), terminateInstancePoolRequest)
+			error := acctest.DeleteSweeperResource(compartment, "InstancePoolId", instancePoolId, func() error {
+				_, err := computeManagementClient.TerminateInstancePool(context.Background(), terminateInstancePoolRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting InstancePool %s %s, It is possible that the resource is already deleted. Please verify manually \n", instancePoolId, error)
+				fmt.Printf("Error deleting InstancePool %s %s \n", instancePoolId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &instancePoolId, instancePoolSweepWaitCondition, time.Duration(3*time.Minute),
 				instancePoolSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getInstancePoolIds(compartment string) ([]string, error) {
@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -1624,10 +1625,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreInstance") {
-		resource.AddTestSweepers("CoreInstance", &resource.Sweeper{
-			Name:         "CoreInstance",
-			Dependencies: acctest.DependencyGraph["instance"],
-			F:            sweepCoreInstanceResource,
+		acctest.AddTestSweepers("CoreInstance", &resource.Sweeper{
+			Name: "CoreInstance",
+			F:    sweepCoreInstanceResource,
 		})
 	}
 }
@@ -1638,6 +1638,7 @@ func sweepCoreInstanceResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, instanceId := range instanceIds {
 		if ok := acctest.SweeperDefaultResourceId[instanceId]; !ok {
 			terminateInstanceRequest := oci_core.TerminateInstanceRequest{}
@@ -1645,16 +1646,20 @@ func sweepCoreInstanceResource(compartment string) error {
 			terminateInstanceRequest.InstanceId = &instanceId
 
 			terminateInstanceRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := computeClient.TerminateInstance(context.Background(), terminateInstanceRequest)
+			error := acctest.DeleteSweeperResource(compartment, "InstanceId", instanceId, func() error {
+				_, err := computeClient.TerminateInstance(context.Background(), terminateInstanceRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Instance %s %s, It is possible that the resource is already deleted. Please verify manually \n", instanceId, error)
+				fmt.Printf("Error deleting Instance %s %s \n", instanceId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &instanceId, instanceSweepWaitCondition, time.Duration(3*time.Minute),
 				instanceSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getInstanceIds(compartment string) ([]string, error) {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -245,10 +246,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreInternetGateway") {
-		resource.AddTestSweepers("CoreInternetGateway", &resource.Sweeper{
-			Name:         "CoreInternetGateway",
-			Dependencies: acctest.DependencyGraph["internetGateway"],
-			F:            sweepCoreInternetGatewayResource,
+		acctest.AddTestSweepers("CoreInternetGateway", &resource.Sweeper{
+			Name: "CoreInternetGateway",
+			F:    sweepCoreInternetGatewayResource,
 		})
 	}
 }
@@ -259,6 +259,7 @@ func sweepCoreInternetGatewayResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, internetGatewayId := range internetGatewayIds {
 		if ok := acctest.SweeperDefaultResourceId[internetGatewayId]; !ok {
 			deleteInternetGatewayRequest := oci_core.DeleteInternetGatewayRequest{}
@@ -266,16 +267,20 @@ func sweepCoreInternetGatewayResource(compartment string) error {
 			deleteInternetGatewayRequest.IgId = &internetGatewayId
 
 			deleteInternetGatewayRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := virtualNetworkClient.DeleteInternetGateway(context.Background(), deleteInternetGatewayRequest)
+			error := acctest.DeleteSweeperResource(compartment, "InternetGatewayId", internetGatewayId, func() error {
+				_, err := virtualNetworkClient.DeleteInternetGateway(context.Background(), deleteInternetGatewayRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting InternetGateway %s %s, It is possible that the resource is already deleted. Please verify manually \n", internetGatewayId, error)
+				fmt.Printf("Error deleting InternetGateway %s %s \n", internetGatewayId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &internetGatewayId, internetGatewaySweepWaitCondition, time.Duration(3*time.Minute),
 				internetGatewaySweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getInternetGatewayIds(compartment string) ([]string, error) {
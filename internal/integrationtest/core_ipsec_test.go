@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -264,10 +265,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreIpSecConnection") {
-		resource.AddTestSweepers("CoreIpSecConnection", &resource.Sweeper{
-			Name:         "CoreIpSecConnection",
-			Dependencies: acctest.DependencyGraph["ipSecConnection"],
-			F:            sweepCoreIpSecConnectionResource,
+		acctest.AddTestSweepers("CoreIpSecConnection", &resource.Sweeper{
+			Name: "CoreIpSecConnection",
+			F:    sweepCoreIpSecConnectionResource,
 		})
 	}
 }
@@ -278,6 +278,7 @@ func sweepCoreIpSecConnectionResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, ipSecConnectionId := range ipSecConnectionIds {
 		if ok := acctest.SweeperDefaultResourceId[ipSecConnectionId]; !ok {
 			deleteIPSecConnectionRequest := oci_core.DeleteIPSecConnectionRequest{}
@@ -285,16 +286,20 @@ func sweepCoreIpSecConnectionResource(compartment string) error {
 			deleteIPSecConnectionRequest.IpscId = &ipSecConnectionId
 
 			deleteIPSecConnectionRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := virtualNetworkClient.DeleteIPSecConnection(context.Background(), deleteIPSecConnectionRequest)
+			error := acctest.DeleteSweeperResource(compartment, "IpSecConnectionId", ipSecConnectionId, func() error {
+				_, err := virtualNetworkClient.DeleteIPSecConnection(context.Background(), deleteIPSecConnectionRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting IpSecConnection %s %s, It is possible that the resource is already deleted. Please verify manually \n", ipSecConnectionId, error)
+				fmt.Printf("Error deleting IpSecConnection %s %s \n", ipSecConnectionId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &ipSecConnectionId, ipSecConnectionSweepWaitCondition, time.Duration(3*time.Minute),
 				ipSecConnectionSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getIpSecConnectionIds(compartment string) ([]string, error) {
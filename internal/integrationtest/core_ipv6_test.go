@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -251,10 +252,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreIpv6") {
-		resource.AddTestSweepers("CoreIpv6", &resource.Sweeper{
-			Name:         "CoreIpv6",
-			Dependencies: acctest.DependencyGraph["ipv6"],
-			F:            sweepCoreIpv6Resource,
+		acctest.AddTestSweepers("CoreIpv6", &resource.Sweeper{
+			Name: "CoreIpv6",
+			F:    sweepCoreIpv6Resource,
 		})
 	}
 }
@@ -265,6 +265,7 @@ func sweepCoreIpv6Resource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, ipv6Id := range ipv6Ids {
 		if ok := acctest.SweeperDefaultResourceId[ipv6Id]; !ok {
 			deleteIpv6Request := oci_core.DeleteIpv6Request{}
@@ -272,16 +273,20 @@ func sweepCoreIpv6Resource(compartment string) error {
 			deleteIpv6Request.Ipv6Id = &ipv6Id
 
 			deleteIpv6Request.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := virtualNetworkClient.DeleteIpv6(context.Background(), deleteIpv6Request)
+			error := acctest.DeleteSweeperResource(compartment, "Ipv6Id", ipv6Id, func() error {
+				_, err := virtualNetworkClient.DeleteIpv6(context.Background(), deleteIpv6Request)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Ipv6 %s %s, It is possible that the resource is already deleted. Please verify manually \n", ipv6Id, error)
+				fmt.Printf("Error deleting Ipv6 %s %s \n", ipv6Id, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &ipv6Id, ipv6SweepWaitCondition, time.Duration(3*time.Minute),
 				ipv6SweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getIpv6Ids(compartment string) ([]string, error) {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -320,10 +321,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreLocalPeeringGateway") {
-		resource.AddTestSweepers("CoreLocalPeeringGateway", &resource.Sweeper{
-			Name:         "CoreLocalPeeringGateway",
-			Dependencies: acctest.DependencyGraph["localPeeringGateway"],
-			F:            sweepCoreLocalPeeringGatewayResource,
+		acctest.AddTestSweepers("CoreLocalPeeringGateway", &resource.Sweeper{
+			Name: "CoreLocalPeeringGateway",
+			F:    sweepCoreLocalPeeringGatewayResource,
 		})
 	}
 }
@@ -334,6 +334,7 @@ func sweepCoreLocalPeeringGatewayResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, localPeeringGatewayId := range localPeeringGatewayIds {
 		if ok := acctest.SweeperDefaultResourceId[localPeeringGatewayId]; !ok {
 			deleteLocalPeeringGatewayRequest := oci_core.DeleteLocalPeeringGatewayRequest{}
@@ -341,16 +342,20 @@ func sweepCoreLocalPeeringGatewayResource(compartment string) error {
 			deleteLocalPeeringGatewayRequest.LocalPeeringGatewayId = &localPeeringGatewayId
 
 			deleteLocalPeeringGatewayRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := virtualNetworkClient.DeleteLocalPeeringGateway(context.Background(), deleteLocalPeeringGatewayRequest)
+			error := acctest.DeleteSweeperResource(compartment, "LocalPeeringGatewayId", localPeeringGatewayId, func() error {
+				_, err := virtualNetworkClient.DeleteLocalPeeringGateway(context.Background(), deleteLocalPeeringGatewayRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting LocalPeeringGateway %s %s, It is possible that the resource is already deleted. Please verify manually \n", localPeeringGatewayId, error)
+				fmt.Printf("Error deleting LocalPeeringGateway %s %s \n", localPeeringGatewayId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &localPeeringGatewayId, localPeeringGatewaySweepWaitCondition, time.Duration(3*time.Minute),
 				localPeeringGatewaySweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getLocalPeeringGatewayIds(compartment string) ([]string, error) {
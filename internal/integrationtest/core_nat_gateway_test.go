@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -289,10 +290,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreNatGateway") {
-		resource.AddTestSweepers("CoreNatGateway", &resource.Sweeper{
-			Name:         "CoreNatGateway",
-			Dependencies: acctest.DependencyGraph["natGateway"],
-			F:            sweepCoreNatGatewayResource,
+		acctest.AddTestSweepers("CoreNatGateway", &resource.Sweeper{
+			Name: "CoreNatGateway",
+			F:    sweepCoreNatGatewayResource,
 		})
 	}
 }
@@ -303,6 +303,7 @@ func sweepCoreNatGatewayResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, natGatewayId := range natGatewayIds {
 		if ok := acctest.SweeperDefaultResourceId[natGatewayId]; !ok {
 			deleteNatGatewayRequest := oci_core.DeleteNatGatewayRequest{}
@@ -310,16 +311,20 @@ func sweepCoreNatGatewayResource(compartment string) error {
 			deleteNatGatewayRequest.NatGatewayId = &natGatewayId
 
 			deleteNatGatewayRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := virtualNetworkClient.DeleteNatGateway(context.Background(), deleteNatGatewayRequest)
+			error := acctest.DeleteSweeperResource(compartment, "NatGatewayId", natGatewayId, func() error {
+				_, err := virtualNetworkClient.DeleteNatGateway(context.Background(), deleteNatGatewayRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting NatGateway %s %s, It is possible that the resource is already deleted. Please verify manually \n", natGatewayId, error)
+				fmt.Printf("Error deleting NatGateway %s %s \n", natGatewayId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &natGatewayId, natGatewaySweepWaitCondition, time.Duration(3*time.Minute),
 				natGatewaySweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getNatGatewayIds(compartment string) ([]string, error) {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -302,10 +303,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreNetworkSecurityGroup") {
-		resource.AddTestSweepers("CoreNetworkSecurityGroup", &resource.Sweeper{
-			Name:         "CoreNetworkSecurityGroup",
-			Dependencies: acctest.DependencyGraph["networkSecurityGroup"],
-			F:            sweepCoreNetworkSecurityGroupResource,
+		acctest.AddTestSweepers("CoreNetworkSecurityGroup", &resource.Sweeper{
+			Name: "CoreNetworkSecurityGroup",
+			F:    sweepCoreNetworkSecurityGroupResource,
 		})
 	}
 }
@@ -316,6 +316,7 @@ func sweepCoreNetworkSecurityGroupResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, networkSecurityGroupId := range networkSecurityGroupIds {
 		if ok := acctest.SweeperDefaultResourceId[networkSecurityGroupId]; !ok {
 			deleteNetworkSecurityGroupRequest := oci_core.DeleteNetworkSecurityGroupRequest{}
@@ -323,16 +324,20 @@ func sweepCoreNetworkSecurityGroupResource(compartment string) error {
 			deleteNetworkSecurityGroupRequest.NetworkSecurityGroupId = &networkSecurityGroupId
 
 			deleteNetworkSecurityGroupRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := virtualNetworkClient.DeleteNetworkSecurityGroup(context.Background(), deleteNetworkSecurityGroupRequest)
+			error := acctest.DeleteSweeperResource(compartment, "NetworkSecurityGroupId", networkSecurityGroupId, func() error {
+				_, err := virtualNetworkClient.DeleteNetworkSecurityGroup(context.Background(), deleteNetworkSecurityGroupRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting NetworkSecurityGroup %s %s, It is possible that the resource is already deleted. Please verify manually \n", networkSecurityGroupId, error)
+				fmt.Printf("Error deleting NetworkSecurityGroup %s %s \n", networkSecurityGroupId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &networkSecurityGroupId, networkSecurityGroupSweepWaitCondition, time.Duration(3*time.Minute),
 				networkSecurityGroupSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getNetworkSecurityGroupIds(compartment string) ([]string, error) {
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -242,10 +243,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CorePrivateIp") {
-		resource.AddTestSweepers("CorePrivateIp", &resource.Sweeper{
-			Name:         "CorePrivateIp",
-			Dependencies: acctest.DependencyGraph["privateIp"],
-			F:            sweepCorePrivateIpResource,
+		acctest.AddTestSweepers("CorePrivateIp", &resource.Sweeper{
+			Name: "CorePrivateIp",
+			F:    sweepCorePrivateIpResource,
 		})
 	}
 }
@@ -256,6 +256,7 @@ func sweepCorePrivateIpResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, privateIpId := range privateIpIds {
 		if ok := acctest.SweeperDefaultResourceId[privateIpId]; !ok {
 			deletePrivateIpRequest := oci_core.DeletePrivateIpRequest{}
@@ -263,14 +264,18 @@ func sweepCorePrivateIpResource(compartment string) error {
 			deletePrivateIpRequest.PrivateIpId = &privateIpId
 
 			deletePrivateIpRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := virtualNetworkClient.DeletePrivateIp(context.Background(), deletePrivateIpRequest)
+			error := acctest.DeleteSweeperResource(compartment, "PrivateIpId", privateIpId, func() error {
+				_, err := virtualNetworkClient.DeletePrivateIp(context.Background(), deletePrivateIpRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting PrivateIp %s %s, It is possible that the resource is already deleted. Please verify manually \n", privateIpId, error)
+				fmt.Printf("Error deleting PrivateIp %s %s \n", privateIpId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getPrivateIpIds(compartment string) ([]string, error) {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -257,10 +258,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CorePublicIpPool") {
-		resource.AddTestSweepers("CorePublicIpPool", &resource.Sweeper{
-			Name:         "CorePublicIpPool",
-			Dependencies: acctest.DependencyGraph["publicIpPool"],
-			F:            sweepCorePublicIpPoolResource,
+		acctest.AddTestSweepers("CorePublicIpPool", &resource.Sweeper{
+			Name: "CorePublicIpPool",
+			F:    sweepCorePublicIpPoolResource,
 		})
 	}
 }
@@ -271,6 +271,7 @@ func sweepCorePublicIpPoolResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, publicIpPoolId := range publicIpPoolIds {
 		if ok := acctest.SweeperDefaultResourceId[publicIpPoolId]; !ok {
 			deletePublicIpPoolRequest := oci_core.DeletePublicIpPoolRequest{}
@@ -278,16 +279,20 @@ func sweepCorePublicIpPoolResource(compartment string) error {
 			deletePublicIpPoolRequest.PublicIpPoolId = &publicIpPoolId
 
 			deletePublicIpPoolRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := virtualNetworkClient.DeletePublicIpPool(context.Background(), deletePublicIpPoolRequest)
+			error := acctest.DeleteSweeperResource(compartment, "PublicIpPoolId", publicIpPoolId, func() error {
+				_, err := virtualNetworkClient.DeletePublicIpPool(context.Background(), deletePublicIpPoolRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting PublicIpPool %s %s, It is possible that the resource is already deleted. Please verify manually \n", publicIpPoolId, error)
+				fmt.Printf("Error deleting PublicIpPool %s %s \n", publicIpPoolId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &publicIpPoolId, publicIpPoolSweepWaitCondition, time.Duration(3*time.Minute),
 				publicIpPoolSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getPublicIpPoolIds(compartment string) ([]string, error) {
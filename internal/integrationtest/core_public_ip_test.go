@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -458,10 +459,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CorePublicIp") {
-		resource.AddTestSweepers("CorePublicIp", &resource.Sweeper{
-			Name:         "CorePublicIp",
-			Dependencies: acctest.DependencyGraph["publicIp"],
-			F:            sweepCorePublicIpResource,
+		acctest.AddTestSweepers("CorePublicIp", &resource.Sweeper{
+			Name: "CorePublicIp",
+			F:    sweepCorePublicIpResource,
 		})
 	}
 }
@@ -472,6 +472,7 @@ func sweepCorePublicIpResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, publicIpId := range publicIpIds {
 		if ok := acctest.SweeperDefaultResourceId[publicIpId]; !ok {
 			deletePublicIpRequest := oci_core.DeletePublicIpRequest{}
@@ -479,16 +480,20 @@ func sweepCorePublicIpResource(compartment string) error {
 			deletePublicIpRequest.PublicIpId = &publicIpId
 
 			deletePublicIpRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := virtualNetworkClient.DeletePublicIp(context.Background(), deletePublicIpRequest)
+			error := acctest.DeleteSweeperResource(compartment, "PublicIpId", publicIpId, func() error {
+				_, err := virtualNetworkClient.DeletePublicIp(context.Background(), deletePublicIpRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting PublicIp %s %s, It is possible that the resource is already deleted. Please verify manually \n", publicIpId, error)
+				fmt.Printf("Error deleting PublicIp %s %s \n", publicIpId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &publicIpId, publicIpSweepWaitCondition, time.Duration(3*time.Minute),
 				publicIpSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getPublicIpIds(compartment string) ([]string, error) {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -246,10 +247,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreRemotePeeringConnection") {
-		resource.AddTestSweepers("CoreRemotePeeringConnection", &resource.Sweeper{
-			Name:         "CoreRemotePeeringConnection",
-			Dependencies: acctest.DependencyGraph["remotePeeringConnection"],
-			F:            sweepCoreRemotePeeringConnectionResource,
+		acctest.AddTestSweepers("CoreRemotePeeringConnection", &resource.Sweeper{
+			Name: "CoreRemotePeeringConnection",
+			F:    sweepCoreRemotePeeringConnectionResource,
 		})
 	}
 }
@@ -260,6 +260,7 @@ func sweepCoreRemotePeeringConnectionResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, remotePeeringConnectionId := range remotePeeringConnectionIds {
 		if ok := acctest.SweeperDefaultResourceId[remotePeeringConnectionId]; !ok {
 			deleteRemotePeeringConnectionRequest := oci_core.DeleteRemotePeeringConnectionRequest{}
@@ -267,16 +268,20 @@ func sweepCoreRemotePeeringConnectionResource(compartment string) error {
 			deleteRemotePeeringConnectionRequest.RemotePeeringConnectionId = &remotePeeringConnectionId
 
 			deleteRemotePeeringConnectionRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := virtualNetworkClient.DeleteRemotePeeringConnection(context.Background(), deleteRemotePeeringConnectionRequest)
+			error := acctest.DeleteSweeperResource(compartment, "RemotePeeringConnectionId", remotePeeringConnectionId, func() error {
+				_, err := virtualNetworkClient.DeleteRemotePeeringConnection(context.Background(), deleteRemotePeeringConnectionRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting RemotePeeringConnection %s %s, It is possible that the resource is already deleted. Please verify manually \n", remotePeeringConnectionId, error)
+				fmt.Printf("Error deleting RemotePeeringConnection %s %s \n", remotePeeringConnectionId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &remotePeeringConnectionId, remotePeeringConnectionSweepWaitCondition, time.Duration(3*time.Minute),
 				remotePeeringConnectionSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getRemotePeeringConnectionIds(compartment string) ([]string, error) {
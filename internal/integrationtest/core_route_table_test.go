@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -286,10 +287,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreRouteTable") {
-		resource.AddTestSweepers("CoreRouteTable", &resource.Sweeper{
-			Name:         "CoreRouteTable",
-			Dependencies: acctest.DependencyGraph["routeTable"],
-			F:            sweepCoreRouteTableResource,
+		acctest.AddTestSweepers("CoreRouteTable", &resource.Sweeper{
+			Name: "CoreRouteTable",
+			F:    sweepCoreRouteTableResource,
 		})
 	}
 }
@@ -300,6 +300,7 @@ func sweepCoreRouteTableResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, routeTableId := range routeTableIds {
 		if ok := acctest.SweeperDefaultResourceId[routeTableId]; !ok {
 			deleteRouteTableRequest := oci_core.DeleteRouteTableRequest{}
@@ -307,16 +308,20 @@ func sweepCoreRouteTableResource(compartment string) error {
 			deleteRouteTableRequest.RtId = &routeTableId
 
 			deleteRouteTableRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := virtualNetworkClient.DeleteRouteTable(context.Background(), deleteRouteTableRequest)
+			error := acctest.DeleteSweeperResource(compartment, "RouteTableId", routeTableId, func() error {
+				_, err := virtualNetworkClient.DeleteRouteTable(context.Background(), deleteRouteTableRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting RouteTable %s %s, It is possible that the resource is already deleted. Please verify manually \n", routeTableId, error)
+				fmt.Printf("Error deleting RouteTable %s %s \n", routeTableId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &routeTableId, routeTableSweepWaitCondition, time.Duration(3*time.Minute),
 				routeTableSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getRouteTableIds(compartment string) ([]string, error) {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -610,10 +611,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreSecurityList") {
-		resource.AddTestSweepers("CoreSecurityList", &resource.Sweeper{
-			Name:         "CoreSecurityList",
-			Dependencies: acctest.DependencyGraph["securityList"],
-			F:            sweepCoreSecurityListResource,
+		acctest.AddTestSweepers("CoreSecurityList", &resource.Sweeper{
+			Name: "CoreSecurityList",
+			F:    sweepCoreSecurityListResource,
 		})
 	}
 }
@@ -624,6 +624,7 @@ func sweepCoreSecurityListResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, securityListId := range securityListIds {
 		if ok := acctest.SweeperDefaultResourceId[securityListId]; !ok {
 			deleteSecurityListRequest := oci_core.DeleteSecurityListRequest{}
@@ -631,16 +632,20 @@ func sweepCoreSecurityListResource(compartment string) error {
 			deleteSecurityListRequest.SecurityListId = &securityListId
 
 			deleteSecurityListRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := virtualNetworkClient.DeleteSecurityList(context.Background(), deleteSecurityListRequest)
+			error := acctest.DeleteSweeperResource(compartment, "SecurityListId", securityListId, func() error {
+				_, err := virtualNetworkClient.DeleteSecurityList(context.Background(), deleteSecurityListRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting SecurityList %s %s, It is possible that the resource is already deleted. Please verify manually \n", securityListId, error)
+				fmt.Printf("Error deleting SecurityList %s %s \n", securityListId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &securityListId, securityListSweepWaitCondition, time.Duration(3*time.Minute),
 				securityListSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getSecurityListIds(compartment string) ([]string, error) {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -282,10 +283,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreServiceGateway") {
-		resource.AddTestSweepers("CoreServiceGateway", &resource.Sweeper{
-			Name:         "CoreServiceGateway",
-			Dependencies: acctest.DependencyGraph["serviceGateway"],
-			F:            sweepCoreServiceGatewayResource,
+		acctest.AddTestSweepers("CoreServiceGateway", &resource.Sweeper{
+			Name: "CoreServiceGateway",
+			F:    sweepCoreServiceGatewayResource,
 		})
 	}
 }
@@ -296,6 +296,7 @@ func sweepCoreServiceGatewayResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, serviceGatewayId := range serviceGatewayIds {
 		if ok := acctest.SweeperDefaultResourceId[serviceGatewayId]; !ok {
 			deleteServiceGatewayRequest := oci_core.DeleteServiceGatewayRequest{}
@@ -303,16 +304,20 @@ func sweepCoreServiceGatewayResource(compartment string) error {
 			deleteServiceGatewayRequest.ServiceGatewayId = &serviceGatewayId
 
 			deleteServiceGatewayRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := virtualNetworkClient.DeleteServiceGateway(context.Background(), deleteServiceGatewayRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ServiceGatewayId", serviceGatewayId, func() error {
+				_, err := virtualNetworkClient.DeleteServiceGateway(context.Background(), deleteServiceGatewayRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ServiceGateway %s %s, It is possible that the resource is already deleted. Please verify manually \n", serviceGatewayId, error)
+				fmt.Printf("Error deleting ServiceGateway %s %s \n", serviceGatewayId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &serviceGatewayId, serviceGatewaySweepWaitCondition, time.Duration(3*time.Minute),
 				serviceGatewaySweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getServiceGatewayIds(compartment string) ([]string, error) {
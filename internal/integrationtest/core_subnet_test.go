@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -339,10 +340,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreSubnet") {
-		resource.AddTestSweepers("CoreSubnet", &resource.Sweeper{
-			Name:         "CoreSubnet",
-			Dependencies: acctest.DependencyGraph["subnet"],
-			F:            sweepCoreSubnetResource,
+		acctest.AddTestSweepers("CoreSubnet", &resource.Sweeper{
+			Name: "CoreSubnet",
+			F:    sweepCoreSubnetResource,
 		})
 	}
 }
@@ -353,6 +353,7 @@ func sweepCoreSubnetResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, subnetId := range subnetIds {
 		if ok := acctest.SweeperDefaultResourceId[subnetId]; !ok {
 			deleteSubnetRequest := oci_core.DeleteSubnetRequest{}
@@ -360,16 +361,20 @@ func sweepCoreSubnetResource(compartment string) error {
 			deleteSubnetRequest.SubnetId = &subnetId
 
 			deleteSubnetRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := virtualNetworkClient.DeleteSubnet(context.Background(), deleteSubnetRequest)
+			error := acctest.DeleteSweeperResource(compartment, "SubnetId", subnetId, func() error {
+				_, err := virtualNetworkClient.DeleteSubnet(context.Background(), deleteSubnetRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Subnet %s %s, It is possible that the resource is already deleted. Please verify manually \n", subnetId, error)
+				fmt.Printf("Error deleting Subnet %s %s \n", subnetId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &subnetId, subnetSweepWaitCondition, time.Duration(3*time.Minute),
 				subnetSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getSubnetIds(compartment string) ([]string, error) {
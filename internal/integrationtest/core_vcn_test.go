@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -294,10 +295,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreVcn") {
-		resource.AddTestSweepers("CoreVcn", &resource.Sweeper{
-			Name:         "CoreVcn",
-			Dependencies: acctest.DependencyGraph["vcn"],
-			F:            sweepCoreVcnResource,
+		acctest.AddTestSweepers("CoreVcn", &resource.Sweeper{
+			Name: "CoreVcn",
+			F:    sweepCoreVcnResource,
 		})
 	}
 }
@@ -308,6 +308,7 @@ func sweepCoreVcnResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, vcnId := range vcnIds {
 		if ok := acctest.SweeperDefaultResourceId[vcnId]; !ok {
 			deleteVcnRequest := oci_core.DeleteVcnRequest{}
@@ -315,16 +316,20 @@ func sweepCoreVcnResource(compartment string) error {
 			deleteVcnRequest.VcnId = &vcnId
 
 			deleteVcnRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := virtualNetworkClient.DeleteVcn(context.Background(), deleteVcnRequest)
+			error := acctest.DeleteSweeperResource(compartment, "VcnId", vcnId, func() error {
+				_, err := virtualNetworkClient.DeleteVcn(context.Background(), deleteVcnRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Vcn %s %s, It is possible that the resource is already deleted. Please verify manually \n", vcnId, error)
+				fmt.Printf("Error deleting Vcn %s %s \n", vcnId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &vcnId, vcnSweepWaitCondition, time.Duration(3*time.Minute),
 				vcnSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getVcnIds(compartment string) ([]string, error) {
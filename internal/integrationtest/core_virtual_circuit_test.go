@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -609,10 +610,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreVirtualCircuit") {
-		resource.AddTestSweepers("CoreVirtualCircuit", &resource.Sweeper{
-			Name:         "CoreVirtualCircuit",
-			Dependencies: acctest.DependencyGraph["virtualCircuit"],
-			F:            sweepCoreVirtualCircuitResource,
+		acctest.AddTestSweepers("CoreVirtualCircuit", &resource.Sweeper{
+			Name: "CoreVirtualCircuit",
+			F:    sweepCoreVirtualCircuitResource,
 		})
 	}
 }
@@ -623,6 +623,7 @@ func sweepCoreVirtualCircuitResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, virtualCircuitId := range virtualCircuitIds {
 		if ok := acctest.SweeperDefaultResourceId[virtualCircuitId]; !ok {
 			deleteVirtualCircuitRequest := oci_core.DeleteVirtualCircuitRequest{}
@@ -630,16 +631,20 @@ func sweepCoreVirtualCircuitResource(compartment string) error {
 			deleteVirtualCircuitRequest.VirtualCircuitId = &virtualCircuitId
 
 			deleteVirtualCircuitRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := virtualNetworkClient.DeleteVirtualCircuit(context.Background(), deleteVirtualCircuitRequest)
+			error := acctest.DeleteSweeperResource(compartment, "VirtualCircuitId", virtualCircuitId, func() error {
+				_, err := virtualNetworkClient.DeleteVirtualCircuit(context.Background(), deleteVirtualCircuitRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting VirtualCircuit %s %s, It is possible that the resource is already deleted. Please verify manually \n", virtualCircuitId, error)
+				fmt.Printf("Error deleting VirtualCircuit %s %s \n", virtualCircuitId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &virtualCircuitId, virtualCircuitSweepWaitCondition, time.Duration(3*time.Minute),
 				virtualCircuitSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getVirtualCircuitIds(compartment string) ([]string, error) {
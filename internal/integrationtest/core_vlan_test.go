@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -298,10 +299,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreVlan") {
-		resource.AddTestSweepers("CoreVlan", &resource.Sweeper{
-			Name:         "CoreVlan",
-			Dependencies: acctest.DependencyGraph["vlan"],
-			F:            sweepCoreVlanResource,
+		acctest.AddTestSweepers("CoreVlan", &resource.Sweeper{
+			Name: "CoreVlan",
+			F:    sweepCoreVlanResource,
 		})
 	}
 }
@@ -312,6 +312,7 @@ func sweepCoreVlanResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, vlanId := range vlanIds {
 		if ok := acctest.SweeperDefaultResourceId[vlanId]; !ok {
 			deleteVlanRequest := oci_core.DeleteVlanRequest{}
@@ -319,16 +320,20 @@ func sweepCoreVlanResource(compartment string) error {
 			deleteVlanRequest.VlanId = &vlanId
 
 			deleteVlanRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := virtualNetworkClient.DeleteVlan(context.Background(), deleteVlanRequest)
+			error := acctest.DeleteSweeperResource(compartment, "VlanId", vlanId, func() error {
+				_, err := virtualNetworkClient.DeleteVlan(context.Background(), deleteVlanRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Vlan %s %s, It is possible that the resource is already deleted. Please verify manually \n", vlanId, error)
+				fmt.Printf("Error deleting Vlan %s %s \n", vlanId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &vlanId, vlanSweepWaitCondition, time.Duration(3*time.Minute),
 				vlanSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getVlanIds(compartment string) ([]string, error) {
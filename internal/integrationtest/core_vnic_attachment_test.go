@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -225,10 +226,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreVnicAttachment") {
-		resource.AddTestSweepers("CoreVnicAttachment", &resource.Sweeper{
-			Name:         "CoreVnicAttachment",
-			Dependencies: acctest.DependencyGraph["vnicAttachment"],
-			F:            sweepCoreVnicAttachmentResource,
+		acctest.AddTestSweepers("CoreVnicAttachment", &resource.Sweeper{
+			Name: "CoreVnicAttachment",
+			F:    sweepCoreVnicAttachmentResource,
 		})
 	}
 }
@@ -239,6 +239,7 @@ func sweepCoreVnicAttachmentResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, vnicAttachmentId := range vnicAttachmentIds {
 		if ok := acctest.SweeperDefaultResourceId[vnicAttachmentId]; !ok {
 			detachVnicRequest := oci_core.DetachVnicRequest{}
@@ -246,16 +247,20 @@ func sweepCoreVnicAttachmentResource(compartment string) error {
 			detachVnicRequest.VnicAttachmentId = &vnicAttachmentId
 
 			detachVnicRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := computeClient.DetachVnic(context.Background(), detachVnicRequest)
+			error := acctest.DeleteSweeperResource(compartment, "VnicAttachmentId", vnicAttachmentId, func() error {
+				_, err := computeClient.DetachVnic(context.Background(), detachVnicRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting VnicAttachment %s %s, It is possible that the resource is already deleted. Please verify manually \n", vnicAttachmentId, error)
+				fmt.Printf("Error deleting VnicAttachment %s %s \n", vnicAttachmentId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &vnicAttachmentId, vnicAttachmentSweepWaitCondition, time.Duration(3*time.Minute),
 				vnicAttachmentSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getVnicAttachmentIds(compartment string) ([]string, error) {
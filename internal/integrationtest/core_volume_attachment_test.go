@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -215,10 +216,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreVolumeAttachment") {
-		resource.AddTestSweepers("CoreVolumeAttachment", &resource.Sweeper{
-			Name:         "CoreVolumeAttachment",
-			Dependencies: acctest.DependencyGraph["volumeAttachment"],
-			F:            sweepCoreVolumeAttachmentResource,
+		acctest.AddTestSweepers("CoreVolumeAttachment", &resource.Sweeper{
+			Name: "CoreVolumeAttachment",
+			F:    sweepCoreVolumeAttachmentResource,
 		})
 	}
 }
@@ -229,6 +229,7 @@ func sweepCoreVolumeAttachmentResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, volumeAttachmentId := range volumeAttachmentIds {
 		if ok := acctest.SweeperDefaultResourceId[volumeAttachmentId]; !ok {
 			detachVolumeRequest := oci_core.DetachVolumeRequest{}
@@ -236,16 +237,20 @@ func sweepCoreVolumeAttachmentResource(compartment string) error {
 			detachVolumeRequest.VolumeAttachmentId = &volumeAttachmentId
 
 			detachVolumeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := computeClient.DetachVolume(context.Background(), detachVolumeRequest)
+			error := acctest.DeleteSweeperResource(compartment, "VolumeAttachmentId", volumeAttachmentId, func() error {
+				_, err := computeClient.DetachVolume(context.Background(), detachVolumeRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting VolumeAttachment %s %s, It is possible that the resource is already deleted. Please verify manually \n", volumeAttachmentId, error)
+				fmt.Printf("Error deleting VolumeAttachment %s %s \n", volumeAttachmentId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &volumeAttachmentId, volumeAttachmentSweepWaitCondition, time.Duration(3*time.Minute),
 				volumeAttachmentSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getVolumeAttachmentIds(compartment string) ([]string, error) {
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -252,10 +253,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreVolumeBackupPolicy") {
-		resource.AddTestSweepers("CoreVolumeBackupPolicy", &resource.Sweeper{
-			Name:         "CoreVolumeBackupPolicy",
-			Dependencies: acctest.DependencyGraph["volumeBackupPolicy"],
-			F:            sweepCoreVolumeBackupPolicyResource,
+		acctest.AddTestSweepers("CoreVolumeBackupPolicy", &resource.Sweeper{
+			Name: "CoreVolumeBackupPolicy",
+			F:    sweepCoreVolumeBackupPolicyResource,
 		})
 	}
 }
@@ -266,6 +266,7 @@ func sweepCoreVolumeBackupPolicyResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, volumeBackupPolicyId := range volumeBackupPolicyIds {
 		if ok := acctest.SweeperDefaultResourceId[volumeBackupPolicyId]; !ok {
 			deleteVolumeBackupPolicyRequest := oci_core.DeleteVolumeBackupPolicyRequest{}
@@ -273,14 +274,18 @@ func sweepCoreVolumeBackupPolicyResource(compartment string) error {
 			deleteVolumeBackupPolicyRequest.PolicyId = &volumeBackupPolicyId
 
 			deleteVolumeBackupPolicyRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := blockstorageClient.DeleteVolumeBackupPolicy(context.Background(), deleteVolumeBackupPolicyRequest)
+			error := acctest.DeleteSweeperResource(compartment, "VolumeBackupPolicyId", volumeBackupPolicyId, func() error {
+				_, err := blockstorageClient.DeleteVolumeBackupPolicy(context.Background(), deleteVolumeBackupPolicyRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting VolumeBackupPolicy %s %s, It is possible that the resource is already deleted. Please verify manually \n", volumeBackupPolicyId, error)
+				fmt.Printf("Error deleting VolumeBackupPolicy %s %s \n", volumeBackupPolicyId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getVolumeBackupPolicyIds(compartment string) ([]string, error) {
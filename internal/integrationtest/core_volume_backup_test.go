@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -258,10 +259,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreVolumeBackup") {
-		resource.AddTestSweepers("CoreVolumeBackup", &resource.Sweeper{
-			Name:         "CoreVolumeBackup",
-			Dependencies: acctest.DependencyGraph["volumeBackup"],
-			F:            sweepCoreVolumeBackupResource,
+		acctest.AddTestSweepers("CoreVolumeBackup", &resource.Sweeper{
+			Name: "CoreVolumeBackup",
+			F:    sweepCoreVolumeBackupResource,
 		})
 	}
 }
@@ -272,6 +272,7 @@ func sweepCoreVolumeBackupResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, volumeBackupId := range volumeBackupIds {
 		if ok := acctest.SweeperDefaultResourceId[volumeBackupId]; !ok {
 			deleteVolumeBackupRequest := oci_core.DeleteVolumeBackupRequest{}
@@ -279,16 +280,20 @@ func sweepCoreVolumeBackupResource(compartment string) error {
 			deleteVolumeBackupRequest.VolumeBackupId = &volumeBackupId
 
 			deleteVolumeBackupRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := blockstorageClient.DeleteVolumeBackup(context.Background(), deleteVolumeBackupRequest)
+			error := acctest.DeleteSweeperResource(compartment, "VolumeBackupId", volumeBackupId, func() error {
+				_, err := blockstorageClient.DeleteVolumeBackup(context.Background(), deleteVolumeBackupRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting VolumeBackup %s %s, It is possible that the resource is already deleted. Please verify manually \n", volumeBackupId, error)
+				fmt.Printf("Error deleting VolumeBackup %s %s \n", volumeBackupId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &volumeBackupId, volumeBackupSweepWaitCondition, time.Duration(3*time.Minute),
 				volumeBackupSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getVolumeBackupIds(compartment string) ([]string, error) {
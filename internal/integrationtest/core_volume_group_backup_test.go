@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -262,10 +263,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreVolumeGroupBackup") {
-		resource.AddTestSweepers("CoreVolumeGroupBackup", &resource.Sweeper{
-			Name:         "CoreVolumeGroupBackup",
-			Dependencies: acctest.DependencyGraph["volumeGroupBackup"],
-			F:            sweepCoreVolumeGroupBackupResource,
+		acctest.AddTestSweepers("CoreVolumeGroupBackup", &resource.Sweeper{
+			Name: "CoreVolumeGroupBackup",
+			F:    sweepCoreVolumeGroupBackupResource,
 		})
 	}
 }
@@ -276,6 +276,7 @@ func sweepCoreVolumeGroupBackupResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, volumeGroupBackupId := range volumeGroupBackupIds {
 		if ok := acctest.SweeperDefaultResourceId[volumeGroupBackupId]; !ok {
 			deleteVolumeGroupBackupRequest := oci_core.DeleteVolumeGroupBackupRequest{}
@@ -283,16 +284,20 @@ func sweepCoreVolumeGroupBackupResource(compartment string) error {
 			deleteVolumeGroupBackupRequest.VolumeGroupBackupId = &volumeGroupBackupId
 
 			deleteVolumeGroupBackupRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := blockstorageClient.DeleteVolumeGroupBackup(context.Background(), deleteVolumeGroupBackupRequest)
+			error := acctest.DeleteSweeperResource(compartment, "VolumeGroupBackupId", volumeGroupBackupId, func() error {
+				_, err := blockstorageClient.DeleteVolumeGroupBackup(context.Background(), deleteVolumeGroupBackupRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting VolumeGroupBackup %s %s, It is possible that the resource is already deleted. Please verify manually \n", volumeGroupBackupId, error)
+				fmt.Printf("Error deleting VolumeGroupBackup %s %s \n", volumeGroupBackupId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &volumeGroupBackupId, volumeGroupBackupSweepWaitCondition, time.Duration(3*time.Minute),
 				volumeGroupBackupSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getVolumeGroupBackupIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -336,10 +337,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreVolumeGroup") {
-		resource.AddTestSweepers("CoreVolumeGroup", &resource.Sweeper{
-			Name:         "CoreVolumeGroup",
-			Dependencies: acctest.DependencyGraph["volumeGroup"],
-			F:            sweepCoreVolumeGroupResource,
+		acctest.AddTestSweepers("CoreVolumeGroup", &resource.Sweeper{
+			Name: "CoreVolumeGroup",
+			F:    sweepCoreVolumeGroupResource,
 		})
 	}
 }
@@ -350,6 +350,7 @@ func sweepCoreVolumeGroupResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, volumeGroupId := range volumeGroupIds {
 		if ok := acctest.SweeperDefaultResourceId[volumeGroupId]; !ok {
 			deleteVolumeGroupRequest := oci_core.DeleteVolumeGroupRequest{}
@@ -357,16 +358,20 @@ func sweepCoreVolumeGroupResource(compartment string) error {
 			deleteVolumeGroupRequest.VolumeGroupId = &volumeGroupId
 
 			deleteVolumeGroupRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := blockstorageClient.DeleteVolumeGroup(context.Background(), deleteVolumeGroupRequest)
+			error := acctest.DeleteSweeperResource(compartment, "VolumeGroupId", volumeGroupId, func() error {
+				_, err := blockstorageClient.DeleteVolumeGroup(context.Background(), deleteVolumeGroupRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting VolumeGroup %s %s, It is possible that the resource is already deleted. Please verify manually \n", volumeGroupId, error)
+				fmt.Printf("Error deleting VolumeGroup %s %s \n", volumeGroupId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &volumeGroupId, volumeGroupSweepWaitCondition, time.Duration(3*time.Minute),
 				volumeGroupSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getVolumeGroupIds(compartment string) ([]string, error) {
@@ -12,6 +12,7 @@ import (
 
 	"regexp"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -646,10 +647,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CoreVolume") {
-		resource.AddTestSweepers("CoreVolume", &resource.Sweeper{
-			Name:         "CoreVolume",
-			Dependencies: acctest.DependencyGraph["volume"],
-			F:            sweepCoreVolumeResource,
+		acctest.AddTestSweepers("CoreVolume", &resource.Sweeper{
+			Name: "CoreVolume",
+			F:    sweepCoreVolumeResource,
 		})
 	}
 }
@@ -660,6 +660,7 @@ func sweepCoreVolumeResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, volumeId := range volumeIds {
 		if ok := acctest.SweeperDefaultResourceId[volumeId]; !ok {
 			deleteVolumeRequest := oci_core.DeleteVolumeRequest{}
@@ -667,16 +668,20 @@ func sweepCoreVolumeResource(compartment string) error {
 			deleteVolumeRequest.VolumeId = &volumeId
 
 			deleteVolumeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "core")
-			_, error := blockstorageClient.DeleteVolume(context.Background(), deleteVolumeRequest)
+			error := acctest.DeleteSweeperResource(compartment, "VolumeId", volumeId, func() error {
+				_, err := blockstorageClient.DeleteVolume(context.Background(), deleteVolumeRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Volume %s %s, It is possible that the resource is already deleted. Please verify manually \n", volumeId, error)
+				fmt.Printf("Error deleting Volume %s %s \n", volumeId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &volumeId, volumeSweepWaitCondition, time.Duration(3*time.Minute),
 				volumeSweepResponseFetchOperation, "core", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getVolumeIds(compartment string) ([]string, error) {
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -311,10 +312,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DataConnectivityRegistryConnection") {
-		resource.AddTestSweepers("DataConnectivityRegistryConnection", &resource.Sweeper{
-			Name:         "DataConnectivityRegistryConnection",
-			Dependencies: acctest.DependencyGraph["registryConnection"],
-			F:            sweepDataConnectivityRegistryConnectionResource,
+		acctest.AddTestSweepers("DataConnectivityRegistryConnection", &resource.Sweeper{
+			Name: "DataConnectivityRegistryConnection",
+			F:    sweepDataConnectivityRegistryConnectionResource,
 		})
 	}
 }
@@ -325,19 +325,24 @@ func sweepDataConnectivityRegistryConnectionResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, registryConnectionId := range registryConnectionIds {
 		if ok := acctest.SweeperDefaultResourceId[registryConnectionId]; !ok {
 			deleteConnectionRequest := oci_data_connectivity.DeleteConnectionRequest{}
 
 			deleteConnectionRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "data_connectivity")
-			_, error := dataConnectivityManagementClient.DeleteConnection(context.Background(), deleteConnectionRequest)
+			error := acctest.DeleteSweeperResource(compartment, "RegistryConnectionId", registryConnectionId, func() error {
+				_, err := dataConnectivityManagementClient.DeleteConnection(context.Background(), deleteConnectionRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting RegistryConnection %s %s, It is possible that the resource is already deleted. Please verify manually \n", registryConnectionId, error)
+				fmt.Printf("Error deleting RegistryConnection %s %s \n", registryConnectionId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getRegistryConnectionIds(compartment string) ([]string, error) {
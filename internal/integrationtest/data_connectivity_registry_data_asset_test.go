@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -244,10 +245,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DataConnectivityRegistryDataAsset") {
-		resource.AddTestSweepers("DataConnectivityRegistryDataAsset", &resource.Sweeper{
-			Name:         "DataConnectivityRegistryDataAsset",
-			Dependencies: acctest.DependencyGraph["registryDataAsset"],
-			F:            sweepDataConnectivityRegistryDataAssetResource,
+		acctest.AddTestSweepers("DataConnectivityRegistryDataAsset", &resource.Sweeper{
+			Name: "DataConnectivityRegistryDataAsset",
+			F:    sweepDataConnectivityRegistryDataAssetResource,
 		})
 	}
 }
@@ -258,19 +258,24 @@ func sweepDataConnectivityRegistryDataAssetResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, registryDataAssetId := range registryDataAssetIds {
 		if ok := acctest.SweeperDefaultResourceId[registryDataAssetId]; !ok {
 			deleteDataAssetRequest := oci_data_connectivity.DeleteDataAssetRequest{}
 
 			deleteDataAssetRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "data_connectivity")
-			_, error := dataConnectivityManagementClient.DeleteDataAsset(context.Background(), deleteDataAssetRequest)
+			error := acctest.DeleteSweeperResource(compartment, "RegistryDataAssetId", registryDataAssetId, func() error {
+				_, err := dataConnectivityManagementClient.DeleteDataAsset(context.Background(), deleteDataAssetRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting RegistryDataAsset %s %s, It is possible that the resource is already deleted. Please verify manually \n", registryDataAssetId, error)
+				fmt.Printf("Error deleting RegistryDataAsset %s %s \n", registryDataAssetId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getRegistryDataAssetIds(compartment string) ([]string, error) {
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -431,10 +432,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DataConnectivityRegistryFolder") {
-		resource.AddTestSweepers("DataConnectivityRegistryFolder", &resource.Sweeper{
-			Name:         "DataConnectivityRegistryFolder",
-			Dependencies: acctest.DependencyGraph["registryFolder"],
-			F:            sweepDataConnectivityRegistryFolderResource,
+		acctest.AddTestSweepers("DataConnectivityRegistryFolder", &resource.Sweeper{
+			Name: "DataConnectivityRegistryFolder",
+			F:    sweepDataConnectivityRegistryFolderResource,
 		})
 	}
 }
@@ -445,19 +445,24 @@ func sweepDataConnectivityRegistryFolderResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, registryFolderId := range registryFolderIds {
 		if ok := acctest.SweeperDefaultResourceId[registryFolderId]; !ok {
 			deleteFolderRequest := oci_data_connectivity.DeleteFolderRequest{}
 
 			deleteFolderRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "data_connectivity")
-			_, error := dataConnectivityManagementClient.DeleteFolder(context.Background(), deleteFolderRequest)
+			error := acctest.DeleteSweeperResource(compartment, "RegistryFolderId", registryFolderId, func() error {
+				_, err := dataConnectivityManagementClient.DeleteFolder(context.Background(), deleteFolderRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting RegistryFolder %s %s, It is possible that the resource is already deleted. Please verify manually \n", registryFolderId, error)
+				fmt.Printf("Error deleting RegistryFolder %s %s \n", registryFolderId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getRegistryFolderIds(compartment string) ([]string, error) {
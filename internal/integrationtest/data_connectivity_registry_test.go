@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -259,10 +260,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DataConnectivityRegistry") {
-		resource.AddTestSweepers("DataConnectivityRegistry", &resource.Sweeper{
-			Name:         "DataConnectivityRegistry",
-			Dependencies: acctest.DependencyGraph["registry"],
-			F:            sweepDataConnectivityRegistryResource,
+		acctest.AddTestSweepers("DataConnectivityRegistry", &resource.Sweeper{
+			Name: "DataConnectivityRegistry",
+			F:    sweepDataConnectivityRegistryResource,
 		})
 	}
 }
@@ -273,6 +273,7 @@ func sweepDataConnectivityRegistryResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, registryId := range registryIds {
 		if ok := acctest.SweeperDefaultResourceId[registryId]; !ok {
 			deleteRegistryRequest := oci_data_connectivity.DeleteRegistryRequest{}
@@ -280,16 +281,20 @@ func sweepDataConnectivityRegistryResource(compartment string) error {
 			deleteRegistryRequest.RegistryId = &registryId
 
 			deleteRegistryRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "data_connectivity")
-			_, error := dataConnectivityManagementClient.DeleteRegistry(context.Background(), deleteRegistryRequest)
+			error := acctest.DeleteSweeperResource(compartment, "RegistryId", registryId, func() error {
+				_, err := dataConnectivityManagementClient.DeleteRegistry(context.Background(), deleteRegistryRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Registry %s %s, It is possible that the resource is already deleted. Please verify manually \n", registryId, error)
+				fmt.Printf("Error deleting Registry %s %s \n", registryId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &registryId, registrySweepWaitCondition, time.Duration(3*time.Minute),
 				registrySweepResponseFetchOperation, "data_connectivity", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getRegistryIds(compartment string) ([]string, error) {
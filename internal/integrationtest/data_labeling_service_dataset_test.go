@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -373,10 +374,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DataLabelingServiceDataset") {
-		resource.AddTestSweepers("DataLabelingServiceDataset", &resource.Sweeper{
-			Name:         "DataLabelingServiceDataset",
-			Dependencies: acctest.DependencyGraph["dataset"],
-			F:            sweepDataLabelingServiceDatasetResource,
+		acctest.AddTestSweepers("DataLabelingServiceDataset", &resource.Sweeper{
+			Name: "DataLabelingServiceDataset",
+			F:    sweepDataLabelingServiceDatasetResource,
 		})
 	}
 }
@@ -392,6 +392,7 @@ func sweepDataLabelingServiceDatasetResource(compartment string) error {
 		return err
 	}
 	datasetIds := append(activeDatasetIds, nonActiveDatasetIds...)
+	var deleteErrors *multierror.Error
 	for _, datasetId := range datasetIds {
 		if ok := acctest.SweeperDefaultResourceId[datasetId]; !ok {
 			deleteDatasetRequest := oci_data_labeling_service.DeleteDatasetRequest{}
@@ -399,16 +400,20 @@ func sweepDataLabelingServiceDatasetResource(compartment string) error {
 			deleteDatasetRequest.DatasetId = &datasetId
 
 			deleteDatasetRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "data_labeling_service")
-			_, error := dataLabelingManagementClient.DeleteDataset(context.Background(), deleteDatasetRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DatasetId", datasetId, func() error {
+				_, err := dataLabelingManagementClient.DeleteDataset(context.Background(), deleteDatasetRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Dataset %s %s, It is possible that the resource is already deleted. Please verify manually \n", datasetId, error)
+				fmt.Printf("Error deleting Dataset %s %s \n", datasetId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &datasetId, datasetSweepWaitCondition, time.Duration(3*time.Minute),
 				datasetSweepResponseFetchOperation, "data_labeling_service", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDatasetIds(compartment string, lifecycleState oci_data_labeling_service.DatasetLifecycleStateEnum) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -291,10 +292,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DataSafeAuditArchiveRetrieval") {
-		resource.AddTestSweepers("DataSafeAuditArchiveRetrieval", &resource.Sweeper{
-			Name:         "DataSafeAuditArchiveRetrieval",
-			Dependencies: acctest.DependencyGraph["auditArchiveRetrieval"],
-			F:            sweepDataSafeAuditArchiveRetrievalResource,
+		acctest.AddTestSweepers("DataSafeAuditArchiveRetrieval", &resource.Sweeper{
+			Name: "DataSafeAuditArchiveRetrieval",
+			F:    sweepDataSafeAuditArchiveRetrievalResource,
 		})
 	}
 }
@@ -305,6 +305,7 @@ func sweepDataSafeAuditArchiveRetrievalResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, auditArchiveRetrievalId := range auditArchiveRetrievalIds {
 		if ok := acctest.SweeperDefaultResourceId[auditArchiveRetrievalId]; !ok {
 			deleteAuditArchiveRetrievalRequest := oci_data_safe.DeleteAuditArchiveRetrievalRequest{}
@@ -312,16 +313,20 @@ func sweepDataSafeAuditArchiveRetrievalResource(compartment string) error {
 			deleteAuditArchiveRetrievalRequest.AuditArchiveRetrievalId = &auditArchiveRetrievalId
 
 			deleteAuditArchiveRetrievalRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "data_safe")
-			_, error := dataSafeClient.DeleteAuditArchiveRetrieval(context.Background(), deleteAuditArchiveRetrievalRequest)
+			error := acctest.DeleteSweeperResource(compartment, "AuditArchiveRetrievalId", auditArchiveRetrievalId, func() error {
+				_, err := dataSafeClient.DeleteAuditArchiveRetrieval(context.Background(), deleteAuditArchiveRetrievalRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting AuditArchiveRetrieval %s %s, It is possible that the resource is already deleted. Please verify manually \n", auditArchiveRetrievalId, error)
+				fmt.Printf("Error deleting AuditArchiveRetrieval %s %s \n", auditArchiveRetrievalId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &auditArchiveRetrievalId, auditArchiveRetrievalSweepWaitCondition, time.Duration(3*time.Minute),
 				auditArchiveRetrievalSweepResponseFetchOperation, "data_safe", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getAuditArchiveRetrievalIds(compartment string) ([]string, error) {
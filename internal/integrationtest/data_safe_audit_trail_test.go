@@ -20,6 +20,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -279,10 +280,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DataSafeAuditTrail") {
-		resource.AddTestSweepers("DataSafeAuditTrail", &resource.Sweeper{
-			Name:         "DataSafeAuditTrail",
-			Dependencies: acctest.DependencyGraph["auditTrail"],
-			F:            sweepDataSafeAuditTrailResource,
+		acctest.AddTestSweepers("DataSafeAuditTrail", &resource.Sweeper{
+			Name: "DataSafeAuditTrail",
+			F:    sweepDataSafeAuditTrailResource,
 		})
 	}
 }
@@ -293,6 +293,7 @@ func sweepDataSafeAuditTrailResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, auditTrailId := range auditTrailIds {
 		if ok := acctest.SweeperDefaultResourceId[auditTrailId]; !ok {
 			deleteAuditTrailRequest := oci_data_safe.DeleteAuditTrailRequest{}
@@ -300,14 +301,18 @@ func sweepDataSafeAuditTrailResource(compartment string) error {
 			deleteAuditTrailRequest.AuditTrailId = &auditTrailId
 
 			deleteAuditTrailRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "data_safe")
-			_, error := dataSafeClient.DeleteAuditTrail(context.Background(), deleteAuditTrailRequest)
+			error := acctest.DeleteSweeperResource(compartment, "AuditTrailId", auditTrailId, func() error {
+				_, err := dataSafeClient.DeleteAuditTrail(context.Background(), deleteAuditTrailRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting AuditTrail %s %s, It is possible that the resource is already deleted. Please verify manually \n", auditTrailId, error)
+				fmt.Printf("Error deleting AuditTrail %s %s \n", auditTrailId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getAuditTrailIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -294,10 +295,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DataSafeDataSafePrivateEndpoint") {
-		resource.AddTestSweepers("DataSafeDataSafePrivateEndpoint", &resource.Sweeper{
-			Name:         "DataSafeDataSafePrivateEndpoint",
-			Dependencies: acctest.DependencyGraph["dataSafePrivateEndpoint"],
-			F:            sweepDataSafeDataSafePrivateEndpointResource,
+		acctest.AddTestSweepers("DataSafeDataSafePrivateEndpoint", &resource.Sweeper{
+			Name: "DataSafeDataSafePrivateEndpoint",
+			F:    sweepDataSafeDataSafePrivateEndpointResource,
 		})
 	}
 }
@@ -308,6 +308,7 @@ func sweepDataSafeDataSafePrivateEndpointResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, dataSafePrivateEndpointId := range dataSafePrivateEndpointIds {
 		if ok := acctest.SweeperDefaultResourceId[dataSafePrivateEndpointId]; !ok {
 			deleteDataSafePrivateEndpointRequest := oci_data_safe.DeleteDataSafePrivateEndpointRequest{}
@@ -315,16 +316,20 @@ func sweepDataSafeDataSafePrivateEndpointResource(compartment string) error {
 			deleteDataSafePrivateEndpointRequest.DataSafePrivateEndpointId = &dataSafePrivateEndpointId
 
 			deleteDataSafePrivateEndpointRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "data_safe")
-			_, error := dataSafeClient.DeleteDataSafePrivateEndpoint(context.Background(), deleteDataSafePrivateEndpointRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DataSafePrivateEndpointId", dataSafePrivateEndpointId, func() error {
+				_, err := dataSafeClient.DeleteDataSafePrivateEndpoint(context.Background(), deleteDataSafePrivateEndpointRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting DataSafePrivateEndpoint %s %s, It is possible that the resource is already deleted. Please verify manually \n", dataSafePrivateEndpointId, error)
+				fmt.Printf("Error deleting DataSafePrivateEndpoint %s %s \n", dataSafePrivateEndpointId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &dataSafePrivateEndpointId, dataSafePrivateEndpointSweepWaitCondition, time.Duration(3*time.Minute),
 				dataSafePrivateEndpointSweepResponseFetchOperation, "data_safe", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDataSafePrivateEndpointIds(compartment string) ([]string, error) {
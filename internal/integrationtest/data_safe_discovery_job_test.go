@@ -20,6 +20,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -286,10 +287,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DataSafeDiscoveryJob") {
-		resource.AddTestSweepers("DataSafeDiscoveryJob", &resource.Sweeper{
-			Name:         "DataSafeDiscoveryJob",
-			Dependencies: acctest.DependencyGraph["discoveryJob"],
-			F:            sweepDataSafeDiscoveryJobResource,
+		acctest.AddTestSweepers("DataSafeDiscoveryJob", &resource.Sweeper{
+			Name: "DataSafeDiscoveryJob",
+			F:    sweepDataSafeDiscoveryJobResource,
 		})
 	}
 }
@@ -300,6 +300,7 @@ func sweepDataSafeDiscoveryJobResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, discoveryJobId := range discoveryJobIds {
 		if ok := acctest.SweeperDefaultResourceId[discoveryJobId]; !ok {
 			deleteDiscoveryJobRequest := oci_data_safe.DeleteDiscoveryJobRequest{}
@@ -307,16 +308,20 @@ func sweepDataSafeDiscoveryJobResource(compartment string) error {
 			deleteDiscoveryJobRequest.DiscoveryJobId = &discoveryJobId
 
 			deleteDiscoveryJobRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "data_safe")
-			_, error := dataSafeClient.DeleteDiscoveryJob(context.Background(), deleteDiscoveryJobRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DiscoveryJobId", discoveryJobId, func() error {
+				_, err := dataSafeClient.DeleteDiscoveryJob(context.Background(), deleteDiscoveryJobRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting DiscoveryJob %s %s, It is possible that the resource is already deleted. Please verify manually \n", discoveryJobId, error)
+				fmt.Printf("Error deleting DiscoveryJob %s %s \n", discoveryJobId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &discoveryJobId, discoveryJobSweepWaitCondition, time.Duration(3*time.Minute),
 				discoveryJobSweepResponseFetchOperation, "data_safe", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDiscoveryJobIds(compartment string) ([]string, error) {
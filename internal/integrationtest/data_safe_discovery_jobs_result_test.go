@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -192,10 +193,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DataSafeDiscoveryJobsResult") {
-		resource.AddTestSweepers("DataSafeDiscoveryJobsResult", &resource.Sweeper{
-			Name:         "DataSafeDiscoveryJobsResult",
-			Dependencies: acctest.DependencyGraph["discoveryJobsResult"],
-			F:            sweepDataSafeDiscoveryJobsResultResource,
+		acctest.AddTestSweepers("DataSafeDiscoveryJobsResult", &resource.Sweeper{
+			Name: "DataSafeDiscoveryJobsResult",
+			F:    sweepDataSafeDiscoveryJobsResultResource,
 		})
 	}
 }
@@ -206,19 +206,24 @@ func sweepDataSafeDiscoveryJobsResultResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, discoveryJobsResultId := range discoveryJobsResultIds {
 		if ok := acctest.SweeperDefaultResourceId[discoveryJobsResultId]; !ok {
 			deleteDiscoveryJobResultRequest := oci_data_safe.DeleteDiscoveryJobResultRequest{}
 
 			deleteDiscoveryJobResultRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "data_safe")
-			_, error := dataSafeClient.DeleteDiscoveryJobResult(context.Background(), deleteDiscoveryJobResultRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DiscoveryJobsResultId", discoveryJobsResultId, func() error {
+				_, err := dataSafeClient.DeleteDiscoveryJobResult(context.Background(), deleteDiscoveryJobResultRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting DiscoveryJobsResult %s %s, It is possible that the resource is already deleted. Please verify manually \n", discoveryJobsResultId, error)
+				fmt.Printf("Error deleting DiscoveryJobsResult %s %s \n", discoveryJobsResultId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDiscoveryJobsResultIds(compartment string) ([]string, error) {
@@ -18,6 +18,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -283,10 +284,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DataSafeLibraryMaskingFormat") {
-		resource.AddTestSweepers("DataSafeLibraryMaskingFormat", &resource.Sweeper{
-			Name:         "DataSafeLibraryMaskingFormat",
-			Dependencies: acctest.DependencyGraph["libraryMaskingFormat"],
-			F:            sweepDataSafeLibraryMaskingFormatResource,
+		acctest.AddTestSweepers("DataSafeLibraryMaskingFormat", &resource.Sweeper{
+			Name: "DataSafeLibraryMaskingFormat",
+			F:    sweepDataSafeLibraryMaskingFormatResource,
 		})
 	}
 }
@@ -297,6 +297,7 @@ func sweepDataSafeLibraryMaskingFormatResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, libraryMaskingFormatId := range libraryMaskingFormatIds {
 		if ok := acctest.SweeperDefaultResourceId[libraryMaskingFormatId]; !ok {
 			deleteLibraryMaskingFormatRequest := oci_data_safe.DeleteLibraryMaskingFormatRequest{}
@@ -304,16 +305,20 @@ func sweepDataSafeLibraryMaskingFormatResource(compartment string) error {
 			deleteLibraryMaskingFormatRequest.LibraryMaskingFormatId = &libraryMaskingFormatId
 
 			deleteLibraryMaskingFormatRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "data_safe")
-			_, error := dataSafeClient.DeleteLibraryMaskingFormat(context.Background(), deleteLibraryMaskingFormatRequest)
+			error := acctest.DeleteSweeperResource(compartment, "LibraryMaskingFormatId", libraryMaskingFormatId, func() error {
+				_, err := dataSafeClient.DeleteLibraryMaskingFormat(context.Background(), deleteLibraryMaskingFormatRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting LibraryMaskingFormat %s %s, It is possible that the resource is already deleted. Please verify manually \n", libraryMaskingFormatId, error)
+				fmt.Printf("Error deleting LibraryMaskingFormat %s %s \n", libraryMaskingFormatId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &libraryMaskingFormatId, libraryMaskingFormatSweepWaitCondition, time.Duration(3*time.Minute),
 				libraryMaskingFormatSweepResponseFetchOperation, "data_safe", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getLibraryMaskingFormatIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -285,10 +286,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DataSafeMaskingPoliciesMaskingColumn") {
-		resource.AddTestSweepers("DataSafeMaskingPoliciesMaskingColumn", &resource.Sweeper{
-			Name:         "DataSafeMaskingPoliciesMaskingColumn",
-			Dependencies: acctest.DependencyGraph["maskingPoliciesMaskingColumn"],
-			F:            sweepDataSafeMaskingPoliciesMaskingColumnResource,
+		acctest.AddTestSweepers("DataSafeMaskingPoliciesMaskingColumn", &resource.Sweeper{
+			Name: "DataSafeMaskingPoliciesMaskingColumn",
+			F:    sweepDataSafeMaskingPoliciesMaskingColumnResource,
 		})
 	}
 }
@@ -299,19 +299,24 @@ func sweepDataSafeMaskingPoliciesMaskingColumnResource(compartment string) error
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, maskingPoliciesMaskingColumnId := range maskingPoliciesMaskingColumnIds {
 		if ok := acctest.SweeperDefaultResourceId[maskingPoliciesMaskingColumnId]; !ok {
 			deleteMaskingColumnRequest := oci_data_safe.DeleteMaskingColumnRequest{}
 
 			deleteMaskingColumnRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "data_safe")
-			_, error := dataSafeClient.DeleteMaskingColumn(context.Background(), deleteMaskingColumnRequest)
+			error := acctest.DeleteSweeperResource(compartment, "MaskingPoliciesMaskingColumnId", maskingPoliciesMaskingColumnId, func() error {
+				_, err := dataSafeClient.DeleteMaskingColumn(context.Background(), deleteMaskingColumnRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting MaskingPoliciesMaskingColumn %s %s, It is possible that the resource is already deleted. Please verify manually \n", maskingPoliciesMaskingColumnId, error)
+				fmt.Printf("Error deleting MaskingPoliciesMaskingColumn %s %s \n", maskingPoliciesMaskingColumnId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getMaskingPoliciesMaskingColumnIds(compartment string) ([]string, error) {
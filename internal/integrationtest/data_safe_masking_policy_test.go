@@ -18,6 +18,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -323,10 +324,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DataSafeMaskingPolicy") {
-		resource.AddTestSweepers("DataSafeMaskingPolicy", &resource.Sweeper{
-			Name:         "DataSafeMaskingPolicy",
-			Dependencies: acctest.DependencyGraph["maskingPolicy"],
-			F:            sweepDataSafeMaskingPolicyResource,
+		acctest.AddTestSweepers("DataSafeMaskingPolicy", &resource.Sweeper{
+			Name: "DataSafeMaskingPolicy",
+			F:    sweepDataSafeMaskingPolicyResource,
 		})
 	}
 }
@@ -337,6 +337,7 @@ func sweepDataSafeMaskingPolicyResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, maskingPolicyId := range maskingPolicyIds {
 		if ok := acctest.SweeperDefaultResourceId[maskingPolicyId]; !ok {
 			deleteMaskingPolicyRequest := oci_data_safe.DeleteMaskingPolicyRequest{}
@@ -344,16 +345,20 @@ func sweepDataSafeMaskingPolicyResource(compartment string) error {
 			deleteMaskingPolicyRequest.MaskingPolicyId = &maskingPolicyId
 
 			deleteMaskingPolicyRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "data_safe")
-			_, error := dataSafeClient.DeleteMaskingPolicy(context.Background(), deleteMaskingPolicyRequest)
+			error := acctest.DeleteSweeperResource(compartment, "MaskingPolicyId", maskingPolicyId, func() error {
+				_, err := dataSafeClient.DeleteMaskingPolicy(context.Background(), deleteMaskingPolicyRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting MaskingPolicy %s %s, It is possible that the resource is already deleted. Please verify manually \n", maskingPolicyId, error)
+				fmt.Printf("Error deleting MaskingPolicy %s %s \n", maskingPolicyId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &maskingPolicyId, maskingPolicySweepWaitCondition, time.Duration(3*time.Minute),
 				maskingPolicySweepResponseFetchOperation, "data_safe", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getMaskingPolicyIds(compartment string) ([]string, error) {
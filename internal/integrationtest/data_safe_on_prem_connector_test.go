@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -276,10 +277,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DataSafeOnPremConnector") {
-		resource.AddTestSweepers("DataSafeOnPremConnector", &resource.Sweeper{
-			Name:         "DataSafeOnPremConnector",
-			Dependencies: acctest.DependencyGraph["onPremConnector"],
-			F:            sweepDataSafeOnPremConnectorResource,
+		acctest.AddTestSweepers("DataSafeOnPremConnector", &resource.Sweeper{
+			Name: "DataSafeOnPremConnector",
+			F:    sweepDataSafeOnPremConnectorResource,
 		})
 	}
 }
@@ -290,6 +290,7 @@ func sweepDataSafeOnPremConnectorResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, onPremConnectorId := range onPremConnectorIds {
 		if ok := acctest.SweeperDefaultResourceId[onPremConnectorId]; !ok {
 			deleteOnPremConnectorRequest := oci_data_safe.DeleteOnPremConnectorRequest{}
@@ -297,16 +298,20 @@ func sweepDataSafeOnPremConnectorResource(compartment string) error {
 			deleteOnPremConnectorRequest.OnPremConnectorId = &onPremConnectorId
 
 			deleteOnPremConnectorRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "data_safe")
-			_, error := dataSafeClient.DeleteOnPremConnector(context.Background(), deleteOnPremConnectorRequest)
+			error := acctest.DeleteSweeperResource(compartment, "OnPremConnectorId", onPremConnectorId, func() error {
+				_, err := dataSafeClient.DeleteOnPremConnector(context.Background(), deleteOnPremConnectorRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting OnPremConnector %s %s, It is possible that the resource is already deleted. Please verify manually \n", onPremConnectorId, error)
+				fmt.Printf("Error deleting OnPremConnector %s %s \n", onPremConnectorId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &onPremConnectorId, onPremConnectorSweepWaitCondition, time.Duration(3*time.Minute),
 				onPremConnectorSweepResponseFetchOperation, "data_safe", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getOnPremConnectorIds(compartment string) ([]string, error) {
@@ -17,6 +17,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -402,10 +403,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DataSafeReportDefinition") {
-		resource.AddTestSweepers("DataSafeReportDefinition", &resource.Sweeper{
-			Name:         "DataSafeReportDefinition",
-			Dependencies: acctest.DependencyGraph["reportDefinition"],
-			F:            sweepDataSafeReportDefinitionResource,
+		acctest.AddTestSweepers("DataSafeReportDefinition", &resource.Sweeper{
+			Name: "DataSafeReportDefinition",
+			F:    sweepDataSafeReportDefinitionResource,
 		})
 	}
 }
@@ -416,6 +416,7 @@ func sweepDataSafeReportDefinitionResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, reportDefinitionId := range reportDefinitionIds {
 		if ok := acctest.SweeperDefaultResourceId[reportDefinitionId]; !ok {
 			deleteReportDefinitionRequest := oci_data_safe.DeleteReportDefinitionRequest{}
@@ -423,16 +424,20 @@ func sweepDataSafeReportDefinitionResource(compartment string) error {
 			deleteReportDefinitionRequest.ReportDefinitionId = &reportDefinitionId
 
 			deleteReportDefinitionRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "data_safe")
-			_, error := dataSafeClient.DeleteReportDefinition(context.Background(), deleteReportDefinitionRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ReportDefinitionId", reportDefinitionId, func() error {
+				_, err := dataSafeClient.DeleteReportDefinition(context.Background(), deleteReportDefinitionRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ReportDefinition %s %s, It is possible that the resource is already deleted. Please verify manually \n", reportDefinitionId, error)
+				fmt.Printf("Error deleting ReportDefinition %s %s \n", reportDefinitionId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &reportDefinitionId, reportDefinitionSweepWaitCondition, time.Duration(3*time.Minute),
 				reportDefinitionSweepResponseFetchOperation, "data_safe", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getReportDefinitionIds(compartment string) ([]string, error) {
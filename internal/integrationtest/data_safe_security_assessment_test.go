@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -300,10 +301,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DataSafeSecurityAssessment") {
-		resource.AddTestSweepers("DataSafeSecurityAssessment", &resource.Sweeper{
-			Name:         "DataSafeSecurityAssessment",
-			Dependencies: acctest.DependencyGraph["securityassessment"],
-			F:            sweepDataSafeSecurityAssessmentResource,
+		acctest.AddTestSweepers("DataSafeSecurityAssessment", &resource.Sweeper{
+			Name: "DataSafeSecurityAssessment",
+			F:    sweepDataSafeSecurityAssessmentResource,
 		})
 	}
 }
@@ -314,6 +314,7 @@ func sweepDataSafeSecurityAssessmentResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, securityAssessmentId := range securityAssessmentIds {
 		if ok := acctest.SweeperDefaultResourceId[securityAssessmentId]; !ok {
 			deleteSecurityAssessmentRequest := oci_data_safe.DeleteSecurityAssessmentRequest{}
@@ -321,14 +322,18 @@ func sweepDataSafeSecurityAssessmentResource(compartment string) error {
 			deleteSecurityAssessmentRequest.SecurityAssessmentId = &securityAssessmentId
 
 			deleteSecurityAssessmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "data_safe")
-			_, error := dataSafeClient.DeleteSecurityAssessment(context.Background(), deleteSecurityAssessmentRequest)
+			error := acctest.DeleteSweeperResource(compartment, "SecurityAssessmentId", securityAssessmentId, func() error {
+				_, err := dataSafeClient.DeleteSecurityAssessment(context.Background(), deleteSecurityAssessmentRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting SecurityAssessment %s %s, It is possible that the resource is already deleted. Please verify manually \n", securityAssessmentId, error)
+				fmt.Printf("Error deleting SecurityAssessment %s %s \n", securityAssessmentId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getSecurityAssessmentIds(compartment string) ([]string, error) {
@@ -18,6 +18,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -304,10 +305,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DataSafeSensitiveDataModel") {
-		resource.AddTestSweepers("DataSafeSensitiveDataModel", &resource.Sweeper{
-			Name:         "DataSafeSensitiveDataModel",
-			Dependencies: acctest.DependencyGraph["sensitiveDataModel"],
-			F:            sweepDataSafeSensitiveDataModelResource,
+		acctest.AddTestSweepers("DataSafeSensitiveDataModel", &resource.Sweeper{
+			Name: "DataSafeSensitiveDataModel",
+			F:    sweepDataSafeSensitiveDataModelResource,
 		})
 	}
 }
@@ -318,6 +318,7 @@ func sweepDataSafeSensitiveDataModelResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, sensitiveDataModelId := range sensitiveDataModelIds {
 		if ok := acctest.SweeperDefaultResourceId[sensitiveDataModelId]; !ok {
 			deleteSensitiveDataModelRequest := oci_data_safe.DeleteSensitiveDataModelRequest{}
@@ -325,16 +326,20 @@ func sweepDataSafeSensitiveDataModelResource(compartment string) error {
 			deleteSensitiveDataModelRequest.SensitiveDataModelId = &sensitiveDataModelId
 
 			deleteSensitiveDataModelRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "data_safe")
-			_, error := dataSafeClient.DeleteSensitiveDataModel(context.Background(), deleteSensitiveDataModelRequest)
+			error := acctest.DeleteSweeperResource(compartment, "SensitiveDataModelId", sensitiveDataModelId, func() error {
+				_, err := dataSafeClient.DeleteSensitiveDataModel(context.Background(), deleteSensitiveDataModelRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting SensitiveDataModel %s %s, It is possible that the resource is already deleted. Please verify manually \n", sensitiveDataModelId, error)
+				fmt.Printf("Error deleting SensitiveDataModel %s %s \n", sensitiveDataModelId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &sensitiveDataModelId, sensitiveDataModelSweepWaitCondition, time.Duration(3*time.Minute),
 				sensitiveDataModelSweepResponseFetchOperation, "data_safe", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getSensitiveDataModelIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -267,10 +268,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DataSafeSensitiveDataModelsSensitiveColumn") {
-		resource.AddTestSweepers("DataSafeSensitiveDataModelsSensitiveColumn", &resource.Sweeper{
-			Name:         "DataSafeSensitiveDataModelsSensitiveColumn",
-			Dependencies: acctest.DependencyGraph["sensitiveDataModelsSensitiveColumn"],
-			F:            sweepDataSafeSensitiveDataModelsSensitiveColumnResource,
+		acctest.AddTestSweepers("DataSafeSensitiveDataModelsSensitiveColumn", &resource.Sweeper{
+			Name: "DataSafeSensitiveDataModelsSensitiveColumn",
+			F:    sweepDataSafeSensitiveDataModelsSensitiveColumnResource,
 		})
 	}
 }
@@ -281,19 +281,24 @@ func sweepDataSafeSensitiveDataModelsSensitiveColumnResource(compartment string)
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, sensitiveDataModelsSensitiveColumnId := range sensitiveDataModelsSensitiveColumnIds {
 		if ok := acctest.SweeperDefaultResourceId[sensitiveDataModelsSensitiveColumnId]; !ok {
 			deleteSensitiveColumnRequest := oci_data_safe.DeleteSensitiveColumnRequest{}
 
 			deleteSensitiveColumnRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "data_safe")
-			_, error := dataSafeClient.DeleteSensitiveColumn(context.Background(), deleteSensitiveColumnRequest)
+			error := acctest.DeleteSweeperResource(compartment, "SensitiveDataModelsSensitiveColumnId", sensitiveDataModelsSensitiveColumnId, func() error {
+				_, err := dataSafeClient.DeleteSensitiveColumn(context.Background(), deleteSensitiveColumnRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting SensitiveDataModelsSensitiveColumn %s %s, It is possible that the resource is already deleted. Please verify manually \n", sensitiveDataModelsSensitiveColumnId, error)
+				fmt.Printf("Error deleting SensitiveDataModelsSensitiveColumn %s %s \n", sensitiveDataModelsSensitiveColumnId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getSensitiveDataModelsSensitiveColumnIds(compartment string) ([]string, error) {
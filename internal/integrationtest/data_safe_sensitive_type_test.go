@@ -17,6 +17,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -309,10 +310,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DataSafeSensitiveType") {
-		resource.AddTestSweepers("DataSafeSensitiveType", &resource.Sweeper{
-			Name:         "DataSafeSensitiveType",
-			Dependencies: acctest.DependencyGraph["sensitiveType"],
-			F:            sweepDataSafeSensitiveTypeResource,
+		acctest.AddTestSweepers("DataSafeSensitiveType", &resource.Sweeper{
+			Name: "DataSafeSensitiveType",
+			F:    sweepDataSafeSensitiveTypeResource,
 		})
 	}
 }
@@ -323,6 +323,7 @@ func sweepDataSafeSensitiveTypeResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, sensitiveTypeId := range sensitiveTypeIds {
 		if ok := acctest.SweeperDefaultResourceId[sensitiveTypeId]; !ok {
 			deleteSensitiveTypeRequest := oci_data_safe.DeleteSensitiveTypeRequest{}
@@ -330,16 +331,20 @@ func sweepDataSafeSensitiveTypeResource(compartment string) error {
 			deleteSensitiveTypeRequest.SensitiveTypeId = &sensitiveTypeId
 
 			deleteSensitiveTypeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "data_safe")
-			_, error := dataSafeClient.DeleteSensitiveType(context.Background(), deleteSensitiveTypeRequest)
+			error := acctest.DeleteSweeperResource(compartment, "SensitiveTypeId", sensitiveTypeId, func() error {
+				_, err := dataSafeClient.DeleteSensitiveType(context.Background(), deleteSensitiveTypeRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting SensitiveType %s %s, It is possible that the resource is already deleted. Please verify manually \n", sensitiveTypeId, error)
+				fmt.Printf("Error deleting SensitiveType %s %s \n", sensitiveTypeId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &sensitiveTypeId, sensitiveTypeSweepWaitCondition, time.Duration(3*time.Minute),
 				sensitiveTypeSweepResponseFetchOperation, "data_safe", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getSensitiveTypeIds(compartment string) ([]string, error) {
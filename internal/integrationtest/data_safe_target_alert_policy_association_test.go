@@ -17,6 +17,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/acctest"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -300,10 +301,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DataSafeTargetAlertPolicyAssociation") {
-		resource.AddTestSweepers("DataSafeTargetAlertPolicyAssociation", &resource.Sweeper{
-			Name:         "DataSafeTargetAlertPolicyAssociation",
-			Dependencies: acctest.DependencyGraph["targetAlertPolicyAssociation"],
-			F:            sweepDataSafeTargetAlertPolicyAssociationResource,
+		acctest.AddTestSweepers("DataSafeTargetAlertPolicyAssociation", &resource.Sweeper{
+			Name: "DataSafeTargetAlertPolicyAssociation",
+			F:    sweepDataSafeTargetAlertPolicyAssociationResource,
 		})
 	}
 }
@@ -314,6 +314,7 @@ func sweepDataSafeTargetAlertPolicyAssociationResource(compartment string) error
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, targetAlertPolicyAssociationId := range targetAlertPolicyAssociationIds {
 		if ok := acctest.SweeperDefaultResourceId[targetAlertPolicyAssociationId]; !ok {
 			deleteTargetAlertPolicyAssociationRequest := oci_data_safe.DeleteTargetAlertPolicyAssociationRequest{}
@@ -321,16 +322,20 @@ func sweepDataSafeTargetAlertPolicyAssociationResource(compartment string) error
 			deleteTargetAlertPolicyAssociationRequest.TargetAlertPolicyAssociationId = &targetAlertPolicyAssociationId
 
 			deleteTargetAlertPolicyAssociationRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "data_safe")
-			_, error := dataSafeClient.DeleteTargetAlertPolicyAssociation(context.Background(), deleteTargetAlertPolicyAssociationRequest)
+			error := acctest.DeleteSweeperResource(compartment, "TargetAlertPolicyAssociationId", targetAlertPolicyAssociationId, func() error {
+				_, err := dataSafeClient.DeleteTargetAlertPolicyAssociation(context.Background(), deleteTargetAlertPolicyAssociationRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting TargetAlertPolicyAssociation %s %s, It is possible that the resource is already deleted. Please verify manually \n", targetAlertPolicyAssociationId, error)
+				fmt.Printf("Error deleting TargetAlertPolicyAssociation %s %s \n", targetAlertPolicyAssociationId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &targetAlertPolicyAssociationId, targetAlertPolicyAssociationSweepWaitCondition, time.Duration(3*time.Minute),
 				targetAlertPolicyAssociationSweepResponseFetchOperation, "data_safe", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getTargetAlertPolicyAssociationIds(compartment string) ([]string, error) {
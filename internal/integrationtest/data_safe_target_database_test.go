@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -330,10 +331,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DataSafeTargetDatabase") {
-		resource.AddTestSweepers("DataSafeTargetDatabase", &resource.Sweeper{
-			Name:         "DataSafeTargetDatabase",
-			Dependencies: acctest.DependencyGraph["targetDatabase"],
-			F:            sweepDataSafeTargetDatabaseResource,
+		acctest.AddTestSweepers("DataSafeTargetDatabase", &resource.Sweeper{
+			Name: "DataSafeTargetDatabase",
+			F:    sweepDataSafeTargetDatabaseResource,
 		})
 	}
 }
@@ -344,6 +344,7 @@ func sweepDataSafeTargetDatabaseResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, targetDatabaseId := range targetDatabaseIds {
 		if ok := acctest.SweeperDefaultResourceId[targetDatabaseId]; !ok {
 			deleteTargetDatabaseRequest := oci_data_safe.DeleteTargetDatabaseRequest{}
@@ -351,16 +352,20 @@ func sweepDataSafeTargetDatabaseResource(compartment string) error {
 			deleteTargetDatabaseRequest.TargetDatabaseId = &targetDatabaseId
 
 			deleteTargetDatabaseRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "data_safe")
-			_, error := dataSafeClient.DeleteTargetDatabase(context.Background(), deleteTargetDatabaseRequest)
+			error := acctest.DeleteSweeperResource(compartment, "TargetDatabaseId", targetDatabaseId, func() error {
+				_, err := dataSafeClient.DeleteTargetDatabase(context.Background(), deleteTargetDatabaseRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting TargetDatabase %s %s, It is possible that the resource is already deleted. Please verify manually \n", targetDatabaseId, error)
+				fmt.Printf("Error deleting TargetDatabase %s %s \n", targetDatabaseId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &targetDatabaseId, targetDatabaseSweepWaitCondition, time.Duration(3*time.Minute),
 				targetDatabaseSweepResponseFetchOperation, "data_safe", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getTargetDatabaseIds(compartment string) ([]string, error) {
@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -293,10 +294,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DataSafeUserAssessment") {
-		resource.AddTestSweepers("DataSafeUserAssessment", &resource.Sweeper{
-			Name:         "DataSafeUserAssessment",
-			Dependencies: acctest.DependencyGraph["userassessment"],
-			F:            sweepDataSafeUserAssessmentResource,
+		acctest.AddTestSweepers("DataSafeUserAssessment", &resource.Sweeper{
+			Name: "DataSafeUserAssessment",
+			F:    sweepDataSafeUserAssessmentResource,
 		})
 	}
 }
@@ -307,6 +307,7 @@ func sweepDataSafeUserAssessmentResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, userAssessmentId := range userAssessmentIds {
 		if ok := acctest.SweeperDefaultResourceId[userAssessmentId]; !ok {
 			deleteUserAssessmentRequest := oci_data_safe.DeleteUserAssessmentRequest{}
@@ -314,14 +315,18 @@ func sweepDataSafeUserAssessmentResource(compartment string) error {
 			deleteUserAssessmentRequest.UserAssessmentId = &userAssessmentId
 
 			deleteUserAssessmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "data_safe")
-			_, error := dataSafeClient.DeleteUserAssessment(context.Background(), deleteUserAssessmentRequest)
+			error := acctest.DeleteSweeperResource(compartment, "UserAssessmentId", userAssessmentId, func() error {
+				_, err := dataSafeClient.DeleteUserAssessment(context.Background(), deleteUserAssessmentRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting UserAssessment %s %s, It is possible that the resource is already deleted. Please verify manually \n", userAssessmentId, error)
+				fmt.Printf("Error deleting UserAssessment %s %s \n", userAssessmentId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getUserAssessmentIds(compartment string) ([]string, error) {
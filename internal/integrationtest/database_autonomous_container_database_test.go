@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -492,10 +493,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseAutonomousContainerDatabase") {
-		resource.AddTestSweepers("DatabaseAutonomousContainerDatabase", &resource.Sweeper{
-			Name:         "DatabaseAutonomousContainerDatabase",
-			Dependencies: acctest.DependencyGraph["autonomousContainerDatabase"],
-			F:            sweepDatabaseAutonomousContainerDatabaseResource,
+		acctest.AddTestSweepers("DatabaseAutonomousContainerDatabase", &resource.Sweeper{
+			Name: "DatabaseAutonomousContainerDatabase",
+			F:    sweepDatabaseAutonomousContainerDatabaseResource,
 		})
 	}
 }
@@ -506,6 +506,7 @@ func sweepDatabaseAutonomousContainerDatabaseResource(compartment string) error
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, autonomousContainerDatabaseId := range autonomousContainerDatabaseIds {
 		if ok := acctest.SweeperDefaultResourceId[autonomousContainerDatabaseId]; !ok {
 			terminateAutonomousContainerDatabaseRequest := oci_database.TerminateAutonomousContainerDatabaseRequest{}
@@ -513,16 +514,20 @@ func sweepDatabaseAutonomousContainerDatabaseResource(compartment string) error
 			terminateAutonomousContainerDatabaseRequest.AutonomousContainerDatabaseId = &autonomousContainerDatabaseId
 
 			terminateAutonomousContainerDatabaseRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
-			_, error := databaseClient.TerminateAutonomousContainerDatabase(context.Background(), terminateAutonomousContainerDatabaseRequest)
+			error := acctest.DeleteSweeperResource(compartment, "AutonomousContainerDatabaseId", autonomousContainerDatabaseId, func() error {
+				_, err := databaseClient.TerminateAutonomousContainerDatabase(context.Background(), terminateAutonomousContainerDatabaseRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting AutonomousContainerDatabase %s %s, It is possible that the resource is already deleted. Please verify manually \n", autonomousContainerDatabaseId, error)
+				fmt.Printf("Error deleting AutonomousContainerDatabase %s %s \n", autonomousContainerDatabaseId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &autonomousContainerDatabaseId, autonomousContainerDatabaseSweepWaitCondition, time.Duration(3*time.Minute),
 				autonomousContainerDatabaseSweepResponseFetchOperation, "database", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getAutonomousContainerDatabaseIds(compartment string) ([]string, error) {
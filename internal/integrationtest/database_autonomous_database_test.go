@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -929,10 +930,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseAutonomousDatabase") {
-		resource.AddTestSweepers("DatabaseAutonomousDatabase", &resource.Sweeper{
-			Name:         "DatabaseAutonomousDatabase",
-			Dependencies: acctest.DependencyGraph["autonomousDatabase"],
-			F:            sweepDatabaseAutonomousDatabaseResource,
+		acctest.AddTestSweepers("DatabaseAutonomousDatabase", &resource.Sweeper{
+			Name: "DatabaseAutonomousDatabase",
+			F:    sweepDatabaseAutonomousDatabaseResource,
 		})
 	}
 }
@@ -943,6 +943,7 @@ func sweepDatabaseAutonomousDatabaseResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, autonomousDatabaseId := range autonomousDatabaseIds {
 		if ok := acctest.SweeperDefaultResourceId[autonomousDatabaseId]; !ok {
 			deleteAutonomousDatabaseRequest := oci_database.DeleteAutonomousDatabaseRequest{}
@@ -950,16 +951,20 @@ func sweepDatabaseAutonomousDatabaseResource(compartment string) error {
 			deleteAutonomousDatabaseRequest.AutonomousDatabaseId = &autonomousDatabaseId
 
 			deleteAutonomousDatabaseRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
-			_, error := databaseClient.DeleteAutonomousDatabase(context.Background(), deleteAutonomousDatabaseRequest)
+			error := acctest.DeleteSweeperResource(compartment, "AutonomousDatabaseId", autonomousDatabaseId, func() error {
+				_, err := databaseClient.DeleteAutonomousDatabase(context.Background(), deleteAutonomousDatabaseRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting AutonomousDatabase %s %s, It is possible that the resource is already deleted. Please verify manually \n", autonomousDatabaseId, error)
+				fmt.Printf("Error deleting AutonomousDatabase %s %s \n", autonomousDatabaseId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &autonomousDatabaseId, autonomousDatabaseSweepWaitCondition, time.Duration(3*time.Minute),
 				autonomousDatabaseSweepResponseFetchOperation, "database", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getAutonomousDatabaseIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -375,10 +376,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseAutonomousExadataInfrastructure") {
-		resource.AddTestSweepers("DatabaseAutonomousExadataInfrastructure", &resource.Sweeper{
-			Name:         "DatabaseAutonomousExadataInfrastructure",
-			Dependencies: acctest.DependencyGraph["autonomousExadataInfrastructure"],
-			F:            sweepDatabaseAutonomousExadataInfrastructureResource,
+		acctest.AddTestSweepers("DatabaseAutonomousExadataInfrastructure", &resource.Sweeper{
+			Name: "DatabaseAutonomousExadataInfrastructure",
+			F:    sweepDatabaseAutonomousExadataInfrastructureResource,
 		})
 	}
 }
@@ -389,6 +389,7 @@ func sweepDatabaseAutonomousExadataInfrastructureResource(compartment string) er
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, autonomousExadataInfrastructureId := range autonomousExadataInfrastructureIds {
 		if ok := acctest.SweeperDefaultResourceId[autonomousExadataInfrastructureId]; !ok {
 			terminateAutonomousExadataInfrastructureRequest := oci_database.TerminateAutonomousExadataInfrastructureRequest{}
@@ -396,16 +397,20 @@ func sweepDatabaseAutonomousExadataInfrastructureResource(compartment string) er
 			terminateAutonomousExadataInfrastructureRequest.AutonomousExadataInfrastructureId = &autonomousExadataInfrastructureId
 
 			terminateAutonomousExadataInfrastructureRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
-			_, error := databaseClient.TerminateAutonomousExadataInfrastructure(context.Background(), terminateAutonomousExadataInfrastructureRequest)
+			error := acctest.DeleteSweeperResource(compartment, "AutonomousExadataInfrastructureId", autonomousExadataInfrastructureId, func() error {
+				_, err := databaseClient.TerminateAutonomousExadataInfrastructure(context.Background(), terminateAutonomousExadataInfrastructureRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting AutonomousExadataInfrastructure %s %s, It is possible that the resource is already deleted. Please verify manually \n", autonomousExadataInfrastructureId, error)
+				fmt.Printf("Error deleting AutonomousExadataInfrastructure %s %s \n", autonomousExadataInfrastructureId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &autonomousExadataInfrastructureId, autonomousExadataInfrastructureSweepWaitCondition, time.Duration(3*time.Minute),
 				autonomousExadataInfrastructureSweepResponseFetchOperation, "database", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getAutonomousExadataInfrastructureIds(compartment string) ([]string, error) {
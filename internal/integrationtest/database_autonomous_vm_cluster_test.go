@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -415,10 +416,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseAutonomousVmCluster") {
-		resource.AddTestSweepers("DatabaseAutonomousVmCluster", &resource.Sweeper{
-			Name:         "DatabaseAutonomousVmCluster",
-			Dependencies: acctest.DependencyGraph["autonomousVmCluster"],
-			F:            sweepDatabaseAutonomousVmClusterResource,
+		acctest.AddTestSweepers("DatabaseAutonomousVmCluster", &resource.Sweeper{
+			Name: "DatabaseAutonomousVmCluster",
+			F:    sweepDatabaseAutonomousVmClusterResource,
 		})
 	}
 }
@@ -429,6 +429,7 @@ func sweepDatabaseAutonomousVmClusterResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, autonomousVmClusterId := range autonomousVmClusterIds {
 		if ok := acctest.SweeperDefaultResourceId[autonomousVmClusterId]; !ok {
 			deleteAutonomousVmClusterRequest := oci_database.DeleteAutonomousVmClusterRequest{}
@@ -436,16 +437,20 @@ func sweepDatabaseAutonomousVmClusterResource(compartment string) error {
 			deleteAutonomousVmClusterRequest.AutonomousVmClusterId = &autonomousVmClusterId
 
 			deleteAutonomousVmClusterRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
-			_, error := databaseClient.DeleteAutonomousVmCluster(context.Background(), deleteAutonomousVmClusterRequest)
+			error := acctest.DeleteSweeperResource(compartment, "AutonomousVmClusterId", autonomousVmClusterId, func() error {
+				_, err := databaseClient.DeleteAutonomousVmCluster(context.Background(), deleteAutonomousVmClusterRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting AutonomousVmCluster %s %s, It is possible that the resource is already deleted. Please verify manually \n", autonomousVmClusterId, error)
+				fmt.Printf("Error deleting AutonomousVmCluster %s %s \n", autonomousVmClusterId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &autonomousVmClusterId, autonomousVmClusterSweepWaitCondition, time.Duration(3*time.Minute),
 				autonomousVmClusterSweepResponseFetchOperation, "database", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getAutonomousVmClusterIds(compartment string) ([]string, error) {
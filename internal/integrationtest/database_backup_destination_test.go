@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -251,10 +252,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseBackupDestination") {
-		resource.AddTestSweepers("DatabaseBackupDestination", &resource.Sweeper{
-			Name:         "DatabaseBackupDestination",
-			Dependencies: acctest.DependencyGraph["backupDestination"],
-			F:            sweepDatabaseBackupDestinationResource,
+		acctest.AddTestSweepers("DatabaseBackupDestination", &resource.Sweeper{
+			Name: "DatabaseBackupDestination",
+			F:    sweepDatabaseBackupDestinationResource,
 		})
 	}
 }
@@ -265,6 +265,7 @@ func sweepDatabaseBackupDestinationResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, backupDestinationId := range backupDestinationIds {
 		if ok := acctest.SweeperDefaultResourceId[backupDestinationId]; !ok {
 			deleteBackupDestinationRequest := oci_database.DeleteBackupDestinationRequest{}
@@ -272,16 +273,20 @@ func sweepDatabaseBackupDestinationResource(compartment string) error {
 			deleteBackupDestinationRequest.BackupDestinationId = &backupDestinationId
 
 			deleteBackupDestinationRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
-			_, error := databaseClient.DeleteBackupDestination(context.Background(), deleteBackupDestinationRequest)
+			error := acctest.DeleteSweeperResource(compartment, "BackupDestinationId", backupDestinationId, func() error {
+				_, err := databaseClient.DeleteBackupDestination(context.Background(), deleteBackupDestinationRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting BackupDestination %s %s, It is possible that the resource is already deleted. Please verify manually \n", backupDestinationId, error)
+				fmt.Printf("Error deleting BackupDestination %s %s \n", backupDestinationId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &backupDestinationId, backupDestinationSweepWaitCondition, time.Duration(3*time.Minute),
 				backupDestinationSweepResponseFetchOperation, "database", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getBackupDestinationIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -183,10 +184,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseBackup") {
-		resource.AddTestSweepers("DatabaseBackup", &resource.Sweeper{
-			Name:         "DatabaseBackup",
-			Dependencies: acctest.DependencyGraph["backup"],
-			F:            sweepDatabaseBackupResource,
+		acctest.AddTestSweepers("DatabaseBackup", &resource.Sweeper{
+			Name: "DatabaseBackup",
+			F:    sweepDatabaseBackupResource,
 		})
 	}
 }
@@ -197,6 +197,7 @@ func sweepDatabaseBackupResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, backupId := range backupIds {
 		if ok := acctest.SweeperDefaultResourceId[backupId]; !ok {
 			deleteBackupRequest := oci_database.DeleteBackupRequest{}
@@ -204,16 +205,20 @@ func sweepDatabaseBackupResource(compartment string) error {
 			deleteBackupRequest.BackupId = &backupId
 
 			deleteBackupRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
-			_, error := databaseClient.DeleteBackup(context.Background(), deleteBackupRequest)
+			error := acctest.DeleteSweeperResource(compartment, "BackupId", backupId, func() error {
+				_, err := databaseClient.DeleteBackup(context.Background(), deleteBackupRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Backup %s %s, It is possible that the resource is already deleted. Please verify manually \n", backupId, error)
+				fmt.Printf("Error deleting Backup %s %s \n", backupId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &backupId, backupSweepWaitCondition, time.Duration(3*time.Minute),
 				backupSweepResponseFetchOperation, "database", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getBackupIds(compartment string) ([]string, error) {
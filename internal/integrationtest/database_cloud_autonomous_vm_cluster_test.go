@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -449,10 +450,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseCloudAutonomousVmCluster") {
-		resource.AddTestSweepers("DatabaseCloudAutonomousVmCluster", &resource.Sweeper{
-			Name:         "DatabaseCloudAutonomousVmCluster",
-			Dependencies: acctest.DependencyGraph["cloudAutonomousVmCluster"],
-			F:            sweepDatabaseCloudAutonomousVmClusterResource,
+		acctest.AddTestSweepers("DatabaseCloudAutonomousVmCluster", &resource.Sweeper{
+			Name: "DatabaseCloudAutonomousVmCluster",
+			F:    sweepDatabaseCloudAutonomousVmClusterResource,
 		})
 	}
 }
@@ -463,6 +463,7 @@ func sweepDatabaseCloudAutonomousVmClusterResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, cloudAutonomousVmClusterId := range cloudAutonomousVmClusterIds {
 		if ok := acctest.SweeperDefaultResourceId[cloudAutonomousVmClusterId]; !ok {
 			deleteCloudAutonomousVmClusterRequest := oci_database.DeleteCloudAutonomousVmClusterRequest{}
@@ -470,16 +471,20 @@ func sweepDatabaseCloudAutonomousVmClusterResource(compartment string) error {
 			deleteCloudAutonomousVmClusterRequest.CloudAutonomousVmClusterId = &cloudAutonomousVmClusterId
 
 			deleteCloudAutonomousVmClusterRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
-			_, error := databaseClient.DeleteCloudAutonomousVmCluster(context.Background(), deleteCloudAutonomousVmClusterRequest)
+			error := acctest.DeleteSweeperResource(compartment, "CloudAutonomousVmClusterId", cloudAutonomousVmClusterId, func() error {
+				_, err := databaseClient.DeleteCloudAutonomousVmCluster(context.Background(), deleteCloudAutonomousVmClusterRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting CloudAutonomousVmCluster %s %s, It is possible that the resource is already deleted. Please verify manually \n", cloudAutonomousVmClusterId, error)
+				fmt.Printf("Error deleting CloudAutonomousVmCluster %s %s \n", cloudAutonomousVmClusterId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &cloudAutonomousVmClusterId, cloudAutonomousVmClusterSweepWaitCondition, time.Duration(3*time.Minute),
 				cloudAutonomousVmClusterSweepResponseFetchOperation, "database", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getCloudAutonomousVmClusterIds(compartment string) ([]string, error) {
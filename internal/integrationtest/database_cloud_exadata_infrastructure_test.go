@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -394,10 +395,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseCloudExadataInfrastructure") {
-		resource.AddTestSweepers("DatabaseCloudExadataInfrastructure", &resource.Sweeper{
-			Name:         "DatabaseCloudExadataInfrastructure",
-			Dependencies: acctest.DependencyGraph["cloudExadataInfrastructure"],
-			F:            sweepDatabaseCloudExadataInfrastructureResource,
+		acctest.AddTestSweepers("DatabaseCloudExadataInfrastructure", &resource.Sweeper{
+			Name: "DatabaseCloudExadataInfrastructure",
+			F:    sweepDatabaseCloudExadataInfrastructureResource,
 		})
 	}
 }
@@ -408,6 +408,7 @@ func sweepDatabaseCloudExadataInfrastructureResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, cloudExadataInfrastructureId := range cloudExadataInfrastructureIds {
 		if ok := acctest.SweeperDefaultResourceId[cloudExadataInfrastructureId]; !ok {
 			deleteCloudExadataInfrastructureRequest := oci_database.DeleteCloudExadataInfrastructureRequest{}
@@ -415,16 +416,20 @@ func sweepDatabaseCloudExadataInfrastructureResource(compartment string) error {
 			deleteCloudExadataInfrastructureRequest.CloudExadataInfrastructureId = &cloudExadataInfrastructureId
 
 			deleteCloudExadataInfrastructureRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
-			_, error := databaseClient.DeleteCloudExadataInfrastructure(context.Background(), deleteCloudExadataInfrastructureRequest)
+			error := acctest.DeleteSweeperResource(compartment, "CloudExadataInfrastructureId", cloudExadataInfrastructureId, func() error {
+				_, err := databaseClient.DeleteCloudExadataInfrastructure(context.Background(), deleteCloudExadataInfrastructureRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting CloudExadataInfrastructure %s %s, It is possible that the resource is already deleted. Please verify manually \n", cloudExadataInfrastructureId, error)
+				fmt.Printf("Error deleting CloudExadataInfrastructure %s %s \n", cloudExadataInfrastructureId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &cloudExadataInfrastructureId, cloudExadataInfrastructureSweepWaitCondition, time.Duration(3*time.Minute),
 				cloudExadataInfrastructureSweepResponseFetchOperation, "database", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getCloudExadataInfrastructureIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -497,10 +498,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseCloudVmCluster") {
-		resource.AddTestSweepers("DatabaseCloudVmCluster", &resource.Sweeper{
-			Name:         "DatabaseCloudVmCluster",
-			Dependencies: acctest.DependencyGraph["cloudVmCluster"],
-			F:            sweepDatabaseCloudVmClusterResource,
+		acctest.AddTestSweepers("DatabaseCloudVmCluster", &resource.Sweeper{
+			Name: "DatabaseCloudVmCluster",
+			F:    sweepDatabaseCloudVmClusterResource,
 		})
 	}
 }
@@ -511,6 +511,7 @@ func sweepDatabaseCloudVmClusterResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, cloudVmClusterId := range cloudVmClusterIds {
 		if ok := acctest.SweeperDefaultResourceId[cloudVmClusterId]; !ok {
 			deleteCloudVmClusterRequest := oci_database.DeleteCloudVmClusterRequest{}
@@ -518,16 +519,20 @@ func sweepDatabaseCloudVmClusterResource(compartment string) error {
 			deleteCloudVmClusterRequest.CloudVmClusterId = &cloudVmClusterId
 
 			deleteCloudVmClusterRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
-			_, error := databaseClient.DeleteCloudVmCluster(context.Background(), deleteCloudVmClusterRequest)
+			error := acctest.DeleteSweeperResource(compartment, "CloudVmClusterId", cloudVmClusterId, func() error {
+				_, err := databaseClient.DeleteCloudVmCluster(context.Background(), deleteCloudVmClusterRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting CloudVmCluster %s %s, It is possible that the resource is already deleted. Please verify manually \n", cloudVmClusterId, error)
+				fmt.Printf("Error deleting CloudVmCluster %s %s \n", cloudVmClusterId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &cloudVmClusterId, cloudVmClusterSweepWaitCondition, time.Duration(3*time.Minute),
 				cloudVmClusterSweepResponseFetchOperation, "database", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getCloudVmClusterIds(compartment string) ([]string, error) {
@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -251,10 +252,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseDatabaseSoftwareImageForExaccShape") {
-		resource.AddTestSweepers("DatabaseDatabaseSoftwareImageForExaccShape", &resource.Sweeper{
-			Name:         "DatabaseDatabaseSoftwareImageForExaccShape",
-			Dependencies: acctest.DependencyGraph["databaseSoftwareImageForExaccShape"],
-			F:            sweepDatabaseDatabaseSoftwareImageResourceForExaccShape,
+		acctest.AddTestSweepers("DatabaseDatabaseSoftwareImageForExaccShape", &resource.Sweeper{
+			Name: "DatabaseDatabaseSoftwareImageForExaccShape",
+			F:    sweepDatabaseDatabaseSoftwareImageResourceForExaccShape,
 		})
 	}
 }
@@ -265,6 +265,7 @@ func sweepDatabaseDatabaseSoftwareImageResourceForExaccShape(compartment string)
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, databaseSoftwareImageId := range databaseSoftwareImageIds {
 		if ok := acctest.SweeperDefaultResourceId[databaseSoftwareImageId]; !ok {
 			deleteDatabaseSoftwareImageRequest := oci_database.DeleteDatabaseSoftwareImageRequest{}
@@ -272,16 +273,20 @@ func sweepDatabaseDatabaseSoftwareImageResourceForExaccShape(compartment string)
 			deleteDatabaseSoftwareImageRequest.DatabaseSoftwareImageId = &databaseSoftwareImageId
 
 			deleteDatabaseSoftwareImageRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
-			_, error := databaseClient.DeleteDatabaseSoftwareImage(context.Background(), deleteDatabaseSoftwareImageRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DatabaseSoftwareImageId", databaseSoftwareImageId, func() error {
+				_, err := databaseClient.DeleteDatabaseSoftwareImage(context.Background(), deleteDatabaseSoftwareImageRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting DatabaseSoftwareImage %s %s, It is possible that the resource is already deleted. Please verify manually \n", databaseSoftwareImageId, error)
+				fmt.Printf("Error deleting DatabaseSoftwareImage %s %s \n", databaseSoftwareImageId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &databaseSoftwareImageId, databaseSoftwareImageSweepWaitConditionForExacc, time.Duration(3*time.Minute),
 				databaseSoftwareImageSweepResponseFetchOperationForExacc, "database", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDatabaseSoftwareImageIdsForExaccShape(compartment string) ([]string, error) {
@@ -605,10 +610,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseDatabaseSoftwareImageExa") {
-		resource.AddTestSweepers("DatabaseDatabaseSoftwareImageExa", &resource.Sweeper{
-			Name:         "DatabaseDatabaseSoftwareImageExa",
-			Dependencies: acctest.DependencyGraph["databaseSoftwareImageExa"],
-			F:            sweepDatabaseDatabaseSoftwareImageResourceExa,
+		acctest.AddTestSweepers("DatabaseDatabaseSoftwareImageExa", &resource.Sweeper{
+			Name: "DatabaseDatabaseSoftwareImageExa",
+			F:    sweepDatabaseDatabaseSoftwareImageResourceExa,
 		})
 	}
 }
@@ -619,6 +623,7 @@ func sweepDatabaseDatabaseSoftwareImageResourceExa(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, databaseSoftwareImageId := range databaseSoftwareImageIds {
 		if ok := acctest.SweeperDefaultResourceId[databaseSoftwareImageId]; !ok {
 			deleteDatabaseSoftwareImageRequest := oci_database.DeleteDatabaseSoftwareImageRequest{}
@@ -626,16 +631,20 @@ func sweepDatabaseDatabaseSoftwareImageResourceExa(compartment string) error {
 			deleteDatabaseSoftwareImageRequest.DatabaseSoftwareImageId = &databaseSoftwareImageId
 
 			deleteDatabaseSoftwareImageRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
-			_, error := databaseClient.DeleteDatabaseSoftwareImage(context.Background(), deleteDatabaseSoftwareImageRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DatabaseSoftwareImageId", databaseSoftwareImageId, func() error {
+				_, err := databaseClient.DeleteDatabaseSoftwareImage(context.Background(), deleteDatabaseSoftwareImageRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting DatabaseSoftwareImage %s %s, It is possible that the resource is already deleted. Please verify manually \n", databaseSoftwareImageId, error)
+				fmt.Printf("Error deleting DatabaseSoftwareImage %s %s \n", databaseSoftwareImageId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &databaseSoftwareImageId, databaseSoftwareImageSweepWaitConditionExa, time.Duration(3*time.Minute),
 				databaseSoftwareImageSweepResponseFetchOperationExa, "database", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDatabaseSoftwareImageIdsExa(compartment string) ([]string, error) {
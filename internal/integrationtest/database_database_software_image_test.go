@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -306,10 +307,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseDatabaseSoftwareImage") {
-		resource.AddTestSweepers("DatabaseDatabaseSoftwareImage", &resource.Sweeper{
-			Name:         "DatabaseDatabaseSoftwareImage",
-			Dependencies: acctest.DependencyGraph["databaseSoftwareImage"],
-			F:            sweepDatabaseDatabaseSoftwareImageResource,
+		acctest.AddTestSweepers("DatabaseDatabaseSoftwareImage", &resource.Sweeper{
+			Name: "DatabaseDatabaseSoftwareImage",
+			F:    sweepDatabaseDatabaseSoftwareImageResource,
 		})
 	}
 }
@@ -320,6 +320,7 @@ func sweepDatabaseDatabaseSoftwareImageResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, databaseSoftwareImageId := range databaseSoftwareImageIds {
 		if ok := acctest.SweeperDefaultResourceId[databaseSoftwareImageId]; !ok {
 			deleteDatabaseSoftwareImageRequest := oci_database.DeleteDatabaseSoftwareImageRequest{}
@@ -327,16 +328,20 @@ func sweepDatabaseDatabaseSoftwareImageResource(compartment string) error {
 			deleteDatabaseSoftwareImageRequest.DatabaseSoftwareImageId = &databaseSoftwareImageId
 
 			deleteDatabaseSoftwareImageRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
-			_, error := databaseClient.DeleteDatabaseSoftwareImage(context.Background(), deleteDatabaseSoftwareImageRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DatabaseSoftwareImageId", databaseSoftwareImageId, func() error {
+				_, err := databaseClient.DeleteDatabaseSoftwareImage(context.Background(), deleteDatabaseSoftwareImageRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting DatabaseSoftwareImage %s %s, It is possible that the resource is already deleted. Please verify manually \n", databaseSoftwareImageId, error)
+				fmt.Printf("Error deleting DatabaseSoftwareImage %s %s \n", databaseSoftwareImageId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &databaseSoftwareImageId, databaseSoftwareImageSweepWaitCondition, time.Duration(3*time.Minute),
 				databaseSoftwareImageSweepResponseFetchOperation, "database", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDatabaseSoftwareImageIds(compartment string) ([]string, error) {
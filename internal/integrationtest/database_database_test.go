@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -426,10 +427,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseDatabase") {
-		resource.AddTestSweepers("DatabaseDatabase", &resource.Sweeper{
-			Name:         "DatabaseDatabase",
-			Dependencies: acctest.DependencyGraph["database"],
-			F:            sweepDatabaseDatabaseResource,
+		acctest.AddTestSweepers("DatabaseDatabase", &resource.Sweeper{
+			Name: "DatabaseDatabase",
+			F:    sweepDatabaseDatabaseResource,
 		})
 	}
 }
@@ -440,6 +440,7 @@ func sweepDatabaseDatabaseResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, databaseId := range databaseIds {
 		if ok := acctest.SweeperDefaultResourceId[databaseId]; !ok {
 			deleteDatabaseRequest := oci_database.DeleteDatabaseRequest{}
@@ -447,16 +448,20 @@ func sweepDatabaseDatabaseResource(compartment string) error {
 			deleteDatabaseRequest.DatabaseId = &databaseId
 
 			deleteDatabaseRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
-			_, error := databaseClient.DeleteDatabase(context.Background(), deleteDatabaseRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DatabaseId", databaseId, func() error {
+				_, err := databaseClient.DeleteDatabase(context.Background(), deleteDatabaseRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Database %s %s, It is possible that the resource is already deleted. Please verify manually \n", databaseId, error)
+				fmt.Printf("Error deleting Database %s %s \n", databaseId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &databaseId, databaseSweepWaitCondition, time.Duration(3*time.Minute),
 				databaseSweepResponseFetchOperation, "database", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDatabaseIds(compartment string) ([]string, error) {
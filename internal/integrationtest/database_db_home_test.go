@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -627,10 +628,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseDbHome") {
-		resource.AddTestSweepers("DatabaseDbHome", &resource.Sweeper{
-			Name:         "DatabaseDbHome",
-			Dependencies: acctest.DependencyGraph["dbHome"],
-			F:            sweepDatabaseDbHomeResource,
+		acctest.AddTestSweepers("DatabaseDbHome", &resource.Sweeper{
+			Name: "DatabaseDbHome",
+			F:    sweepDatabaseDbHomeResource,
 		})
 	}
 }
@@ -641,6 +641,7 @@ func sweepDatabaseDbHomeResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, dbHomeId := range dbHomeIds {
 		if ok := acctest.SweeperDefaultResourceId[dbHomeId]; !ok {
 			deleteDbHomeRequest := oci_database.DeleteDbHomeRequest{}
@@ -648,16 +649,20 @@ func sweepDatabaseDbHomeResource(compartment string) error {
 			deleteDbHomeRequest.DbHomeId = &dbHomeId
 
 			deleteDbHomeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
-			_, error := databaseClient.DeleteDbHome(context.Background(), deleteDbHomeRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DbHomeId", dbHomeId, func() error {
+				_, err := databaseClient.DeleteDbHome(context.Background(), deleteDbHomeRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting DbHome %s %s, It is possible that the resource is already deleted. Please verify manually \n", dbHomeId, error)
+				fmt.Printf("Error deleting DbHome %s %s \n", dbHomeId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &dbHomeId, dbHomeSweepWaitCondition, time.Duration(3*time.Minute),
 				dbHomeSweepResponseFetchOperation, "database", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDbHomeIds(compartment string) ([]string, error) {
@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -197,10 +198,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseDbNodeConsoleConnection") {
-		resource.AddTestSweepers("DatabaseDbNodeConsoleConnection", &resource.Sweeper{
-			Name:         "DatabaseDbNodeConsoleConnection",
-			Dependencies: acctest.DependencyGraph["dbNodeConsoleConnection"],
-			F:            sweepDatabaseDbNodeConsoleConnectionResource,
+		acctest.AddTestSweepers("DatabaseDbNodeConsoleConnection", &resource.Sweeper{
+			Name: "DatabaseDbNodeConsoleConnection",
+			F:    sweepDatabaseDbNodeConsoleConnectionResource,
 		})
 	}
 }
@@ -211,6 +211,7 @@ func sweepDatabaseDbNodeConsoleConnectionResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, dbNodeConsoleConnectionId := range dbNodeConsoleConnectionIds {
 		if ok := acctest.SweeperDefaultResourceId[dbNodeConsoleConnectionId]; !ok {
 			deleteConsoleConnectionRequest := oci_database.DeleteConsoleConnectionRequest{}
@@ -224,16 +225,20 @@ func sweepDatabaseDbNodeConsoleConnectionResource(compartment string) error {
 			}
 
 			deleteConsoleConnectionRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
-			_, error := databaseClient.DeleteConsoleConnection(context.Background(), deleteConsoleConnectionRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DbNodeConsoleConnectionId", dbNodeConsoleConnectionId, func() error {
+				_, err := databaseClient.DeleteConsoleConnection(context.Background(), deleteConsoleConnectionRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting DbNodeConsoleConnection %s %s, It is possible that the resource is already deleted. Please verify manually \n", dbNodeConsoleConnectionId, error)
+				fmt.Printf("Error deleting DbNodeConsoleConnection %s %s \n", dbNodeConsoleConnectionId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &dbNodeConsoleConnectionId, dbNodeConsoleConnectionSweepWaitCondition, time.Duration(3*time.Minute),
 				dbNodeConsoleConnectionSweepResponseFetchOperation, "database", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDbNodeConsoleConnectionIds(compartment string) ([]string, error) {
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/oracle/oci-go-sdk/v65/common"
 	oci_database "github.com/oracle/oci-go-sdk/v65/database"
@@ -23,6 +24,7 @@ func sweepDatabaseDbSystemResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, dbSystemId := range dbSystemIds {
 		if ok := acctest.SweeperDefaultResourceId[dbSystemId]; !ok {
 			terminateDbSystemRequest := oci_database.TerminateDbSystemRequest{}
@@ -30,16 +32,20 @@ func sweepDatabaseDbSystemResource(compartment string) error {
 			terminateDbSystemRequest.DbSystemId = &dbSystemId
 
 			terminateDbSystemRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
-			_, error := databaseClient.TerminateDbSystem(context.Background(), terminateDbSystemRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DbSystemId", dbSystemId, func() error {
+				_, err := databaseClient.TerminateDbSystem(context.Background(), terminateDbSystemRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting DbSystem %s %s, It is possible that the resource is already deleted. Please verify manually \n", dbSystemId, error)
+				fmt.Printf("Error deleting DbSystem %s %s \n", dbSystemId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &dbSystemId, dbSystemSweepWaitCondition, time.Duration(3*time.Minute),
 				dbSystemSweepResponseFetchOperation, "database", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDbSystemIds(compartment string) ([]string, error) {
@@ -798,9 +798,8 @@ func init() {
 	if acctest.DependencyGraph == nil {
 		acctest.InitDependencyGraph()
 	}
-	resource.AddTestSweepers("DatabaseDbSystem", &resource.Sweeper{
-		Name:         "DatabaseDbSystem",
-		Dependencies: acctest.DependencyGraph["dbSystem"],
-		F:            sweepDatabaseDbSystemResource,
+	acctest.AddTestSweepers("DatabaseDbSystem", &resource.Sweeper{
+		Name: "DatabaseDbSystem",
+		F:    sweepDatabaseDbSystemResource,
 	})
 }
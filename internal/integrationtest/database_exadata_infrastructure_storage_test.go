@@ -260,10 +260,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseExadataInfrastructureStorage") {
-		resource.AddTestSweepers("DatabaseExadataInfrastructureStorage", &resource.Sweeper{
-			Name:         "DatabaseExadataInfrastructureStorage",
-			Dependencies: acctest.DependencyGraph["exadataInfrastructureStorage"],
-			F:            sweepDatabaseExadataInfrastructureResource,
+		acctest.AddTestSweepers("DatabaseExadataInfrastructureStorage", &resource.Sweeper{
+			Name: "DatabaseExadataInfrastructureStorage",
+			F:    sweepDatabaseExadataInfrastructureResource,
 		})
 	}
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -510,10 +511,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseExadataInfrastructure") {
-		resource.AddTestSweepers("DatabaseExadataInfrastructure", &resource.Sweeper{
-			Name:         "DatabaseExadataInfrastructure",
-			Dependencies: acctest.DependencyGraph["exadataInfrastructure"],
-			F:            sweepDatabaseExadataInfrastructureResource,
+		acctest.AddTestSweepers("DatabaseExadataInfrastructure", &resource.Sweeper{
+			Name: "DatabaseExadataInfrastructure",
+			F:    sweepDatabaseExadataInfrastructureResource,
 		})
 	}
 }
@@ -524,6 +524,7 @@ func sweepDatabaseExadataInfrastructureResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, exadataInfrastructureId := range exadataInfrastructureIds {
 		if ok := acctest.SweeperDefaultResourceId[exadataInfrastructureId]; !ok {
 			deleteExadataInfrastructureRequest := oci_database.DeleteExadataInfrastructureRequest{}
@@ -531,16 +532,20 @@ func sweepDatabaseExadataInfrastructureResource(compartment string) error {
 			deleteExadataInfrastructureRequest.ExadataInfrastructureId = &exadataInfrastructureId
 
 			deleteExadataInfrastructureRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
-			_, error := databaseClient.DeleteExadataInfrastructure(context.Background(), deleteExadataInfrastructureRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ExadataInfrastructureId", exadataInfrastructureId, func() error {
+				_, err := databaseClient.DeleteExadataInfrastructure(context.Background(), deleteExadataInfrastructureRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ExadataInfrastructure %s %s, It is possible that the resource is already deleted. Please verify manually \n", exadataInfrastructureId, error)
+				fmt.Printf("Error deleting ExadataInfrastructure %s %s \n", exadataInfrastructureId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &exadataInfrastructureId, exadataInfrastructureSweepWaitCondition, time.Duration(3*time.Minute),
 				exadataInfrastructureSweepResponseFetchOperation, "database", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getExadataInfrastructureIds(compartment string) ([]string, error) {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -261,10 +262,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseExternalContainerDatabase") {
-		resource.AddTestSweepers("DatabaseExternalContainerDatabase", &resource.Sweeper{
-			Name:         "DatabaseExternalContainerDatabase",
-			Dependencies: acctest.DependencyGraph["externalContainerDatabase"],
-			F:            sweepDatabaseExternalContainerDatabaseResource,
+		acctest.AddTestSweepers("DatabaseExternalContainerDatabase", &resource.Sweeper{
+			Name: "DatabaseExternalContainerDatabase",
+			F:    sweepDatabaseExternalContainerDatabaseResource,
 		})
 	}
 }
@@ -275,6 +275,7 @@ func sweepDatabaseExternalContainerDatabaseResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, externalContainerDatabaseId := range externalContainerDatabaseIds {
 		if ok := acctest.SweeperDefaultResourceId[externalContainerDatabaseId]; !ok {
 			deleteExternalContainerDatabaseRequest := oci_database.DeleteExternalContainerDatabaseRequest{}
@@ -282,16 +283,20 @@ func sweepDatabaseExternalContainerDatabaseResource(compartment string) error {
 			deleteExternalContainerDatabaseRequest.ExternalContainerDatabaseId = &externalContainerDatabaseId
 
 			deleteExternalContainerDatabaseRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
-			_, error := databaseClient.DeleteExternalContainerDatabase(context.Background(), deleteExternalContainerDatabaseRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ExternalContainerDatabaseId", externalContainerDatabaseId, func() error {
+				_, err := databaseClient.DeleteExternalContainerDatabase(context.Background(), deleteExternalContainerDatabaseRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ExternalContainerDatabase %s %s, It is possible that the resource is already deleted. Please verify manually \n", externalContainerDatabaseId, error)
+				fmt.Printf("Error deleting ExternalContainerDatabase %s %s \n", externalContainerDatabaseId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &externalContainerDatabaseId, externalContainerDatabaseSweepWaitCondition, time.Duration(3*time.Minute),
 				externalContainerDatabaseSweepResponseFetchOperation, "database", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getExternalContainerDatabaseIds(compartment string) ([]string, error) {
@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -312,10 +313,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseExternalDatabaseConnector") {
-		resource.AddTestSweepers("DatabaseExternalDatabaseConnector", &resource.Sweeper{
-			Name:         "DatabaseExternalDatabaseConnector",
-			Dependencies: acctest.DependencyGraph["externalDatabaseConnector"],
-			F:            sweepDatabaseExternalDatabaseConnectorResource,
+		acctest.AddTestSweepers("DatabaseExternalDatabaseConnector", &resource.Sweeper{
+			Name: "DatabaseExternalDatabaseConnector",
+			F:    sweepDatabaseExternalDatabaseConnectorResource,
 		})
 	}
 }
@@ -326,6 +326,7 @@ func sweepDatabaseExternalDatabaseConnectorResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, externalDatabaseConnectorId := range externalDatabaseConnectorIds {
 		if ok := acctest.SweeperDefaultResourceId[externalDatabaseConnectorId]; !ok {
 			deleteExternalDatabaseConnectorRequest := oci_database.DeleteExternalDatabaseConnectorRequest{}
@@ -333,16 +334,20 @@ func sweepDatabaseExternalDatabaseConnectorResource(compartment string) error {
 			deleteExternalDatabaseConnectorRequest.ExternalDatabaseConnectorId = &externalDatabaseConnectorId
 
 			deleteExternalDatabaseConnectorRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
-			_, error := databaseClient.DeleteExternalDatabaseConnector(context.Background(), deleteExternalDatabaseConnectorRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ExternalDatabaseConnectorId", externalDatabaseConnectorId, func() error {
+				_, err := databaseClient.DeleteExternalDatabaseConnector(context.Background(), deleteExternalDatabaseConnectorRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ExternalDatabaseConnector %s %s, It is possible that the resource is already deleted. Please verify manually \n", externalDatabaseConnectorId, error)
+				fmt.Printf("Error deleting ExternalDatabaseConnector %s %s \n", externalDatabaseConnectorId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &externalDatabaseConnectorId, externalDatabaseConnectorSweepWaitCondition, time.Duration(3*time.Minute),
 				externalDatabaseConnectorSweepResponseFetchOperation, "database", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getExternalDatabaseConnectorIds(compartment string) ([]string, error) {
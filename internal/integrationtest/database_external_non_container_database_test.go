@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -263,10 +264,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseExternalNonContainerDatabase") {
-		resource.AddTestSweepers("DatabaseExternalNonContainerDatabase", &resource.Sweeper{
-			Name:         "DatabaseExternalNonContainerDatabase",
-			Dependencies: acctest.DependencyGraph["externalNonContainerDatabase"],
-			F:            sweepDatabaseExternalNonContainerDatabaseResource,
+		acctest.AddTestSweepers("DatabaseExternalNonContainerDatabase", &resource.Sweeper{
+			Name: "DatabaseExternalNonContainerDatabase",
+			F:    sweepDatabaseExternalNonContainerDatabaseResource,
 		})
 	}
 }
@@ -277,6 +277,7 @@ func sweepDatabaseExternalNonContainerDatabaseResource(compartment string) error
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, externalNonContainerDatabaseId := range externalNonContainerDatabaseIds {
 		if ok := acctest.SweeperDefaultResourceId[externalNonContainerDatabaseId]; !ok {
 			deleteExternalNonContainerDatabaseRequest := oci_database.DeleteExternalNonContainerDatabaseRequest{}
@@ -284,16 +285,20 @@ func sweepDatabaseExternalNonContainerDatabaseResource(compartment string) error
 			deleteExternalNonContainerDatabaseRequest.ExternalNonContainerDatabaseId = &externalNonContainerDatabaseId
 
 			deleteExternalNonContainerDatabaseRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
-			_, error := databaseClient.DeleteExternalNonContainerDatabase(context.Background(), deleteExternalNonContainerDatabaseRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ExternalNonContainerDatabaseId", externalNonContainerDatabaseId, func() error {
+				_, err := databaseClient.DeleteExternalNonContainerDatabase(context.Background(), deleteExternalNonContainerDatabaseRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ExternalNonContainerDatabase %s %s, It is possible that the resource is already deleted. Please verify manually \n", externalNonContainerDatabaseId, error)
+				fmt.Printf("Error deleting ExternalNonContainerDatabase %s %s \n", externalNonContainerDatabaseId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &externalNonContainerDatabaseId, externalNonContainerDatabaseSweepWaitCondition, time.Duration(3*time.Minute),
 				externalNonContainerDatabaseSweepResponseFetchOperation, "database", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getExternalNonContainerDatabaseIds(compartment string) ([]string, error) {
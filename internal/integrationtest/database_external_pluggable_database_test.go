@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -276,10 +277,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseExternalPluggableDatabase") {
-		resource.AddTestSweepers("DatabaseExternalPluggableDatabase", &resource.Sweeper{
-			Name:         "DatabaseExternalPluggableDatabase",
-			Dependencies: acctest.DependencyGraph["externalPluggableDatabase"],
-			F:            sweepDatabaseExternalPluggableDatabaseResource,
+		acctest.AddTestSweepers("DatabaseExternalPluggableDatabase", &resource.Sweeper{
+			Name: "DatabaseExternalPluggableDatabase",
+			F:    sweepDatabaseExternalPluggableDatabaseResource,
 		})
 	}
 }
@@ -290,6 +290,7 @@ func sweepDatabaseExternalPluggableDatabaseResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, externalPluggableDatabaseId := range externalPluggableDatabaseIds {
 		if ok := acctest.SweeperDefaultResourceId[externalPluggableDatabaseId]; !ok {
 			deleteExternalPluggableDatabaseRequest := oci_database.DeleteExternalPluggableDatabaseRequest{}
@@ -297,16 +298,20 @@ func sweepDatabaseExternalPluggableDatabaseResource(compartment string) error {
 			deleteExternalPluggableDatabaseRequest.ExternalPluggableDatabaseId = &externalPluggableDatabaseId
 
 			deleteExternalPluggableDatabaseRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
-			_, error := databaseClient.DeleteExternalPluggableDatabase(context.Background(), deleteExternalPluggableDatabaseRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ExternalPluggableDatabaseId", externalPluggableDatabaseId, func() error {
+				_, err := databaseClient.DeleteExternalPluggableDatabase(context.Background(), deleteExternalPluggableDatabaseRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ExternalPluggableDatabase %s %s, It is possible that the resource is already deleted. Please verify manually \n", externalPluggableDatabaseId, error)
+				fmt.Printf("Error deleting ExternalPluggableDatabase %s %s \n", externalPluggableDatabaseId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &externalPluggableDatabaseId, externalPluggableDatabaseSweepWaitCondition, time.Duration(3*time.Minute),
 				externalPluggableDatabaseSweepResponseFetchOperation, "database", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getExternalPluggableDatabaseIds(compartment string) ([]string, error) {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -293,10 +294,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseKeyStore") {
-		resource.AddTestSweepers("DatabaseKeyStore", &resource.Sweeper{
-			Name:         "DatabaseKeyStore",
-			Dependencies: acctest.DependencyGraph["keyStore"],
-			F:            sweepDatabaseKeyStoreResource,
+		acctest.AddTestSweepers("DatabaseKeyStore", &resource.Sweeper{
+			Name: "DatabaseKeyStore",
+			F:    sweepDatabaseKeyStoreResource,
 		})
 	}
 }
@@ -307,6 +307,7 @@ func sweepDatabaseKeyStoreResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, keyStoreId := range keyStoreIds {
 		if ok := acctest.SweeperDefaultResourceId[keyStoreId]; !ok {
 			deleteKeyStoreRequest := oci_database.DeleteKeyStoreRequest{}
@@ -314,16 +315,20 @@ func sweepDatabaseKeyStoreResource(compartment string) error {
 			deleteKeyStoreRequest.KeyStoreId = &keyStoreId
 
 			deleteKeyStoreRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
-			_, error := databaseClient.DeleteKeyStore(context.Background(), deleteKeyStoreRequest)
+			error := acctest.DeleteSweeperResource(compartment, "KeyStoreId", keyStoreId, func() error {
+				_, err := databaseClient.DeleteKeyStore(context.Background(), deleteKeyStoreRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting KeyStore %s %s, It is possible that the resource is already deleted. Please verify manually \n", keyStoreId, error)
+				fmt.Printf("Error deleting KeyStore %s %s \n", keyStoreId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &keyStoreId, keyStoreSweepWaitCondition, time.Duration(3*time.Minute),
 				keyStoreSweepResponseFetchOperation, "database", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getKeyStoreIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -281,10 +282,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseManagementDbManagementPrivateEndpoint") {
-		resource.AddTestSweepers("DatabaseManagementDbManagementPrivateEndpoint", &resource.Sweeper{
-			Name:         "DatabaseManagementDbManagementPrivateEndpoint",
-			Dependencies: acctest.DependencyGraph["dbManagementPrivateEndpoint"],
-			F:            sweepDatabaseManagementDbManagementPrivateEndpointResource,
+		acctest.AddTestSweepers("DatabaseManagementDbManagementPrivateEndpoint", &resource.Sweeper{
+			Name: "DatabaseManagementDbManagementPrivateEndpoint",
+			F:    sweepDatabaseManagementDbManagementPrivateEndpointResource,
 		})
 	}
 }
@@ -295,6 +295,7 @@ func sweepDatabaseManagementDbManagementPrivateEndpointResource(compartment stri
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, dbManagementPrivateEndpointId := range dbManagementPrivateEndpointIds {
 		if ok := acctest.SweeperDefaultResourceId[dbManagementPrivateEndpointId]; !ok {
 			deleteDbManagementPrivateEndpointRequest := oci_database_management.DeleteDbManagementPrivateEndpointRequest{}
@@ -302,16 +303,20 @@ func sweepDatabaseManagementDbManagementPrivateEndpointResource(compartment stri
 			deleteDbManagementPrivateEndpointRequest.DbManagementPrivateEndpointId = &dbManagementPrivateEndpointId
 
 			deleteDbManagementPrivateEndpointRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database_management")
-			_, error := dbManagementClient.DeleteDbManagementPrivateEndpoint(context.Background(), deleteDbManagementPrivateEndpointRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DbManagementPrivateEndpointId", dbManagementPrivateEndpointId, func() error {
+				_, err := dbManagementClient.DeleteDbManagementPrivateEndpoint(context.Background(), deleteDbManagementPrivateEndpointRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting DbManagementPrivateEndpoint %s %s, It is possible that the resource is already deleted. Please verify manually \n", dbManagementPrivateEndpointId, error)
+				fmt.Printf("Error deleting DbManagementPrivateEndpoint %s %s \n", dbManagementPrivateEndpointId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &dbManagementPrivateEndpointId, dbManagementPrivateEndpointSweepWaitCondition, time.Duration(3*time.Minute),
 				dbManagementPrivateEndpointSweepResponseFetchOperation, "database_management", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDbManagementPrivateEndpointIds(compartment string) ([]string, error) {
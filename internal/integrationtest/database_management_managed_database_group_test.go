@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -366,10 +367,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseManagementManagedDatabaseGroup") {
-		resource.AddTestSweepers("DatabaseManagementManagedDatabaseGroup", &resource.Sweeper{
-			Name:         "DatabaseManagementManagedDatabaseGroup",
-			Dependencies: acctest.DependencyGraph["managedDatabaseGroup"],
-			F:            sweepDatabaseManagementManagedDatabaseGroupResource,
+		acctest.AddTestSweepers("DatabaseManagementManagedDatabaseGroup", &resource.Sweeper{
+			Name: "DatabaseManagementManagedDatabaseGroup",
+			F:    sweepDatabaseManagementManagedDatabaseGroupResource,
 		})
 	}
 }
@@ -380,6 +380,7 @@ func sweepDatabaseManagementManagedDatabaseGroupResource(compartment string) err
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, managedDatabaseGroupId := range managedDatabaseGroupIds {
 		if ok := acctest.SweeperDefaultResourceId[managedDatabaseGroupId]; !ok {
 			deleteManagedDatabaseGroupRequest := oci_database_management.DeleteManagedDatabaseGroupRequest{}
@@ -387,16 +388,20 @@ func sweepDatabaseManagementManagedDatabaseGroupResource(compartment string) err
 			deleteManagedDatabaseGroupRequest.ManagedDatabaseGroupId = &managedDatabaseGroupId
 
 			deleteManagedDatabaseGroupRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database_management")
-			_, error := dbManagementClient.DeleteManagedDatabaseGroup(context.Background(), deleteManagedDatabaseGroupRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ManagedDatabaseGroupId", managedDatabaseGroupId, func() error {
+				_, err := dbManagementClient.DeleteManagedDatabaseGroup(context.Background(), deleteManagedDatabaseGroupRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ManagedDatabaseGroup %s %s, It is possible that the resource is already deleted. Please verify manually \n", managedDatabaseGroupId, error)
+				fmt.Printf("Error deleting ManagedDatabaseGroup %s %s \n", managedDatabaseGroupId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &managedDatabaseGroupId, managedDatabaseGroupSweepWaitCondition, time.Duration(3*time.Minute),
 				managedDatabaseGroupSweepResponseFetchOperation, "database_management", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getManagedDatabaseGroupIds(compartment string) ([]string, error) {
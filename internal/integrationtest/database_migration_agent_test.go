@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -272,10 +273,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseMigrationAgent") {
-		resource.AddTestSweepers("DatabaseMigrationAgent", &resource.Sweeper{
-			Name:         "DatabaseMigrationAgent",
-			Dependencies: acctest.DependencyGraph["agent"],
-			F:            sweepDatabaseMigrationAgentResource,
+		acctest.AddTestSweepers("DatabaseMigrationAgent", &resource.Sweeper{
+			Name: "DatabaseMigrationAgent",
+			F:    sweepDatabaseMigrationAgentResource,
 		})
 	}
 }
@@ -286,6 +286,7 @@ func sweepDatabaseMigrationAgentResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, agentId := range agentIds {
 		if ok := acctest.SweeperDefaultResourceId[agentId]; !ok {
 			deleteAgentRequest := oci_database_migration.DeleteAgentRequest{}
@@ -293,16 +294,20 @@ func sweepDatabaseMigrationAgentResource(compartment string) error {
 			deleteAgentRequest.AgentId = &agentId
 
 			deleteAgentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database_migration")
-			_, error := databaseMigrationClient.DeleteAgent(context.Background(), deleteAgentRequest)
+			error := acctest.DeleteSweeperResource(compartment, "AgentId", agentId, func() error {
+				_, err := databaseMigrationClient.DeleteAgent(context.Background(), deleteAgentRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Agent %s %s, It is possible that the resource is already deleted. Please verify manually \n", agentId, error)
+				fmt.Printf("Error deleting Agent %s %s \n", agentId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &agentId, agentSweepWaitCondition, time.Duration(3*time.Minute),
 				agentSweepResponseFetchOperation, "database_migration", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getAgentIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -486,10 +487,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseMigrationConnection") {
-		resource.AddTestSweepers("DatabaseMigrationConnection", &resource.Sweeper{
-			Name:         "DatabaseMigrationConnection",
-			Dependencies: acctest.DependencyGraph["connection"],
-			F:            sweepDatabaseMigrationConnectionResource,
+		acctest.AddTestSweepers("DatabaseMigrationConnection", &resource.Sweeper{
+			Name: "DatabaseMigrationConnection",
+			F:    sweepDatabaseMigrationConnectionResource,
 		})
 	}
 }
@@ -500,6 +500,7 @@ func sweepDatabaseMigrationConnectionResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, connectionId := range connectionIds {
 		if ok := acctest.SweeperDefaultResourceId[connectionId]; !ok {
 			deleteConnectionRequest := oci_database_migration.DeleteConnectionRequest{}
@@ -507,16 +508,20 @@ func sweepDatabaseMigrationConnectionResource(compartment string) error {
 			deleteConnectionRequest.ConnectionId = &connectionId
 
 			deleteConnectionRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database_migration")
-			_, error := databaseMigrationClient.DeleteConnection(context.Background(), deleteConnectionRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ConnectionId", connectionId, func() error {
+				_, err := databaseMigrationClient.DeleteConnection(context.Background(), deleteConnectionRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Connection %s %s, It is possible that the resource is already deleted. Please verify manually \n", connectionId, error)
+				fmt.Printf("Error deleting Connection %s %s \n", connectionId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &connectionId, connectionSweepWaitCondition, time.Duration(3*time.Minute),
 				connectionSweepResponseFetchOperation, "database_migration", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getConnectionIds(compartment string) ([]string, error) {
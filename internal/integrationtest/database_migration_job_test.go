@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -237,10 +238,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseMigrationJob") {
-		resource.AddTestSweepers("DatabaseMigrationJob", &resource.Sweeper{
-			Name:         "DatabaseMigrationJob",
-			Dependencies: acctest.DependencyGraph["job"],
-			F:            sweepDatabaseMigrationJobResource,
+		acctest.AddTestSweepers("DatabaseMigrationJob", &resource.Sweeper{
+			Name: "DatabaseMigrationJob",
+			F:    sweepDatabaseMigrationJobResource,
 		})
 	}
 }
@@ -251,6 +251,7 @@ func sweepDatabaseMigrationJobResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, jobId := range jobIds {
 		if ok := acctest.SweeperDefaultResourceId[jobId]; !ok {
 			deleteJobRequest := oci_database_migration.DeleteJobRequest{}
@@ -258,16 +259,20 @@ func sweepDatabaseMigrationJobResource(compartment string) error {
 			deleteJobRequest.JobId = &jobId
 
 			deleteJobRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database_migration")
-			_, error := databaseMigrationClient.DeleteJob(context.Background(), deleteJobRequest)
+			error := acctest.DeleteSweeperResource(compartment, "JobId", jobId, func() error {
+				_, err := databaseMigrationClient.DeleteJob(context.Background(), deleteJobRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Job %s %s, It is possible that the resource is already deleted. Please verify manually \n", jobId, error)
+				fmt.Printf("Error deleting Job %s %s \n", jobId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &jobId, jobSweepWaitCondition, time.Duration(3*time.Minute),
 				jobSweepResponseFetchOperation, "database_migration", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getJobIds(compartment string) ([]string, error) {
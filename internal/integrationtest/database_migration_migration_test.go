@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -527,10 +528,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseMigrationMigration") {
-		resource.AddTestSweepers("DatabaseMigrationMigration", &resource.Sweeper{
-			Name:         "DatabaseMigrationMigration",
-			Dependencies: acctest.DependencyGraph["migration"],
-			F:            sweepDatabaseMigrationMigrationResource,
+		acctest.AddTestSweepers("DatabaseMigrationMigration", &resource.Sweeper{
+			Name: "DatabaseMigrationMigration",
+			F:    sweepDatabaseMigrationMigrationResource,
 		})
 	}
 }
@@ -541,6 +541,7 @@ func sweepDatabaseMigrationMigrationResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, migrationId := range migrationIds {
 		if ok := acctest.SweeperDefaultResourceId[migrationId]; !ok {
 			deleteMigrationRequest := oci_database_migration.DeleteMigrationRequest{}
@@ -548,16 +549,20 @@ func sweepDatabaseMigrationMigrationResource(compartment string) error {
 			deleteMigrationRequest.MigrationId = &migrationId
 
 			deleteMigrationRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database_migration")
-			_, error := databaseMigrationClient.DeleteMigration(context.Background(), deleteMigrationRequest)
+			error := acctest.DeleteSweeperResource(compartment, "MigrationId", migrationId, func() error {
+				_, err := databaseMigrationClient.DeleteMigration(context.Background(), deleteMigrationRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Migration %s %s, It is possible that the resource is already deleted. Please verify manually \n", migrationId, error)
+				fmt.Printf("Error deleting Migration %s %s \n", migrationId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &migrationId, migrationSweepWaitCondition, time.Duration(3*time.Minute),
 				migrationSweepResponseFetchOperation, "database_migration", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getMigrationIds(compartment string) ([]string, error) {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -392,10 +393,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabasePluggableDatabase") {
-		resource.AddTestSweepers("DatabasePluggableDatabase", &resource.Sweeper{
-			Name:         "DatabasePluggableDatabase",
-			Dependencies: acctest.DependencyGraph["pluggableDatabase"],
-			F:            sweepDatabasePluggableDatabaseResource,
+		acctest.AddTestSweepers("DatabasePluggableDatabase", &resource.Sweeper{
+			Name: "DatabasePluggableDatabase",
+			F:    sweepDatabasePluggableDatabaseResource,
 		})
 	}
 }
@@ -406,6 +406,7 @@ func sweepDatabasePluggableDatabaseResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, pluggableDatabaseId := range pluggableDatabaseIds {
 		if ok := acctest.SweeperDefaultResourceId[pluggableDatabaseId]; !ok {
 			deletePluggableDatabaseRequest := oci_database.DeletePluggableDatabaseRequest{}
@@ -413,16 +414,20 @@ func sweepDatabasePluggableDatabaseResource(compartment string) error {
 			deletePluggableDatabaseRequest.PluggableDatabaseId = &pluggableDatabaseId
 
 			deletePluggableDatabaseRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
-			_, error := databaseClient.DeletePluggableDatabase(context.Background(), deletePluggableDatabaseRequest)
+			error := acctest.DeleteSweeperResource(compartment, "PluggableDatabaseId", pluggableDatabaseId, func() error {
+				_, err := databaseClient.DeletePluggableDatabase(context.Background(), deletePluggableDatabaseRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting PluggableDatabase %s %s, It is possible that the resource is already deleted. Please verify manually \n", pluggableDatabaseId, error)
+				fmt.Printf("Error deleting PluggableDatabase %s %s \n", pluggableDatabaseId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &pluggableDatabaseId, pluggableDatabaseSweepWaitCondition, time.Duration(3*time.Minute),
 				pluggableDatabaseSweepResponseFetchOperation, "database", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getPluggableDatabaseIds(compartment string) ([]string, error) {
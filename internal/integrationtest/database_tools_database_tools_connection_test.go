@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -389,10 +390,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseToolsDatabaseToolsConnection") {
-		resource.AddTestSweepers("DatabaseToolsDatabaseToolsConnection", &resource.Sweeper{
-			Name:         "DatabaseToolsDatabaseToolsConnection",
-			Dependencies: acctest.DependencyGraph["databaseToolsConnection"],
-			F:            sweepDatabaseToolsDatabaseToolsConnectionResource,
+		acctest.AddTestSweepers("DatabaseToolsDatabaseToolsConnection", &resource.Sweeper{
+			Name: "DatabaseToolsDatabaseToolsConnection",
+			F:    sweepDatabaseToolsDatabaseToolsConnectionResource,
 		})
 	}
 }
@@ -403,6 +403,7 @@ func sweepDatabaseToolsDatabaseToolsConnectionResource(compartment string) error
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, databaseToolsConnectionId := range databaseToolsConnectionIds {
 		if ok := acctest.SweeperDefaultResourceId[databaseToolsConnectionId]; !ok {
 			deleteDatabaseToolsConnectionRequest := oci_database_tools.DeleteDatabaseToolsConnectionRequest{}
@@ -410,16 +411,20 @@ func sweepDatabaseToolsDatabaseToolsConnectionResource(compartment string) error
 			deleteDatabaseToolsConnectionRequest.DatabaseToolsConnectionId = &databaseToolsConnectionId
 
 			deleteDatabaseToolsConnectionRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database_tools")
-			_, error := databaseToolsClient.DeleteDatabaseToolsConnection(context.Background(), deleteDatabaseToolsConnectionRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DatabaseToolsConnectionId", databaseToolsConnectionId, func() error {
+				_, err := databaseToolsClient.DeleteDatabaseToolsConnection(context.Background(), deleteDatabaseToolsConnectionRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting DatabaseToolsConnection %s %s, It is possible that the resource is already deleted. Please verify manually \n", databaseToolsConnectionId, error)
+				fmt.Printf("Error deleting DatabaseToolsConnection %s %s \n", databaseToolsConnectionId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &databaseToolsConnectionId, databaseToolsConnectionSweepWaitCondition, time.Duration(3*time.Minute),
 				databaseToolsConnectionSweepResponseFetchOperation, "database_tools", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDatabaseToolsConnectionIds(compartment string) ([]string, error) {
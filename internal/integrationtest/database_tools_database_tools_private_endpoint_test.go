@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -315,10 +316,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseToolsDatabaseToolsPrivateEndpoint") {
-		resource.AddTestSweepers("DatabaseToolsDatabaseToolsPrivateEndpoint", &resource.Sweeper{
-			Name:         "DatabaseToolsDatabaseToolsPrivateEndpoint",
-			Dependencies: acctest.DependencyGraph["databaseToolsPrivateEndpoint"],
-			F:            sweepDatabaseToolsDatabaseToolsPrivateEndpointResource,
+		acctest.AddTestSweepers("DatabaseToolsDatabaseToolsPrivateEndpoint", &resource.Sweeper{
+			Name: "DatabaseToolsDatabaseToolsPrivateEndpoint",
+			F:    sweepDatabaseToolsDatabaseToolsPrivateEndpointResource,
 		})
 	}
 }
@@ -329,6 +329,7 @@ func sweepDatabaseToolsDatabaseToolsPrivateEndpointResource(compartment string)
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, databaseToolsPrivateEndpointId := range databaseToolsPrivateEndpointIds {
 		if ok := acctest.SweeperDefaultResourceId[databaseToolsPrivateEndpointId]; !ok {
 			deleteDatabaseToolsPrivateEndpointRequest := oci_database_tools.DeleteDatabaseToolsPrivateEndpointRequest{}
@@ -336,16 +337,20 @@ func sweepDatabaseToolsDatabaseToolsPrivateEndpointResource(compartment string)
 			deleteDatabaseToolsPrivateEndpointRequest.DatabaseToolsPrivateEndpointId = &databaseToolsPrivateEndpointId
 
 			deleteDatabaseToolsPrivateEndpointRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database_tools")
-			_, error := databaseToolsClient.DeleteDatabaseToolsPrivateEndpoint(context.Background(), deleteDatabaseToolsPrivateEndpointRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DatabaseToolsPrivateEndpointId", databaseToolsPrivateEndpointId, func() error {
+				_, err := databaseToolsClient.DeleteDatabaseToolsPrivateEndpoint(context.Background(), deleteDatabaseToolsPrivateEndpointRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting DatabaseToolsPrivateEndpoint %s %s, It is possible that the resource is already deleted. Please verify manually \n", databaseToolsPrivateEndpointId, error)
+				fmt.Printf("Error deleting DatabaseToolsPrivateEndpoint %s %s \n", databaseToolsPrivateEndpointId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &databaseToolsPrivateEndpointId, databaseToolsPrivateEndpointSweepWaitCondition, time.Duration(3*time.Minute),
 				databaseToolsPrivateEndpointSweepResponseFetchOperation, "database_tools", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDatabaseToolsPrivateEndpointIds(compartment string) ([]string, error) {
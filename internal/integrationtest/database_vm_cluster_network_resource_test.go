@@ -12,6 +12,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
@@ -207,10 +208,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseValidatedVmClusterNetwork") {
-		resource.AddTestSweepers("DatabaseValidatedVmClusterNetwork", &resource.Sweeper{
-			Name:         "DatabaseValidatedVmClusterNetwork",
-			Dependencies: acctest.DependencyGraph["vmClusterNetwork"],
-			F:            sweepDatabaseValidatedVmClusterNetworkResource,
+		acctest.AddTestSweepers("DatabaseValidatedVmClusterNetwork", &resource.Sweeper{
+			Name: "DatabaseValidatedVmClusterNetwork",
+			F:    sweepDatabaseValidatedVmClusterNetworkResource,
 		})
 	}
 }
@@ -221,6 +221,7 @@ func sweepDatabaseValidatedVmClusterNetworkResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, vmClusterNetworkId := range vmClusterNetworkIds {
 		if ok := acctest.SweeperDefaultResourceId[vmClusterNetworkId]; !ok {
 			deleteVmClusterNetworkRequest := oci_database.DeleteVmClusterNetworkRequest{}
@@ -228,16 +229,20 @@ func sweepDatabaseValidatedVmClusterNetworkResource(compartment string) error {
 			deleteVmClusterNetworkRequest.VmClusterNetworkId = &vmClusterNetworkId
 
 			deleteVmClusterNetworkRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
-			_, error := databaseClient.DeleteVmClusterNetwork(context.Background(), deleteVmClusterNetworkRequest)
+			error := acctest.DeleteSweeperResource(compartment, "VmClusterNetworkId", vmClusterNetworkId, func() error {
+				_, err := databaseClient.DeleteVmClusterNetwork(context.Background(), deleteVmClusterNetworkRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting VmClusterNetwork %s %s, It is possible that the resource is already deleted. Please verify manually \n", vmClusterNetworkId, error)
+				fmt.Printf("Error deleting VmClusterNetwork %s %s \n", vmClusterNetworkId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &vmClusterNetworkId, vmClusterNetworkSweepWaitCondition, time.Duration(3*time.Minute),
 				vmClusterNetworkSweepResponseFetchOperation, "database", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getValidatedVmClusterNetworkIds(compartment string) ([]string, error) {
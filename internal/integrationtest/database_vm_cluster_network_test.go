@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -471,10 +472,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseVmClusterNetwork") {
-		resource.AddTestSweepers("DatabaseVmClusterNetwork", &resource.Sweeper{
-			Name:         "DatabaseVmClusterNetwork",
-			Dependencies: acctest.DependencyGraph["vmClusterNetwork"],
-			F:            sweepDatabaseVmClusterNetworkResource,
+		acctest.AddTestSweepers("DatabaseVmClusterNetwork", &resource.Sweeper{
+			Name: "DatabaseVmClusterNetwork",
+			F:    sweepDatabaseVmClusterNetworkResource,
 		})
 	}
 }
@@ -485,6 +485,7 @@ func sweepDatabaseVmClusterNetworkResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, vmClusterNetworkId := range vmClusterNetworkIds {
 		if ok := acctest.SweeperDefaultResourceId[vmClusterNetworkId]; !ok {
 			deleteVmClusterNetworkRequest := oci_database.DeleteVmClusterNetworkRequest{}
@@ -492,16 +493,20 @@ func sweepDatabaseVmClusterNetworkResource(compartment string) error {
 			deleteVmClusterNetworkRequest.VmClusterNetworkId = &vmClusterNetworkId
 
 			deleteVmClusterNetworkRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
-			_, error := databaseClient.DeleteVmClusterNetwork(context.Background(), deleteVmClusterNetworkRequest)
+			error := acctest.DeleteSweeperResource(compartment, "VmClusterNetworkId", vmClusterNetworkId, func() error {
+				_, err := databaseClient.DeleteVmClusterNetwork(context.Background(), deleteVmClusterNetworkRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting VmClusterNetwork %s %s, It is possible that the resource is already deleted. Please verify manually \n", vmClusterNetworkId, error)
+				fmt.Printf("Error deleting VmClusterNetwork %s %s \n", vmClusterNetworkId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &vmClusterNetworkId, vmClusterNetworkSweepWaitCondition, time.Duration(3*time.Minute),
 				vmClusterNetworkSweepResponseFetchOperation, "database", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getVmClusterNetworkIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -339,10 +340,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatabaseVmCluster") {
-		resource.AddTestSweepers("DatabaseVmCluster", &resource.Sweeper{
-			Name:         "DatabaseVmCluster",
-			Dependencies: acctest.DependencyGraph["vmCluster"],
-			F:            sweepDatabaseVmClusterResource,
+		acctest.AddTestSweepers("DatabaseVmCluster", &resource.Sweeper{
+			Name: "DatabaseVmCluster",
+			F:    sweepDatabaseVmClusterResource,
 		})
 	}
 }
@@ -353,6 +353,7 @@ func sweepDatabaseVmClusterResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, vmClusterId := range vmClusterIds {
 		if ok := acctest.SweeperDefaultResourceId[vmClusterId]; !ok {
 			deleteVmClusterRequest := oci_database.DeleteVmClusterRequest{}
@@ -360,16 +361,20 @@ func sweepDatabaseVmClusterResource(compartment string) error {
 			deleteVmClusterRequest.VmClusterId = &vmClusterId
 
 			deleteVmClusterRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "database")
-			_, error := databaseClient.DeleteVmCluster(context.Background(), deleteVmClusterRequest)
+			error := acctest.DeleteSweeperResource(compartment, "VmClusterId", vmClusterId, func() error {
+				_, err := databaseClient.DeleteVmCluster(context.Background(), deleteVmClusterRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting VmCluster %s %s, It is possible that the resource is already deleted. Please verify manually \n", vmClusterId, error)
+				fmt.Printf("Error deleting VmCluster %s %s \n", vmClusterId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &vmClusterId, vmClusterSweepWaitCondition, time.Duration(3*time.Minute),
 				vmClusterSweepResponseFetchOperation, "database", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getVmClusterIds(compartment string) ([]string, error) {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -275,10 +276,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatacatalogCatalogPrivateEndpoint") {
-		resource.AddTestSweepers("DatacatalogCatalogPrivateEndpoint", &resource.Sweeper{
-			Name:         "DatacatalogCatalogPrivateEndpoint",
-			Dependencies: acctest.DependencyGraph["catalogPrivateEndpoint"],
-			F:            sweepDatacatalogCatalogPrivateEndpointResource,
+		acctest.AddTestSweepers("DatacatalogCatalogPrivateEndpoint", &resource.Sweeper{
+			Name: "DatacatalogCatalogPrivateEndpoint",
+			F:    sweepDatacatalogCatalogPrivateEndpointResource,
 		})
 	}
 }
@@ -289,6 +289,7 @@ func sweepDatacatalogCatalogPrivateEndpointResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, catalogPrivateEndpointId := range catalogPrivateEndpointIds {
 		if ok := acctest.SweeperDefaultResourceId[catalogPrivateEndpointId]; !ok {
 			deleteCatalogPrivateEndpointRequest := oci_datacatalog.DeleteCatalogPrivateEndpointRequest{}
@@ -296,16 +297,20 @@ func sweepDatacatalogCatalogPrivateEndpointResource(compartment string) error {
 			deleteCatalogPrivateEndpointRequest.CatalogPrivateEndpointId = &catalogPrivateEndpointId
 
 			deleteCatalogPrivateEndpointRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "datacatalog")
-			_, error := dataCatalogClient.DeleteCatalogPrivateEndpoint(context.Background(), deleteCatalogPrivateEndpointRequest)
+			error := acctest.DeleteSweeperResource(compartment, "CatalogPrivateEndpointId", catalogPrivateEndpointId, func() error {
+				_, err := dataCatalogClient.DeleteCatalogPrivateEndpoint(context.Background(), deleteCatalogPrivateEndpointRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting CatalogPrivateEndpoint %s %s, It is possible that the resource is already deleted. Please verify manually \n", catalogPrivateEndpointId, error)
+				fmt.Printf("Error deleting CatalogPrivateEndpoint %s %s \n", catalogPrivateEndpointId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &catalogPrivateEndpointId, catalogPrivateEndpointSweepWaitCondition, time.Duration(3*time.Minute),
 				catalogPrivateEndpointSweepResponseFetchOperation, "datacatalog", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getCatalogPrivateEndpointIds(compartment string) ([]string, error) {
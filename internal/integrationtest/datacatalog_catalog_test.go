@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -272,10 +273,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatacatalogCatalog") {
-		resource.AddTestSweepers("DatacatalogCatalog", &resource.Sweeper{
-			Name:         "DatacatalogCatalog",
-			Dependencies: acctest.DependencyGraph["catalog"],
-			F:            sweepDatacatalogCatalogResource,
+		acctest.AddTestSweepers("DatacatalogCatalog", &resource.Sweeper{
+			Name: "DatacatalogCatalog",
+			F:    sweepDatacatalogCatalogResource,
 		})
 	}
 }
@@ -286,6 +286,7 @@ func sweepDatacatalogCatalogResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, catalogId := range catalogIds {
 		if ok := acctest.SweeperDefaultResourceId[catalogId]; !ok {
 			deleteCatalogRequest := oci_datacatalog.DeleteCatalogRequest{}
@@ -293,16 +294,20 @@ func sweepDatacatalogCatalogResource(compartment string) error {
 			deleteCatalogRequest.CatalogId = &catalogId
 
 			deleteCatalogRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "datacatalog")
-			_, error := dataCatalogClient.DeleteCatalog(context.Background(), deleteCatalogRequest)
+			error := acctest.DeleteSweeperResource(compartment, "CatalogId", catalogId, func() error {
+				_, err := dataCatalogClient.DeleteCatalog(context.Background(), deleteCatalogRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Catalog %s %s, It is possible that the resource is already deleted. Please verify manually \n", catalogId, error)
+				fmt.Printf("Error deleting Catalog %s %s \n", catalogId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &catalogId, catalogSweepWaitCondition, time.Duration(3*time.Minute),
 				catalogSweepResponseFetchOperation, "datacatalog", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getCatalogIds(compartment string) ([]string, error) {
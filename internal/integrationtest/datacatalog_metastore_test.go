@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -316,10 +317,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatacatalogMetastore") {
-		resource.AddTestSweepers("DatacatalogMetastore", &resource.Sweeper{
-			Name:         "DatacatalogMetastore",
-			Dependencies: acctest.DependencyGraph["metastore"],
-			F:            sweepDatacatalogMetastoreResource,
+		acctest.AddTestSweepers("DatacatalogMetastore", &resource.Sweeper{
+			Name: "DatacatalogMetastore",
+			F:    sweepDatacatalogMetastoreResource,
 		})
 	}
 }
@@ -330,6 +330,7 @@ func sweepDatacatalogMetastoreResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, metastoreId := range metastoreIds {
 		if ok := acctest.SweeperDefaultResourceId[metastoreId]; !ok {
 			deleteMetastoreRequest := oci_datacatalog.DeleteMetastoreRequest{}
@@ -337,16 +338,20 @@ func sweepDatacatalogMetastoreResource(compartment string) error {
 			deleteMetastoreRequest.MetastoreId = &metastoreId
 
 			deleteMetastoreRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "datacatalog")
-			_, error := dataCatalogClient.DeleteMetastore(context.Background(), deleteMetastoreRequest)
+			error := acctest.DeleteSweeperResource(compartment, "MetastoreId", metastoreId, func() error {
+				_, err := dataCatalogClient.DeleteMetastore(context.Background(), deleteMetastoreRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Metastore %s %s, It is possible that the resource is already deleted. Please verify manually \n", metastoreId, error)
+				fmt.Printf("Error deleting Metastore %s %s \n", metastoreId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &metastoreId, metastoreSweepWaitCondition, time.Duration(3*time.Minute),
 				metastoreSweepResponseFetchOperation, "datacatalog", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getMetastoreIds(compartment string) ([]string, error) {
@@ -316,10 +316,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DataflowApplicationSubmit") {
-		resource.AddTestSweepers("DataflowApplicationSubmit", &resource.Sweeper{
-			Name:         "DataflowApplicationSubmit",
-			Dependencies: acctest.DependencyGraph["application"],
-			F:            sweepDataflowApplicationResource,
+		acctest.AddTestSweepers("DataflowApplicationSubmit", &resource.Sweeper{
+			Name: "DataflowApplicationSubmit",
+			F:    sweepDataflowApplicationResource,
 		})
 	}
 }
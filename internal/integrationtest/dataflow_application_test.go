@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -395,10 +396,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DataflowApplication") {
-		resource.AddTestSweepers("DataflowApplication", &resource.Sweeper{
-			Name:         "DataflowApplication",
-			Dependencies: acctest.DependencyGraph["application"],
-			F:            sweepDataflowApplicationResource,
+		acctest.AddTestSweepers("DataflowApplication", &resource.Sweeper{
+			Name: "DataflowApplication",
+			F:    sweepDataflowApplicationResource,
 		})
 	}
 }
@@ -409,6 +409,7 @@ func sweepDataflowApplicationResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, applicationId := range applicationIds {
 		if ok := acctest.SweeperDefaultResourceId[applicationId]; !ok {
 			deleteApplicationRequest := oci_dataflow.DeleteApplicationRequest{}
@@ -416,16 +417,20 @@ func sweepDataflowApplicationResource(compartment string) error {
 			deleteApplicationRequest.ApplicationId = &applicationId
 
 			deleteApplicationRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "dataflow")
-			_, error := dataFlowClient.DeleteApplication(context.Background(), deleteApplicationRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ApplicationId", applicationId, func() error {
+				_, err := dataFlowClient.DeleteApplication(context.Background(), deleteApplicationRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Application %s %s, It is possible that the resource is already deleted. Please verify manually \n", applicationId, error)
+				fmt.Printf("Error deleting Application %s %s \n", applicationId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &applicationId, dataFlowApplicationSweepWaitCondition, time.Duration(3*time.Minute),
 				dataFlowApplicationSweepResponseFetchOperation, "dataflow", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func dataFlowGetApplicationIds(compartment string) ([]string, error) {
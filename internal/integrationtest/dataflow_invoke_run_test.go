@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -366,10 +367,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DataflowInvokeRun") {
-		resource.AddTestSweepers("DataflowInvokeRun", &resource.Sweeper{
-			Name:         "DataflowInvokeRun",
-			Dependencies: acctest.DependencyGraph["invokeRun"],
-			F:            sweepDataflowInvokeRunResource,
+		acctest.AddTestSweepers("DataflowInvokeRun", &resource.Sweeper{
+			Name: "DataflowInvokeRun",
+			F:    sweepDataflowInvokeRunResource,
 		})
 	}
 }
@@ -380,19 +380,24 @@ func sweepDataflowInvokeRunResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, invokeRunId := range invokeRunIds {
 		if ok := acctest.SweeperDefaultResourceId[invokeRunId]; !ok {
 			deleteRunRequest := oci_dataflow.DeleteRunRequest{}
 
 			deleteRunRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "dataflow")
-			_, error := dataFlowClient.DeleteRun(context.Background(), deleteRunRequest)
+			error := acctest.DeleteSweeperResource(compartment, "InvokeRunId", invokeRunId, func() error {
+				_, err := dataFlowClient.DeleteRun(context.Background(), deleteRunRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting InvokeRun %s %s, It is possible that the resource is already deleted. Please verify manually \n", invokeRunId, error)
+				fmt.Printf("Error deleting InvokeRun %s %s \n", invokeRunId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getInvokeRunIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -290,10 +291,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DataflowPrivateEndpoint") {
-		resource.AddTestSweepers("DataflowPrivateEndpoint", &resource.Sweeper{
-			Name:         "DataflowPrivateEndpoint",
-			Dependencies: acctest.DependencyGraph["privateEndpoint"],
-			F:            sweepDataflowPrivateEndpointResource,
+		acctest.AddTestSweepers("DataflowPrivateEndpoint", &resource.Sweeper{
+			Name: "DataflowPrivateEndpoint",
+			F:    sweepDataflowPrivateEndpointResource,
 		})
 	}
 }
@@ -304,6 +304,7 @@ func sweepDataflowPrivateEndpointResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, privateEndpointId := range privateEndpointIds {
 		if ok := acctest.SweeperDefaultResourceId[privateEndpointId]; !ok {
 			deletePrivateEndpointRequest := oci_dataflow.DeletePrivateEndpointRequest{}
@@ -311,16 +312,20 @@ func sweepDataflowPrivateEndpointResource(compartment string) error {
 			deletePrivateEndpointRequest.PrivateEndpointId = &privateEndpointId
 
 			deletePrivateEndpointRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "dataflow")
-			_, error := dataFlowClient.DeletePrivateEndpoint(context.Background(), deletePrivateEndpointRequest)
+			error := acctest.DeleteSweeperResource(compartment, "PrivateEndpointId", privateEndpointId, func() error {
+				_, err := dataFlowClient.DeletePrivateEndpoint(context.Background(), deletePrivateEndpointRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting PrivateEndpoint %s %s, It is possible that the resource is already deleted. Please verify manually \n", privateEndpointId, error)
+				fmt.Printf("Error deleting PrivateEndpoint %s %s \n", privateEndpointId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &privateEndpointId, privateEndpointSweepWaitCondition, time.Duration(3*time.Minute),
 				privateEndpointSweepResponseFetchOperation, "dataflow", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getPrivateEndpointIds(compartment string) ([]string, error) {
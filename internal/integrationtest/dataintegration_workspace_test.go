@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -273,10 +274,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DataintegrationWorkspace") {
-		resource.AddTestSweepers("DataintegrationWorkspace", &resource.Sweeper{
-			Name:         "DataintegrationWorkspace",
-			Dependencies: acctest.DependencyGraph["workspace"],
-			F:            sweepDataintegrationWorkspaceResource,
+		acctest.AddTestSweepers("DataintegrationWorkspace", &resource.Sweeper{
+			Name: "DataintegrationWorkspace",
+			F:    sweepDataintegrationWorkspaceResource,
 		})
 	}
 }
@@ -287,6 +287,7 @@ func sweepDataintegrationWorkspaceResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, workspaceId := range workspaceIds {
 		if ok := acctest.SweeperDefaultResourceId[workspaceId]; !ok {
 			deleteWorkspaceRequest := oci_dataintegration.DeleteWorkspaceRequest{}
@@ -294,16 +295,20 @@ func sweepDataintegrationWorkspaceResource(compartment string) error {
 			deleteWorkspaceRequest.WorkspaceId = &workspaceId
 
 			deleteWorkspaceRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "dataintegration")
-			_, error := dataIntegrationClient.DeleteWorkspace(context.Background(), deleteWorkspaceRequest)
+			error := acctest.DeleteSweeperResource(compartment, "WorkspaceId", workspaceId, func() error {
+				_, err := dataIntegrationClient.DeleteWorkspace(context.Background(), deleteWorkspaceRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Workspace %s %s, It is possible that the resource is already deleted. Please verify manually \n", workspaceId, error)
+				fmt.Printf("Error deleting Workspace %s %s \n", workspaceId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &workspaceId, workspaceSweepWaitCondition, time.Duration(3*time.Minute),
 				workspaceSweepResponseFetchOperation, "dataintegration", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getWorkspaceIds(compartment string) ([]string, error) {
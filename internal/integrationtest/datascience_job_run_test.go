@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -307,10 +308,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatascienceJobRun") {
-		resource.AddTestSweepers("DatascienceJobRun", &resource.Sweeper{
-			Name:         "DatascienceJobRun",
-			Dependencies: acctest.DependencyGraph["jobRun"],
-			F:            sweepDatascienceJobRunResource,
+		acctest.AddTestSweepers("DatascienceJobRun", &resource.Sweeper{
+			Name: "DatascienceJobRun",
+			F:    sweepDatascienceJobRunResource,
 		})
 	}
 }
@@ -321,6 +321,7 @@ func sweepDatascienceJobRunResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, jobRunId := range jobRunIds {
 		if ok := acctest.SweeperDefaultResourceId[jobRunId]; !ok {
 			deleteJobRunRequest := oci_datascience.DeleteJobRunRequest{}
@@ -328,16 +329,20 @@ func sweepDatascienceJobRunResource(compartment string) error {
 			deleteJobRunRequest.JobRunId = &jobRunId
 
 			deleteJobRunRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "datascience")
-			_, error := dataScienceClient.DeleteJobRun(context.Background(), deleteJobRunRequest)
+			error := acctest.DeleteSweeperResource(compartment, "JobRunId", jobRunId, func() error {
+				_, err := dataScienceClient.DeleteJobRun(context.Background(), deleteJobRunRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting JobRun %s %s, It is possible that the resource is already deleted. Please verify manually \n", jobRunId, error)
+				fmt.Printf("Error deleting JobRun %s %s \n", jobRunId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &jobRunId, jobRunSweepWaitCondition, time.Duration(3*time.Minute),
 				jobRunSweepResponseFetchOperation, "datascience", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getJobRunIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -383,10 +384,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatascienceJob") {
-		resource.AddTestSweepers("DatascienceJob", &resource.Sweeper{
-			Name:         "DatascienceJob",
-			Dependencies: acctest.DependencyGraph["job"],
-			F:            sweepDatascienceJobResource,
+		acctest.AddTestSweepers("DatascienceJob", &resource.Sweeper{
+			Name: "DatascienceJob",
+			F:    sweepDatascienceJobResource,
 		})
 	}
 }
@@ -397,6 +397,7 @@ func sweepDatascienceJobResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, jobId := range jobIds {
 		if ok := acctest.SweeperDefaultResourceId[jobId]; !ok {
 			deleteJobRequest := oci_datascience.DeleteJobRequest{}
@@ -404,16 +405,20 @@ func sweepDatascienceJobResource(compartment string) error {
 			deleteJobRequest.JobId = &jobId
 
 			deleteJobRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "datascience")
-			_, error := dataScienceClient.DeleteJob(context.Background(), deleteJobRequest)
+			error := acctest.DeleteSweeperResource(compartment, "JobId", jobId, func() error {
+				_, err := dataScienceClient.DeleteJob(context.Background(), deleteJobRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Job %s %s, It is possible that the resource is already deleted. Please verify manually \n", jobId, error)
+				fmt.Printf("Error deleting Job %s %s \n", jobId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &jobId, mlJobSweepWaitCondition, time.Duration(3*time.Minute),
 				mlJobSweepResponseFetchOperation, "datascience", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getMlJobIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -466,10 +467,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatascienceModelDeployment") {
-		resource.AddTestSweepers("DatascienceModelDeployment", &resource.Sweeper{
-			Name:         "DatascienceModelDeployment",
-			Dependencies: acctest.DependencyGraph["modelDeployment"],
-			F:            sweepDatascienceModelDeploymentResource,
+		acctest.AddTestSweepers("DatascienceModelDeployment", &resource.Sweeper{
+			Name: "DatascienceModelDeployment",
+			F:    sweepDatascienceModelDeploymentResource,
 		})
 	}
 }
@@ -480,6 +480,7 @@ func sweepDatascienceModelDeploymentResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, modelDeploymentId := range modelDeploymentIds {
 		if ok := acctest.SweeperDefaultResourceId[modelDeploymentId]; !ok {
 			deleteModelDeploymentRequest := oci_datascience.DeleteModelDeploymentRequest{}
@@ -487,16 +488,20 @@ func sweepDatascienceModelDeploymentResource(compartment string) error {
 			deleteModelDeploymentRequest.ModelDeploymentId = &modelDeploymentId
 
 			deleteModelDeploymentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "datascience")
-			_, error := dataScienceClient.DeleteModelDeployment(context.Background(), deleteModelDeploymentRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ModelDeploymentId", modelDeploymentId, func() error {
+				_, err := dataScienceClient.DeleteModelDeployment(context.Background(), deleteModelDeploymentRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ModelDeployment %s %s, It is possible that the resource is already deleted. Please verify manually \n", modelDeploymentId, error)
+				fmt.Printf("Error deleting ModelDeployment %s %s \n", modelDeploymentId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &modelDeploymentId, modelDeploymentSweepWaitCondition, time.Duration(3*time.Minute),
 				modelDeploymentSweepResponseFetchOperation, "datascience", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getModelDeploymentIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -354,10 +355,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatascienceModel") {
-		resource.AddTestSweepers("DatascienceModel", &resource.Sweeper{
-			Name:         "DatascienceModel",
-			Dependencies: acctest.DependencyGraph["model"],
-			F:            sweepDatascienceModelResource,
+		acctest.AddTestSweepers("DatascienceModel", &resource.Sweeper{
+			Name: "DatascienceModel",
+			F:    sweepDatascienceModelResource,
 		})
 	}
 }
@@ -368,6 +368,7 @@ func sweepDatascienceModelResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, modelId := range modelIds {
 		if ok := acctest.SweeperDefaultResourceId[modelId]; !ok {
 			deleteModelRequest := oci_datascience.DeleteModelRequest{}
@@ -375,16 +376,20 @@ func sweepDatascienceModelResource(compartment string) error {
 			deleteModelRequest.ModelId = &modelId
 
 			deleteModelRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "datascience")
-			_, error := dataScienceClient.DeleteModel(context.Background(), deleteModelRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ModelId", modelId, func() error {
+				_, err := dataScienceClient.DeleteModel(context.Background(), deleteModelRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Model %s %s, It is possible that the resource is already deleted. Please verify manually \n", modelId, error)
+				fmt.Printf("Error deleting Model %s %s \n", modelId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &modelId, modelSweepWaitCondition, time.Duration(3*time.Minute),
 				modelSweepResponseFetchOperation, "datascience", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getModelIds(compartment string) ([]string, error) {
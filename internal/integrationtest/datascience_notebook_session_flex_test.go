@@ -113,10 +113,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatascienceFlexNotebookSession") {
-		resource.AddTestSweepers("DatascienceFlexNotebookSession", &resource.Sweeper{
-			Name:         "DatascienceFlexNotebookSession",
-			Dependencies: acctest.DependencyGraph["notebookSession"],
-			F:            sweepDatascienceNotebookSessionResource,
+		acctest.AddTestSweepers("DatascienceFlexNotebookSession", &resource.Sweeper{
+			Name: "DatascienceFlexNotebookSession",
+			F:    sweepDatascienceNotebookSessionResource,
 		})
 	}
 }
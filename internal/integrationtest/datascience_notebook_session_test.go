@@ -17,6 +17,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -520,10 +521,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatascienceNotebookSession") {
-		resource.AddTestSweepers("DatascienceNotebookSession", &resource.Sweeper{
-			Name:         "DatascienceNotebookSession",
-			Dependencies: acctest.DependencyGraph["notebookSession"],
-			F:            sweepDatascienceNotebookSessionResource,
+		acctest.AddTestSweepers("DatascienceNotebookSession", &resource.Sweeper{
+			Name: "DatascienceNotebookSession",
+			F:    sweepDatascienceNotebookSessionResource,
 		})
 	}
 }
@@ -534,6 +534,7 @@ func sweepDatascienceNotebookSessionResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, notebookSessionId := range notebookSessionIds {
 		if ok := acctest.SweeperDefaultResourceId[notebookSessionId]; !ok {
 			deleteNotebookSessionRequest := oci_datascience.DeleteNotebookSessionRequest{}
@@ -541,16 +542,20 @@ func sweepDatascienceNotebookSessionResource(compartment string) error {
 			deleteNotebookSessionRequest.NotebookSessionId = &notebookSessionId
 
 			deleteNotebookSessionRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "datascience")
-			_, err = dataScienceClient.DeleteNotebookSession(context.Background(), deleteNotebookSessionRequest)
+			err = acctest.DeleteSweeperResource(compartment, "NotebookSessionId", notebookSessionId, func() error {
+				_, err := dataScienceClient.DeleteNotebookSession(context.Background(), deleteNotebookSessionRequest)
+				return err
+			})
 			if err != nil {
-				fmt.Printf("Error deleting NotebookSession %s %s, It is possible that the resource is already deleted. Please verify manually \n", notebookSessionId, err)
+				fmt.Printf("Error deleting NotebookSession %s %s \n", notebookSessionId, err)
+				deleteErrors = multierror.Append(deleteErrors, err)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &notebookSessionId, notebookSessionSweepWaitCondition, time.Duration(3*time.Minute),
 				notebookSessionSweepResponseFetchOperation, "datascience", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getNotebookSessionIds(compartment string) ([]string, error) {
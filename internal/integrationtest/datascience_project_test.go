@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -272,10 +273,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DatascienceProject") {
-		resource.AddTestSweepers("DatascienceProject", &resource.Sweeper{
-			Name:         "DatascienceProject",
-			Dependencies: acctest.DependencyGraph["project"],
-			F:            sweepDatascienceProjectResource,
+		acctest.AddTestSweepers("DatascienceProject", &resource.Sweeper{
+			Name: "DatascienceProject",
+			F:    sweepDatascienceProjectResource,
 		})
 	}
 }
@@ -286,6 +286,7 @@ func sweepDatascienceProjectResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, projectId := range projectIds {
 		if ok := acctest.SweeperDefaultResourceId[projectId]; !ok {
 			deleteProjectRequest := oci_datascience.DeleteProjectRequest{}
@@ -293,16 +294,20 @@ func sweepDatascienceProjectResource(compartment string) error {
 			deleteProjectRequest.ProjectId = &projectId
 
 			deleteProjectRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "datascience")
-			_, error := dataScienceClient.DeleteProject(context.Background(), deleteProjectRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ProjectId", projectId, func() error {
+				_, err := dataScienceClient.DeleteProject(context.Background(), deleteProjectRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Project %s %s, It is possible that the resource is already deleted. Please verify manually \n", projectId, error)
+				fmt.Printf("Error deleting Project %s %s \n", projectId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &projectId, projectSweepWaitCondition, time.Duration(3*time.Minute),
 				projectSweepResponseFetchOperation, "datascience", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getProjectIds(compartment string) ([]string, error) {
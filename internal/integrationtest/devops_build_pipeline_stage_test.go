@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -272,10 +273,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DevopsBuildPipelineStage") {
-		resource.AddTestSweepers("DevopsBuildPipelineStage", &resource.Sweeper{
-			Name:         "DevopsBuildPipelineStage",
-			Dependencies: acctest.DependencyGraph["buildPipelineStage"],
-			F:            sweepDevopsBuildPipelineStageResource,
+		acctest.AddTestSweepers("DevopsBuildPipelineStage", &resource.Sweeper{
+			Name: "DevopsBuildPipelineStage",
+			F:    sweepDevopsBuildPipelineStageResource,
 		})
 	}
 }
@@ -286,6 +286,7 @@ func sweepDevopsBuildPipelineStageResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, buildPipelineStageId := range buildPipelineStageIds {
 		if ok := acctest.SweeperDefaultResourceId[buildPipelineStageId]; !ok {
 			deleteBuildPipelineStageRequest := oci_devops.DeleteBuildPipelineStageRequest{}
@@ -293,16 +294,20 @@ func sweepDevopsBuildPipelineStageResource(compartment string) error {
 			deleteBuildPipelineStageRequest.BuildPipelineStageId = &buildPipelineStageId
 
 			deleteBuildPipelineStageRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "devops")
-			_, error := devopsClient.DeleteBuildPipelineStage(context.Background(), deleteBuildPipelineStageRequest)
+			error := acctest.DeleteSweeperResource(compartment, "BuildPipelineStageId", buildPipelineStageId, func() error {
+				_, err := devopsClient.DeleteBuildPipelineStage(context.Background(), deleteBuildPipelineStageRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting BuildPipelineStage %s %s, It is possible that the resource is already deleted. Please verify manually \n", buildPipelineStageId, error)
+				fmt.Printf("Error deleting BuildPipelineStage %s %s \n", buildPipelineStageId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &buildPipelineStageId, buildPipelineStageSweepWaitCondition, time.Duration(3*time.Minute),
 				buildPipelineStageSweepResponseFetchOperation, "devops", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getBuildPipelineStageIds(compartment string) ([]string, error) {
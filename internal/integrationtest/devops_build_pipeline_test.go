@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -259,10 +260,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DevopsBuildPipeline") {
-		resource.AddTestSweepers("DevopsBuildPipeline", &resource.Sweeper{
-			Name:         "DevopsBuildPipeline",
-			Dependencies: acctest.DependencyGraph["buildPipeline"],
-			F:            sweepDevopsBuildPipelineResource,
+		acctest.AddTestSweepers("DevopsBuildPipeline", &resource.Sweeper{
+			Name: "DevopsBuildPipeline",
+			F:    sweepDevopsBuildPipelineResource,
 		})
 	}
 }
@@ -273,6 +273,7 @@ func sweepDevopsBuildPipelineResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, buildPipelineId := range buildPipelineIds {
 		if ok := acctest.SweeperDefaultResourceId[buildPipelineId]; !ok {
 			deleteBuildPipelineRequest := oci_devops.DeleteBuildPipelineRequest{}
@@ -280,16 +281,20 @@ func sweepDevopsBuildPipelineResource(compartment string) error {
 			deleteBuildPipelineRequest.BuildPipelineId = &buildPipelineId
 
 			deleteBuildPipelineRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "devops")
-			_, error := devopsClient.DeleteBuildPipeline(context.Background(), deleteBuildPipelineRequest)
+			error := acctest.DeleteSweeperResource(compartment, "BuildPipelineId", buildPipelineId, func() error {
+				_, err := devopsClient.DeleteBuildPipeline(context.Background(), deleteBuildPipelineRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting BuildPipeline %s %s, It is possible that the resource is already deleted. Please verify manually \n", buildPipelineId, error)
+				fmt.Printf("Error deleting BuildPipeline %s %s \n", buildPipelineId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &buildPipelineId, buildPipelineSweepWaitCondition, time.Duration(3*time.Minute),
 				buildPipelineSweepResponseFetchOperation, "devops", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getBuildPipelineIds(compartment string) ([]string, error) {
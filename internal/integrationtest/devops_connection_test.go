@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -235,10 +236,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DevopsConnection") {
-		resource.AddTestSweepers("DevopsConnection", &resource.Sweeper{
-			Name:         "DevopsConnection",
-			Dependencies: acctest.DependencyGraph["connection"],
-			F:            sweepDevopsConnectionResource,
+		acctest.AddTestSweepers("DevopsConnection", &resource.Sweeper{
+			Name: "DevopsConnection",
+			F:    sweepDevopsConnectionResource,
 		})
 	}
 }
@@ -249,6 +249,7 @@ func sweepDevopsConnectionResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, connectionId := range connectionIds {
 		if ok := acctest.SweeperDefaultResourceId[connectionId]; !ok {
 			deleteConnectionRequest := oci_devops.DeleteConnectionRequest{}
@@ -256,14 +257,18 @@ func sweepDevopsConnectionResource(compartment string) error {
 			deleteConnectionRequest.ConnectionId = &connectionId
 
 			deleteConnectionRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "devops")
-			_, error := devopsClient.DeleteConnection(context.Background(), deleteConnectionRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ConnectionId", connectionId, func() error {
+				_, err := devopsClient.DeleteConnection(context.Background(), deleteConnectionRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Connection %s %s, It is possible that the resource is already deleted. Please verify manually \n", connectionId, error)
+				fmt.Printf("Error deleting Connection %s %s \n", connectionId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func devopsGetConnectionIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -269,10 +270,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DevopsDeployArtifact") {
-		resource.AddTestSweepers("DevopsDeployArtifact", &resource.Sweeper{
-			Name:         "DevopsDeployArtifact",
-			Dependencies: acctest.DependencyGraph["deployArtifact"],
-			F:            sweepDevopsDeployArtifactResource,
+		acctest.AddTestSweepers("DevopsDeployArtifact", &resource.Sweeper{
+			Name: "DevopsDeployArtifact",
+			F:    sweepDevopsDeployArtifactResource,
 		})
 	}
 }
@@ -283,6 +283,7 @@ func sweepDevopsDeployArtifactResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, deployArtifactId := range deployArtifactIds {
 		if ok := acctest.SweeperDefaultResourceId[deployArtifactId]; !ok {
 			deleteDeployArtifactRequest := oci_devops.DeleteDeployArtifactRequest{}
@@ -290,16 +291,20 @@ func sweepDevopsDeployArtifactResource(compartment string) error {
 			deleteDeployArtifactRequest.DeployArtifactId = &deployArtifactId
 
 			deleteDeployArtifactRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "devops")
-			_, error := deployArtifactClient.DeleteDeployArtifact(context.Background(), deleteDeployArtifactRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DeployArtifactId", deployArtifactId, func() error {
+				_, err := deployArtifactClient.DeleteDeployArtifact(context.Background(), deleteDeployArtifactRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting DeployArtifact %s %s, It is possible that the resource is already deleted. Please verify manually \n", deployArtifactId, error)
+				fmt.Printf("Error deleting DeployArtifact %s %s \n", deployArtifactId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &deployArtifactId, deployArtifactSweepWaitCondition, time.Duration(3*time.Minute),
 				deployArtifactSweepResponseFetchOperation, "devops", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDeployArtifactIds(compartment string) ([]string, error) {
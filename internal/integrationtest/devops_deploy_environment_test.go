@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -250,10 +251,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DevopsDeployEnvironment") {
-		resource.AddTestSweepers("DevopsDeployEnvironment", &resource.Sweeper{
-			Name:         "DevopsDeployEnvironment",
-			Dependencies: acctest.DependencyGraph["deployEnvironment"],
-			F:            sweepDevopsDeployEnvironmentResource,
+		acctest.AddTestSweepers("DevopsDeployEnvironment", &resource.Sweeper{
+			Name: "DevopsDeployEnvironment",
+			F:    sweepDevopsDeployEnvironmentResource,
 		})
 	}
 }
@@ -264,6 +264,7 @@ func sweepDevopsDeployEnvironmentResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, deployEnvironmentId := range deployEnvironmentIds {
 		if ok := acctest.SweeperDefaultResourceId[deployEnvironmentId]; !ok {
 			deleteDeployEnvironmentRequest := oci_devops.DeleteDeployEnvironmentRequest{}
@@ -271,16 +272,20 @@ func sweepDevopsDeployEnvironmentResource(compartment string) error {
 			deleteDeployEnvironmentRequest.DeployEnvironmentId = &deployEnvironmentId
 
 			deleteDeployEnvironmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "devops")
-			_, error := deployEnvironmentClient.DeleteDeployEnvironment(context.Background(), deleteDeployEnvironmentRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DeployEnvironmentId", deployEnvironmentId, func() error {
+				_, err := deployEnvironmentClient.DeleteDeployEnvironment(context.Background(), deleteDeployEnvironmentRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting DeployEnvironment %s %s, It is possible that the resource is already deleted. Please verify manually \n", deployEnvironmentId, error)
+				fmt.Printf("Error deleting DeployEnvironment %s %s \n", deployEnvironmentId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &deployEnvironmentId, deployEnvironmentSweepWaitCondition, time.Duration(3*time.Minute),
 				deployEnvironmentSweepResponseFetchOperation, "devops", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDeployEnvironmentIds(compartment string) ([]string, error) {
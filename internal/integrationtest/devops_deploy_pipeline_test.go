@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -263,10 +264,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DevopsDeployPipeline") {
-		resource.AddTestSweepers("DevopsDeployPipeline", &resource.Sweeper{
-			Name:         "DevopsDeployPipeline",
-			Dependencies: acctest.DependencyGraph["deployPipeline"],
-			F:            sweepDevopsDeployPipelineResource,
+		acctest.AddTestSweepers("DevopsDeployPipeline", &resource.Sweeper{
+			Name: "DevopsDeployPipeline",
+			F:    sweepDevopsDeployPipelineResource,
 		})
 	}
 }
@@ -277,6 +277,7 @@ func sweepDevopsDeployPipelineResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, deployPipelineId := range deployPipelineIds {
 		if ok := acctest.SweeperDefaultResourceId[deployPipelineId]; !ok {
 			deleteDeployPipelineRequest := oci_devops.DeleteDeployPipelineRequest{}
@@ -284,16 +285,20 @@ func sweepDevopsDeployPipelineResource(compartment string) error {
 			deleteDeployPipelineRequest.DeployPipelineId = &deployPipelineId
 
 			deleteDeployPipelineRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "devops")
-			_, error := deployPipelineClient.DeleteDeployPipeline(context.Background(), deleteDeployPipelineRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DeployPipelineId", deployPipelineId, func() error {
+				_, err := deployPipelineClient.DeleteDeployPipeline(context.Background(), deleteDeployPipelineRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting DeployPipeline %s %s, It is possible that the resource is already deleted. Please verify manually \n", deployPipelineId, error)
+				fmt.Printf("Error deleting DeployPipeline %s %s \n", deployPipelineId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &deployPipelineId, deployPipelineSweepWaitCondition, time.Duration(3*time.Minute),
 				deployPipelineSweepResponseFetchOperation, "devops", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDeployPipelineIds(compartment string) ([]string, error) {
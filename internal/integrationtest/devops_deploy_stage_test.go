@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -280,10 +281,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DevopsDeployStage") {
-		resource.AddTestSweepers("DevopsDeployStage", &resource.Sweeper{
-			Name:         "DevopsDeployStage",
-			Dependencies: acctest.DependencyGraph["deployStage"],
-			F:            sweepDevopsDeployStageResource,
+		acctest.AddTestSweepers("DevopsDeployStage", &resource.Sweeper{
+			Name: "DevopsDeployStage",
+			F:    sweepDevopsDeployStageResource,
 		})
 	}
 }
@@ -294,6 +294,7 @@ func sweepDevopsDeployStageResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, deployStageId := range deployStageIds {
 		if ok := acctest.SweeperDefaultResourceId[deployStageId]; !ok {
 			deleteDeployStageRequest := oci_devops.DeleteDeployStageRequest{}
@@ -301,16 +302,20 @@ func sweepDevopsDeployStageResource(compartment string) error {
 			deleteDeployStageRequest.DeployStageId = &deployStageId
 
 			deleteDeployStageRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "devops")
-			_, error := deployStageClient.DeleteDeployStage(context.Background(), deleteDeployStageRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DeployStageId", deployStageId, func() error {
+				_, err := deployStageClient.DeleteDeployStage(context.Background(), deleteDeployStageRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting DeployStage %s %s, It is possible that the resource is already deleted. Please verify manually \n", deployStageId, error)
+				fmt.Printf("Error deleting DeployStage %s %s \n", deployStageId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &deployStageId, deployStageSweepWaitCondition, time.Duration(3*time.Minute),
 				deployStageSweepResponseFetchOperation, "devops", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDeployStageIds(compartment string) ([]string, error) {
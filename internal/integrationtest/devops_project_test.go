@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -289,10 +290,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DevopsProject") {
-		resource.AddTestSweepers("DevopsProject", &resource.Sweeper{
-			Name:         "DevopsProject",
-			Dependencies: acctest.DependencyGraph["project"],
-			F:            sweepDevopsProjectResource,
+		acctest.AddTestSweepers("DevopsProject", &resource.Sweeper{
+			Name: "DevopsProject",
+			F:    sweepDevopsProjectResource,
 		})
 	}
 }
@@ -303,6 +303,7 @@ func sweepDevopsProjectResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, projectId := range projectIds {
 		if ok := acctest.SweeperDefaultResourceId[projectId]; !ok {
 			deleteProjectRequest := oci_devops.DeleteProjectRequest{}
@@ -310,16 +311,20 @@ func sweepDevopsProjectResource(compartment string) error {
 			deleteProjectRequest.ProjectId = &projectId
 
 			deleteProjectRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "devops")
-			_, error := projectClient.DeleteProject(context.Background(), deleteProjectRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ProjectId", projectId, func() error {
+				_, err := projectClient.DeleteProject(context.Background(), deleteProjectRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Project %s %s, It is possible that the resource is already deleted. Please verify manually \n", projectId, error)
+				fmt.Printf("Error deleting Project %s %s \n", projectId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &projectId, devopsProjectSweepWaitCondition, time.Duration(3*time.Minute),
 				devopsProjectSweepResponseFetchOperation, "devops", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDevopsProjectIds(compartment string) ([]string, error) {
@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -206,10 +207,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DevopsRepositoryTagRef") {
-		resource.AddTestSweepers("DevopsRepositoryTagRef", &resource.Sweeper{
-			Name:         "DevopsRepositoryTagRef",
-			Dependencies: acctest.DependencyGraph["repositoryRef"],
-			F:            sweepDevopsRepositoryTagRefResource,
+		acctest.AddTestSweepers("DevopsRepositoryTagRef", &resource.Sweeper{
+			Name: "DevopsRepositoryTagRef",
+			F:    sweepDevopsRepositoryTagRefResource,
 		})
 	}
 }
@@ -220,20 +220,25 @@ func sweepDevopsRepositoryTagRefResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, repositoryRefId := range repositoryRefIds {
 		if ok := acctest.SweeperDefaultResourceId[repositoryRefId]; !ok {
 			deleteRefRequest := oci_devops.DeleteRefRequest{}
 			deleteRefRequest.RefName = &repositoryRefId
 
 			deleteRefRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "devops")
-			_, error := devopsClient.DeleteRef(context.Background(), deleteRefRequest)
+			error := acctest.DeleteSweeperResource(compartment, "RepositoryTagRefId", repositoryRefId, func() error {
+				_, err := devopsClient.DeleteRef(context.Background(), deleteRefRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting RepositoryRef %s %s, It is possible that the resource is already deleted. Please verify manually \n", repositoryRefId, error)
+				fmt.Printf("Error deleting RepositoryRef %s %s \n", repositoryRefId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getRepositoryTagRefIds(compartment string) ([]string, error) {
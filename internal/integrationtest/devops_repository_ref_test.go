@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -196,10 +197,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DevopsRepositoryRef") {
-		resource.AddTestSweepers("DevopsRepositoryRef", &resource.Sweeper{
-			Name:         "DevopsRepositoryRef",
-			Dependencies: acctest.DependencyGraph["repositoryRef"],
-			F:            sweepDevopsRepositoryRefResource,
+		acctest.AddTestSweepers("DevopsRepositoryRef", &resource.Sweeper{
+			Name: "DevopsRepositoryRef",
+			F:    sweepDevopsRepositoryRefResource,
 		})
 	}
 }
@@ -210,19 +210,24 @@ func sweepDevopsRepositoryRefResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, repositoryRefId := range repositoryRefIds {
 		if ok := acctest.SweeperDefaultResourceId[repositoryRefId]; !ok {
 			deleteRefRequest := oci_devops.DeleteRefRequest{}
 
 			deleteRefRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "devops")
-			_, error := devopsClient.DeleteRef(context.Background(), deleteRefRequest)
+			error := acctest.DeleteSweeperResource(compartment, "RepositoryRefId", repositoryRefId, func() error {
+				_, err := devopsClient.DeleteRef(context.Background(), deleteRefRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting RepositoryRef %s %s, It is possible that the resource is already deleted. Please verify manually \n", repositoryRefId, error)
+				fmt.Printf("Error deleting RepositoryRef %s %s \n", repositoryRefId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getRepositoryRefIds(compartment string) ([]string, error) {
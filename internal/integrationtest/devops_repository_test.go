@@ -17,6 +17,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -260,10 +261,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DevopsRepository") {
-		resource.AddTestSweepers("DevopsRepository", &resource.Sweeper{
-			Name:         "DevopsRepository",
-			Dependencies: acctest.DependencyGraph["repository"],
-			F:            sweepDevopsRepositoryResource,
+		acctest.AddTestSweepers("DevopsRepository", &resource.Sweeper{
+			Name: "DevopsRepository",
+			F:    sweepDevopsRepositoryResource,
 		})
 	}
 }
@@ -274,6 +274,7 @@ func sweepDevopsRepositoryResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, repositoryId := range repositoryIds {
 		if ok := acctest.SweeperDefaultResourceId[repositoryId]; !ok {
 			deleteRepositoryRequest := oci_devops.DeleteRepositoryRequest{}
@@ -281,16 +282,20 @@ func sweepDevopsRepositoryResource(compartment string) error {
 			deleteRepositoryRequest.RepositoryId = &repositoryId
 
 			deleteRepositoryRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "devops")
-			_, error := devopsClient.DeleteRepository(context.Background(), deleteRepositoryRequest)
+			error := acctest.DeleteSweeperResource(compartment, "RepositoryId", repositoryId, func() error {
+				_, err := devopsClient.DeleteRepository(context.Background(), deleteRepositoryRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Repository %s %s, It is possible that the resource is already deleted. Please verify manually \n", repositoryId, error)
+				fmt.Printf("Error deleting Repository %s %s \n", repositoryId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &repositoryId, devopsRepositorySweepWaitCondition, time.Duration(3*time.Minute),
 				devopsRepositorySweepResponseFetchOperation, "devops", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func devopsGetRepositoryIds(compartment string) ([]string, error) {
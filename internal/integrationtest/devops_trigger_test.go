@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -273,10 +274,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DevopsTrigger") {
-		resource.AddTestSweepers("DevopsTrigger", &resource.Sweeper{
-			Name:         "DevopsTrigger",
-			Dependencies: acctest.DependencyGraph["trigger"],
-			F:            sweepDevopsTriggerResource,
+		acctest.AddTestSweepers("DevopsTrigger", &resource.Sweeper{
+			Name: "DevopsTrigger",
+			F:    sweepDevopsTriggerResource,
 		})
 	}
 }
@@ -287,6 +287,7 @@ func sweepDevopsTriggerResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, triggerId := range triggerIds {
 		if ok := acctest.SweeperDefaultResourceId[triggerId]; !ok {
 			deleteTriggerRequest := oci_devops.DeleteTriggerRequest{}
@@ -294,14 +295,18 @@ func sweepDevopsTriggerResource(compartment string) error {
 			deleteTriggerRequest.TriggerId = &triggerId
 
 			deleteTriggerRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "devops")
-			_, error := devopsClient.DeleteTrigger(context.Background(), deleteTriggerRequest)
+			error := acctest.DeleteSweeperResource(compartment, "TriggerId", triggerId, func() error {
+				_, err := devopsClient.DeleteTrigger(context.Background(), deleteTriggerRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Trigger %s %s, It is possible that the resource is already deleted. Please verify manually \n", triggerId, error)
+				fmt.Printf("Error deleting Trigger %s %s \n", triggerId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getTriggerIds(compartment string) ([]string, error) {
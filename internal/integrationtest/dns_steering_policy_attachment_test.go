@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -299,10 +300,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DnsSteeringPolicyAttachment") {
-		resource.AddTestSweepers("DnsSteeringPolicyAttachment", &resource.Sweeper{
-			Name:         "DnsSteeringPolicyAttachment",
-			Dependencies: acctest.DependencyGraph["steeringPolicyAttachment"],
-			F:            sweepDnsSteeringPolicyAttachmentResource,
+		acctest.AddTestSweepers("DnsSteeringPolicyAttachment", &resource.Sweeper{
+			Name: "DnsSteeringPolicyAttachment",
+			F:    sweepDnsSteeringPolicyAttachmentResource,
 		})
 	}
 }
@@ -313,6 +313,7 @@ func sweepDnsSteeringPolicyAttachmentResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, steeringPolicyAttachmentId := range steeringPolicyAttachmentIds {
 		if ok := acctest.SweeperDefaultResourceId[steeringPolicyAttachmentId]; !ok {
 			deleteSteeringPolicyAttachmentRequest := oci_dns.DeleteSteeringPolicyAttachmentRequest{}
@@ -320,14 +321,18 @@ func sweepDnsSteeringPolicyAttachmentResource(compartment string) error {
 			deleteSteeringPolicyAttachmentRequest.SteeringPolicyAttachmentId = &steeringPolicyAttachmentId
 
 			deleteSteeringPolicyAttachmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "dns")
-			_, error := dnsClient.DeleteSteeringPolicyAttachment(context.Background(), deleteSteeringPolicyAttachmentRequest)
+			error := acctest.DeleteSweeperResource(compartment, "SteeringPolicyAttachmentId", steeringPolicyAttachmentId, func() error {
+				_, err := dnsClient.DeleteSteeringPolicyAttachment(context.Background(), deleteSteeringPolicyAttachmentRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting SteeringPolicyAttachment %s %s, It is possible that the resource is already deleted. Please verify manually \n", steeringPolicyAttachmentId, error)
+				fmt.Printf("Error deleting SteeringPolicyAttachment %s %s \n", steeringPolicyAttachmentId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getSteeringPolicyAttachmentIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -581,10 +582,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DnsSteeringPolicy") {
-		resource.AddTestSweepers("DnsSteeringPolicy", &resource.Sweeper{
-			Name:         "DnsSteeringPolicy",
-			Dependencies: acctest.DependencyGraph["steeringPolicy"],
-			F:            sweepDnsSteeringPolicyResource,
+		acctest.AddTestSweepers("DnsSteeringPolicy", &resource.Sweeper{
+			Name: "DnsSteeringPolicy",
+			F:    sweepDnsSteeringPolicyResource,
 		})
 	}
 }
@@ -595,6 +595,7 @@ func sweepDnsSteeringPolicyResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, steeringPolicyId := range steeringPolicyIds {
 		if ok := acctest.SweeperDefaultResourceId[steeringPolicyId]; !ok {
 			deleteSteeringPolicyRequest := oci_dns.DeleteSteeringPolicyRequest{}
@@ -602,16 +603,20 @@ func sweepDnsSteeringPolicyResource(compartment string) error {
 			deleteSteeringPolicyRequest.SteeringPolicyId = &steeringPolicyId
 
 			deleteSteeringPolicyRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "dns")
-			_, error := dnsClient.DeleteSteeringPolicy(context.Background(), deleteSteeringPolicyRequest)
+			error := acctest.DeleteSweeperResource(compartment, "SteeringPolicyId", steeringPolicyId, func() error {
+				_, err := dnsClient.DeleteSteeringPolicy(context.Background(), deleteSteeringPolicyRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting SteeringPolicy %s %s, It is possible that the resource is already deleted. Please verify manually \n", steeringPolicyId, error)
+				fmt.Printf("Error deleting SteeringPolicy %s %s \n", steeringPolicyId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &steeringPolicyId, steeringPolicySweepWaitCondition, time.Duration(3*time.Minute),
 				steeringPolicySweepResponseFetchOperation, "dns", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getSteeringPolicyIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -270,10 +271,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DnsTsigKey") {
-		resource.AddTestSweepers("DnsTsigKey", &resource.Sweeper{
-			Name:         "DnsTsigKey",
-			Dependencies: acctest.DependencyGraph["tsigKey"],
-			F:            sweepDnsTsigKeyResource,
+		acctest.AddTestSweepers("DnsTsigKey", &resource.Sweeper{
+			Name: "DnsTsigKey",
+			F:    sweepDnsTsigKeyResource,
 		})
 	}
 }
@@ -284,6 +284,7 @@ func sweepDnsTsigKeyResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, tsigKeyId := range tsigKeyIds {
 		if ok := acctest.SweeperDefaultResourceId[tsigKeyId]; !ok {
 			deleteTsigKeyRequest := oci_dns.DeleteTsigKeyRequest{}
@@ -291,16 +292,20 @@ func sweepDnsTsigKeyResource(compartment string) error {
 			deleteTsigKeyRequest.TsigKeyId = &tsigKeyId
 
 			deleteTsigKeyRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "dns")
-			_, error := dnsClient.DeleteTsigKey(context.Background(), deleteTsigKeyRequest)
+			error := acctest.DeleteSweeperResource(compartment, "TsigKeyId", tsigKeyId, func() error {
+				_, err := dnsClient.DeleteTsigKey(context.Background(), deleteTsigKeyRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting TsigKey %s %s, It is possible that the resource is already deleted. Please verify manually \n", tsigKeyId, error)
+				fmt.Printf("Error deleting TsigKey %s %s \n", tsigKeyId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &tsigKeyId, tsigKeySweepWaitCondition, time.Duration(3*time.Minute),
 				tsigKeySweepResponseFetchOperation, "dns", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getTsigKeyIds(compartment string) ([]string, error) {
@@ -14,6 +14,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -295,10 +296,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DnsView") {
-		resource.AddTestSweepers("DnsView", &resource.Sweeper{
-			Name:         "DnsView",
-			Dependencies: acctest.DependencyGraph["view"],
-			F:            sweepDnsViewResource,
+		acctest.AddTestSweepers("DnsView", &resource.Sweeper{
+			Name: "DnsView",
+			F:    sweepDnsViewResource,
 		})
 	}
 }
@@ -309,6 +309,7 @@ func sweepDnsViewResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, viewId := range viewIds {
 		if ok := acctest.SweeperDefaultResourceId[viewId]; !ok {
 			deleteViewRequest := oci_dns.DeleteViewRequest{}
@@ -316,16 +317,20 @@ func sweepDnsViewResource(compartment string) error {
 			deleteViewRequest.ViewId = &viewId
 
 			deleteViewRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "dns")
-			_, error := dnsClient.DeleteView(context.Background(), deleteViewRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ViewId", viewId, func() error {
+				_, err := dnsClient.DeleteView(context.Background(), deleteViewRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting View %s %s, It is possible that the resource is already deleted. Please verify manually \n", viewId, error)
+				fmt.Printf("Error deleting View %s %s \n", viewId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &viewId, viewSweepWaitCondition, time.Duration(3*time.Minute),
 				viewSweepResponseFetchOperation, "dns", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getViewIds(compartment string) ([]string, error) {
@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -385,10 +386,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("DnsZone") {
-		resource.AddTestSweepers("DnsZone", &resource.Sweeper{
-			Name:         "DnsZone",
-			Dependencies: acctest.DependencyGraph["zone"],
-			F:            sweepDnsZoneResource,
+		acctest.AddTestSweepers("DnsZone", &resource.Sweeper{
+			Name: "DnsZone",
+			F:    sweepDnsZoneResource,
 		})
 	}
 }
@@ -399,6 +399,7 @@ func sweepDnsZoneResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, zoneId := range zoneIds {
 		if ok := acctest.SweeperDefaultResourceId[zoneId]; !ok {
 			deleteZoneRequest := oci_dns.DeleteZoneRequest{}
@@ -406,16 +407,20 @@ func sweepDnsZoneResource(compartment string) error {
 			deleteZoneRequest.ZoneNameOrId = &zoneId
 
 			deleteZoneRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "dns")
-			_, error := dnsClient.DeleteZone(context.Background(), deleteZoneRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ZoneId", zoneId, func() error {
+				_, err := dnsClient.DeleteZone(context.Background(), deleteZoneRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Zone %s %s, It is possible that the resource is already deleted. Please verify manually \n", zoneId, error)
+				fmt.Printf("Error deleting Zone %s %s \n", zoneId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &zoneId, zoneSweepWaitCondition, time.Duration(3*time.Minute),
 				zoneSweepResponseFetchOperation, "dns", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getZoneIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -231,10 +232,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("EmailDkim") {
-		resource.AddTestSweepers("EmailDkim", &resource.Sweeper{
-			Name:         "EmailDkim",
-			Dependencies: acctest.DependencyGraph["dkim"],
-			F:            sweepEmailDkimResource,
+		acctest.AddTestSweepers("EmailDkim", &resource.Sweeper{
+			Name: "EmailDkim",
+			F:    sweepEmailDkimResource,
 		})
 	}
 }
@@ -245,6 +245,7 @@ func sweepEmailDkimResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, dkimId := range dkimIds {
 		if ok := acctest.SweeperDefaultResourceId[dkimId]; !ok {
 			deleteDkimRequest := oci_email.DeleteDkimRequest{}
@@ -252,16 +253,20 @@ func sweepEmailDkimResource(compartment string) error {
 			deleteDkimRequest.DkimId = &dkimId
 
 			deleteDkimRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "email")
-			_, error := emailClient.DeleteDkim(context.Background(), deleteDkimRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DkimId", dkimId, func() error {
+				_, err := emailClient.DeleteDkim(context.Background(), deleteDkimRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Dkim %s %s, It is possible that the resource is already deleted. Please verify manually \n", dkimId, error)
+				fmt.Printf("Error deleting Dkim %s %s \n", dkimId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &dkimId, dkimSweepWaitCondition, time.Duration(3*time.Minute),
 				dkimSweepResponseFetchOperation, "email", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDkimIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -257,10 +258,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("EmailEmailDomain") {
-		resource.AddTestSweepers("EmailEmailDomain", &resource.Sweeper{
-			Name:         "EmailEmailDomain",
-			Dependencies: acctest.DependencyGraph["emailDomain"],
-			F:            sweepEmailEmailDomainResource,
+		acctest.AddTestSweepers("EmailEmailDomain", &resource.Sweeper{
+			Name: "EmailEmailDomain",
+			F:    sweepEmailEmailDomainResource,
 		})
 	}
 }
@@ -271,6 +271,7 @@ func sweepEmailEmailDomainResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, emailDomainId := range emailDomainIds {
 		if ok := acctest.SweeperDefaultResourceId[emailDomainId]; !ok {
 			deleteEmailDomainRequest := oci_email.DeleteEmailDomainRequest{}
@@ -278,16 +279,20 @@ func sweepEmailEmailDomainResource(compartment string) error {
 			deleteEmailDomainRequest.EmailDomainId = &emailDomainId
 
 			deleteEmailDomainRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "email")
-			_, error := emailClient.DeleteEmailDomain(context.Background(), deleteEmailDomainRequest)
+			error := acctest.DeleteSweeperResource(compartment, "EmailDomainId", emailDomainId, func() error {
+				_, err := emailClient.DeleteEmailDomain(context.Background(), deleteEmailDomainRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting EmailDomain %s %s, It is possible that the resource is already deleted. Please verify manually \n", emailDomainId, error)
+				fmt.Printf("Error deleting EmailDomain %s %s \n", emailDomainId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &emailDomainId, emailDomainSweepWaitCondition, time.Duration(3*time.Minute),
 				emailDomainSweepResponseFetchOperation, "email", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getEmailDomainIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -256,10 +257,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("EmailSender") {
-		resource.AddTestSweepers("EmailSender", &resource.Sweeper{
-			Name:         "EmailSender",
-			Dependencies: acctest.DependencyGraph["sender"],
-			F:            sweepEmailSenderResource,
+		acctest.AddTestSweepers("EmailSender", &resource.Sweeper{
+			Name: "EmailSender",
+			F:    sweepEmailSenderResource,
 		})
 	}
 }
@@ -270,6 +270,7 @@ func sweepEmailSenderResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, senderId := range senderIds {
 		if ok := acctest.SweeperDefaultResourceId[senderId]; !ok {
 			deleteSenderRequest := oci_email.DeleteSenderRequest{}
@@ -277,16 +278,20 @@ func sweepEmailSenderResource(compartment string) error {
 			deleteSenderRequest.SenderId = &senderId
 
 			deleteSenderRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "email")
-			_, error := emailClient.DeleteSender(context.Background(), deleteSenderRequest)
+			error := acctest.DeleteSweeperResource(compartment, "SenderId", senderId, func() error {
+				_, err := emailClient.DeleteSender(context.Background(), deleteSenderRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Sender %s %s, It is possible that the resource is already deleted. Please verify manually \n", senderId, error)
+				fmt.Printf("Error deleting Sender %s %s \n", senderId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &senderId, senderSweepWaitCondition, time.Duration(3*time.Minute),
 				senderSweepResponseFetchOperation, "email", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getSenderIds(compartment string) ([]string, error) {
@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -180,10 +181,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("EmailSuppression") {
-		resource.AddTestSweepers("EmailSuppression", &resource.Sweeper{
-			Name:         "EmailSuppression",
-			Dependencies: acctest.DependencyGraph["suppression"],
-			F:            sweepEmailSuppressionResource,
+		acctest.AddTestSweepers("EmailSuppression", &resource.Sweeper{
+			Name: "EmailSuppression",
+			F:    sweepEmailSuppressionResource,
 		})
 	}
 }
@@ -196,6 +196,7 @@ func sweepEmailSuppressionResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, suppressionId := range suppressionIds {
 		if ok := acctest.SweeperDefaultResourceId[suppressionId]; !ok {
 			deleteSuppressionRequest := oci_email.DeleteSuppressionRequest{}
@@ -203,14 +204,18 @@ func sweepEmailSuppressionResource(compartment string) error {
 			deleteSuppressionRequest.SuppressionId = &suppressionId
 
 			deleteSuppressionRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "email")
-			_, error := emailClient.DeleteSuppression(context.Background(), deleteSuppressionRequest)
+			error := acctest.DeleteSweeperResource(compartment, "SuppressionId", suppressionId, func() error {
+				_, err := emailClient.DeleteSuppression(context.Background(), deleteSuppressionRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Suppression %s %s, It is possible that the resource is already deleted. Please verify manually \n", suppressionId, error)
+				fmt.Printf("Error deleting Suppression %s %s \n", suppressionId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getSuppressionIds(compartment string) ([]string, error) {
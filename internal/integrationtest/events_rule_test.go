@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -353,10 +354,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("EventsRule") {
-		resource.AddTestSweepers("EventsRule", &resource.Sweeper{
-			Name:         "EventsRule",
-			Dependencies: acctest.DependencyGraph["rule"],
-			F:            sweepEventsRuleResource,
+		acctest.AddTestSweepers("EventsRule", &resource.Sweeper{
+			Name: "EventsRule",
+			F:    sweepEventsRuleResource,
 		})
 	}
 }
@@ -367,6 +367,7 @@ func sweepEventsRuleResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, ruleId := range ruleIds {
 		if ok := acctest.SweeperDefaultResourceId[ruleId]; !ok {
 			deleteRuleRequest := oci_events.DeleteRuleRequest{}
@@ -374,16 +375,20 @@ func sweepEventsRuleResource(compartment string) error {
 			deleteRuleRequest.RuleId = &ruleId
 
 			deleteRuleRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "events")
-			_, error := eventsClient.DeleteRule(context.Background(), deleteRuleRequest)
+			error := acctest.DeleteSweeperResource(compartment, "RuleId", ruleId, func() error {
+				_, err := eventsClient.DeleteRule(context.Background(), deleteRuleRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Rule %s %s, It is possible that the resource is already deleted. Please verify manually \n", ruleId, error)
+				fmt.Printf("Error deleting Rule %s %s \n", ruleId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &ruleId, ruleSweepWaitCondition, time.Duration(3*time.Minute),
 				ruleSweepResponseFetchOperation, "events", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getRuleIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -236,10 +237,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("FileStorageExport") {
-		resource.AddTestSweepers("FileStorageExport", &resource.Sweeper{
-			Name:         "FileStorageExport",
-			Dependencies: acctest.DependencyGraph["export"],
-			F:            sweepFileStorageExportResource,
+		acctest.AddTestSweepers("FileStorageExport", &resource.Sweeper{
+			Name: "FileStorageExport",
+			F:    sweepFileStorageExportResource,
 		})
 	}
 }
@@ -250,6 +250,7 @@ func sweepFileStorageExportResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, exportId := range exportIds {
 		if ok := acctest.SweeperDefaultResourceId[exportId]; !ok {
 			deleteExportRequest := oci_file_storage.DeleteExportRequest{}
@@ -257,16 +258,20 @@ func sweepFileStorageExportResource(compartment string) error {
 			deleteExportRequest.ExportId = &exportId
 
 			deleteExportRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "file_storage")
-			_, error := fileStorageClient.DeleteExport(context.Background(), deleteExportRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ExportId", exportId, func() error {
+				_, err := fileStorageClient.DeleteExport(context.Background(), deleteExportRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Export %s %s, It is possible that the resource is already deleted. Please verify manually \n", exportId, error)
+				fmt.Printf("Error deleting Export %s %s \n", exportId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &exportId, exportSweepWaitCondition, time.Duration(3*time.Minute),
 				exportSweepResponseFetchOperation, "file_storage", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getExportIds(compartment string) ([]string, error) {
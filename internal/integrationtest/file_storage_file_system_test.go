@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -271,10 +272,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("FileStorageFileSystem") {
-		resource.AddTestSweepers("FileStorageFileSystem", &resource.Sweeper{
-			Name:         "FileStorageFileSystem",
-			Dependencies: acctest.DependencyGraph["fileSystem"],
-			F:            sweepFileStorageFileSystemResource,
+		acctest.AddTestSweepers("FileStorageFileSystem", &resource.Sweeper{
+			Name: "FileStorageFileSystem",
+			F:    sweepFileStorageFileSystemResource,
 		})
 	}
 }
@@ -285,6 +285,7 @@ func sweepFileStorageFileSystemResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, fileSystemId := range fileSystemIds {
 		if ok := acctest.SweeperDefaultResourceId[fileSystemId]; !ok {
 			deleteFileSystemRequest := oci_file_storage.DeleteFileSystemRequest{}
@@ -292,16 +293,20 @@ func sweepFileStorageFileSystemResource(compartment string) error {
 			deleteFileSystemRequest.FileSystemId = &fileSystemId
 
 			deleteFileSystemRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "file_storage")
-			_, error := fileStorageClient.DeleteFileSystem(context.Background(), deleteFileSystemRequest)
+			error := acctest.DeleteSweeperResource(compartment, "FileSystemId", fileSystemId, func() error {
+				_, err := fileStorageClient.DeleteFileSystem(context.Background(), deleteFileSystemRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting FileSystem %s %s, It is possible that the resource is already deleted. Please verify manually \n", fileSystemId, error)
+				fmt.Printf("Error deleting FileSystem %s %s \n", fileSystemId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &fileSystemId, fileSystemSweepWaitCondition, time.Duration(3*time.Minute),
 				fileSystemSweepResponseFetchOperation, "file_storage", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getFileSystemIds(compartment string) ([]string, error) {
@@ -17,6 +17,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -303,10 +304,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("FileStorageMountTarget") {
-		resource.AddTestSweepers("FileStorageMountTarget", &resource.Sweeper{
-			Name:         "FileStorageMountTarget",
-			Dependencies: acctest.DependencyGraph["mountTarget"],
-			F:            sweepFileStorageMountTargetResource,
+		acctest.AddTestSweepers("FileStorageMountTarget", &resource.Sweeper{
+			Name: "FileStorageMountTarget",
+			F:    sweepFileStorageMountTargetResource,
 		})
 	}
 }
@@ -317,6 +317,7 @@ func sweepFileStorageMountTargetResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, mountTargetId := range mountTargetIds {
 		if ok := acctest.SweeperDefaultResourceId[mountTargetId]; !ok {
 			deleteMountTargetRequest := oci_file_storage.DeleteMountTargetRequest{}
@@ -324,16 +325,20 @@ func sweepFileStorageMountTargetResource(compartment string) error {
 			deleteMountTargetRequest.MountTargetId = &mountTargetId
 
 			deleteMountTargetRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "file_storage")
-			_, error := fileStorageClient.DeleteMountTarget(context.Background(), deleteMountTargetRequest)
+			error := acctest.DeleteSweeperResource(compartment, "MountTargetId", mountTargetId, func() error {
+				_, err := fileStorageClient.DeleteMountTarget(context.Background(), deleteMountTargetRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting MountTarget %s %s, It is possible that the resource is already deleted. Please verify manually \n", mountTargetId, error)
+				fmt.Printf("Error deleting MountTarget %s %s \n", mountTargetId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &mountTargetId, mountTargetSweepWaitCondition, time.Duration(3*time.Minute),
 				mountTargetSweepResponseFetchOperation, "file_storage", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getMountTargetIds(compartment string) ([]string, error) {
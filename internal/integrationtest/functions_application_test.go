@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -330,10 +331,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("FunctionsApplication") {
-		resource.AddTestSweepers("FunctionsApplication", &resource.Sweeper{
-			Name:         "FunctionsApplication",
-			Dependencies: acctest.DependencyGraph["application"],
-			F:            sweepFunctionsApplicationResource,
+		acctest.AddTestSweepers("FunctionsApplication", &resource.Sweeper{
+			Name: "FunctionsApplication",
+			F:    sweepFunctionsApplicationResource,
 		})
 	}
 }
@@ -344,6 +344,7 @@ func sweepFunctionsApplicationResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, applicationId := range applicationIds {
 		if ok := acctest.SweeperDefaultResourceId[applicationId]; !ok {
 			deleteApplicationRequest := oci_functions.DeleteApplicationRequest{}
@@ -351,16 +352,20 @@ func sweepFunctionsApplicationResource(compartment string) error {
 			deleteApplicationRequest.ApplicationId = &applicationId
 
 			deleteApplicationRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "functions")
-			_, error := functionsManagementClient.DeleteApplication(context.Background(), deleteApplicationRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ApplicationId", applicationId, func() error {
+				_, err := functionsManagementClient.DeleteApplication(context.Background(), deleteApplicationRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Application %s %s, It is possible that the resource is already deleted. Please verify manually \n", applicationId, error)
+				fmt.Printf("Error deleting Application %s %s \n", applicationId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &applicationId, applicationSweepWaitCondition, time.Duration(3*time.Minute),
 				applicationSweepResponseFetchOperation, "functions", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getApplicationIds(compartment string) ([]string, error) {
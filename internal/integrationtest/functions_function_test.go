@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -291,10 +292,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("FunctionsFunction") {
-		resource.AddTestSweepers("FunctionsFunction", &resource.Sweeper{
-			Name:         "FunctionsFunction",
-			Dependencies: acctest.DependencyGraph["function"],
-			F:            sweepFunctionsFunctionResource,
+		acctest.AddTestSweepers("FunctionsFunction", &resource.Sweeper{
+			Name: "FunctionsFunction",
+			F:    sweepFunctionsFunctionResource,
 		})
 	}
 }
@@ -305,6 +305,7 @@ func sweepFunctionsFunctionResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, functionId := range functionIds {
 		if ok := acctest.SweeperDefaultResourceId[functionId]; !ok {
 			deleteFunctionRequest := oci_functions.DeleteFunctionRequest{}
@@ -312,16 +313,20 @@ func sweepFunctionsFunctionResource(compartment string) error {
 			deleteFunctionRequest.FunctionId = &functionId
 
 			deleteFunctionRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "functions")
-			_, error := functionsManagementClient.DeleteFunction(context.Background(), deleteFunctionRequest)
+			error := acctest.DeleteSweeperResource(compartment, "FunctionId", functionId, func() error {
+				_, err := functionsManagementClient.DeleteFunction(context.Background(), deleteFunctionRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Function %s %s, It is possible that the resource is already deleted. Please verify manually \n", functionId, error)
+				fmt.Printf("Error deleting Function %s %s \n", functionId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &functionId, functionSweepWaitCondition, time.Duration(3*time.Minute),
 				functionSweepResponseFetchOperation, "functions", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getFunctionIds(compartment string) ([]string, error) {
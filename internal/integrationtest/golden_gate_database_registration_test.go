@@ -17,6 +17,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -350,10 +351,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("GoldenGateDatabaseRegistration") {
-		resource.AddTestSweepers("GoldenGateDatabaseRegistration", &resource.Sweeper{
-			Name:         "GoldenGateDatabaseRegistration",
-			Dependencies: acctest.DependencyGraph["databaseRegistration"],
-			F:            sweepGoldenGateDatabaseRegistrationResource,
+		acctest.AddTestSweepers("GoldenGateDatabaseRegistration", &resource.Sweeper{
+			Name: "GoldenGateDatabaseRegistration",
+			F:    sweepGoldenGateDatabaseRegistrationResource,
 		})
 	}
 }
@@ -364,6 +364,7 @@ func sweepGoldenGateDatabaseRegistrationResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, databaseRegistrationId := range databaseRegistrationIds {
 		if ok := acctest.SweeperDefaultResourceId[databaseRegistrationId]; !ok {
 			deleteDatabaseRegistrationRequest := oci_golden_gate.DeleteDatabaseRegistrationRequest{}
@@ -371,16 +372,20 @@ func sweepGoldenGateDatabaseRegistrationResource(compartment string) error {
 			deleteDatabaseRegistrationRequest.DatabaseRegistrationId = &databaseRegistrationId
 
 			deleteDatabaseRegistrationRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "golden_gate")
-			_, error := goldenGateClient.DeleteDatabaseRegistration(context.Background(), deleteDatabaseRegistrationRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DatabaseRegistrationId", databaseRegistrationId, func() error {
+				_, err := goldenGateClient.DeleteDatabaseRegistration(context.Background(), deleteDatabaseRegistrationRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting DatabaseRegistration %s %s, It is possible that the resource is already deleted. Please verify manually \n", databaseRegistrationId, error)
+				fmt.Printf("Error deleting DatabaseRegistration %s %s \n", databaseRegistrationId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &databaseRegistrationId, databaseRegistrationSweepWaitCondition, time.Duration(3*time.Minute),
 				databaseRegistrationSweepResponseFetchOperation, "golden_gate", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDatabaseRegistrationIds(compartment string) ([]string, error) {
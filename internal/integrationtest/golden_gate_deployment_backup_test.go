@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -306,10 +307,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("GoldenGateDeploymentBackup") {
-		resource.AddTestSweepers("GoldenGateDeploymentBackup", &resource.Sweeper{
-			Name:         "GoldenGateDeploymentBackup",
-			Dependencies: acctest.DependencyGraph["deploymentBackup"],
-			F:            sweepGoldenGateDeploymentBackupResource,
+		acctest.AddTestSweepers("GoldenGateDeploymentBackup", &resource.Sweeper{
+			Name: "GoldenGateDeploymentBackup",
+			F:    sweepGoldenGateDeploymentBackupResource,
 		})
 	}
 }
@@ -320,6 +320,7 @@ func sweepGoldenGateDeploymentBackupResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, deploymentBackupId := range deploymentBackupIds {
 		if ok := acctest.SweeperDefaultResourceId[deploymentBackupId]; !ok {
 			deleteDeploymentBackupRequest := oci_golden_gate.DeleteDeploymentBackupRequest{}
@@ -327,16 +328,20 @@ func sweepGoldenGateDeploymentBackupResource(compartment string) error {
 			deleteDeploymentBackupRequest.DeploymentBackupId = &deploymentBackupId
 
 			deleteDeploymentBackupRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "golden_gate")
-			_, error := goldenGateClient.DeleteDeploymentBackup(context.Background(), deleteDeploymentBackupRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DeploymentBackupId", deploymentBackupId, func() error {
+				_, err := goldenGateClient.DeleteDeploymentBackup(context.Background(), deleteDeploymentBackupRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting DeploymentBackup %s %s, It is possible that the resource is already deleted. Please verify manually \n", deploymentBackupId, error)
+				fmt.Printf("Error deleting DeploymentBackup %s %s \n", deploymentBackupId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &deploymentBackupId, deploymentBackupSweepWaitCondition, time.Duration(3*time.Minute),
 				deploymentBackupSweepResponseFetchOperation, "golden_gate", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDeploymentBackupIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -337,10 +338,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("GoldenGateDeployment") {
-		resource.AddTestSweepers("GoldenGateDeployment", &resource.Sweeper{
-			Name:         "GoldenGateDeployment",
-			Dependencies: acctest.DependencyGraph["deployment"],
-			F:            sweepGoldenGateDeploymentResource,
+		acctest.AddTestSweepers("GoldenGateDeployment", &resource.Sweeper{
+			Name: "GoldenGateDeployment",
+			F:    sweepGoldenGateDeploymentResource,
 		})
 	}
 }
@@ -351,6 +351,7 @@ func sweepGoldenGateDeploymentResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, deploymentId := range deploymentIds {
 		if ok := acctest.SweeperDefaultResourceId[deploymentId]; !ok {
 			deleteDeploymentRequest := oci_golden_gate.DeleteDeploymentRequest{}
@@ -358,16 +359,20 @@ func sweepGoldenGateDeploymentResource(compartment string) error {
 			deleteDeploymentRequest.DeploymentId = &deploymentId
 
 			deleteDeploymentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "golden_gate")
-			_, error := goldenGateClient.DeleteDeployment(context.Background(), deleteDeploymentRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DeploymentId", deploymentId, func() error {
+				_, err := goldenGateClient.DeleteDeployment(context.Background(), deleteDeploymentRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Deployment %s %s, It is possible that the resource is already deleted. Please verify manually \n", deploymentId, error)
+				fmt.Printf("Error deleting Deployment %s %s \n", deploymentId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &deploymentId, goldenGateDeploymentSweepWaitCondition, time.Duration(3*time.Minute),
 				goldenGateDeploymentSweepResponseFetchOperation, "golden_gate", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getGoldenGateDeploymentIds(compartment string) ([]string, error) {
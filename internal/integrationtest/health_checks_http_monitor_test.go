@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -299,10 +300,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("HealthChecksHttpMonitor") {
-		resource.AddTestSweepers("HealthChecksHttpMonitor", &resource.Sweeper{
-			Name:         "HealthChecksHttpMonitor",
-			Dependencies: acctest.DependencyGraph["httpMonitor"],
-			F:            sweepHealthChecksHttpMonitorResource,
+		acctest.AddTestSweepers("HealthChecksHttpMonitor", &resource.Sweeper{
+			Name: "HealthChecksHttpMonitor",
+			F:    sweepHealthChecksHttpMonitorResource,
 		})
 	}
 }
@@ -313,6 +313,7 @@ func sweepHealthChecksHttpMonitorResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, httpMonitorId := range httpMonitorIds {
 		if ok := acctest.SweeperDefaultResourceId[httpMonitorId]; !ok {
 			deleteHttpMonitorRequest := oci_health_checks.DeleteHttpMonitorRequest{}
@@ -320,14 +321,18 @@ func sweepHealthChecksHttpMonitorResource(compartment string) error {
 			deleteHttpMonitorRequest.MonitorId = &httpMonitorId
 
 			deleteHttpMonitorRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "health_checks")
-			_, error := healthChecksClient.DeleteHttpMonitor(context.Background(), deleteHttpMonitorRequest)
+			error := acctest.DeleteSweeperResource(compartment, "HttpMonitorId", httpMonitorId, func() error {
+				_, err := healthChecksClient.DeleteHttpMonitor(context.Background(), deleteHttpMonitorRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting HttpMonitor %s %s, It is possible that the resource is already deleted. Please verify manually \n", httpMonitorId, error)
+				fmt.Printf("Error deleting HttpMonitor %s %s \n", httpMonitorId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getHttpMonitorIds(compartment string) ([]string, error) {
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -284,10 +285,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("HealthChecksPingMonitor") {
-		resource.AddTestSweepers("HealthChecksPingMonitor", &resource.Sweeper{
-			Name:         "HealthChecksPingMonitor",
-			Dependencies: acctest.DependencyGraph["pingMonitor"],
-			F:            sweepHealthChecksPingMonitorResource,
+		acctest.AddTestSweepers("HealthChecksPingMonitor", &resource.Sweeper{
+			Name: "HealthChecksPingMonitor",
+			F:    sweepHealthChecksPingMonitorResource,
 		})
 	}
 }
@@ -298,6 +298,7 @@ func sweepHealthChecksPingMonitorResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, pingMonitorId := range pingMonitorIds {
 		if ok := acctest.SweeperDefaultResourceId[pingMonitorId]; !ok {
 			deletePingMonitorRequest := oci_health_checks.DeletePingMonitorRequest{}
@@ -305,14 +306,18 @@ func sweepHealthChecksPingMonitorResource(compartment string) error {
 			deletePingMonitorRequest.MonitorId = &pingMonitorId
 
 			deletePingMonitorRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "health_checks")
-			_, error := healthChecksClient.DeletePingMonitor(context.Background(), deletePingMonitorRequest)
+			error := acctest.DeleteSweeperResource(compartment, "PingMonitorId", pingMonitorId, func() error {
+				_, err := healthChecksClient.DeletePingMonitor(context.Background(), deletePingMonitorRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting PingMonitor %s %s, It is possible that the resource is already deleted. Please verify manually \n", pingMonitorId, error)
+				fmt.Printf("Error deleting PingMonitor %s %s \n", pingMonitorId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getPingMonitorIds(compartment string) ([]string, error) {
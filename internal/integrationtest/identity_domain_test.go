@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -333,10 +334,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("IdentityDomain") {
-		resource.AddTestSweepers("IdentityDomain", &resource.Sweeper{
-			Name:         "IdentityDomain",
-			Dependencies: acctest.DependencyGraph["domain"],
-			F:            sweepIdentityDomainResource,
+		acctest.AddTestSweepers("IdentityDomain", &resource.Sweeper{
+			Name: "IdentityDomain",
+			F:    sweepIdentityDomainResource,
 		})
 	}
 }
@@ -347,6 +347,7 @@ func sweepIdentityDomainResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, domainId := range domainIds {
 		if ok := acctest.SweeperDefaultResourceId[domainId]; !ok {
 			deleteDomainRequest := oci_identity.DeleteDomainRequest{}
@@ -354,14 +355,18 @@ func sweepIdentityDomainResource(compartment string) error {
 			deleteDomainRequest.DomainId = &domainId
 
 			deleteDomainRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "identity")
-			_, error := identityClient.DeleteDomain(context.Background(), deleteDomainRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DomainId", domainId, func() error {
+				_, err := identityClient.DeleteDomain(context.Background(), deleteDomainRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Domain %s %s, It is possible that the resource is already deleted. Please verify manually \n", domainId, error)
+				fmt.Printf("Error deleting Domain %s %s \n", domainId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDomainIds(compartment string) ([]string, error) {
@@ -12,6 +12,7 @@ import (
 
 	tf_client "github.com/terraform-providers/terraform-provider-oci/internal/client"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -258,10 +259,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("IdentityNetworkSource") {
-		resource.AddTestSweepers("IdentityNetworkSource", &resource.Sweeper{
-			Name:         "IdentityNetworkSource",
-			Dependencies: acctest.DependencyGraph["networkSource"],
-			F:            sweepIdentityNetworkSourceResource,
+		acctest.AddTestSweepers("IdentityNetworkSource", &resource.Sweeper{
+			Name: "IdentityNetworkSource",
+			F:    sweepIdentityNetworkSourceResource,
 		})
 	}
 }
@@ -272,6 +272,7 @@ func sweepIdentityNetworkSourceResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, networkSourceId := range networkSourceIds {
 		if ok := acctest.SweeperDefaultResourceId[networkSourceId]; !ok {
 			deleteNetworkSourceRequest := oci_identity.DeleteNetworkSourceRequest{}
@@ -279,16 +280,20 @@ func sweepIdentityNetworkSourceResource(compartment string) error {
 			deleteNetworkSourceRequest.NetworkSourceId = &networkSourceId
 
 			deleteNetworkSourceRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "identity")
-			_, error := identityClient.DeleteNetworkSource(context.Background(), deleteNetworkSourceRequest)
+			error := acctest.DeleteSweeperResource(compartment, "NetworkSourceId", networkSourceId, func() error {
+				_, err := identityClient.DeleteNetworkSource(context.Background(), deleteNetworkSourceRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting NetworkSource %s %s, It is possible that the resource is already deleted. Please verify manually \n", networkSourceId, error)
+				fmt.Printf("Error deleting NetworkSource %s %s \n", networkSourceId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &networkSourceId, networkSourceSweepWaitCondition, time.Duration(3*time.Minute),
 				networkSourceSweepResponseFetchOperation, "identity", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getNetworkSourceIds(compartment string) ([]string, error) {
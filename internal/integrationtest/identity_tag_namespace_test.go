@@ -14,6 +14,7 @@ import (
 
 	"github.com/oracle/oci-go-sdk/v65/common"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -246,10 +247,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("IdentityTagNamespace") {
-		resource.AddTestSweepers("IdentityTagNamespace", &resource.Sweeper{
-			Name:         "IdentityTagNamespace",
-			Dependencies: acctest.DependencyGraph["tagNamespace"],
-			F:            sweepIdentityTagNamespaceResource,
+		acctest.AddTestSweepers("IdentityTagNamespace", &resource.Sweeper{
+			Name: "IdentityTagNamespace",
+			F:    sweepIdentityTagNamespaceResource,
 		})
 	}
 }
@@ -273,6 +273,7 @@ func sweepIdentityTagNamespaceResource(compartment string) error {
 		return err
 	}
 
+	var deleteErrors *multierror.Error
 	for _, tagNamespaceId := range tagNamespaceIds {
 		if ok := acctest.SweeperDefaultResourceId[tagNamespaceId]; !ok {
 			deleteTagNamespaceRequest := oci_identity.DeleteTagNamespaceRequest{}
@@ -280,16 +281,20 @@ func sweepIdentityTagNamespaceResource(compartment string) error {
 			deleteTagNamespaceRequest.TagNamespaceId = &tagNamespaceId
 
 			deleteTagNamespaceRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "identity")
-			_, error := identityClient.DeleteTagNamespace(context.Background(), deleteTagNamespaceRequest)
+			error := acctest.DeleteSweeperResource(compartment, "TagNamespaceId", tagNamespaceId, func() error {
+				_, err := identityClient.DeleteTagNamespace(context.Background(), deleteTagNamespaceRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting TagNamespace %s %s, It is possible that the resource is already deleted. Please verify manually \n", tagNamespaceId, error)
+				fmt.Printf("Error deleting TagNamespace %s %s \n", tagNamespaceId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &tagNamespaceId, tagNamespaceSweepWaitCondition, time.Duration(3*time.Minute),
 				tagNamespaceSweepResponseFetchOperation, "identity", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getTagNamespaceIds(compartment string) ([]string, error) {
@@ -14,6 +14,7 @@ import (
 
 	"github.com/oracle/oci-go-sdk/v65/common"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -269,10 +270,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("IdentityTag") {
-		resource.AddTestSweepers("IdentityTag", &resource.Sweeper{
-			Name:         "IdentityTag",
-			Dependencies: acctest.DependencyGraph["tag"],
-			F:            sweepIdentityTagResource,
+		acctest.AddTestSweepers("IdentityTag", &resource.Sweeper{
+			Name: "IdentityTag",
+			F:    sweepIdentityTagResource,
 		})
 	}
 }
@@ -289,21 +289,26 @@ func sweepIdentityTagResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, tagId := range tagIds {
 		if ok := acctest.SweeperDefaultResourceId[tagId]; !ok {
 			deleteTagRequest := oci_identity.DeleteTagRequest{}
 
 			deleteTagRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "identity")
-			_, error := identityClient.DeleteTag(context.Background(), deleteTagRequest)
+			error := acctest.DeleteSweeperResource(compartment, "TagId", tagId, func() error {
+				_, err := identityClient.DeleteTag(context.Background(), deleteTagRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Tag %s %s, It is possible that the resource is already deleted. Please verify manually \n", tagId, error)
+				fmt.Printf("Error deleting Tag %s %s \n", tagId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &tagId, tagSweepWaitCondition, time.Duration(3*time.Minute),
 				tagSweepResponseFetchOperation, "identity", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getTagIds(compartment string) ([]string, error) {
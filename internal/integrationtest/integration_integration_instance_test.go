@@ -17,6 +17,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -465,10 +466,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("IntegrationIntegrationInstance") {
-		resource.AddTestSweepers("IntegrationIntegrationInstance", &resource.Sweeper{
-			Name:         "IntegrationIntegrationInstance",
-			Dependencies: acctest.DependencyGraph["integrationInstance"],
-			F:            sweepIntegrationIntegrationInstanceResource,
+		acctest.AddTestSweepers("IntegrationIntegrationInstance", &resource.Sweeper{
+			Name: "IntegrationIntegrationInstance",
+			F:    sweepIntegrationIntegrationInstanceResource,
 		})
 	}
 }
@@ -479,6 +479,7 @@ func sweepIntegrationIntegrationInstanceResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, integrationInstanceId := range integrationInstanceIds {
 		if ok := acctest.SweeperDefaultResourceId[integrationInstanceId]; !ok {
 			deleteIntegrationInstanceRequest := oci_integration.DeleteIntegrationInstanceRequest{}
@@ -486,16 +487,20 @@ func sweepIntegrationIntegrationInstanceResource(compartment string) error {
 			deleteIntegrationInstanceRequest.IntegrationInstanceId = &integrationInstanceId
 
 			deleteIntegrationInstanceRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "integration")
-			_, error := integrationInstanceClient.DeleteIntegrationInstance(context.Background(), deleteIntegrationInstanceRequest)
+			error := acctest.DeleteSweeperResource(compartment, "IntegrationInstanceId", integrationInstanceId, func() error {
+				_, err := integrationInstanceClient.DeleteIntegrationInstance(context.Background(), deleteIntegrationInstanceRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting IntegrationInstance %s %s, It is possible that the resource is already deleted. Please verify manually \n", integrationInstanceId, error)
+				fmt.Printf("Error deleting IntegrationInstance %s %s \n", integrationInstanceId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &integrationInstanceId, integrationInstanceSweepWaitCondition, time.Duration(3*time.Minute),
 				integrationInstanceSweepResponseFetchOperation, "integration", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getIntegrationInstanceIds(compartment string) ([]string, error) {
@@ -59,10 +59,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("JmsFleetBlocklist") {
-		resource.AddTestSweepers("JmsFleetBlocklist", &resource.Sweeper{
-			Name:         "JmsFleetBlocklist",
-			Dependencies: acctest.DependencyGraph["fleet"],
-			F:            sweepJmsFleetResource,
+		acctest.AddTestSweepers("JmsFleetBlocklist", &resource.Sweeper{
+			Name: "JmsFleetBlocklist",
+			F:    sweepJmsFleetResource,
 		})
 	}
 }
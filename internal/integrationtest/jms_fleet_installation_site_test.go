@@ -110,10 +110,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("JmsFleetInstallationSite") {
-		resource.AddTestSweepers("JmsFleetInstallationSite", &resource.Sweeper{
-			Name:         "JmsFleetInstallationSite",
-			Dependencies: acctest.DependencyGraph["fleet"],
-			F:            sweepJmsFleetResource,
+		acctest.AddTestSweepers("JmsFleetInstallationSite", &resource.Sweeper{
+			Name: "JmsFleetInstallationSite",
+			F:    sweepJmsFleetResource,
 		})
 	}
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -308,10 +309,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("JmsFleet") {
-		resource.AddTestSweepers("JmsFleet", &resource.Sweeper{
-			Name:         "JmsFleet",
-			Dependencies: acctest.DependencyGraph["fleet"],
-			F:            sweepJmsFleetResource,
+		acctest.AddTestSweepers("JmsFleet", &resource.Sweeper{
+			Name: "JmsFleet",
+			F:    sweepJmsFleetResource,
 		})
 	}
 }
@@ -322,6 +322,7 @@ func sweepJmsFleetResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, fleetId := range fleetIds {
 		if ok := acctest.SweeperDefaultResourceId[fleetId]; !ok {
 			deleteFleetRequest := oci_jms.DeleteFleetRequest{}
@@ -329,16 +330,20 @@ func sweepJmsFleetResource(compartment string) error {
 			deleteFleetRequest.FleetId = &fleetId
 
 			deleteFleetRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "jms")
-			_, error := javaManagementServiceClient.DeleteFleet(context.Background(), deleteFleetRequest)
+			error := acctest.DeleteSweeperResource(compartment, "FleetId", fleetId, func() error {
+				_, err := javaManagementServiceClient.DeleteFleet(context.Background(), deleteFleetRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Fleet %s %s, It is possible that the resource is already deleted. Please verify manually \n", fleetId, error)
+				fmt.Printf("Error deleting Fleet %s %s \n", fleetId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &fleetId, fleetSweepWaitCondition, time.Duration(3*time.Minute),
 				fleetSweepResponseFetchOperation, "jms", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getFleetIds(compartment string) ([]string, error) {
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -232,10 +233,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("LimitsQuota") {
-		resource.AddTestSweepers("LimitsQuota", &resource.Sweeper{
-			Name:         "LimitsQuota",
-			Dependencies: acctest.DependencyGraph["quota"],
-			F:            sweepLimitsQuotaResource,
+		acctest.AddTestSweepers("LimitsQuota", &resource.Sweeper{
+			Name: "LimitsQuota",
+			F:    sweepLimitsQuotaResource,
 		})
 	}
 }
@@ -248,6 +248,7 @@ func sweepLimitsQuotaResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, quotaId := range quotaIds {
 		if ok := acctest.SweeperDefaultResourceId[quotaId]; !ok {
 			deleteQuotaRequest := oci_limits.DeleteQuotaRequest{}
@@ -255,14 +256,18 @@ func sweepLimitsQuotaResource(compartment string) error {
 			deleteQuotaRequest.QuotaId = &quotaId
 
 			deleteQuotaRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "limits")
-			_, error := quotasClient.DeleteQuota(context.Background(), deleteQuotaRequest)
+			error := acctest.DeleteSweeperResource(compartment, "QuotaId", quotaId, func() error {
+				_, err := quotasClient.DeleteQuota(context.Background(), deleteQuotaRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Quota %s %s, It is possible that the resource is already deleted. Please verify manually \n", quotaId, error)
+				fmt.Printf("Error deleting Quota %s %s \n", quotaId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getQuotaIds(compartment string) ([]string, error) {
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -1134,10 +1135,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("LoadBalancerBackendSet") {
-		resource.AddTestSweepers("LoadBalancerBackendSet", &resource.Sweeper{
-			Name:         "LoadBalancerBackendSet",
-			Dependencies: acctest.DependencyGraph["backendSet"],
-			F:            sweepLoadBalancerBackendSetResource,
+		acctest.AddTestSweepers("LoadBalancerBackendSet", &resource.Sweeper{
+			Name: "LoadBalancerBackendSet",
+			F:    sweepLoadBalancerBackendSetResource,
 		})
 	}
 }
@@ -1148,19 +1148,24 @@ func sweepLoadBalancerBackendSetResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, backendSetId := range backendSetIds {
 		if ok := acctest.SweeperDefaultResourceId[backendSetId]; !ok {
 			deleteBackendSetRequest := oci_load_balancer.DeleteBackendSetRequest{}
 
 			deleteBackendSetRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "load_balancer")
-			_, error := loadBalancerClient.DeleteBackendSet(context.Background(), deleteBackendSetRequest)
+			error := acctest.DeleteSweeperResource(compartment, "BackendSetId", backendSetId, func() error {
+				_, err := loadBalancerClient.DeleteBackendSet(context.Background(), deleteBackendSetRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting BackendSet %s %s, It is possible that the resource is already deleted. Please verify manually \n", backendSetId, error)
+				fmt.Printf("Error deleting BackendSet %s %s \n", backendSetId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getBackendSetIds(compartment string) ([]string, error) {
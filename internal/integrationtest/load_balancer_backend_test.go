@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -224,10 +225,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("LoadBalancerBackend") {
-		resource.AddTestSweepers("LoadBalancerBackend", &resource.Sweeper{
-			Name:         "LoadBalancerBackend",
-			Dependencies: acctest.DependencyGraph["backend"],
-			F:            sweepLoadBalancerBackendResource,
+		acctest.AddTestSweepers("LoadBalancerBackend", &resource.Sweeper{
+			Name: "LoadBalancerBackend",
+			F:    sweepLoadBalancerBackendResource,
 		})
 	}
 }
@@ -238,19 +238,24 @@ func sweepLoadBalancerBackendResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, backendId := range backendIds {
 		if ok := acctest.SweeperDefaultResourceId[backendId]; !ok {
 			deleteBackendRequest := oci_load_balancer.DeleteBackendRequest{}
 
 			deleteBackendRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "load_balancer")
-			_, error := loadBalancerClient.DeleteBackend(context.Background(), deleteBackendRequest)
+			error := acctest.DeleteSweeperResource(compartment, "BackendId", backendId, func() error {
+				_, err := loadBalancerClient.DeleteBackend(context.Background(), deleteBackendRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Backend %s %s, It is possible that the resource is already deleted. Please verify manually \n", backendId, error)
+				fmt.Printf("Error deleting Backend %s %s \n", backendId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getBackendIds(compartment string) ([]string, error) {
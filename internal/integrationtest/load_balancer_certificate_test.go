@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -186,10 +187,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("LoadBalancerCertificate") {
-		resource.AddTestSweepers("LoadBalancerCertificate", &resource.Sweeper{
-			Name:         "LoadBalancerCertificate",
-			Dependencies: acctest.DependencyGraph["certificate"],
-			F:            sweepLoadBalancerCertificateResource,
+		acctest.AddTestSweepers("LoadBalancerCertificate", &resource.Sweeper{
+			Name: "LoadBalancerCertificate",
+			F:    sweepLoadBalancerCertificateResource,
 		})
 	}
 }
@@ -200,19 +200,24 @@ func sweepLoadBalancerCertificateResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, certificateId := range certificateIds {
 		if ok := acctest.SweeperDefaultResourceId[certificateId]; !ok {
 			deleteCertificateRequest := oci_load_balancer.DeleteCertificateRequest{}
 
 			deleteCertificateRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "load_balancer")
-			_, error := loadBalancerClient.DeleteCertificate(context.Background(), deleteCertificateRequest)
+			error := acctest.DeleteSweeperResource(compartment, "CertificateId", certificateId, func() error {
+				_, err := loadBalancerClient.DeleteCertificate(context.Background(), deleteCertificateRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Certificate %s %s, It is possible that the resource is already deleted. Please verify manually \n", certificateId, error)
+				fmt.Printf("Error deleting Certificate %s %s \n", certificateId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getLBCertificateIds(compartment string) ([]string, error) {
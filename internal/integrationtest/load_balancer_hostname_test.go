@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -171,10 +172,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("LoadBalancerHostname") {
-		resource.AddTestSweepers("LoadBalancerHostname", &resource.Sweeper{
-			Name:         "LoadBalancerHostname",
-			Dependencies: acctest.DependencyGraph["hostname"],
-			F:            sweepLoadBalancerHostnameResource,
+		acctest.AddTestSweepers("LoadBalancerHostname", &resource.Sweeper{
+			Name: "LoadBalancerHostname",
+			F:    sweepLoadBalancerHostnameResource,
 		})
 	}
 }
@@ -185,19 +185,24 @@ func sweepLoadBalancerHostnameResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, hostnameId := range hostnameIds {
 		if ok := acctest.SweeperDefaultResourceId[hostnameId]; !ok {
 			deleteHostnameRequest := oci_load_balancer.DeleteHostnameRequest{}
 
 			deleteHostnameRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "load_balancer")
-			_, error := loadBalancerClient.DeleteHostname(context.Background(), deleteHostnameRequest)
+			error := acctest.DeleteSweeperResource(compartment, "HostnameId", hostnameId, func() error {
+				_, err := loadBalancerClient.DeleteHostname(context.Background(), deleteHostnameRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Hostname %s %s, It is possible that the resource is already deleted. Please verify manually \n", hostnameId, error)
+				fmt.Printf("Error deleting Hostname %s %s \n", hostnameId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getHostnameIds(compartment string) ([]string, error) {
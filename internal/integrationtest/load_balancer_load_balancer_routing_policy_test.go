@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -223,10 +224,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("LoadBalancerLoadBalancerRoutingPolicy") {
-		resource.AddTestSweepers("LoadBalancerLoadBalancerRoutingPolicy", &resource.Sweeper{
-			Name:         "LoadBalancerLoadBalancerRoutingPolicy",
-			Dependencies: acctest.DependencyGraph["loadBalancerRoutingPolicy"],
-			F:            sweepLoadBalancerLoadBalancerRoutingPolicyResource,
+		acctest.AddTestSweepers("LoadBalancerLoadBalancerRoutingPolicy", &resource.Sweeper{
+			Name: "LoadBalancerLoadBalancerRoutingPolicy",
+			F:    sweepLoadBalancerLoadBalancerRoutingPolicyResource,
 		})
 	}
 }
@@ -237,19 +237,24 @@ func sweepLoadBalancerLoadBalancerRoutingPolicyResource(compartment string) erro
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, loadBalancerRoutingPolicyId := range loadBalancerRoutingPolicyIds {
 		if ok := acctest.SweeperDefaultResourceId[loadBalancerRoutingPolicyId]; !ok {
 			deleteRoutingPolicyRequest := oci_load_balancer.DeleteRoutingPolicyRequest{}
 
 			deleteRoutingPolicyRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "load_balancer")
-			_, error := loadBalancerClient.DeleteRoutingPolicy(context.Background(), deleteRoutingPolicyRequest)
+			error := acctest.DeleteSweeperResource(compartment, "LoadBalancerRoutingPolicyId", loadBalancerRoutingPolicyId, func() error {
+				_, err := loadBalancerClient.DeleteRoutingPolicy(context.Background(), deleteRoutingPolicyRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting LoadBalancerRoutingPolicy %s %s, It is possible that the resource is already deleted. Please verify manually \n", loadBalancerRoutingPolicyId, error)
+				fmt.Printf("Error deleting LoadBalancerRoutingPolicy %s %s \n", loadBalancerRoutingPolicyId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getLoadBalancerRoutingPolicyIds(compartment string) ([]string, error) {
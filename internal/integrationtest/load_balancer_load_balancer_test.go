@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -352,10 +353,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("LoadBalancerLoadBalancer") {
-		resource.AddTestSweepers("LoadBalancerLoadBalancer", &resource.Sweeper{
-			Name:         "LoadBalancerLoadBalancer",
-			Dependencies: acctest.DependencyGraph["loadBalancer"],
-			F:            sweepLoadBalancerLoadBalancerResource,
+		acctest.AddTestSweepers("LoadBalancerLoadBalancer", &resource.Sweeper{
+			Name: "LoadBalancerLoadBalancer",
+			F:    sweepLoadBalancerLoadBalancerResource,
 		})
 	}
 }
@@ -366,6 +366,7 @@ func sweepLoadBalancerLoadBalancerResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, loadBalancerId := range loadBalancerIds {
 		if ok := acctest.SweeperDefaultResourceId[loadBalancerId]; !ok {
 			deleteLoadBalancerRequest := oci_load_balancer.DeleteLoadBalancerRequest{}
@@ -373,16 +374,20 @@ func sweepLoadBalancerLoadBalancerResource(compartment string) error {
 			deleteLoadBalancerRequest.LoadBalancerId = &loadBalancerId
 
 			deleteLoadBalancerRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "load_balancer")
-			_, error := loadBalancerClient.DeleteLoadBalancer(context.Background(), deleteLoadBalancerRequest)
+			error := acctest.DeleteSweeperResource(compartment, "LoadBalancerId", loadBalancerId, func() error {
+				_, err := loadBalancerClient.DeleteLoadBalancer(context.Background(), deleteLoadBalancerRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting LoadBalancer %s %s, It is possible that the resource is already deleted. Please verify manually \n", loadBalancerId, error)
+				fmt.Printf("Error deleting LoadBalancer %s %s \n", loadBalancerId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &loadBalancerId, loadBalancerSweepWaitCondition, time.Duration(3*time.Minute),
 				loadBalancerSweepResponseFetchOperation, "load_balancer", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getLoadBalancerIds(compartment string) ([]string, error) {
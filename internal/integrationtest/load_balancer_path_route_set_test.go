@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -193,10 +194,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("LoadBalancerPathRouteSet") {
-		resource.AddTestSweepers("LoadBalancerPathRouteSet", &resource.Sweeper{
-			Name:         "LoadBalancerPathRouteSet",
-			Dependencies: acctest.DependencyGraph["pathRouteSet"],
-			F:            sweepLoadBalancerPathRouteSetResource,
+		acctest.AddTestSweepers("LoadBalancerPathRouteSet", &resource.Sweeper{
+			Name: "LoadBalancerPathRouteSet",
+			F:    sweepLoadBalancerPathRouteSetResource,
 		})
 	}
 }
@@ -207,19 +207,24 @@ func sweepLoadBalancerPathRouteSetResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, pathRouteSetId := range pathRouteSetIds {
 		if ok := acctest.SweeperDefaultResourceId[pathRouteSetId]; !ok {
 			deletePathRouteSetRequest := oci_load_balancer.DeletePathRouteSetRequest{}
 
 			deletePathRouteSetRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "load_balancer")
-			_, error := loadBalancerClient.DeletePathRouteSet(context.Background(), deletePathRouteSetRequest)
+			error := acctest.DeleteSweeperResource(compartment, "PathRouteSetId", pathRouteSetId, func() error {
+				_, err := loadBalancerClient.DeletePathRouteSet(context.Background(), deletePathRouteSetRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting PathRouteSet %s %s, It is possible that the resource is already deleted. Please verify manually \n", pathRouteSetId, error)
+				fmt.Printf("Error deleting PathRouteSet %s %s \n", pathRouteSetId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getPathRouteSetIds(compartment string) ([]string, error) {
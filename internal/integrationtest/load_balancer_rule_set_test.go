@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -387,10 +388,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("LoadBalancerRuleSet") {
-		resource.AddTestSweepers("LoadBalancerRuleSet", &resource.Sweeper{
-			Name:         "LoadBalancerRuleSet",
-			Dependencies: acctest.DependencyGraph["ruleSet"],
-			F:            sweepLoadBalancerRuleSetResource,
+		acctest.AddTestSweepers("LoadBalancerRuleSet", &resource.Sweeper{
+			Name: "LoadBalancerRuleSet",
+			F:    sweepLoadBalancerRuleSetResource,
 		})
 	}
 }
@@ -401,19 +401,24 @@ func sweepLoadBalancerRuleSetResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, ruleSetId := range ruleSetIds {
 		if ok := acctest.SweeperDefaultResourceId[ruleSetId]; !ok {
 			deleteRuleSetRequest := oci_load_balancer.DeleteRuleSetRequest{}
 
 			deleteRuleSetRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "load_balancer")
-			_, error := loadBalancerClient.DeleteRuleSet(context.Background(), deleteRuleSetRequest)
+			error := acctest.DeleteSweeperResource(compartment, "RuleSetId", ruleSetId, func() error {
+				_, err := loadBalancerClient.DeleteRuleSet(context.Background(), deleteRuleSetRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting RuleSet %s %s, It is possible that the resource is already deleted. Please verify manually \n", ruleSetId, error)
+				fmt.Printf("Error deleting RuleSet %s %s \n", ruleSetId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getRuleSetIds(compartment string) ([]string, error) {
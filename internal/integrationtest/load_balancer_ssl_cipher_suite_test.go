@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -186,10 +187,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("LoadBalancerSslCipherSuite") {
-		resource.AddTestSweepers("LoadBalancerSslCipherSuite", &resource.Sweeper{
-			Name:         "LoadBalancerSslCipherSuite",
-			Dependencies: acctest.DependencyGraph["sslCipherSuite"],
-			F:            sweepLoadBalancerSslCipherSuiteResource,
+		acctest.AddTestSweepers("LoadBalancerSslCipherSuite", &resource.Sweeper{
+			Name: "LoadBalancerSslCipherSuite",
+			F:    sweepLoadBalancerSslCipherSuiteResource,
 		})
 	}
 }
@@ -200,19 +200,24 @@ func sweepLoadBalancerSslCipherSuiteResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, sslCipherSuiteId := range sslCipherSuiteIds {
 		if ok := acctest.SweeperDefaultResourceId[sslCipherSuiteId]; !ok {
 			deleteSSLCipherSuiteRequest := oci_load_balancer.DeleteSSLCipherSuiteRequest{}
 
 			deleteSSLCipherSuiteRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "load_balancer")
-			_, error := loadBalancerClient.DeleteSSLCipherSuite(context.Background(), deleteSSLCipherSuiteRequest)
+			error := acctest.DeleteSweeperResource(compartment, "SslCipherSuiteId", sslCipherSuiteId, func() error {
+				_, err := loadBalancerClient.DeleteSSLCipherSuite(context.Background(), deleteSSLCipherSuiteRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting SslCipherSuite %s %s, It is possible that the resource is already deleted. Please verify manually \n", sslCipherSuiteId, error)
+				fmt.Printf("Error deleting SslCipherSuite %s %s \n", sslCipherSuiteId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getSslCipherSuiteIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -342,10 +343,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("LogAnalyticsLogAnalyticsEntity") {
-		resource.AddTestSweepers("LogAnalyticsLogAnalyticsEntity", &resource.Sweeper{
-			Name:         "LogAnalyticsLogAnalyticsEntity",
-			Dependencies: acctest.DependencyGraph["logAnalyticsEntity"],
-			F:            sweepLogAnalyticsLogAnalyticsEntityResource,
+		acctest.AddTestSweepers("LogAnalyticsLogAnalyticsEntity", &resource.Sweeper{
+			Name: "LogAnalyticsLogAnalyticsEntity",
+			F:    sweepLogAnalyticsLogAnalyticsEntityResource,
 		})
 	}
 }
@@ -356,6 +356,7 @@ func sweepLogAnalyticsLogAnalyticsEntityResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, logAnalyticsEntityId := range logAnalyticsEntityIds {
 		if ok := acctest.SweeperDefaultResourceId[logAnalyticsEntityId]; !ok {
 			deleteLogAnalyticsEntityRequest := oci_log_analytics.DeleteLogAnalyticsEntityRequest{}
@@ -363,16 +364,20 @@ func sweepLogAnalyticsLogAnalyticsEntityResource(compartment string) error {
 			deleteLogAnalyticsEntityRequest.LogAnalyticsEntityId = &logAnalyticsEntityId
 
 			deleteLogAnalyticsEntityRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "log_analytics")
-			_, error := logAnalyticsClient.DeleteLogAnalyticsEntity(context.Background(), deleteLogAnalyticsEntityRequest)
+			error := acctest.DeleteSweeperResource(compartment, "LogAnalyticsEntityId", logAnalyticsEntityId, func() error {
+				_, err := logAnalyticsClient.DeleteLogAnalyticsEntity(context.Background(), deleteLogAnalyticsEntityRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting LogAnalyticsEntity %s %s, It is possible that the resource is already deleted. Please verify manually \n", logAnalyticsEntityId, error)
+				fmt.Printf("Error deleting LogAnalyticsEntity %s %s \n", logAnalyticsEntityId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &logAnalyticsEntityId, logAnalyticsEntitySweepWaitCondition, time.Duration(3*time.Minute),
 				logAnalyticsEntitySweepResponseFetchOperation, "log_analytics", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getLogAnalyticsEntityIds(compartment string) ([]string, error) {
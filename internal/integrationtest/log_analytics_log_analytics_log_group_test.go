@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -255,10 +256,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("LogAnalyticsLogAnalyticsLogGroup") {
-		resource.AddTestSweepers("LogAnalyticsLogAnalyticsLogGroup", &resource.Sweeper{
-			Name:         "LogAnalyticsLogAnalyticsLogGroup",
-			Dependencies: acctest.DependencyGraph["logAnalyticsLogGroup"],
-			F:            sweepLogAnalyticsLogAnalyticsLogGroupResource,
+		acctest.AddTestSweepers("LogAnalyticsLogAnalyticsLogGroup", &resource.Sweeper{
+			Name: "LogAnalyticsLogAnalyticsLogGroup",
+			F:    sweepLogAnalyticsLogAnalyticsLogGroupResource,
 		})
 	}
 }
@@ -269,6 +269,7 @@ func sweepLogAnalyticsLogAnalyticsLogGroupResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, logAnalyticsLogGroupId := range logAnalyticsLogGroupIds {
 		if ok := acctest.SweeperDefaultResourceId[logAnalyticsLogGroupId]; !ok {
 			deleteLogAnalyticsLogGroupRequest := oci_log_analytics.DeleteLogAnalyticsLogGroupRequest{}
@@ -276,14 +277,18 @@ func sweepLogAnalyticsLogAnalyticsLogGroupResource(compartment string) error {
 			deleteLogAnalyticsLogGroupRequest.LogAnalyticsLogGroupId = &logAnalyticsLogGroupId
 
 			deleteLogAnalyticsLogGroupRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "log_analytics")
-			_, error := logAnalyticsClient.DeleteLogAnalyticsLogGroup(context.Background(), deleteLogAnalyticsLogGroupRequest)
+			error := acctest.DeleteSweeperResource(compartment, "LogAnalyticsLogGroupId", logAnalyticsLogGroupId, func() error {
+				_, err := logAnalyticsClient.DeleteLogAnalyticsLogGroup(context.Background(), deleteLogAnalyticsLogGroupRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting LogAnalyticsLogGroup %s %s, It is possible that the resource is already deleted. Please verify manually \n", logAnalyticsLogGroupId, error)
+				fmt.Printf("Error deleting LogAnalyticsLogGroup %s %s \n", logAnalyticsLogGroupId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getLogAnalyticsLogGroupIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -355,10 +356,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("LogAnalyticsLogAnalyticsObjectCollectionRule") {
-		resource.AddTestSweepers("LogAnalyticsLogAnalyticsObjectCollectionRule", &resource.Sweeper{
-			Name:         "LogAnalyticsLogAnalyticsObjectCollectionRule",
-			Dependencies: acctest.DependencyGraph["logAnalyticsObjectCollectionRule"],
-			F:            sweepLogAnalyticsLogAnalyticsObjectCollectionRuleResource,
+		acctest.AddTestSweepers("LogAnalyticsLogAnalyticsObjectCollectionRule", &resource.Sweeper{
+			Name: "LogAnalyticsLogAnalyticsObjectCollectionRule",
+			F:    sweepLogAnalyticsLogAnalyticsObjectCollectionRuleResource,
 		})
 	}
 }
@@ -369,6 +369,7 @@ func sweepLogAnalyticsLogAnalyticsObjectCollectionRuleResource(compartment strin
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, logAnalyticsObjectCollectionRuleId := range logAnalyticsObjectCollectionRuleIds {
 		if ok := acctest.SweeperDefaultResourceId[logAnalyticsObjectCollectionRuleId]; !ok {
 			deleteLogAnalyticsObjectCollectionRuleRequest := oci_log_analytics.DeleteLogAnalyticsObjectCollectionRuleRequest{}
@@ -376,16 +377,20 @@ func sweepLogAnalyticsLogAnalyticsObjectCollectionRuleResource(compartment strin
 			deleteLogAnalyticsObjectCollectionRuleRequest.LogAnalyticsObjectCollectionRuleId = &logAnalyticsObjectCollectionRuleId
 
 			deleteLogAnalyticsObjectCollectionRuleRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "log_analytics")
-			_, error := logAnalyticsClient.DeleteLogAnalyticsObjectCollectionRule(context.Background(), deleteLogAnalyticsObjectCollectionRuleRequest)
+			error := acctest.DeleteSweeperResource(compartment, "LogAnalyticsObjectCollectionRuleId", logAnalyticsObjectCollectionRuleId, func() error {
+				_, err := logAnalyticsClient.DeleteLogAnalyticsObjectCollectionRule(context.Background(), deleteLogAnalyticsObjectCollectionRuleRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting LogAnalyticsObjectCollectionRule %s %s, It is possible that the resource is already deleted. Please verify manually \n", logAnalyticsObjectCollectionRuleId, error)
+				fmt.Printf("Error deleting LogAnalyticsObjectCollectionRule %s %s \n", logAnalyticsObjectCollectionRuleId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &logAnalyticsObjectCollectionRuleId, logAnalyticsObjectCollectionRuleSweepWaitCondition, time.Duration(3*time.Minute),
 				logAnalyticsObjectCollectionRuleSweepResponseFetchOperation, "log_analytics", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getLogAnalyticsObjectCollectionRuleIds(compartment string) ([]string, error) {
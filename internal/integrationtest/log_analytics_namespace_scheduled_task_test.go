@@ -17,6 +17,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -382,10 +383,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("LogAnalyticsNamespaceScheduledTask") {
-		resource.AddTestSweepers("LogAnalyticsNamespaceScheduledTask", &resource.Sweeper{
-			Name:         "LogAnalyticsNamespaceScheduledTask",
-			Dependencies: acctest.DependencyGraph["namespaceScheduledTask"],
-			F:            sweepLogAnalyticsNamespaceScheduledTaskResource,
+		acctest.AddTestSweepers("LogAnalyticsNamespaceScheduledTask", &resource.Sweeper{
+			Name: "LogAnalyticsNamespaceScheduledTask",
+			F:    sweepLogAnalyticsNamespaceScheduledTaskResource,
 		})
 	}
 }
@@ -396,21 +396,26 @@ func sweepLogAnalyticsNamespaceScheduledTaskResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, namespaceScheduledTaskId := range namespaceScheduledTaskIds {
 		if ok := acctest.SweeperDefaultResourceId[namespaceScheduledTaskId]; !ok {
 			deleteScheduledTaskRequest := oci_log_analytics.DeleteScheduledTaskRequest{}
 
 			deleteScheduledTaskRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "log_analytics")
-			_, error := logAnalyticsClient.DeleteScheduledTask(context.Background(), deleteScheduledTaskRequest)
+			error := acctest.DeleteSweeperResource(compartment, "NamespaceScheduledTaskId", namespaceScheduledTaskId, func() error {
+				_, err := logAnalyticsClient.DeleteScheduledTask(context.Background(), deleteScheduledTaskRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting NamespaceScheduledTask %s %s, It is possible that the resource is already deleted. Please verify manually \n", namespaceScheduledTaskId, error)
+				fmt.Printf("Error deleting NamespaceScheduledTask %s %s \n", namespaceScheduledTaskId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &namespaceScheduledTaskId, namespaceScheduledTaskSweepWaitCondition, time.Duration(3*time.Minute),
 				namespaceScheduledTaskSweepResponseFetchOperation, "log_analytics", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getNamespaceScheduledTaskIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -251,10 +252,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("CustomLoggingLog") {
-		resource.AddTestSweepers("CustomLoggingLog", &resource.Sweeper{
-			Name:         "CustomLoggingLog",
-			Dependencies: acctest.DependencyGraph["log"],
-			F:            sweepLoggingCustomLogResource,
+		acctest.AddTestSweepers("CustomLoggingLog", &resource.Sweeper{
+			Name: "CustomLoggingLog",
+			F:    sweepLoggingCustomLogResource,
 		})
 	}
 }
@@ -265,6 +265,7 @@ func sweepLoggingCustomLogResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, logId := range logIds {
 		if ok := acctest.SweeperDefaultResourceId[logId]; !ok {
 			deleteLogRequest := oci_logging.DeleteLogRequest{}
@@ -272,12 +273,16 @@ func sweepLoggingCustomLogResource(compartment string) error {
 			deleteLogRequest.LogId = &logId
 
 			deleteLogRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "logging")
-			_, error := loggingManagementClient.DeleteLog(context.Background(), deleteLogRequest)
+			error := acctest.DeleteSweeperResource(compartment, "LogId", logId, func() error {
+				_, err := loggingManagementClient.DeleteLog(context.Background(), deleteLogRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Log %s %s, It is possible that the resource is already deleted. Please verify manually \n", logId, error)
+				fmt.Printf("Error deleting Log %s %s \n", logId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -261,10 +262,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("LoggingLogGroup") {
-		resource.AddTestSweepers("LoggingLogGroup", &resource.Sweeper{
-			Name:         "LoggingLogGroup",
-			Dependencies: acctest.DependencyGraph["logGroup"],
-			F:            sweepLoggingLogGroupResource,
+		acctest.AddTestSweepers("LoggingLogGroup", &resource.Sweeper{
+			Name: "LoggingLogGroup",
+			F:    sweepLoggingLogGroupResource,
 		})
 	}
 }
@@ -275,6 +275,7 @@ func sweepLoggingLogGroupResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, logGroupId := range logGroupIds {
 		if ok := acctest.SweeperDefaultResourceId[logGroupId]; !ok {
 			deleteLogGroupRequest := oci_logging.DeleteLogGroupRequest{}
@@ -282,14 +283,18 @@ func sweepLoggingLogGroupResource(compartment string) error {
 			deleteLogGroupRequest.LogGroupId = &logGroupId
 
 			deleteLogGroupRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "logging")
-			_, error := loggingManagementClient.DeleteLogGroup(context.Background(), deleteLogGroupRequest)
+			error := acctest.DeleteSweeperResource(compartment, "LogGroupId", logGroupId, func() error {
+				_, err := loggingManagementClient.DeleteLogGroup(context.Background(), deleteLogGroupRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting LogGroup %s %s, It is possible that the resource is already deleted. Please verify manually \n", logGroupId, error)
+				fmt.Printf("Error deleting LogGroup %s %s \n", logGroupId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getLogGroupIds(compartment string) ([]string, error) {
@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -253,10 +254,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("LoggingLogSavedSearch") {
-		resource.AddTestSweepers("LoggingLogSavedSearch", &resource.Sweeper{
-			Name:         "LoggingLogSavedSearch",
-			Dependencies: acctest.DependencyGraph["logSavedSearch"],
-			F:            sweepLoggingLogSavedSearchResource,
+		acctest.AddTestSweepers("LoggingLogSavedSearch", &resource.Sweeper{
+			Name: "LoggingLogSavedSearch",
+			F:    sweepLoggingLogSavedSearchResource,
 		})
 	}
 }
@@ -267,6 +267,7 @@ func sweepLoggingLogSavedSearchResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, logSavedSearchId := range logSavedSearchIds {
 		if ok := acctest.SweeperDefaultResourceId[logSavedSearchId]; !ok {
 			deleteLogSavedSearchRequest := oci_logging.DeleteLogSavedSearchRequest{}
@@ -274,14 +275,18 @@ func sweepLoggingLogSavedSearchResource(compartment string) error {
 			deleteLogSavedSearchRequest.LogSavedSearchId = &logSavedSearchId
 
 			deleteLogSavedSearchRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "logging")
-			_, error := loggingManagementClient.DeleteLogSavedSearch(context.Background(), deleteLogSavedSearchRequest)
+			error := acctest.DeleteSweeperResource(compartment, "LogSavedSearchId", logSavedSearchId, func() error {
+				_, err := loggingManagementClient.DeleteLogSavedSearch(context.Background(), deleteLogSavedSearchRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting LogSavedSearch %s %s, It is possible that the resource is already deleted. Please verify manually \n", logSavedSearchId, error)
+				fmt.Printf("Error deleting LogSavedSearch %s %s \n", logSavedSearchId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getLogSavedSearchIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -312,10 +313,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("LoggingLog") {
-		resource.AddTestSweepers("LoggingLog", &resource.Sweeper{
-			Name:         "LoggingLog",
-			Dependencies: acctest.DependencyGraph["log"],
-			F:            sweepLoggingLogResource,
+		acctest.AddTestSweepers("LoggingLog", &resource.Sweeper{
+			Name: "LoggingLog",
+			F:    sweepLoggingLogResource,
 		})
 	}
 }
@@ -326,6 +326,7 @@ func sweepLoggingLogResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, logId := range logIds {
 		if ok := acctest.SweeperDefaultResourceId[logId]; !ok {
 			deleteLogRequest := oci_logging.DeleteLogRequest{}
@@ -333,14 +334,18 @@ func sweepLoggingLogResource(compartment string) error {
 			deleteLogRequest.LogId = &logId
 
 			deleteLogRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "logging")
-			_, error := loggingManagementClient.DeleteLog(context.Background(), deleteLogRequest)
+			error := acctest.DeleteSweeperResource(compartment, "LogId", logId, func() error {
+				_, err := loggingManagementClient.DeleteLog(context.Background(), deleteLogRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Log %s %s, It is possible that the resource is already deleted. Please verify manually \n", logId, error)
+				fmt.Printf("Error deleting Log %s %s \n", logId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getLogIds(compartment string) ([]string, error) {
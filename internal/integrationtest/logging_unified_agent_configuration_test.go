@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -342,10 +343,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("LoggingUnifiedAgentConfiguration") {
-		resource.AddTestSweepers("LoggingUnifiedAgentConfiguration", &resource.Sweeper{
-			Name:         "LoggingUnifiedAgentConfiguration",
-			Dependencies: acctest.DependencyGraph["unifiedAgentConfiguration"],
-			F:            sweepLoggingUnifiedAgentConfigurationResource,
+		acctest.AddTestSweepers("LoggingUnifiedAgentConfiguration", &resource.Sweeper{
+			Name: "LoggingUnifiedAgentConfiguration",
+			F:    sweepLoggingUnifiedAgentConfigurationResource,
 		})
 	}
 }
@@ -356,6 +356,7 @@ func sweepLoggingUnifiedAgentConfigurationResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, unifiedAgentConfigurationId := range unifiedAgentConfigurationIds {
 		if ok := acctest.SweeperDefaultResourceId[unifiedAgentConfigurationId]; !ok {
 			deleteUnifiedAgentConfigurationRequest := oci_logging.DeleteUnifiedAgentConfigurationRequest{}
@@ -363,14 +364,18 @@ func sweepLoggingUnifiedAgentConfigurationResource(compartment string) error {
 			deleteUnifiedAgentConfigurationRequest.UnifiedAgentConfigurationId = &unifiedAgentConfigurationId
 
 			deleteUnifiedAgentConfigurationRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "logging")
-			_, error := loggingManagementClient.DeleteUnifiedAgentConfiguration(context.Background(), deleteUnifiedAgentConfigurationRequest)
+			error := acctest.DeleteSweeperResource(compartment, "UnifiedAgentConfigurationId", unifiedAgentConfigurationId, func() error {
+				_, err := loggingManagementClient.DeleteUnifiedAgentConfiguration(context.Background(), deleteUnifiedAgentConfigurationRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting UnifiedAgentConfiguration %s %s, It is possible that the resource is already deleted. Please verify manually \n", unifiedAgentConfigurationId, error)
+				fmt.Printf("Error deleting UnifiedAgentConfiguration %s %s \n", unifiedAgentConfigurationId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getUnifiedAgentConfigurationIds(compartment string) ([]string, error) {
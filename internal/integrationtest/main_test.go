@@ -0,0 +1,15 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package integrationtest
+
+import (
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/acctest"
+)
+
+// issue-routing-tag: terraform/default
+func TestMain(m *testing.M) {
+	acctest.TestMain(m)
+}
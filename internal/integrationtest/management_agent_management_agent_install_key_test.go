@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -248,10 +249,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("ManagementAgentManagementAgentInstallKey") {
-		resource.AddTestSweepers("ManagementAgentManagementAgentInstallKey", &resource.Sweeper{
-			Name:         "ManagementAgentManagementAgentInstallKey",
-			Dependencies: acctest.DependencyGraph["managementAgentInstallKey"],
-			F:            sweepManagementAgentManagementAgentInstallKeyResource,
+		acctest.AddTestSweepers("ManagementAgentManagementAgentInstallKey", &resource.Sweeper{
+			Name: "ManagementAgentManagementAgentInstallKey",
+			F:    sweepManagementAgentManagementAgentInstallKeyResource,
 		})
 	}
 }
@@ -262,6 +262,7 @@ func sweepManagementAgentManagementAgentInstallKeyResource(compartment string) e
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, managementAgentInstallKeyId := range managementAgentInstallKeyIds {
 		if ok := acctest.SweeperDefaultResourceId[managementAgentInstallKeyId]; !ok {
 			deleteManagementAgentInstallKeyRequest := oci_management_agent.DeleteManagementAgentInstallKeyRequest{}
@@ -269,16 +270,20 @@ func sweepManagementAgentManagementAgentInstallKeyResource(compartment string) e
 			deleteManagementAgentInstallKeyRequest.ManagementAgentInstallKeyId = &managementAgentInstallKeyId
 
 			deleteManagementAgentInstallKeyRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "management_agent")
-			_, error := managementAgentClient.DeleteManagementAgentInstallKey(context.Background(), deleteManagementAgentInstallKeyRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ManagementAgentInstallKeyId", managementAgentInstallKeyId, func() error {
+				_, err := managementAgentClient.DeleteManagementAgentInstallKey(context.Background(), deleteManagementAgentInstallKeyRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ManagementAgentInstallKey %s %s, It is possible that the resource is already deleted. Please verify manually \n", managementAgentInstallKeyId, error)
+				fmt.Printf("Error deleting ManagementAgentInstallKey %s %s \n", managementAgentInstallKeyId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &managementAgentInstallKeyId, managementAgentInstallKeySweepWaitCondition, time.Duration(3*time.Minute),
 				managementAgentInstallKeySweepResponseFetchOperation, "management_agent", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getManagementAgentInstallKeyIds(compartment string) ([]string, error) {
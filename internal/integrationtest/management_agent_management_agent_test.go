@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -246,10 +247,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("ManagementAgentManagementAgent") {
-		resource.AddTestSweepers("ManagementAgentManagementAgent", &resource.Sweeper{
-			Name:         "ManagementAgentManagementAgent",
-			Dependencies: acctest.DependencyGraph["managementAgent"],
-			F:            sweepManagementAgentManagementAgentResource,
+		acctest.AddTestSweepers("ManagementAgentManagementAgent", &resource.Sweeper{
+			Name: "ManagementAgentManagementAgent",
+			F:    sweepManagementAgentManagementAgentResource,
 		})
 	}
 }
@@ -260,6 +260,7 @@ func sweepManagementAgentManagementAgentResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, managementAgentId := range managementAgentIds {
 		if ok := acctest.SweeperDefaultResourceId[managementAgentId]; !ok {
 			deleteManagementAgentRequest := oci_management_agent.DeleteManagementAgentRequest{}
@@ -267,16 +268,20 @@ func sweepManagementAgentManagementAgentResource(compartment string) error {
 			deleteManagementAgentRequest.ManagementAgentId = &managementAgentId
 
 			deleteManagementAgentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "management_agent")
-			_, error := managementAgentClient.DeleteManagementAgent(context.Background(), deleteManagementAgentRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ManagementAgentId", managementAgentId, func() error {
+				_, err := managementAgentClient.DeleteManagementAgent(context.Background(), deleteManagementAgentRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ManagementAgent %s %s, It is possible that the resource is already deleted. Please verify manually \n", managementAgentId, error)
+				fmt.Printf("Error deleting ManagementAgent %s %s \n", managementAgentId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &managementAgentId, managementAgentSweepWaitCondition, time.Duration(3*time.Minute),
 				managementAgentSweepResponseFetchOperation, "management_agent", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getManagementAgentIds(compartment string) ([]string, error) {
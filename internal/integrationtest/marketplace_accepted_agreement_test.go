@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -241,10 +242,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("MarketplaceAcceptedAgreement") {
-		resource.AddTestSweepers("MarketplaceAcceptedAgreement", &resource.Sweeper{
-			Name:         "MarketplaceAcceptedAgreement",
-			Dependencies: acctest.DependencyGraph["acceptedAgreement"],
-			F:            sweepMarketplaceAcceptedAgreementResource,
+		acctest.AddTestSweepers("MarketplaceAcceptedAgreement", &resource.Sweeper{
+			Name: "MarketplaceAcceptedAgreement",
+			F:    sweepMarketplaceAcceptedAgreementResource,
 		})
 	}
 }
@@ -255,6 +255,7 @@ func sweepMarketplaceAcceptedAgreementResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, acceptedAgreementId := range acceptedAgreementIds {
 		if ok := acctest.SweeperDefaultResourceId[acceptedAgreementId]; !ok {
 			deleteAcceptedAgreementRequest := oci_marketplace.DeleteAcceptedAgreementRequest{}
@@ -262,14 +263,18 @@ func sweepMarketplaceAcceptedAgreementResource(compartment string) error {
 			deleteAcceptedAgreementRequest.AcceptedAgreementId = &acceptedAgreementId
 
 			deleteAcceptedAgreementRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "marketplace")
-			_, error := marketplaceClient.DeleteAcceptedAgreement(context.Background(), deleteAcceptedAgreementRequest)
+			error := acctest.DeleteSweeperResource(compartment, "AcceptedAgreementId", acceptedAgreementId, func() error {
+				_, err := marketplaceClient.DeleteAcceptedAgreement(context.Background(), deleteAcceptedAgreementRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting AcceptedAgreement %s %s, It is possible that the resource is already deleted. Please verify manually \n", acceptedAgreementId, error)
+				fmt.Printf("Error deleting AcceptedAgreement %s %s \n", acceptedAgreementId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getAcceptedAgreementIds(compartment string) ([]string, error) {
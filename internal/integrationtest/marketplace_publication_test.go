@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -389,10 +390,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("MarketplacePublication") {
-		resource.AddTestSweepers("MarketplacePublication", &resource.Sweeper{
-			Name:         "MarketplacePublication",
-			Dependencies: acctest.DependencyGraph["publication"],
-			F:            sweepMarketplacePublicationResource,
+		acctest.AddTestSweepers("MarketplacePublication", &resource.Sweeper{
+			Name: "MarketplacePublication",
+			F:    sweepMarketplacePublicationResource,
 		})
 	}
 }
@@ -403,6 +403,7 @@ func sweepMarketplacePublicationResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, publicationId := range publicationIds {
 		if ok := acctest.SweeperDefaultResourceId[publicationId]; !ok {
 			deletePublicationRequest := oci_marketplace.DeletePublicationRequest{}
@@ -410,16 +411,20 @@ func sweepMarketplacePublicationResource(compartment string) error {
 			deletePublicationRequest.PublicationId = &publicationId
 
 			deletePublicationRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "marketplace")
-			_, error := marketplaceClient.DeletePublication(context.Background(), deletePublicationRequest)
+			error := acctest.DeleteSweeperResource(compartment, "PublicationId", publicationId, func() error {
+				_, err := marketplaceClient.DeletePublication(context.Background(), deletePublicationRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Publication %s %s, It is possible that the resource is already deleted. Please verify manually \n", publicationId, error)
+				fmt.Printf("Error deleting Publication %s %s \n", publicationId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			//acctest.WaitTillCondition(acctest.TestAccProvider, &publicationId, publicationSweepWaitCondition, time.Duration(3*time.Minute),
 			//	publicationSweepResponseFetchOperation, "marketplace", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getPublicationIds(compartment string) ([]string, error) {
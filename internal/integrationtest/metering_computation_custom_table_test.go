@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -214,10 +215,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("MeteringComputationCustomTable") {
-		resource.AddTestSweepers("MeteringComputationCustomTable", &resource.Sweeper{
-			Name:         "MeteringComputationCustomTable",
-			Dependencies: acctest.DependencyGraph["customTable"],
-			F:            sweepMeteringComputationCustomTableResource,
+		acctest.AddTestSweepers("MeteringComputationCustomTable", &resource.Sweeper{
+			Name: "MeteringComputationCustomTable",
+			F:    sweepMeteringComputationCustomTableResource,
 		})
 	}
 }
@@ -228,6 +228,7 @@ func sweepMeteringComputationCustomTableResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, customTableId := range customTableIds {
 		if ok := acctest.SweeperDefaultResourceId[customTableId]; !ok {
 			deleteCustomTableRequest := oci_metering_computation.DeleteCustomTableRequest{}
@@ -235,14 +236,18 @@ func sweepMeteringComputationCustomTableResource(compartment string) error {
 			deleteCustomTableRequest.CustomTableId = &customTableId
 
 			deleteCustomTableRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "metering_computation")
-			_, error := usageapiClient.DeleteCustomTable(context.Background(), deleteCustomTableRequest)
+			error := acctest.DeleteSweeperResource(compartment, "CustomTableId", customTableId, func() error {
+				_, err := usageapiClient.DeleteCustomTable(context.Background(), deleteCustomTableRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting CustomTable %s %s, It is possible that the resource is already deleted. Please verify manually \n", customTableId, error)
+				fmt.Printf("Error deleting CustomTable %s %s \n", customTableId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getCustomTableIds(compartment string) ([]string, error) {
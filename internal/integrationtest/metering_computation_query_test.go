@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -269,10 +270,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("MeteringComputationQuery") {
-		resource.AddTestSweepers("MeteringComputationQuery", &resource.Sweeper{
-			Name:         "MeteringComputationQuery",
-			Dependencies: acctest.DependencyGraph["query"],
-			F:            sweepMeteringComputationQueryResource,
+		acctest.AddTestSweepers("MeteringComputationQuery", &resource.Sweeper{
+			Name: "MeteringComputationQuery",
+			F:    sweepMeteringComputationQueryResource,
 		})
 	}
 }
@@ -283,6 +283,7 @@ func sweepMeteringComputationQueryResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, queryId := range queryIds {
 		if ok := acctest.SweeperDefaultResourceId[queryId]; !ok {
 			deleteQueryRequest := oci_metering_computation.DeleteQueryRequest{}
@@ -290,14 +291,18 @@ func sweepMeteringComputationQueryResource(compartment string) error {
 			deleteQueryRequest.QueryId = &queryId
 
 			deleteQueryRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "metering_computation")
-			_, error := usageapiClient.DeleteQuery(context.Background(), deleteQueryRequest)
+			error := acctest.DeleteSweeperResource(compartment, "QueryId", queryId, func() error {
+				_, err := usageapiClient.DeleteQuery(context.Background(), deleteQueryRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Query %s %s, It is possible that the resource is already deleted. Please verify manually \n", queryId, error)
+				fmt.Printf("Error deleting Query %s %s \n", queryId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getQueryIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -370,10 +371,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("MonitoringAlarm") {
-		resource.AddTestSweepers("MonitoringAlarm", &resource.Sweeper{
-			Name:         "MonitoringAlarm",
-			Dependencies: acctest.DependencyGraph["alarm"],
-			F:            sweepMonitoringAlarmResource,
+		acctest.AddTestSweepers("MonitoringAlarm", &resource.Sweeper{
+			Name: "MonitoringAlarm",
+			F:    sweepMonitoringAlarmResource,
 		})
 	}
 }
@@ -384,6 +384,7 @@ func sweepMonitoringAlarmResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, alarmId := range alarmIds {
 		if ok := acctest.SweeperDefaultResourceId[alarmId]; !ok {
 			deleteAlarmRequest := oci_monitoring.DeleteAlarmRequest{}
@@ -391,16 +392,20 @@ func sweepMonitoringAlarmResource(compartment string) error {
 			deleteAlarmRequest.AlarmId = &alarmId
 
 			deleteAlarmRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "monitoring")
-			_, error := monitoringClient.DeleteAlarm(context.Background(), deleteAlarmRequest)
+			error := acctest.DeleteSweeperResource(compartment, "AlarmId", alarmId, func() error {
+				_, err := monitoringClient.DeleteAlarm(context.Background(), deleteAlarmRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Alarm %s %s, It is possible that the resource is already deleted. Please verify manually \n", alarmId, error)
+				fmt.Printf("Error deleting Alarm %s %s \n", alarmId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &alarmId, alarmSweepWaitCondition, time.Duration(3*time.Minute),
 				alarmSweepResponseFetchOperation, "monitoring", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getAlarmIds(compartment string) ([]string, error) {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -211,10 +212,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("MysqlAnalyticsCluster") {
-		resource.AddTestSweepers("MysqlAnalyticsCluster", &resource.Sweeper{
-			Name:         "MysqlAnalyticsCluster",
-			Dependencies: acctest.DependencyGraph["analyticsCluster"],
-			F:            sweepMysqlAnalyticsClusterResource,
+		acctest.AddTestSweepers("MysqlAnalyticsCluster", &resource.Sweeper{
+			Name: "MysqlAnalyticsCluster",
+			F:    sweepMysqlAnalyticsClusterResource,
 		})
 	}
 }
@@ -225,22 +225,27 @@ func sweepMysqlAnalyticsClusterResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, mysqlDbSystemId := range mysqlDbSystemIds {
 		if ok := acctest.SweeperDefaultResourceId[mysqlDbSystemId]; !ok {
 			deleteAnalyticsClusterRequest := oci_mysql.DeleteAnalyticsClusterRequest{}
 			deleteAnalyticsClusterRequest.DbSystemId = &mysqlDbSystemId
 
 			deleteAnalyticsClusterRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "mysql")
-			_, error := dbSystemClient.DeleteAnalyticsCluster(context.Background(), deleteAnalyticsClusterRequest)
+			error := acctest.DeleteSweeperResource(compartment, "MysqlDbSystemId", mysqlDbSystemId, func() error {
+				_, err := dbSystemClient.DeleteAnalyticsCluster(context.Background(), deleteAnalyticsClusterRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting AnalyticsCluster of DbSystem %s %s, It is possible that the resource is already deleted. Please verify manually \n", mysqlDbSystemId, error)
+				fmt.Printf("Error deleting AnalyticsCluster of DbSystem %s %s \n", mysqlDbSystemId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &mysqlDbSystemId, analyticsClusterSweepWaitCondition, time.Duration(3*time.Minute),
 				analyticsClusterSweepResponseFetchOperation, "mysql", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func analyticsClusterSweepWaitCondition(response common.OCIOperationResponse) bool {
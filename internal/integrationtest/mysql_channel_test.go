@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -298,10 +299,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("MysqlChannel") {
-		resource.AddTestSweepers("MysqlChannel", &resource.Sweeper{
-			Name:         "MysqlChannel",
-			Dependencies: acctest.DependencyGraph["channel"],
-			F:            sweepMysqlChannelResource,
+		acctest.AddTestSweepers("MysqlChannel", &resource.Sweeper{
+			Name: "MysqlChannel",
+			F:    sweepMysqlChannelResource,
 		})
 	}
 }
@@ -312,6 +312,7 @@ func sweepMysqlChannelResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, channelId := range channelIds {
 		if ok := acctest.SweeperDefaultResourceId[channelId]; !ok {
 			deleteChannelRequest := oci_mysql.DeleteChannelRequest{}
@@ -319,16 +320,20 @@ func sweepMysqlChannelResource(compartment string) error {
 			deleteChannelRequest.ChannelId = &channelId
 
 			deleteChannelRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "mysql")
-			_, error := channelsClient.DeleteChannel(context.Background(), deleteChannelRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ChannelId", channelId, func() error {
+				_, err := channelsClient.DeleteChannel(context.Background(), deleteChannelRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Channel %s %s, It is possible that the resource is already deleted. Please verify manually \n", channelId, error)
+				fmt.Printf("Error deleting Channel %s %s \n", channelId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &channelId, channelSweepWaitCondition, time.Duration(3*time.Minute),
 				channelSweepResponseFetchOperation, "mysql", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getChannelIds(compartment string) ([]string, error) {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -230,10 +231,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("MysqlHeatWaveCluster") {
-		resource.AddTestSweepers("MysqlHeatWaveCluster", &resource.Sweeper{
-			Name:         "MysqlHeatWaveCluster",
-			Dependencies: acctest.DependencyGraph["heatWaveCluster"],
-			F:            sweepMysqlHeatWaveClusterResource,
+		acctest.AddTestSweepers("MysqlHeatWaveCluster", &resource.Sweeper{
+			Name: "MysqlHeatWaveCluster",
+			F:    sweepMysqlHeatWaveClusterResource,
 		})
 	}
 }
@@ -244,22 +244,27 @@ func sweepMysqlHeatWaveClusterResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, mysqlDbSystemId := range mysqlDbSystemIds {
 		if ok := acctest.SweeperDefaultResourceId[mysqlDbSystemId]; !ok {
 			deleteHeatWaveClusterRequest := oci_mysql.DeleteHeatWaveClusterRequest{}
 			deleteHeatWaveClusterRequest.DbSystemId = &mysqlDbSystemId
 
 			deleteHeatWaveClusterRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "mysql")
-			_, error := dbSystemClient.DeleteHeatWaveCluster(context.Background(), deleteHeatWaveClusterRequest)
+			error := acctest.DeleteSweeperResource(compartment, "MysqlDbSystemId", mysqlDbSystemId, func() error {
+				_, err := dbSystemClient.DeleteHeatWaveCluster(context.Background(), deleteHeatWaveClusterRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting HeatWaveCluster of DbSystem %s %s, It is possible that the resource is already deleted. Please verify manually \n", mysqlDbSystemId, error)
+				fmt.Printf("Error deleting HeatWaveCluster of DbSystem %s %s \n", mysqlDbSystemId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &mysqlDbSystemId, heatWaveClusterSweepWaitCondition, time.Duration(3*time.Minute),
 				heatWaveClusterSweepResponseFetchOperation, "mysql", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func heatWaveClusterSweepWaitCondition(response common.OCIOperationResponse) bool {
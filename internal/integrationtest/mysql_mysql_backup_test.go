@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -315,10 +316,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("MysqlMysqlBackup") {
-		resource.AddTestSweepers("MysqlMysqlBackup", &resource.Sweeper{
-			Name:         "MysqlMysqlBackup",
-			Dependencies: acctest.DependencyGraph["mysqlBackup"],
-			F:            sweepMysqlMysqlBackupResource,
+		acctest.AddTestSweepers("MysqlMysqlBackup", &resource.Sweeper{
+			Name: "MysqlMysqlBackup",
+			F:    sweepMysqlMysqlBackupResource,
 		})
 	}
 }
@@ -329,22 +329,27 @@ func sweepMysqlMysqlBackupResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, mysqlBackupId := range mysqlBackupIds {
 		if ok := acctest.SweeperDefaultResourceId[mysqlBackupId]; !ok {
 			deleteBackupRequest := oci_mysql.DeleteBackupRequest{}
 			deleteBackupRequest.BackupId = &mysqlBackupId
 
 			deleteBackupRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "mysql")
-			_, error := dbBackupsClient.DeleteBackup(context.Background(), deleteBackupRequest)
+			error := acctest.DeleteSweeperResource(compartment, "MysqlBackupId", mysqlBackupId, func() error {
+				_, err := dbBackupsClient.DeleteBackup(context.Background(), deleteBackupRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting MysqlBackup %s %s, It is possible that the resource is already deleted. Please verify manually \n", mysqlBackupId, error)
+				fmt.Printf("Error deleting MysqlBackup %s %s \n", mysqlBackupId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &mysqlBackupId, mysqlBackupSweepWaitCondition, time.Duration(3*time.Minute),
 				mysqlBackupSweepResponseFetchOperation, "mysql", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getMysqlBackupIds(compartment string) ([]string, error) {
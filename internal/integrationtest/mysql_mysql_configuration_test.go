@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
@@ -148,10 +149,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("MysqlMysqlConfiguration") {
-		resource.AddTestSweepers("MysqlMysqlConfiguration", &resource.Sweeper{
-			Name:         "MysqlMysqlConfiguration",
-			Dependencies: acctest.DependencyGraph["mysqlConfiguration"],
-			F:            sweepMysqlMysqlConfigurationResource,
+		acctest.AddTestSweepers("MysqlMysqlConfiguration", &resource.Sweeper{
+			Name: "MysqlMysqlConfiguration",
+			F:    sweepMysqlMysqlConfigurationResource,
 		})
 	}
 }
@@ -162,22 +162,27 @@ func sweepMysqlMysqlConfigurationResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, mysqlConfigurationId := range mysqlConfigurationIds {
 		if ok := acctest.SweeperDefaultResourceId[mysqlConfigurationId]; !ok {
 			deleteConfigurationRequest := oci_mysql.DeleteConfigurationRequest{}
 			deleteConfigurationRequest.ConfigurationId = &mysqlConfigurationId
 
 			deleteConfigurationRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "mysql")
-			_, error := mysqlaasClient.DeleteConfiguration(context.Background(), deleteConfigurationRequest)
+			error := acctest.DeleteSweeperResource(compartment, "MysqlConfigurationId", mysqlConfigurationId, func() error {
+				_, err := mysqlaasClient.DeleteConfiguration(context.Background(), deleteConfigurationRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting MysqlConfiguration %s %s, It is possible that the resource is already deleted. Please verify manually \n", mysqlConfigurationId, error)
+				fmt.Printf("Error deleting MysqlConfiguration %s %s \n", mysqlConfigurationId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &mysqlConfigurationId, mysqlConfigurationSweepWaitCondition, time.Duration(3*time.Minute),
 				mysqlConfigurationSweepResponseFetchOperation, "mysql", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getMysqlConfigurationIds(compartment string) ([]string, error) {
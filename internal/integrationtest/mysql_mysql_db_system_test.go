@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -417,10 +418,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("MysqlMysqlDbSystem") {
-		resource.AddTestSweepers("MysqlMysqlDbSystem", &resource.Sweeper{
-			Name:         "MysqlMysqlDbSystem",
-			Dependencies: acctest.DependencyGraph["mysqlDbSystem"],
-			F:            sweepMysqlMysqlDbSystemResource,
+		acctest.AddTestSweepers("MysqlMysqlDbSystem", &resource.Sweeper{
+			Name: "MysqlMysqlDbSystem",
+			F:    sweepMysqlMysqlDbSystemResource,
 		})
 	}
 }
@@ -431,22 +431,27 @@ func sweepMysqlMysqlDbSystemResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, mysqlDbSystemId := range mysqlDbSystemIds {
 		if ok := acctest.SweeperDefaultResourceId[mysqlDbSystemId]; !ok {
 			deleteDbSystemRequest := oci_mysql.DeleteDbSystemRequest{}
 			deleteDbSystemRequest.DbSystemId = &mysqlDbSystemId
 
 			deleteDbSystemRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "mysql")
-			_, error := dbSystemClient.DeleteDbSystem(context.Background(), deleteDbSystemRequest)
+			error := acctest.DeleteSweeperResource(compartment, "MysqlDbSystemId", mysqlDbSystemId, func() error {
+				_, err := dbSystemClient.DeleteDbSystem(context.Background(), deleteDbSystemRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting MysqlDbSystem %s %s, It is possible that the resource is already deleted. Please verify manually \n", mysqlDbSystemId, error)
+				fmt.Printf("Error deleting MysqlDbSystem %s %s \n", mysqlDbSystemId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &mysqlDbSystemId, mysqlDbSystemSweepWaitCondition, time.Duration(3*time.Minute),
 				mysqlDbSystemSweepResponseFetchOperation, "mysql", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getMysqlDbSystemIds(compartment string) ([]string, error) {
@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -484,10 +485,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("NetworkLoadBalancerBackendSet") {
-		resource.AddTestSweepers("NetworkLoadBalancerBackendSet", &resource.Sweeper{
-			Name:         "NetworkLoadBalancerBackendSet",
-			Dependencies: acctest.DependencyGraph["backendSet"],
-			F:            sweepNetworkLoadBalancerBackendSetResource,
+		acctest.AddTestSweepers("NetworkLoadBalancerBackendSet", &resource.Sweeper{
+			Name: "NetworkLoadBalancerBackendSet",
+			F:    sweepNetworkLoadBalancerBackendSetResource,
 		})
 	}
 }
@@ -498,19 +498,24 @@ func sweepNetworkLoadBalancerBackendSetResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, backendSetId := range backendSetIds {
 		if ok := acctest.SweeperDefaultResourceId[backendSetId]; !ok {
 			deleteBackendSetRequest := oci_network_load_balancer.DeleteBackendSetRequest{}
 
 			deleteBackendSetRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "network_load_balancer")
-			_, error := networkLoadBalancerClient.DeleteBackendSet(context.Background(), deleteBackendSetRequest)
+			error := acctest.DeleteSweeperResource(compartment, "BackendSetId", backendSetId, func() error {
+				_, err := networkLoadBalancerClient.DeleteBackendSet(context.Background(), deleteBackendSetRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting BackendSet %s %s, It is possible that the resource is already deleted. Please verify manually \n", backendSetId, error)
+				fmt.Printf("Error deleting BackendSet %s %s \n", backendSetId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getNetworkLoadBalancerBackendSetIds(compartment string) ([]string, error) {
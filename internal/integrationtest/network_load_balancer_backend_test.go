@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -212,10 +213,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("NetworkLoadBalancerBackend") {
-		resource.AddTestSweepers("NetworkLoadBalancerBackend", &resource.Sweeper{
-			Name:         "NetworkLoadBalancerBackend",
-			Dependencies: acctest.DependencyGraph["backend"],
-			F:            sweepNetworkLoadBalancerBackendResource,
+		acctest.AddTestSweepers("NetworkLoadBalancerBackend", &resource.Sweeper{
+			Name: "NetworkLoadBalancerBackend",
+			F:    sweepNetworkLoadBalancerBackendResource,
 		})
 	}
 }
@@ -226,19 +226,24 @@ func sweepNetworkLoadBalancerBackendResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, backendId := range backendIds {
 		if ok := acctest.SweeperDefaultResourceId[backendId]; !ok {
 			deleteBackendRequest := oci_network_load_balancer.DeleteBackendRequest{}
 
 			deleteBackendRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "network_load_balancer")
-			_, error := networkLoadBalancerClient.DeleteBackend(context.Background(), deleteBackendRequest)
+			error := acctest.DeleteSweeperResource(compartment, "BackendId", backendId, func() error {
+				_, err := networkLoadBalancerClient.DeleteBackend(context.Background(), deleteBackendRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Backend %s %s, It is possible that the resource is already deleted. Please verify manually \n", backendId, error)
+				fmt.Printf("Error deleting Backend %s %s \n", backendId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getNetworkLoadBalancerBackendIds(compartment string) ([]string, error) {
@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -219,10 +220,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("NetworkLoadBalancerListener") {
-		resource.AddTestSweepers("NetworkLoadBalancerListener", &resource.Sweeper{
-			Name:         "NetworkLoadBalancerListener",
-			Dependencies: acctest.DependencyGraph["listener"],
-			F:            sweepNetworkLoadBalancerListenerResource,
+		acctest.AddTestSweepers("NetworkLoadBalancerListener", &resource.Sweeper{
+			Name: "NetworkLoadBalancerListener",
+			F:    sweepNetworkLoadBalancerListenerResource,
 		})
 	}
 }
@@ -233,19 +233,24 @@ func sweepNetworkLoadBalancerListenerResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, listenerId := range listenerIds {
 		if ok := acctest.SweeperDefaultResourceId[listenerId]; !ok {
 			deleteListenerRequest := oci_network_load_balancer.DeleteListenerRequest{}
 
 			deleteListenerRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "network_load_balancer")
-			_, error := networkLoadBalancerClient.DeleteListener(context.Background(), deleteListenerRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ListenerId", listenerId, func() error {
+				_, err := networkLoadBalancerClient.DeleteListener(context.Background(), deleteListenerRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Listener %s %s, It is possible that the resource is already deleted. Please verify manually \n", listenerId, error)
+				fmt.Printf("Error deleting Listener %s %s \n", listenerId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getNetworkLoadBalancerListenerIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -385,10 +386,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("NetworkLoadBalancerNetworkLoadBalancer") {
-		resource.AddTestSweepers("NetworkLoadBalancerNetworkLoadBalancer", &resource.Sweeper{
-			Name:         "NetworkLoadBalancerNetworkLoadBalancer",
-			Dependencies: acctest.DependencyGraph["networkLoadBalancer"],
-			F:            sweepNetworkLoadBalancerNetworkLoadBalancerResource,
+		acctest.AddTestSweepers("NetworkLoadBalancerNetworkLoadBalancer", &resource.Sweeper{
+			Name: "NetworkLoadBalancerNetworkLoadBalancer",
+			F:    sweepNetworkLoadBalancerNetworkLoadBalancerResource,
 		})
 	}
 }
@@ -399,6 +399,7 @@ func sweepNetworkLoadBalancerNetworkLoadBalancerResource(compartment string) err
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, networkLoadBalancerId := range networkLoadBalancerIds {
 		if ok := acctest.SweeperDefaultResourceId[networkLoadBalancerId]; !ok {
 			deleteNetworkLoadBalancerRequest := oci_network_load_balancer.DeleteNetworkLoadBalancerRequest{}
@@ -406,16 +407,20 @@ func sweepNetworkLoadBalancerNetworkLoadBalancerResource(compartment string) err
 			deleteNetworkLoadBalancerRequest.NetworkLoadBalancerId = &networkLoadBalancerId
 
 			deleteNetworkLoadBalancerRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "network_load_balancer")
-			_, error := networkLoadBalancerClient.DeleteNetworkLoadBalancer(context.Background(), deleteNetworkLoadBalancerRequest)
+			error := acctest.DeleteSweeperResource(compartment, "NetworkLoadBalancerId", networkLoadBalancerId, func() error {
+				_, err := networkLoadBalancerClient.DeleteNetworkLoadBalancer(context.Background(), deleteNetworkLoadBalancerRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting NetworkLoadBalancer %s %s, It is possible that the resource is already deleted. Please verify manually \n", networkLoadBalancerId, error)
+				fmt.Printf("Error deleting NetworkLoadBalancer %s %s \n", networkLoadBalancerId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &networkLoadBalancerId, networkLoadBalancerSweepWaitCondition, time.Duration(3*time.Minute),
 				networkLoadBalancerSweepResponseFetchOperation, "network_load_balancer", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getNetworkLoadBalancerIds(compartment string) ([]string, error) {
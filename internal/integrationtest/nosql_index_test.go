@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -233,10 +234,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("NosqlIndex") {
-		resource.AddTestSweepers("NosqlIndex", &resource.Sweeper{
-			Name:         "NosqlIndex",
-			Dependencies: acctest.DependencyGraph["index"],
-			F:            sweepNosqlIndexResource,
+		acctest.AddTestSweepers("NosqlIndex", &resource.Sweeper{
+			Name: "NosqlIndex",
+			F:    sweepNosqlIndexResource,
 		})
 	}
 }
@@ -247,21 +247,26 @@ func sweepNosqlIndexResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, indexId := range indexIds {
 		if ok := acctest.SweeperDefaultResourceId[indexId]; !ok {
 			deleteIndexRequest := oci_nosql.DeleteIndexRequest{}
 
 			deleteIndexRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "nosql")
-			_, error := nosqlClient.DeleteIndex(context.Background(), deleteIndexRequest)
+			error := acctest.DeleteSweeperResource(compartment, "IndexId", indexId, func() error {
+				_, err := nosqlClient.DeleteIndex(context.Background(), deleteIndexRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Index %s %s, It is possible that the resource is already deleted. Please verify manually \n", indexId, error)
+				fmt.Printf("Error deleting Index %s %s \n", indexId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &indexId, indexSweepWaitCondition, time.Duration(3*time.Minute),
 				indexSweepResponseFetchOperation, "nosql", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getIndexIds(compartment string) ([]string, error) {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -307,10 +308,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("NosqlTable") {
-		resource.AddTestSweepers("NosqlTable", &resource.Sweeper{
-			Name:         "NosqlTable",
-			Dependencies: acctest.DependencyGraph["table"],
-			F:            sweepNosqlTableResource,
+		acctest.AddTestSweepers("NosqlTable", &resource.Sweeper{
+			Name: "NosqlTable",
+			F:    sweepNosqlTableResource,
 		})
 	}
 }
@@ -321,21 +321,26 @@ func sweepNosqlTableResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, tableId := range tableIds {
 		if ok := acctest.SweeperDefaultResourceId[tableId]; !ok {
 			deleteTableRequest := oci_nosql.DeleteTableRequest{}
 
 			deleteTableRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "nosql")
-			_, error := nosqlClient.DeleteTable(context.Background(), deleteTableRequest)
+			error := acctest.DeleteSweeperResource(compartment, "TableId", tableId, func() error {
+				_, err := nosqlClient.DeleteTable(context.Background(), deleteTableRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Table %s %s, It is possible that the resource is already deleted. Please verify manually \n", tableId, error)
+				fmt.Printf("Error deleting Table %s %s \n", tableId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &tableId, tableSweepWaitCondition, time.Duration(3*time.Minute),
 				tableSweepResponseFetchOperation, "nosql", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getTableIds(compartment string) ([]string, error) {
@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -321,10 +322,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("ObjectStorageBucket") {
-		resource.AddTestSweepers("ObjectStorageBucket", &resource.Sweeper{
-			Name:         "ObjectStorageBucket",
-			Dependencies: acctest.DependencyGraph["bucket"],
-			F:            sweepObjectStorageBucketResource,
+		acctest.AddTestSweepers("ObjectStorageBucket", &resource.Sweeper{
+			Name: "ObjectStorageBucket",
+			F:    sweepObjectStorageBucketResource,
 		})
 	}
 }
@@ -335,19 +335,24 @@ func sweepObjectStorageBucketResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, bucketId := range bucketIds {
 		if ok := acctest.SweeperDefaultResourceId[bucketId]; !ok {
 			deleteBucketRequest := oci_object_storage.DeleteBucketRequest{}
 
 			deleteBucketRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "object_storage")
-			_, error := objectStorageClient.DeleteBucket(context.Background(), deleteBucketRequest)
+			error := acctest.DeleteSweeperResource(compartment, "BucketId", bucketId, func() error {
+				_, err := objectStorageClient.DeleteBucket(context.Background(), deleteBucketRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Bucket %s %s, It is possible that the resource is already deleted. Please verify manually \n", bucketId, error)
+				fmt.Printf("Error deleting Bucket %s %s \n", bucketId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getBucketIds(compartment string) ([]string, error) {
@@ -24,6 +24,7 @@ import (
 
 	"os"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -585,10 +586,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("ObjectStorageObject") {
-		resource.AddTestSweepers("ObjectStorageObject", &resource.Sweeper{
-			Name:         "ObjectStorageObject",
-			Dependencies: acctest.DependencyGraph["object"],
-			F:            sweepObjectStorageObjectResource,
+		acctest.AddTestSweepers("ObjectStorageObject", &resource.Sweeper{
+			Name: "ObjectStorageObject",
+			F:    sweepObjectStorageObjectResource,
 		})
 	}
 }
@@ -599,19 +599,24 @@ func sweepObjectStorageObjectResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, objectId := range objectIds {
 		if ok := acctest.SweeperDefaultResourceId[objectId]; !ok {
 			deleteObjectRequest := oci_object_storage.DeleteObjectRequest{}
 
 			deleteObjectRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "object_storage")
-			_, error := objectStorageClient.DeleteObject(context.Background(), deleteObjectRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ObjectId", objectId, func() error {
+				_, err := objectStorageClient.DeleteObject(context.Background(), deleteObjectRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Object %s %s, It is possible that the resource is already deleted. Please verify manually \n", objectId, error)
+				fmt.Printf("Error deleting Object %s %s \n", objectId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getObjectIds(compartment string) ([]string, error) {
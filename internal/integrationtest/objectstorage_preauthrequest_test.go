@@ -17,6 +17,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -243,10 +244,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("ObjectStoragePreauthenticatedRequest") {
-		resource.AddTestSweepers("ObjectStoragePreauthenticatedRequest", &resource.Sweeper{
-			Name:         "ObjectStoragePreauthenticatedRequest",
-			Dependencies: acctest.DependencyGraph["preauthenticatedRequest"],
-			F:            sweepObjectStoragePreauthenticatedRequestResource,
+		acctest.AddTestSweepers("ObjectStoragePreauthenticatedRequest", &resource.Sweeper{
+			Name: "ObjectStoragePreauthenticatedRequest",
+			F:    sweepObjectStoragePreauthenticatedRequestResource,
 		})
 	}
 }
@@ -257,6 +257,7 @@ func sweepObjectStoragePreauthenticatedRequestResource(compartment string) error
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, preauthenticatedRequestId := range preauthenticatedRequestIds {
 		if ok := acctest.SweeperDefaultResourceId[preauthenticatedRequestId]; !ok {
 			deletePreauthenticatedRequestRequest := oci_object_storage.DeletePreauthenticatedRequestRequest{}
@@ -264,14 +265,18 @@ func sweepObjectStoragePreauthenticatedRequestResource(compartment string) error
 			deletePreauthenticatedRequestRequest.ParId = &preauthenticatedRequestId
 
 			deletePreauthenticatedRequestRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "object_storage")
-			_, error := objectStorageClient.DeletePreauthenticatedRequest(context.Background(), deletePreauthenticatedRequestRequest)
+			error := acctest.DeleteSweeperResource(compartment, "PreauthenticatedRequestId", preauthenticatedRequestId, func() error {
+				_, err := objectStorageClient.DeletePreauthenticatedRequest(context.Background(), deletePreauthenticatedRequestRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting PreauthenticatedRequest %s %s, It is possible that the resource is already deleted. Please verify manually \n", preauthenticatedRequestId, error)
+				fmt.Printf("Error deleting PreauthenticatedRequest %s %s \n", preauthenticatedRequestId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getPreauthenticatedRequestIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -197,10 +198,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("ObjectStorageReplicationPolicy") {
-		resource.AddTestSweepers("ObjectStorageReplicationPolicy", &resource.Sweeper{
-			Name:         "ObjectStorageReplicationPolicy",
-			Dependencies: acctest.DependencyGraph["replicationPolicy"],
-			F:            sweepObjectStorageReplicationPolicyResource,
+		acctest.AddTestSweepers("ObjectStorageReplicationPolicy", &resource.Sweeper{
+			Name: "ObjectStorageReplicationPolicy",
+			F:    sweepObjectStorageReplicationPolicyResource,
 		})
 	}
 }
@@ -211,19 +211,24 @@ func sweepObjectStorageReplicationPolicyResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, replicationPolicyId := range replicationPolicyIds {
 		if ok := acctest.SweeperDefaultResourceId[replicationPolicyId]; !ok {
 			deleteReplicationPolicyRequest := oci_object_storage.DeleteReplicationPolicyRequest{}
 
 			deleteReplicationPolicyRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "object_storage")
-			_, error := objectStorageClient.DeleteReplicationPolicy(context.Background(), deleteReplicationPolicyRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ReplicationPolicyId", replicationPolicyId, func() error {
+				_, err := objectStorageClient.DeleteReplicationPolicy(context.Background(), deleteReplicationPolicyRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ReplicationPolicy %s %s, It is possible that the resource is already deleted. Please verify manually \n", replicationPolicyId, error)
+				fmt.Printf("Error deleting ReplicationPolicy %s %s \n", replicationPolicyId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getReplicationPolicyIds(compartment string) ([]string, error) {
@@ -17,6 +17,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -352,10 +353,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("OceOceInstance") {
-		resource.AddTestSweepers("OceOceInstance", &resource.Sweeper{
-			Name:         "OceOceInstance",
-			Dependencies: acctest.DependencyGraph["oceInstance"],
-			F:            sweepOceOceInstanceResource,
+		acctest.AddTestSweepers("OceOceInstance", &resource.Sweeper{
+			Name: "OceOceInstance",
+			F:    sweepOceOceInstanceResource,
 		})
 	}
 }
@@ -366,6 +366,7 @@ func sweepOceOceInstanceResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, oceInstanceId := range oceInstanceIds {
 		if ok := acctest.SweeperDefaultResourceId[oceInstanceId]; !ok {
 			deleteOceInstanceRequest := oci_oce.DeleteOceInstanceRequest{}
@@ -373,16 +374,20 @@ func sweepOceOceInstanceResource(compartment string) error {
 			deleteOceInstanceRequest.OceInstanceId = &oceInstanceId
 
 			deleteOceInstanceRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "oce")
-			_, error := OceInstanceClient.DeleteOceInstance(context.Background(), deleteOceInstanceRequest)
+			error := acctest.DeleteSweeperResource(compartment, "OceInstanceId", oceInstanceId, func() error {
+				_, err := OceInstanceClient.DeleteOceInstance(context.Background(), deleteOceInstanceRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting OceInstance %s %s, It is possible that the resource is already deleted. Please verify manually \n", oceInstanceId, error)
+				fmt.Printf("Error deleting OceInstance %s %s \n", oceInstanceId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &oceInstanceId, oceInstanceSweepWaitCondition, time.Duration(3*time.Minute),
 				oceInstanceSweepResponseFetchOperation, "oce", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getOceInstanceIds(compartment string) ([]string, error) {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -303,10 +304,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("OcvpEsxiHost") {
-		resource.AddTestSweepers("OcvpEsxiHost", &resource.Sweeper{
-			Name:         "OcvpEsxiHost",
-			Dependencies: acctest.DependencyGraph["esxiHost"],
-			F:            sweepOcvpEsxiHostResource,
+		acctest.AddTestSweepers("OcvpEsxiHost", &resource.Sweeper{
+			Name: "OcvpEsxiHost",
+			F:    sweepOcvpEsxiHostResource,
 		})
 	}
 }
@@ -317,6 +317,7 @@ func sweepOcvpEsxiHostResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, esxiHostId := range esxiHostIds {
 		if ok := acctest.SweeperDefaultResourceId[esxiHostId]; !ok {
 			deleteEsxiHostRequest := oci_ocvp.DeleteEsxiHostRequest{}
@@ -324,16 +325,20 @@ func sweepOcvpEsxiHostResource(compartment string) error {
 			deleteEsxiHostRequest.EsxiHostId = &esxiHostId
 
 			deleteEsxiHostRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "ocvp")
-			_, error := esxiHostClient.DeleteEsxiHost(context.Background(), deleteEsxiHostRequest)
+			error := acctest.DeleteSweeperResource(compartment, "EsxiHostId", esxiHostId, func() error {
+				_, err := esxiHostClient.DeleteEsxiHost(context.Background(), deleteEsxiHostRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting EsxiHost %s %s, It is possible that the resource is already deleted. Please verify manually \n", esxiHostId, error)
+				fmt.Printf("Error deleting EsxiHost %s %s \n", esxiHostId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &esxiHostId, esxiHostSweepWaitCondition, time.Duration(3*time.Minute),
 				esxiHostSweepResponseFetchOperation, "ocvp", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getEsxiHostIds(compartment string) ([]string, error) {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -756,10 +757,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("OcvpSddc") {
-		resource.AddTestSweepers("OcvpSddc", &resource.Sweeper{
-			Name:         "OcvpSddc",
-			Dependencies: acctest.DependencyGraph["sddc"],
-			F:            sweepOcvpSddcResource,
+		acctest.AddTestSweepers("OcvpSddc", &resource.Sweeper{
+			Name: "OcvpSddc",
+			F:    sweepOcvpSddcResource,
 		})
 	}
 }
@@ -770,6 +770,7 @@ func sweepOcvpSddcResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, sddcId := range sddcIds {
 		if ok := acctest.SweeperDefaultResourceId[sddcId]; !ok {
 			deleteSddcRequest := oci_ocvp.DeleteSddcRequest{}
@@ -777,16 +778,20 @@ func sweepOcvpSddcResource(compartment string) error {
 			deleteSddcRequest.SddcId = &sddcId
 
 			deleteSddcRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "ocvp")
-			_, error := sddcClient.DeleteSddc(context.Background(), deleteSddcRequest)
+			error := acctest.DeleteSweeperResource(compartment, "SddcId", sddcId, func() error {
+				_, err := sddcClient.DeleteSddc(context.Background(), deleteSddcRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Sddc %s %s, It is possible that the resource is already deleted. Please verify manually \n", sddcId, error)
+				fmt.Printf("Error deleting Sddc %s %s \n", sddcId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &sddcId, sddcSweepWaitCondition, time.Duration(3*time.Minute),
 				sddcSweepResponseFetchOperation, "ocvp", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getSddcIds(compartment string) ([]string, error) {
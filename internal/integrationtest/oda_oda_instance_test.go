@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -304,10 +305,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("OdaOdaInstance") {
-		resource.AddTestSweepers("OdaOdaInstance", &resource.Sweeper{
-			Name:         "OdaOdaInstance",
-			Dependencies: acctest.DependencyGraph["odaInstance"],
-			F:            sweepOdaOdaInstanceResource,
+		acctest.AddTestSweepers("OdaOdaInstance", &resource.Sweeper{
+			Name: "OdaOdaInstance",
+			F:    sweepOdaOdaInstanceResource,
 		})
 	}
 }
@@ -318,6 +318,7 @@ func sweepOdaOdaInstanceResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, odaInstanceId := range odaInstanceIds {
 		if ok := acctest.SweeperDefaultResourceId[odaInstanceId]; !ok {
 			deleteOdaInstanceRequest := oci_oda.DeleteOdaInstanceRequest{}
@@ -325,16 +326,20 @@ func sweepOdaOdaInstanceResource(compartment string) error {
 			deleteOdaInstanceRequest.OdaInstanceId = &odaInstanceId
 
 			deleteOdaInstanceRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "oda")
-			_, error := odaClient.DeleteOdaInstance(context.Background(), deleteOdaInstanceRequest)
+			error := acctest.DeleteSweeperResource(compartment, "OdaInstanceId", odaInstanceId, func() error {
+				_, err := odaClient.DeleteOdaInstance(context.Background(), deleteOdaInstanceRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting OdaInstance %s %s, It is possible that the resource is already deleted. Please verify manually \n", odaInstanceId, error)
+				fmt.Printf("Error deleting OdaInstance %s %s \n", odaInstanceId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &odaInstanceId, odaInstanceSweepWaitCondition, time.Duration(3*time.Minute),
 				odaInstanceSweepResponseFetchOperation, "oda", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getOdaInstanceIds(compartment string) ([]string, error) {
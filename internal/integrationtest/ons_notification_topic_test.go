@@ -17,6 +17,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -279,10 +280,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("OnsNotificationTopic") {
-		resource.AddTestSweepers("OnsNotificationTopic", &resource.Sweeper{
-			Name:         "OnsNotificationTopic",
-			Dependencies: acctest.DependencyGraph["notificationTopic"],
-			F:            sweepOnsNotificationTopicResource,
+		acctest.AddTestSweepers("OnsNotificationTopic", &resource.Sweeper{
+			Name: "OnsNotificationTopic",
+			F:    sweepOnsNotificationTopicResource,
 		})
 	}
 }
@@ -293,21 +293,26 @@ func sweepOnsNotificationTopicResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, notificationTopicId := range notificationTopicIds {
 		if ok := acctest.SweeperDefaultResourceId[notificationTopicId]; !ok {
 			deleteTopicRequest := oci_ons.DeleteTopicRequest{}
 
 			deleteTopicRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "ons")
-			_, error := notificationControlPlaneClient.DeleteTopic(context.Background(), deleteTopicRequest)
+			error := acctest.DeleteSweeperResource(compartment, "NotificationTopicId", notificationTopicId, func() error {
+				_, err := notificationControlPlaneClient.DeleteTopic(context.Background(), deleteTopicRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting NotificationTopic %s %s, It is possible that the resource is already deleted. Please verify manually \n", notificationTopicId, error)
+				fmt.Printf("Error deleting NotificationTopic %s %s \n", notificationTopicId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &notificationTopicId, topicSweepWaitCondition, time.Duration(3*time.Minute),
 				topicSweepResponseFetchOperation, "ons", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getNotificationTopicIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -273,10 +274,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("OnsSubscription") {
-		resource.AddTestSweepers("OnsSubscription", &resource.Sweeper{
-			Name:         "OnsSubscription",
-			Dependencies: acctest.DependencyGraph["subscription"],
-			F:            sweepOnsSubscriptionResource,
+		acctest.AddTestSweepers("OnsSubscription", &resource.Sweeper{
+			Name: "OnsSubscription",
+			F:    sweepOnsSubscriptionResource,
 		})
 	}
 }
@@ -287,6 +287,7 @@ func sweepOnsSubscriptionResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, subscriptionId := range subscriptionIds {
 		if ok := acctest.SweeperDefaultResourceId[subscriptionId]; !ok {
 			deleteSubscriptionRequest := oci_ons.DeleteSubscriptionRequest{}
@@ -294,16 +295,20 @@ func sweepOnsSubscriptionResource(compartment string) error {
 			deleteSubscriptionRequest.SubscriptionId = &subscriptionId
 
 			deleteSubscriptionRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "ons")
-			_, error := notificationDataPlaneClient.DeleteSubscription(context.Background(), deleteSubscriptionRequest)
+			error := acctest.DeleteSweeperResource(compartment, "SubscriptionId", subscriptionId, func() error {
+				_, err := notificationDataPlaneClient.DeleteSubscription(context.Background(), deleteSubscriptionRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Subscription %s %s, It is possible that the resource is already deleted. Please verify manually \n", subscriptionId, error)
+				fmt.Printf("Error deleting Subscription %s %s \n", subscriptionId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &subscriptionId, subscriptionSweepWaitCondition, time.Duration(3*time.Minute),
 				subscriptionSweepResponseFetchOperation, "ons", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getSubscriptionIds(compartment string) ([]string, error) {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -298,10 +299,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("OperatorAccessControlOperatorControlAssignment") {
-		resource.AddTestSweepers("OperatorAccessControlOperatorControlAssignment", &resource.Sweeper{
-			Name:         "OperatorAccessControlOperatorControlAssignment",
-			Dependencies: acctest.DependencyGraph["operatorControlAssignment"],
-			F:            sweepOperatorAccessControlOperatorControlAssignmentResource,
+		acctest.AddTestSweepers("OperatorAccessControlOperatorControlAssignment", &resource.Sweeper{
+			Name: "OperatorAccessControlOperatorControlAssignment",
+			F:    sweepOperatorAccessControlOperatorControlAssignmentResource,
 		})
 	}
 }
@@ -312,6 +312,7 @@ func sweepOperatorAccessControlOperatorControlAssignmentResource(compartment str
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, operatorControlAssignmentId := range operatorControlAssignmentIds {
 		if ok := acctest.SweeperDefaultResourceId[operatorControlAssignmentId]; !ok {
 			deleteOperatorControlAssignmentRequest := oci_operator_access_control.DeleteOperatorControlAssignmentRequest{}
@@ -319,16 +320,20 @@ func sweepOperatorAccessControlOperatorControlAssignmentResource(compartment str
 			deleteOperatorControlAssignmentRequest.OperatorControlAssignmentId = &operatorControlAssignmentId
 
 			deleteOperatorControlAssignmentRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "operator_access_control")
-			_, error := operatorControlAssignmentClient.DeleteOperatorControlAssignment(context.Background(), deleteOperatorControlAssignmentRequest)
+			error := acctest.DeleteSweeperResource(compartment, "OperatorControlAssignmentId", operatorControlAssignmentId, func() error {
+				_, err := operatorControlAssignmentClient.DeleteOperatorControlAssignment(context.Background(), deleteOperatorControlAssignmentRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting OperatorControlAssignment %s %s, It is possible that the resource is already deleted. Please verify manually \n", operatorControlAssignmentId, error)
+				fmt.Printf("Error deleting OperatorControlAssignment %s %s \n", operatorControlAssignmentId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &operatorControlAssignmentId, operatorControlAssignmentSweepWaitCondition, time.Duration(3*time.Minute),
 				operatorControlAssignmentSweepResponseFetchOperation, "operator_access_control", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getOperatorControlAssignmentIds(compartment string) ([]string, error) {
@@ -12,6 +12,7 @@ import (
 
 	"github.com/oracle/oci-go-sdk/v65/identity"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -324,10 +325,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("OperatorAccessControlOperatorControl") {
-		resource.AddTestSweepers("OperatorAccessControlOperatorControl", &resource.Sweeper{
-			Name:         "OperatorAccessControlOperatorControl",
-			Dependencies: acctest.DependencyGraph["operatorControl"],
-			F:            sweepOperatorAccessControlOperatorControlResource,
+		acctest.AddTestSweepers("OperatorAccessControlOperatorControl", &resource.Sweeper{
+			Name: "OperatorAccessControlOperatorControl",
+			F:    sweepOperatorAccessControlOperatorControlResource,
 		})
 	}
 }
@@ -338,6 +338,7 @@ func sweepOperatorAccessControlOperatorControlResource(compartment string) error
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, operatorControlId := range operatorControlIds {
 		if ok := acctest.SweeperDefaultResourceId[operatorControlId]; !ok {
 			deleteOperatorControlRequest := oci_operator_access_control.DeleteOperatorControlRequest{}
@@ -345,16 +346,20 @@ func sweepOperatorAccessControlOperatorControlResource(compartment string) error
 			deleteOperatorControlRequest.OperatorControlId = &operatorControlId
 
 			deleteOperatorControlRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "operator_access_control")
-			_, error := operatorControlClient.DeleteOperatorControl(context.Background(), deleteOperatorControlRequest)
+			error := acctest.DeleteSweeperResource(compartment, "OperatorControlId", operatorControlId, func() error {
+				_, err := operatorControlClient.DeleteOperatorControl(context.Background(), deleteOperatorControlRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting OperatorControl %s %s, It is possible that the resource is already deleted. Please verify manually \n", operatorControlId, error)
+				fmt.Printf("Error deleting OperatorControl %s %s \n", operatorControlId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &operatorControlId, operatorControlSweepWaitCondition, time.Duration(3*time.Minute),
 				operatorControlSweepResponseFetchOperation, "operator_access_control", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getOperatorControlIds(compartment string) ([]string, error) {
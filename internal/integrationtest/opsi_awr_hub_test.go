@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -252,10 +253,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("OpsiAwrHub") {
-		resource.AddTestSweepers("OpsiAwrHub", &resource.Sweeper{
-			Name:         "OpsiAwrHub",
-			Dependencies: acctest.DependencyGraph["awrHub"],
-			F:            sweepOpsiAwrHubResource,
+		acctest.AddTestSweepers("OpsiAwrHub", &resource.Sweeper{
+			Name: "OpsiAwrHub",
+			F:    sweepOpsiAwrHubResource,
 		})
 	}
 }
@@ -266,6 +266,7 @@ func sweepOpsiAwrHubResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, awrHubId := range awrHubIds {
 		if ok := acctest.SweeperDefaultResourceId[awrHubId]; !ok {
 			deleteAwrHubRequest := oci_opsi.DeleteAwrHubRequest{}
@@ -273,16 +274,20 @@ func sweepOpsiAwrHubResource(compartment string) error {
 			deleteAwrHubRequest.AwrHubId = &awrHubId
 
 			deleteAwrHubRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "opsi")
-			_, error := operationsInsightsClient.DeleteAwrHub(context.Background(), deleteAwrHubRequest)
+			error := acctest.DeleteSweeperResource(compartment, "AwrHubId", awrHubId, func() error {
+				_, err := operationsInsightsClient.DeleteAwrHub(context.Background(), deleteAwrHubRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting AwrHub %s %s, It is possible that the resource is already deleted. Please verify manually \n", awrHubId, error)
+				fmt.Printf("Error deleting AwrHub %s %s \n", awrHubId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &awrHubId, awrHubSweepWaitCondition, time.Duration(3*time.Minute),
 				awrHubSweepResponseFetchOperation, "opsi", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getAwrHubIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -330,10 +331,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("OpsiDatabaseInsight") {
-		resource.AddTestSweepers("OpsiDatabaseInsight", &resource.Sweeper{
-			Name:         "OpsiDatabaseInsight",
-			Dependencies: acctest.DependencyGraph["databaseInsight"],
-			F:            sweepOpsiDatabaseInsightResource,
+		acctest.AddTestSweepers("OpsiDatabaseInsight", &resource.Sweeper{
+			Name: "OpsiDatabaseInsight",
+			F:    sweepOpsiDatabaseInsightResource,
 		})
 	}
 }
@@ -344,6 +344,7 @@ func sweepOpsiDatabaseInsightResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, databaseInsightId := range databaseInsightIds {
 		if ok := acctest.SweeperDefaultResourceId[databaseInsightId]; !ok {
 			deleteDatabaseInsightRequest := oci_opsi.DeleteDatabaseInsightRequest{}
@@ -351,16 +352,20 @@ func sweepOpsiDatabaseInsightResource(compartment string) error {
 			deleteDatabaseInsightRequest.DatabaseInsightId = &databaseInsightId
 
 			deleteDatabaseInsightRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "opsi")
-			_, error := operationsInsightsClient.DeleteDatabaseInsight(context.Background(), deleteDatabaseInsightRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DatabaseInsightId", databaseInsightId, func() error {
+				_, err := operationsInsightsClient.DeleteDatabaseInsight(context.Background(), deleteDatabaseInsightRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting DatabaseInsight %s %s, It is possible that the resource is already deleted. Please verify manually \n", databaseInsightId, error)
+				fmt.Printf("Error deleting DatabaseInsight %s %s \n", databaseInsightId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &databaseInsightId, databaseInsightSweepWaitCondition, time.Duration(3*time.Minute),
 				databaseInsightSweepResponseFetchOperation, "opsi", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getDatabaseInsightIds(compartment string) ([]string, error) {
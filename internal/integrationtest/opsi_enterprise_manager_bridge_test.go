@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -282,10 +283,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("OpsiEnterpriseManagerBridge") {
-		resource.AddTestSweepers("OpsiEnterpriseManagerBridge", &resource.Sweeper{
-			Name:         "OpsiEnterpriseManagerBridge",
-			Dependencies: acctest.DependencyGraph["enterpriseManagerBridge"],
-			F:            sweepOpsiEnterpriseManagerBridgeResource,
+		acctest.AddTestSweepers("OpsiEnterpriseManagerBridge", &resource.Sweeper{
+			Name: "OpsiEnterpriseManagerBridge",
+			F:    sweepOpsiEnterpriseManagerBridgeResource,
 		})
 	}
 }
@@ -296,6 +296,7 @@ func sweepOpsiEnterpriseManagerBridgeResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, enterpriseManagerBridgeId := range enterpriseManagerBridgeIds {
 		if ok := acctest.SweeperDefaultResourceId[enterpriseManagerBridgeId]; !ok {
 			deleteEnterpriseManagerBridgeRequest := oci_opsi.DeleteEnterpriseManagerBridgeRequest{}
@@ -303,16 +304,20 @@ func sweepOpsiEnterpriseManagerBridgeResource(compartment string) error {
 			deleteEnterpriseManagerBridgeRequest.EnterpriseManagerBridgeId = &enterpriseManagerBridgeId
 
 			deleteEnterpriseManagerBridgeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "opsi")
-			_, error := operationsInsightsClient.DeleteEnterpriseManagerBridge(context.Background(), deleteEnterpriseManagerBridgeRequest)
+			error := acctest.DeleteSweeperResource(compartment, "EnterpriseManagerBridgeId", enterpriseManagerBridgeId, func() error {
+				_, err := operationsInsightsClient.DeleteEnterpriseManagerBridge(context.Background(), deleteEnterpriseManagerBridgeRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting EnterpriseManagerBridge %s %s, It is possible that the resource is already deleted. Please verify manually \n", enterpriseManagerBridgeId, error)
+				fmt.Printf("Error deleting EnterpriseManagerBridge %s %s \n", enterpriseManagerBridgeId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &enterpriseManagerBridgeId, enterpriseManagerBridgeSweepWaitCondition, time.Duration(3*time.Minute),
 				enterpriseManagerBridgeSweepResponseFetchOperation, "opsi", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getEnterpriseManagerBridgeIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -320,10 +321,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("OpsiExadataInsight") {
-		resource.AddTestSweepers("OpsiExadataInsight", &resource.Sweeper{
-			Name:         "OpsiExadataInsight",
-			Dependencies: acctest.DependencyGraph["exadataInsight"],
-			F:            sweepOpsiExadataInsightResource,
+		acctest.AddTestSweepers("OpsiExadataInsight", &resource.Sweeper{
+			Name: "OpsiExadataInsight",
+			F:    sweepOpsiExadataInsightResource,
 		})
 	}
 }
@@ -334,6 +334,7 @@ func sweepOpsiExadataInsightResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, exadataInsightId := range exadataInsightIds {
 		if ok := acctest.SweeperDefaultResourceId[exadataInsightId]; !ok {
 			deleteExadataInsightRequest := oci_opsi.DeleteExadataInsightRequest{}
@@ -341,16 +342,20 @@ func sweepOpsiExadataInsightResource(compartment string) error {
 			deleteExadataInsightRequest.ExadataInsightId = &exadataInsightId
 
 			deleteExadataInsightRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "opsi")
-			_, error := operationsInsightsClient.DeleteExadataInsight(context.Background(), deleteExadataInsightRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ExadataInsightId", exadataInsightId, func() error {
+				_, err := operationsInsightsClient.DeleteExadataInsight(context.Background(), deleteExadataInsightRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ExadataInsight %s %s, It is possible that the resource is already deleted. Please verify manually \n", exadataInsightId, error)
+				fmt.Printf("Error deleting ExadataInsight %s %s \n", exadataInsightId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &exadataInsightId, exadataInsightSweepWaitCondition, time.Duration(3*time.Minute),
 				exadataInsightSweepResponseFetchOperation, "opsi", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getExadataInsightIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -313,10 +314,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("OpsiEmHostInsight") {
-		resource.AddTestSweepers("OpsiEmHostInsight", &resource.Sweeper{
-			Name:         "OpsiEmHostInsight",
-			Dependencies: acctest.DependencyGraph["hostInsight"],
-			F:            sweepOpsiEmHostInsightResource,
+		acctest.AddTestSweepers("OpsiEmHostInsight", &resource.Sweeper{
+			Name: "OpsiEmHostInsight",
+			F:    sweepOpsiEmHostInsightResource,
 		})
 	}
 }
@@ -327,13 +327,17 @@ func sweepOpsiEmHostInsightResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, hostInsightId := range hostInsightIds {
 		if ok := acctest.SweeperDefaultResourceId[hostInsightId]; !ok {
 
 			disableHostInsightRequest := oci_opsi.DisableHostInsightRequest{}
 			disableHostInsightRequest.HostInsightId = &hostInsightId
 			disableHostInsightRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "opsi")
-			_, error := operationsInsightsClient.DisableHostInsight(context.Background(), disableHostInsightRequest)
+			error := acctest.DeleteSweeperResource(compartment, "HostInsightId", hostInsightId, func() error {
+				_, err := operationsInsightsClient.DisableHostInsight(context.Background(), disableHostInsightRequest)
+				return err
+			})
 			if error != nil {
 				fmt.Printf("Error disabling HostInsight %s %s, It is possible that the resource is already disabled. Please verify manually \n", hostInsightId, error)
 				continue
@@ -348,14 +352,15 @@ func sweepOpsiEmHostInsightResource(compartment string) error {
 			deleteHostInsightRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "opsi")
 			_, error1 := operationsInsightsClient.DeleteHostInsight(context.Background(), deleteHostInsightRequest)
 			if error1 != nil {
-				fmt.Printf("Error deleting HostInsight %s %s, It is possible that the resource is already deleted. Please verify manually \n", hostInsightId, error1)
+				fmt.Printf("Error deleting HostInsight %s %s \n", hostInsightId, error1)
+				deleteErrors = multierror.Append(deleteErrors, error1)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &hostInsightId, emHostInsightSweepWaitCondition, time.Duration(3*time.Minute),
 				emHostInsightSweepResponseFetchOperation, "opsi", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getEmHostInsightIds(compartment string) ([]string, error) {
@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -300,10 +301,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("OpsiOperationsInsightsPrivateEndpoint") {
-		resource.AddTestSweepers("OpsiOperationsInsightsPrivateEndpoint", &resource.Sweeper{
-			Name:         "OpsiOperationsInsightsPrivateEndpoint",
-			Dependencies: acctest.DependencyGraph["operationsInsightsPrivateEndpoint"],
-			F:            sweepOpsiOperationsInsightsPrivateEndpointResource,
+		acctest.AddTestSweepers("OpsiOperationsInsightsPrivateEndpoint", &resource.Sweeper{
+			Name: "OpsiOperationsInsightsPrivateEndpoint",
+			F:    sweepOpsiOperationsInsightsPrivateEndpointResource,
 		})
 	}
 }
@@ -314,6 +314,7 @@ func sweepOpsiOperationsInsightsPrivateEndpointResource(compartment string) erro
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, operationsInsightsPrivateEndpointId := range operationsInsightsPrivateEndpointIds {
 		if ok := acctest.SweeperDefaultResourceId[operationsInsightsPrivateEndpointId]; !ok {
 			deleteOperationsInsightsPrivateEndpointRequest := oci_opsi.DeleteOperationsInsightsPrivateEndpointRequest{}
@@ -321,16 +322,20 @@ func sweepOpsiOperationsInsightsPrivateEndpointResource(compartment string) erro
 			deleteOperationsInsightsPrivateEndpointRequest.OperationsInsightsPrivateEndpointId = &operationsInsightsPrivateEndpointId
 
 			deleteOperationsInsightsPrivateEndpointRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "opsi")
-			_, error := operationsInsightsClient.DeleteOperationsInsightsPrivateEndpoint(context.Background(), deleteOperationsInsightsPrivateEndpointRequest)
+			error := acctest.DeleteSweeperResource(compartment, "OperationsInsightsPrivateEndpointId", operationsInsightsPrivateEndpointId, func() error {
+				_, err := operationsInsightsClient.DeleteOperationsInsightsPrivateEndpoint(context.Background(), deleteOperationsInsightsPrivateEndpointRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting OperationsInsightsPrivateEndpoint %s %s, It is possible that the resource is already deleted. Please verify manually \n", operationsInsightsPrivateEndpointId, error)
+				fmt.Printf("Error deleting OperationsInsightsPrivateEndpoint %s %s \n", operationsInsightsPrivateEndpointId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &operationsInsightsPrivateEndpointId, operationsInsightsPrivateEndpointSweepWaitCondition, time.Duration(3*time.Minute),
 				operationsInsightsPrivateEndpointSweepResponseFetchOperation, "opsi", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getOperationsInsightsPrivateEndpointIds(compartment string) ([]string, error) {
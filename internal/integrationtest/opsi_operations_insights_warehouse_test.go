@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -252,10 +253,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("OpsiOperationsInsightsWarehouse") {
-		resource.AddTestSweepers("OpsiOperationsInsightsWarehouse", &resource.Sweeper{
-			Name:         "OpsiOperationsInsightsWarehouse",
-			Dependencies: acctest.DependencyGraph["operationsInsightsWarehouse"],
-			F:            sweepOpsiOperationsInsightsWarehouseResource,
+		acctest.AddTestSweepers("OpsiOperationsInsightsWarehouse", &resource.Sweeper{
+			Name: "OpsiOperationsInsightsWarehouse",
+			F:    sweepOpsiOperationsInsightsWarehouseResource,
 		})
 	}
 }
@@ -266,6 +266,7 @@ func sweepOpsiOperationsInsightsWarehouseResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, operationsInsightsWarehouseId := range operationsInsightsWarehouseIds {
 		if ok := acctest.SweeperDefaultResourceId[operationsInsightsWarehouseId]; !ok {
 			deleteOperationsInsightsWarehouseRequest := oci_opsi.DeleteOperationsInsightsWarehouseRequest{}
@@ -273,16 +274,20 @@ func sweepOpsiOperationsInsightsWarehouseResource(compartment string) error {
 			deleteOperationsInsightsWarehouseRequest.OperationsInsightsWarehouseId = &operationsInsightsWarehouseId
 
 			deleteOperationsInsightsWarehouseRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "opsi")
-			_, error := operationsInsightsClient.DeleteOperationsInsightsWarehouse(context.Background(), deleteOperationsInsightsWarehouseRequest)
+			error := acctest.DeleteSweeperResource(compartment, "OperationsInsightsWarehouseId", operationsInsightsWarehouseId, func() error {
+				_, err := operationsInsightsClient.DeleteOperationsInsightsWarehouse(context.Background(), deleteOperationsInsightsWarehouseRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting OperationsInsightsWarehouse %s %s, It is possible that the resource is already deleted. Please verify manually \n", operationsInsightsWarehouseId, error)
+				fmt.Printf("Error deleting OperationsInsightsWarehouse %s %s \n", operationsInsightsWarehouseId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &operationsInsightsWarehouseId, operationsInsightsWarehouseSweepWaitCondition, time.Duration(3*time.Minute),
 				operationsInsightsWarehouseSweepResponseFetchOperation, "opsi", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getOperationsInsightsWarehouseIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -260,10 +261,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("OpsiOperationsInsightsWarehouseUser") {
-		resource.AddTestSweepers("OpsiOperationsInsightsWarehouseUser", &resource.Sweeper{
-			Name:         "OpsiOperationsInsightsWarehouseUser",
-			Dependencies: acctest.DependencyGraph["operationsInsightsWarehouseUser"],
-			F:            sweepOpsiOperationsInsightsWarehouseUserResource,
+		acctest.AddTestSweepers("OpsiOperationsInsightsWarehouseUser", &resource.Sweeper{
+			Name: "OpsiOperationsInsightsWarehouseUser",
+			F:    sweepOpsiOperationsInsightsWarehouseUserResource,
 		})
 	}
 }
@@ -274,6 +274,7 @@ func sweepOpsiOperationsInsightsWarehouseUserResource(compartment string) error
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, operationsInsightsWarehouseUserId := range operationsInsightsWarehouseUserIds {
 		if ok := acctest.SweeperDefaultResourceId[operationsInsightsWarehouseUserId]; !ok {
 			deleteOperationsInsightsWarehouseUserRequest := oci_opsi.DeleteOperationsInsightsWarehouseUserRequest{}
@@ -281,16 +282,20 @@ func sweepOpsiOperationsInsightsWarehouseUserResource(compartment string) error
 			deleteOperationsInsightsWarehouseUserRequest.OperationsInsightsWarehouseUserId = &operationsInsightsWarehouseUserId
 
 			deleteOperationsInsightsWarehouseUserRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "opsi")
-			_, error := operationsInsightsClient.DeleteOperationsInsightsWarehouseUser(context.Background(), deleteOperationsInsightsWarehouseUserRequest)
+			error := acctest.DeleteSweeperResource(compartment, "OperationsInsightsWarehouseUserId", operationsInsightsWarehouseUserId, func() error {
+				_, err := operationsInsightsClient.DeleteOperationsInsightsWarehouseUser(context.Background(), deleteOperationsInsightsWarehouseUserRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting OperationsInsightsWarehouseUser %s %s, It is possible that the resource is already deleted. Please verify manually \n", operationsInsightsWarehouseUserId, error)
+				fmt.Printf("Error deleting OperationsInsightsWarehouseUser %s %s \n", operationsInsightsWarehouseUserId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &operationsInsightsWarehouseUserId, operationsInsightsWarehouseUserSweepWaitCondition, time.Duration(3*time.Minute),
 				operationsInsightsWarehouseUserSweepResponseFetchOperation, "opsi", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getOperationsInsightsWarehouseUserIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -362,10 +363,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("OpsiPecomanagedDatabaseInsight") {
-		resource.AddTestSweepers("OpsiPecomanagedDatabaseInsight", &resource.Sweeper{
-			Name:         "OpsiPecomanagedDatabaseInsight",
-			Dependencies: acctest.DependencyGraph["databaseInsight"],
-			F:            sweepOpsiPecomanagedDatabaseInsightResource,
+		acctest.AddTestSweepers("OpsiPecomanagedDatabaseInsight", &resource.Sweeper{
+			Name: "OpsiPecomanagedDatabaseInsight",
+			F:    sweepOpsiPecomanagedDatabaseInsightResource,
 		})
 	}
 }
@@ -376,6 +376,7 @@ func sweepOpsiPecomanagedDatabaseInsightResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, databaseInsightId := range databaseInsightIds {
 		if ok := acctest.SweeperDefaultResourceId[databaseInsightId]; !ok {
 			deleteDatabaseInsightRequest := oci_opsi.DeleteDatabaseInsightRequest{}
@@ -383,16 +384,20 @@ func sweepOpsiPecomanagedDatabaseInsightResource(compartment string) error {
 			deleteDatabaseInsightRequest.DatabaseInsightId = &databaseInsightId
 
 			deleteDatabaseInsightRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "opsi")
-			_, error := operationsInsightsClient.DeleteDatabaseInsight(context.Background(), deleteDatabaseInsightRequest)
+			error := acctest.DeleteSweeperResource(compartment, "DatabaseInsightId", databaseInsightId, func() error {
+				_, err := operationsInsightsClient.DeleteDatabaseInsight(context.Background(), deleteDatabaseInsightRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting DatabaseInsight %s %s, It is possible that the resource is already deleted. Please verify manually \n", databaseInsightId, error)
+				fmt.Printf("Error deleting DatabaseInsight %s %s \n", databaseInsightId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &databaseInsightId, pecomanagedDatabaseInsightSweepWaitCondition, time.Duration(3*time.Minute),
 				pecomanagedDatabaseInsightSweepResponseFetchOperation, "opsi", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getPecomanagedDatabaseInsightIds(compartment string) ([]string, error) {
@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -295,10 +296,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("OptimizerProfile") {
-		resource.AddTestSweepers("OptimizerProfile", &resource.Sweeper{
-			Name:         "OptimizerProfile",
-			Dependencies: acctest.DependencyGraph["profile"],
-			F:            sweepOptimizerProfileResource,
+		acctest.AddTestSweepers("OptimizerProfile", &resource.Sweeper{
+			Name: "OptimizerProfile",
+			F:    sweepOptimizerProfileResource,
 		})
 	}
 }
@@ -309,6 +309,7 @@ func sweepOptimizerProfileResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, profileId := range profileIds {
 		if ok := acctest.SweeperDefaultResourceId[profileId]; !ok {
 			deleteProfileRequest := oci_optimizer.DeleteProfileRequest{}
@@ -316,16 +317,20 @@ func sweepOptimizerProfileResource(compartment string) error {
 			deleteProfileRequest.ProfileId = &profileId
 
 			deleteProfileRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "optimizer")
-			_, error := optimizerClient.DeleteProfile(context.Background(), deleteProfileRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ProfileId", profileId, func() error {
+				_, err := optimizerClient.DeleteProfile(context.Background(), deleteProfileRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Profile %s %s, It is possible that the resource is already deleted. Please verify manually \n", profileId, error)
+				fmt.Printf("Error deleting Profile %s %s \n", profileId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &profileId, profileSweepWaitCondition, time.Duration(3*time.Minute),
 				profileSweepResponseFetchOperation, "optimizer", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getProfileIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -269,10 +270,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("OsmanagementManagedInstanceGroup") {
-		resource.AddTestSweepers("OsmanagementManagedInstanceGroup", &resource.Sweeper{
-			Name:         "OsmanagementManagedInstanceGroup",
-			Dependencies: acctest.DependencyGraph["managedInstanceGroup"],
-			F:            sweepOsmanagementManagedInstanceGroupResource,
+		acctest.AddTestSweepers("OsmanagementManagedInstanceGroup", &resource.Sweeper{
+			Name: "OsmanagementManagedInstanceGroup",
+			F:    sweepOsmanagementManagedInstanceGroupResource,
 		})
 	}
 }
@@ -283,6 +283,7 @@ func sweepOsmanagementManagedInstanceGroupResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, managedInstanceGroupId := range managedInstanceGroupIds {
 		if ok := acctest.SweeperDefaultResourceId[managedInstanceGroupId]; !ok {
 			deleteManagedInstanceGroupRequest := oci_osmanagement.DeleteManagedInstanceGroupRequest{}
@@ -290,16 +291,20 @@ func sweepOsmanagementManagedInstanceGroupResource(compartment string) error {
 			deleteManagedInstanceGroupRequest.ManagedInstanceGroupId = &managedInstanceGroupId
 
 			deleteManagedInstanceGroupRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "osmanagement")
-			_, error := osManagementClient.DeleteManagedInstanceGroup(context.Background(), deleteManagedInstanceGroupRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ManagedInstanceGroupId", managedInstanceGroupId, func() error {
+				_, err := osManagementClient.DeleteManagedInstanceGroup(context.Background(), deleteManagedInstanceGroupRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ManagedInstanceGroup %s %s, It is possible that the resource is already deleted. Please verify manually \n", managedInstanceGroupId, error)
+				fmt.Printf("Error deleting ManagedInstanceGroup %s %s \n", managedInstanceGroupId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &managedInstanceGroupId, managedInstanceGroupSweepWaitCondition, time.Duration(3*time.Minute),
 				managedInstanceGroupSweepResponseFetchOperation, "osmanagement", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getManagedInstanceGroupIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -296,10 +297,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("OsmanagementSoftwareSource") {
-		resource.AddTestSweepers("OsmanagementSoftwareSource", &resource.Sweeper{
-			Name:         "OsmanagementSoftwareSource",
-			Dependencies: acctest.DependencyGraph["softwareSource"],
-			F:            sweepOsmanagementSoftwareSourceResource,
+		acctest.AddTestSweepers("OsmanagementSoftwareSource", &resource.Sweeper{
+			Name: "OsmanagementSoftwareSource",
+			F:    sweepOsmanagementSoftwareSourceResource,
 		})
 	}
 }
@@ -310,6 +310,7 @@ func sweepOsmanagementSoftwareSourceResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, softwareSourceId := range softwareSourceIds {
 		if ok := acctest.SweeperDefaultResourceId[softwareSourceId]; !ok {
 			deleteSoftwareSourceRequest := oci_osmanagement.DeleteSoftwareSourceRequest{}
@@ -317,16 +318,20 @@ func sweepOsmanagementSoftwareSourceResource(compartment string) error {
 			deleteSoftwareSourceRequest.SoftwareSourceId = &softwareSourceId
 
 			deleteSoftwareSourceRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "osmanagement")
-			_, error := osManagementClient.DeleteSoftwareSource(context.Background(), deleteSoftwareSourceRequest)
+			error := acctest.DeleteSweeperResource(compartment, "SoftwareSourceId", softwareSourceId, func() error {
+				_, err := osManagementClient.DeleteSoftwareSource(context.Background(), deleteSoftwareSourceRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting SoftwareSource %s %s, It is possible that the resource is already deleted. Please verify manually \n", softwareSourceId, error)
+				fmt.Printf("Error deleting SoftwareSource %s %s \n", softwareSourceId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &softwareSourceId, softwareSourceSweepWaitCondition, time.Duration(3*time.Minute),
 				softwareSourceSweepResponseFetchOperation, "osmanagement", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getSoftwareSourceIds(compartment string) ([]string, error) {
@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -131,10 +132,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("ResourcemanagerStack") {
-		resource.AddTestSweepers("ResourcemanagerStack", &resource.Sweeper{
-			Name:         "ResourcemanagerStack",
-			Dependencies: acctest.DependencyGraph["stack"],
-			F:            sweepResourcemanagerStackResource,
+		acctest.AddTestSweepers("ResourcemanagerStack", &resource.Sweeper{
+			Name: "ResourcemanagerStack",
+			F:    sweepResourcemanagerStackResource,
 		})
 	}
 }
@@ -145,6 +145,7 @@ func sweepResourcemanagerStackResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, stackId := range stackIds {
 		if ok := acctest.SweeperDefaultResourceId[stackId]; !ok {
 			deleteStackRequest := oci_resourcemanager.DeleteStackRequest{}
@@ -152,16 +153,20 @@ func sweepResourcemanagerStackResource(compartment string) error {
 			deleteStackRequest.StackId = &stackId
 
 			deleteStackRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "resourcemanager")
-			_, error := resourceManagerClient.DeleteStack(context.Background(), deleteStackRequest)
+			error := acctest.DeleteSweeperResource(compartment, "StackId", stackId, func() error {
+				_, err := resourceManagerClient.DeleteStack(context.Background(), deleteStackRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Stack %s %s, It is possible that the resource is already deleted. Please verify manually \n", stackId, error)
+				fmt.Printf("Error deleting Stack %s %s \n", stackId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &stackId, stackSweepWaitCondition, time.Duration(3*time.Minute),
 				stackSweepResponseFetchOperation, "resourcemanager", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getStackIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -662,10 +663,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("SchServiceConnector") {
-		resource.AddTestSweepers("SchServiceConnector", &resource.Sweeper{
-			Name:         "SchServiceConnector",
-			Dependencies: acctest.DependencyGraph["serviceConnector"],
-			F:            sweepSchServiceConnectorResource,
+		acctest.AddTestSweepers("SchServiceConnector", &resource.Sweeper{
+			Name: "SchServiceConnector",
+			F:    sweepSchServiceConnectorResource,
 		})
 	}
 }
@@ -676,6 +676,7 @@ func sweepSchServiceConnectorResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, serviceConnectorId := range serviceConnectorIds {
 		if ok := acctest.SweeperDefaultResourceId[serviceConnectorId]; !ok {
 			deleteServiceConnectorRequest := oci_sch.DeleteServiceConnectorRequest{}
@@ -683,16 +684,20 @@ func sweepSchServiceConnectorResource(compartment string) error {
 			deleteServiceConnectorRequest.ServiceConnectorId = &serviceConnectorId
 
 			deleteServiceConnectorRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "sch")
-			_, error := serviceConnectorClient.DeleteServiceConnector(context.Background(), deleteServiceConnectorRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ServiceConnectorId", serviceConnectorId, func() error {
+				_, err := serviceConnectorClient.DeleteServiceConnector(context.Background(), deleteServiceConnectorRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ServiceConnector %s %s, It is possible that the resource is already deleted. Please verify manually \n", serviceConnectorId, error)
+				fmt.Printf("Error deleting ServiceConnector %s %s \n", serviceConnectorId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &serviceConnectorId, serviceConnectorSweepWaitCondition, time.Duration(3*time.Minute),
 				serviceConnectorSweepResponseFetchOperation, "sch", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getServiceConnectorIds(compartment string) ([]string, error) {
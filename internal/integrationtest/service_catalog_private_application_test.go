@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -299,10 +300,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("ServiceCatalogPrivateApplication") {
-		resource.AddTestSweepers("ServiceCatalogPrivateApplication", &resource.Sweeper{
-			Name:         "ServiceCatalogPrivateApplication",
-			Dependencies: acctest.DependencyGraph["privateApplication"],
-			F:            sweepServiceCatalogPrivateApplicationResource,
+		acctest.AddTestSweepers("ServiceCatalogPrivateApplication", &resource.Sweeper{
+			Name: "ServiceCatalogPrivateApplication",
+			F:    sweepServiceCatalogPrivateApplicationResource,
 		})
 	}
 }
@@ -313,6 +313,7 @@ func sweepServiceCatalogPrivateApplicationResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, privateApplicationId := range privateApplicationIds {
 		if ok := acctest.SweeperDefaultResourceId[privateApplicationId]; !ok {
 			deletePrivateApplicationRequest := oci_service_catalog.DeletePrivateApplicationRequest{}
@@ -320,16 +321,20 @@ func sweepServiceCatalogPrivateApplicationResource(compartment string) error {
 			deletePrivateApplicationRequest.PrivateApplicationId = &privateApplicationId
 
 			deletePrivateApplicationRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "service_catalog")
-			_, err := serviceCatalogClient.DeletePrivateApplication(context.Background(), deletePrivateApplicationRequest)
+			err := acctest.DeleteSweeperResource(compartment, "PrivateApplicationId", privateApplicationId, func() error {
+				_, err := serviceCatalogClient.DeletePrivateApplication(context.Background(), deletePrivateApplicationRequest)
+				return err
+			})
 			if err != nil {
-				fmt.Printf("Error deleting PrivateApplication %s %s, It is possible that the resource is already deleted. Please verify manually \n", privateApplicationId, err)
+				fmt.Printf("Error deleting PrivateApplication %s %s \n", privateApplicationId, err)
+				deleteErrors = multierror.Append(deleteErrors, err)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &privateApplicationId, privateApplicationSweepWaitCondition, time.Duration(3*time.Minute),
 				privateApplicationSweepResponseFetchOperation, "service_catalog", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getPrivateApplicationIds(compartment string) ([]string, error) {
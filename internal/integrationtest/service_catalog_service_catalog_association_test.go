@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -189,10 +190,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("ServiceCatalogServiceCatalogAssociation") {
-		resource.AddTestSweepers("ServiceCatalogServiceCatalogAssociation", &resource.Sweeper{
-			Name:         "ServiceCatalogServiceCatalogAssociation",
-			Dependencies: acctest.DependencyGraph["serviceCatalogAssociation"],
-			F:            sweepServiceCatalogServiceCatalogAssociationResource,
+		acctest.AddTestSweepers("ServiceCatalogServiceCatalogAssociation", &resource.Sweeper{
+			Name: "ServiceCatalogServiceCatalogAssociation",
+			F:    sweepServiceCatalogServiceCatalogAssociationResource,
 		})
 	}
 }
@@ -203,6 +203,7 @@ func sweepServiceCatalogServiceCatalogAssociationResource(compartment string) er
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, serviceCatalogAssociationId := range serviceCatalogAssociationIds {
 		if ok := acctest.SweeperDefaultResourceId[serviceCatalogAssociationId]; !ok {
 			deleteServiceCatalogAssociationRequest := oci_service_catalog.DeleteServiceCatalogAssociationRequest{}
@@ -210,14 +211,18 @@ func sweepServiceCatalogServiceCatalogAssociationResource(compartment string) er
 			deleteServiceCatalogAssociationRequest.ServiceCatalogAssociationId = &serviceCatalogAssociationId
 
 			deleteServiceCatalogAssociationRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "service_catalog")
-			_, error := serviceCatalogClient.DeleteServiceCatalogAssociation(context.Background(), deleteServiceCatalogAssociationRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ServiceCatalogAssociationId", serviceCatalogAssociationId, func() error {
+				_, err := serviceCatalogClient.DeleteServiceCatalogAssociation(context.Background(), deleteServiceCatalogAssociationRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ServiceCatalogAssociation %s %s, It is possible that the resource is already deleted. Please verify manually \n", serviceCatalogAssociationId, error)
+				fmt.Printf("Error deleting ServiceCatalogAssociation %s %s \n", serviceCatalogAssociationId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getServiceCatalogAssociationIds(compartment string) ([]string, error) {
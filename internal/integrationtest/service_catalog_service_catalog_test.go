@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -255,10 +256,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("ServiceCatalogServiceCatalog") {
-		resource.AddTestSweepers("ServiceCatalogServiceCatalog", &resource.Sweeper{
-			Name:         "ServiceCatalogServiceCatalog",
-			Dependencies: acctest.DependencyGraph["serviceCatalog"],
-			F:            sweepServiceCatalogServiceCatalogResource,
+		acctest.AddTestSweepers("ServiceCatalogServiceCatalog", &resource.Sweeper{
+			Name: "ServiceCatalogServiceCatalog",
+			F:    sweepServiceCatalogServiceCatalogResource,
 		})
 	}
 }
@@ -269,6 +269,7 @@ func sweepServiceCatalogServiceCatalogResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, serviceCatalogId := range serviceCatalogIds {
 		if ok := acctest.SweeperDefaultResourceId[serviceCatalogId]; !ok {
 			deleteServiceCatalogRequest := oci_service_catalog.DeleteServiceCatalogRequest{}
@@ -276,16 +277,20 @@ func sweepServiceCatalogServiceCatalogResource(compartment string) error {
 			deleteServiceCatalogRequest.ServiceCatalogId = &serviceCatalogId
 
 			deleteServiceCatalogRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "service_catalog")
-			_, error := serviceCatalogClient.DeleteServiceCatalog(context.Background(), deleteServiceCatalogRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ServiceCatalogId", serviceCatalogId, func() error {
+				_, err := serviceCatalogClient.DeleteServiceCatalog(context.Background(), deleteServiceCatalogRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ServiceCatalog %s %s, It is possible that the resource is already deleted. Please verify manually \n", serviceCatalogId, error)
+				fmt.Printf("Error deleting ServiceCatalog %s %s \n", serviceCatalogId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &serviceCatalogId, serviceCatalogSweepWaitCondition, time.Duration(3*time.Minute),
 				serviceCatalogSweepResponseFetchOperation, "service_catalog", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getServiceCatalogIds(compartment string) ([]string, error) {
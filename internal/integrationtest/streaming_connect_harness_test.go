@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -262,10 +263,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("StreamingConnectHarness") {
-		resource.AddTestSweepers("StreamingConnectHarness", &resource.Sweeper{
-			Name:         "StreamingConnectHarness",
-			Dependencies: acctest.DependencyGraph["connectHarness"],
-			F:            sweepStreamingConnectHarnessResource,
+		acctest.AddTestSweepers("StreamingConnectHarness", &resource.Sweeper{
+			Name: "StreamingConnectHarness",
+			F:    sweepStreamingConnectHarnessResource,
 		})
 	}
 }
@@ -276,6 +276,7 @@ func sweepStreamingConnectHarnessResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, connectHarnessId := range connectHarnessIds {
 		if ok := acctest.SweeperDefaultResourceId[connectHarnessId]; !ok {
 			deleteConnectHarnessRequest := oci_streaming.DeleteConnectHarnessRequest{}
@@ -283,16 +284,20 @@ func sweepStreamingConnectHarnessResource(compartment string) error {
 			deleteConnectHarnessRequest.ConnectHarnessId = &connectHarnessId
 
 			deleteConnectHarnessRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "streaming")
-			_, error := streamAdminClient.DeleteConnectHarness(context.Background(), deleteConnectHarnessRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ConnectHarnessId", connectHarnessId, func() error {
+				_, err := streamAdminClient.DeleteConnectHarness(context.Background(), deleteConnectHarnessRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ConnectHarness %s %s, It is possible that the resource is already deleted. Please verify manually \n", connectHarnessId, error)
+				fmt.Printf("Error deleting ConnectHarness %s %s \n", connectHarnessId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &connectHarnessId, connectHarnessSweepWaitCondition, time.Duration(3*time.Minute),
 				connectHarnessSweepResponseFetchOperation, "streaming", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getConnectHarnessIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -323,10 +324,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("StreamingStreamPool") {
-		resource.AddTestSweepers("StreamingStreamPool", &resource.Sweeper{
-			Name:         "StreamingStreamPool",
-			Dependencies: acctest.DependencyGraph["streamPool"],
-			F:            sweepStreamingStreamPoolResource,
+		acctest.AddTestSweepers("StreamingStreamPool", &resource.Sweeper{
+			Name: "StreamingStreamPool",
+			F:    sweepStreamingStreamPoolResource,
 		})
 	}
 }
@@ -337,6 +337,7 @@ func sweepStreamingStreamPoolResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, streamPoolId := range streamPoolIds {
 		if ok := acctest.SweeperDefaultResourceId[streamPoolId]; !ok {
 			deleteStreamPoolRequest := oci_streaming.DeleteStreamPoolRequest{}
@@ -344,16 +345,20 @@ func sweepStreamingStreamPoolResource(compartment string) error {
 			deleteStreamPoolRequest.StreamPoolId = &streamPoolId
 
 			deleteStreamPoolRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "streaming")
-			_, error := streamAdminClient.DeleteStreamPool(context.Background(), deleteStreamPoolRequest)
+			error := acctest.DeleteSweeperResource(compartment, "StreamPoolId", streamPoolId, func() error {
+				_, err := streamAdminClient.DeleteStreamPool(context.Background(), deleteStreamPoolRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting StreamPool %s %s, It is possible that the resource is already deleted. Please verify manually \n", streamPoolId, error)
+				fmt.Printf("Error deleting StreamPool %s %s \n", streamPoolId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &streamPoolId, streamPoolSweepWaitCondition, time.Duration(3*time.Minute),
 				streamPoolSweepResponseFetchOperation, "streaming", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getStreamPoolIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -297,10 +298,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("StreamingStream") {
-		resource.AddTestSweepers("StreamingStream", &resource.Sweeper{
-			Name:         "StreamingStream",
-			Dependencies: acctest.DependencyGraph["stream"],
-			F:            sweepStreamingStreamResource,
+		acctest.AddTestSweepers("StreamingStream", &resource.Sweeper{
+			Name: "StreamingStream",
+			F:    sweepStreamingStreamResource,
 		})
 	}
 }
@@ -311,6 +311,7 @@ func sweepStreamingStreamResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, streamId := range streamIds {
 		if ok := acctest.SweeperDefaultResourceId[streamId]; !ok {
 			deleteStreamRequest := oci_streaming.DeleteStreamRequest{}
@@ -318,16 +319,20 @@ func sweepStreamingStreamResource(compartment string) error {
 			deleteStreamRequest.StreamId = &streamId
 
 			deleteStreamRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "streaming")
-			_, error := streamAdminClient.DeleteStream(context.Background(), deleteStreamRequest)
+			error := acctest.DeleteSweeperResource(compartment, "StreamId", streamId, func() error {
+				_, err := streamAdminClient.DeleteStream(context.Background(), deleteStreamRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Stream %s %s, It is possible that the resource is already deleted. Please verify manually \n", streamId, error)
+				fmt.Printf("Error deleting Stream %s %s \n", streamId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &streamId, streamSweepWaitCondition, time.Duration(3*time.Minute),
 				streamSweepResponseFetchOperation, "streaming", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getStreamIds(compartment string) ([]string, error) {
@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -203,10 +204,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("UsageProxySubscriptionRedeemableUser") {
-		resource.AddTestSweepers("UsageProxySubscriptionRedeemableUser", &resource.Sweeper{
-			Name:         "UsageProxySubscriptionRedeemableUser",
-			Dependencies: acctest.DependencyGraph["subscriptionRedeemableUser"],
-			F:            sweepUsageProxySubscriptionRedeemableUserResource,
+		acctest.AddTestSweepers("UsageProxySubscriptionRedeemableUser", &resource.Sweeper{
+			Name: "UsageProxySubscriptionRedeemableUser",
+			F:    sweepUsageProxySubscriptionRedeemableUserResource,
 		})
 	}
 }
@@ -217,19 +217,24 @@ func sweepUsageProxySubscriptionRedeemableUserResource(compartment string) error
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, subscriptionRedeemableUserId := range subscriptionRedeemableUserIds {
 		if ok := acctest.SweeperDefaultResourceId[subscriptionRedeemableUserId]; !ok {
 			deleteRedeemableUserRequest := oci_usage_proxy.DeleteRedeemableUserRequest{}
 
 			deleteRedeemableUserRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "usage_proxy")
-			_, error := rewardsClient.DeleteRedeemableUser(context.Background(), deleteRedeemableUserRequest)
+			error := acctest.DeleteSweeperResource(compartment, "SubscriptionRedeemableUserId", subscriptionRedeemableUserId, func() error {
+				_, err := rewardsClient.DeleteRedeemableUser(context.Background(), deleteRedeemableUserRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting SubscriptionRedeemableUser %s %s, It is possible that the resource is already deleted. Please verify manually \n", subscriptionRedeemableUserId, error)
+				fmt.Printf("Error deleting SubscriptionRedeemableUser %s %s \n", subscriptionRedeemableUserId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getSubscriptionRedeemableUserIds(compartment string) ([]string, error) {
@@ -17,6 +17,7 @@ import (
 	tf_client "github.com/terraform-providers/terraform-provider-oci/internal/client"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -341,10 +342,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("VisualBuilderVbInstance") {
-		resource.AddTestSweepers("VisualBuilderVbInstance", &resource.Sweeper{
-			Name:         "VisualBuilderVbInstance",
-			Dependencies: acctest.DependencyGraph["vbInstance"],
-			F:            sweepVisualBuilderVbInstanceResource,
+		acctest.AddTestSweepers("VisualBuilderVbInstance", &resource.Sweeper{
+			Name: "VisualBuilderVbInstance",
+			F:    sweepVisualBuilderVbInstanceResource,
 		})
 	}
 }
@@ -355,6 +355,7 @@ func sweepVisualBuilderVbInstanceResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, vbInstanceId := range vbInstanceIds {
 		if ok := acctest.SweeperDefaultResourceId[vbInstanceId]; !ok {
 			deleteVbInstanceRequest := oci_visual_builder.DeleteVbInstanceRequest{}
@@ -362,16 +363,20 @@ func sweepVisualBuilderVbInstanceResource(compartment string) error {
 			deleteVbInstanceRequest.VbInstanceId = &vbInstanceId
 
 			deleteVbInstanceRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "visual_builder")
-			_, error := vbInstanceClient.DeleteVbInstance(context.Background(), deleteVbInstanceRequest)
+			error := acctest.DeleteSweeperResource(compartment, "VbInstanceId", vbInstanceId, func() error {
+				_, err := vbInstanceClient.DeleteVbInstance(context.Background(), deleteVbInstanceRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting VbInstance %s %s, It is possible that the resource is already deleted. Please verify manually \n", vbInstanceId, error)
+				fmt.Printf("Error deleting VbInstance %s %s \n", vbInstanceId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &vbInstanceId, vbInstanceSweepWaitCondition, time.Duration(3*time.Minute),
 				vbInstanceSweepResponseFetchOperation, "visual_builder", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getVbInstanceIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -281,10 +282,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("VulnerabilityScanningContainerScanRecipe") {
-		resource.AddTestSweepers("VulnerabilityScanningContainerScanRecipe", &resource.Sweeper{
-			Name:         "VulnerabilityScanningContainerScanRecipe",
-			Dependencies: acctest.DependencyGraph["containerScanRecipe"],
-			F:            sweepVulnerabilityScanningContainerScanRecipeResource,
+		acctest.AddTestSweepers("VulnerabilityScanningContainerScanRecipe", &resource.Sweeper{
+			Name: "VulnerabilityScanningContainerScanRecipe",
+			F:    sweepVulnerabilityScanningContainerScanRecipeResource,
 		})
 	}
 }
@@ -295,6 +295,7 @@ func sweepVulnerabilityScanningContainerScanRecipeResource(compartment string) e
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, containerScanRecipeId := range containerScanRecipeIds {
 		if ok := acctest.SweeperDefaultResourceId[containerScanRecipeId]; !ok {
 			deleteContainerScanRecipeRequest := oci_vulnerability_scanning.DeleteContainerScanRecipeRequest{}
@@ -302,16 +303,20 @@ func sweepVulnerabilityScanningContainerScanRecipeResource(compartment string) e
 			deleteContainerScanRecipeRequest.ContainerScanRecipeId = &containerScanRecipeId
 
 			deleteContainerScanRecipeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "vulnerability_scanning")
-			_, error := vulnerabilityScanningClient.DeleteContainerScanRecipe(context.Background(), deleteContainerScanRecipeRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ContainerScanRecipeId", containerScanRecipeId, func() error {
+				_, err := vulnerabilityScanningClient.DeleteContainerScanRecipe(context.Background(), deleteContainerScanRecipeRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ContainerScanRecipe %s %s, It is possible that the resource is already deleted. Please verify manually \n", containerScanRecipeId, error)
+				fmt.Printf("Error deleting ContainerScanRecipe %s %s \n", containerScanRecipeId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &containerScanRecipeId, containerScanRecipeSweepWaitCondition, time.Duration(3*time.Minute),
 				containerScanRecipeSweepResponseFetchOperation, "vulnerability_scanning", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getContainerScanRecipeIds(compartment string) ([]string, error) {
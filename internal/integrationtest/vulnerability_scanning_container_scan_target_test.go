@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -320,10 +321,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("VulnerabilityScanningContainerScanTarget") {
-		resource.AddTestSweepers("VulnerabilityScanningContainerScanTarget", &resource.Sweeper{
-			Name:         "VulnerabilityScanningContainerScanTarget",
-			Dependencies: acctest.DependencyGraph["containerScanTarget"],
-			F:            sweepVulnerabilityScanningContainerScanTargetResource,
+		acctest.AddTestSweepers("VulnerabilityScanningContainerScanTarget", &resource.Sweeper{
+			Name: "VulnerabilityScanningContainerScanTarget",
+			F:    sweepVulnerabilityScanningContainerScanTargetResource,
 		})
 	}
 }
@@ -334,6 +334,7 @@ func sweepVulnerabilityScanningContainerScanTargetResource(compartment string) e
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, containerScanTargetId := range containerScanTargetIds {
 		if ok := acctest.SweeperDefaultResourceId[containerScanTargetId]; !ok {
 			deleteContainerScanTargetRequest := oci_vulnerability_scanning.DeleteContainerScanTargetRequest{}
@@ -341,16 +342,20 @@ func sweepVulnerabilityScanningContainerScanTargetResource(compartment string) e
 			deleteContainerScanTargetRequest.ContainerScanTargetId = &containerScanTargetId
 
 			deleteContainerScanTargetRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "vulnerability_scanning")
-			_, error := vulnerabilityScanningClient.DeleteContainerScanTarget(context.Background(), deleteContainerScanTargetRequest)
+			error := acctest.DeleteSweeperResource(compartment, "ContainerScanTargetId", containerScanTargetId, func() error {
+				_, err := vulnerabilityScanningClient.DeleteContainerScanTarget(context.Background(), deleteContainerScanTargetRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting ContainerScanTarget %s %s, It is possible that the resource is already deleted. Please verify manually \n", containerScanTargetId, error)
+				fmt.Printf("Error deleting ContainerScanTarget %s %s \n", containerScanTargetId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &containerScanTargetId, containerScanTargetSweepWaitCondition, time.Duration(3*time.Minute),
 				containerScanTargetSweepResponseFetchOperation, "vulnerability_scanning", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getContainerScanTargetIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -345,10 +346,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("VulnerabilityScanningHostScanRecipe") {
-		resource.AddTestSweepers("VulnerabilityScanningHostScanRecipe", &resource.Sweeper{
-			Name:         "VulnerabilityScanningHostScanRecipe",
-			Dependencies: acctest.DependencyGraph["hostScanRecipe"],
-			F:            sweepVulnerabilityScanningHostScanRecipeResource,
+		acctest.AddTestSweepers("VulnerabilityScanningHostScanRecipe", &resource.Sweeper{
+			Name: "VulnerabilityScanningHostScanRecipe",
+			F:    sweepVulnerabilityScanningHostScanRecipeResource,
 		})
 	}
 }
@@ -359,6 +359,7 @@ func sweepVulnerabilityScanningHostScanRecipeResource(compartment string) error
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, hostScanRecipeId := range hostScanRecipeIds {
 		if ok := acctest.SweeperDefaultResourceId[hostScanRecipeId]; !ok {
 			deleteHostScanRecipeRequest := oci_vulnerability_scanning.DeleteHostScanRecipeRequest{}
@@ -366,16 +367,20 @@ func sweepVulnerabilityScanningHostScanRecipeResource(compartment string) error
 			deleteHostScanRecipeRequest.HostScanRecipeId = &hostScanRecipeId
 
 			deleteHostScanRecipeRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "vulnerability_scanning")
-			_, error := vulnerabilityScanningClient.DeleteHostScanRecipe(context.Background(), deleteHostScanRecipeRequest)
+			error := acctest.DeleteSweeperResource(compartment, "HostScanRecipeId", hostScanRecipeId, func() error {
+				_, err := vulnerabilityScanningClient.DeleteHostScanRecipe(context.Background(), deleteHostScanRecipeRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting HostScanRecipe %s %s, It is possible that the resource is already deleted. Please verify manually \n", hostScanRecipeId, error)
+				fmt.Printf("Error deleting HostScanRecipe %s %s \n", hostScanRecipeId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &hostScanRecipeId, hostScanRecipeSweepWaitCondition, time.Duration(3*time.Minute),
 				hostScanRecipeSweepResponseFetchOperation, "vulnerability_scanning", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getHostScanRecipeIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -283,10 +284,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("VulnerabilityScanningHostScanTarget") {
-		resource.AddTestSweepers("VulnerabilityScanningHostScanTarget", &resource.Sweeper{
-			Name:         "VulnerabilityScanningHostScanTarget",
-			Dependencies: acctest.DependencyGraph["hostScanTarget"],
-			F:            sweepVulnerabilityScanningHostScanTargetResource,
+		acctest.AddTestSweepers("VulnerabilityScanningHostScanTarget", &resource.Sweeper{
+			Name: "VulnerabilityScanningHostScanTarget",
+			F:    sweepVulnerabilityScanningHostScanTargetResource,
 		})
 	}
 }
@@ -297,6 +297,7 @@ func sweepVulnerabilityScanningHostScanTargetResource(compartment string) error
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, hostScanTargetId := range hostScanTargetIds {
 		if ok := acctest.SweeperDefaultResourceId[hostScanTargetId]; !ok {
 			deleteHostScanTargetRequest := oci_vulnerability_scanning.DeleteHostScanTargetRequest{}
@@ -304,16 +305,20 @@ func sweepVulnerabilityScanningHostScanTargetResource(compartment string) error
 			deleteHostScanTargetRequest.HostScanTargetId = &hostScanTargetId
 
 			deleteHostScanTargetRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "vulnerability_scanning")
-			_, error := vulnerabilityScanningClient.DeleteHostScanTarget(context.Background(), deleteHostScanTargetRequest)
+			error := acctest.DeleteSweeperResource(compartment, "HostScanTargetId", hostScanTargetId, func() error {
+				_, err := vulnerabilityScanningClient.DeleteHostScanTarget(context.Background(), deleteHostScanTargetRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting HostScanTarget %s %s, It is possible that the resource is already deleted. Please verify manually \n", hostScanTargetId, error)
+				fmt.Printf("Error deleting HostScanTarget %s %s \n", hostScanTargetId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &hostScanTargetId, hostScanTargetSweepWaitCondition, time.Duration(3*time.Minute),
 				hostScanTargetSweepResponseFetchOperation, "vulnerability_scanning", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getHostScanTargetIds(compartment string) ([]string, error) {
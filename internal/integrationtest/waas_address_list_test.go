@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -265,10 +266,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("WaasAddressList") {
-		resource.AddTestSweepers("WaasAddressList", &resource.Sweeper{
-			Name:         "WaasAddressList",
-			Dependencies: acctest.DependencyGraph["addressList"],
-			F:            sweepWaasAddressListResource,
+		acctest.AddTestSweepers("WaasAddressList", &resource.Sweeper{
+			Name: "WaasAddressList",
+			F:    sweepWaasAddressListResource,
 		})
 	}
 }
@@ -279,6 +279,7 @@ func sweepWaasAddressListResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, addressListId := range addressListIds {
 		if ok := acctest.SweeperDefaultResourceId[addressListId]; !ok {
 			deleteAddressListRequest := oci_waas.DeleteAddressListRequest{}
@@ -286,16 +287,20 @@ func sweepWaasAddressListResource(compartment string) error {
 			deleteAddressListRequest.AddressListId = &addressListId
 
 			deleteAddressListRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "waas")
-			_, error := waasClient.DeleteAddressList(context.Background(), deleteAddressListRequest)
+			error := acctest.DeleteSweeperResource(compartment, "AddressListId", addressListId, func() error {
+				_, err := waasClient.DeleteAddressList(context.Background(), deleteAddressListRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting AddressList %s %s, It is possible that the resource is already deleted. Please verify manually \n", addressListId, error)
+				fmt.Printf("Error deleting AddressList %s %s \n", addressListId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &addressListId, addressListSweepWaitCondition, time.Duration(3*time.Minute),
 				addressListSweepResponseFetchOperation, "waas", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getAddressListIds(compartment string) ([]string, error) {
@@ -17,6 +17,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -302,10 +303,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("WaasCertificate") {
-		resource.AddTestSweepers("WaasCertificate", &resource.Sweeper{
-			Name:         "WaasCertificate",
-			Dependencies: acctest.DependencyGraph["certificate"],
-			F:            sweepWaasCertificateResource,
+		acctest.AddTestSweepers("WaasCertificate", &resource.Sweeper{
+			Name: "WaasCertificate",
+			F:    sweepWaasCertificateResource,
 		})
 	}
 }
@@ -316,6 +316,7 @@ func sweepWaasCertificateResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, certificateId := range certificateIds {
 		if ok := acctest.SweeperDefaultResourceId[certificateId]; !ok {
 			deleteCertificateRequest := oci_waas.DeleteCertificateRequest{}
@@ -323,16 +324,20 @@ func sweepWaasCertificateResource(compartment string) error {
 			deleteCertificateRequest.CertificateId = &certificateId
 
 			deleteCertificateRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "waas")
-			_, error := waasClient.DeleteCertificate(context.Background(), deleteCertificateRequest)
+			error := acctest.DeleteSweeperResource(compartment, "CertificateId", certificateId, func() error {
+				_, err := waasClient.DeleteCertificate(context.Background(), deleteCertificateRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting Certificate %s %s, It is possible that the resource is already deleted. Please verify manually \n", certificateId, error)
+				fmt.Printf("Error deleting Certificate %s %s \n", certificateId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &certificateId, certificateSweepWaitCondition, time.Duration(3*time.Minute),
 				certificateSweepResponseFetchOperation, "waas", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getCertificateIds(compartment string) ([]string, error) {
@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -276,10 +277,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("WaasCustomProtectionRule") {
-		resource.AddTestSweepers("WaasCustomProtectionRule", &resource.Sweeper{
-			Name:         "WaasCustomProtectionRule",
-			Dependencies: acctest.DependencyGraph["customProtectionRule"],
-			F:            sweepWaasCustomProtectionRuleResource,
+		acctest.AddTestSweepers("WaasCustomProtectionRule", &resource.Sweeper{
+			Name: "WaasCustomProtectionRule",
+			F:    sweepWaasCustomProtectionRuleResource,
 		})
 	}
 }
@@ -290,6 +290,7 @@ func sweepWaasCustomProtectionRuleResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, customProtectionRuleId := range customProtectionRuleIds {
 		if ok := acctest.SweeperDefaultResourceId[customProtectionRuleId]; !ok {
 			deleteCustomProtectionRuleRequest := oci_waas.DeleteCustomProtectionRuleRequest{}
@@ -297,16 +298,20 @@ func sweepWaasCustomProtectionRuleResource(compartment string) error {
 			deleteCustomProtectionRuleRequest.CustomProtectionRuleId = &customProtectionRuleId
 
 			deleteCustomProtectionRuleRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "waas")
-			_, error := waasClient.DeleteCustomProtectionRule(context.Background(), deleteCustomProtectionRuleRequest)
+			error := acctest.DeleteSweeperResource(compartment, "CustomProtectionRuleId", customProtectionRuleId, func() error {
+				_, err := waasClient.DeleteCustomProtectionRule(context.Background(), deleteCustomProtectionRuleRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting CustomProtectionRule %s %s, It is possible that the resource is already deleted. Please verify manually \n", customProtectionRuleId, error)
+				fmt.Printf("Error deleting CustomProtectionRule %s %s \n", customProtectionRuleId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &customProtectionRuleId, customProtectionRuleSweepWaitCondition, time.Duration(3*time.Minute),
 				customProtectionRuleSweepResponseFetchOperation, "waas", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getCustomProtectionRuleIds(compartment string) ([]string, error) {
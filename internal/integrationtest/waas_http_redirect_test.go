@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -313,10 +314,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("WaasHttpRedirect") {
-		resource.AddTestSweepers("WaasHttpRedirect", &resource.Sweeper{
-			Name:         "WaasHttpRedirect",
-			Dependencies: acctest.DependencyGraph["httpRedirect"],
-			F:            sweepWaasHttpRedirectResource,
+		acctest.AddTestSweepers("WaasHttpRedirect", &resource.Sweeper{
+			Name: "WaasHttpRedirect",
+			F:    sweepWaasHttpRedirectResource,
 		})
 	}
 }
@@ -327,6 +327,7 @@ func sweepWaasHttpRedirectResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, httpRedirectId := range httpRedirectIds {
 		if ok := acctest.SweeperDefaultResourceId[httpRedirectId]; !ok {
 			deleteHttpRedirectRequest := oci_waas.DeleteHttpRedirectRequest{}
@@ -334,16 +335,20 @@ func sweepWaasHttpRedirectResource(compartment string) error {
 			deleteHttpRedirectRequest.HttpRedirectId = &httpRedirectId
 
 			deleteHttpRedirectRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "waas")
-			_, error := redirectClient.DeleteHttpRedirect(context.Background(), deleteHttpRedirectRequest)
+			error := acctest.DeleteSweeperResource(compartment, "HttpRedirectId", httpRedirectId, func() error {
+				_, err := redirectClient.DeleteHttpRedirect(context.Background(), deleteHttpRedirectRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting HttpRedirect %s %s, It is possible that the resource is already deleted. Please verify manually \n", httpRedirectId, error)
+				fmt.Printf("Error deleting HttpRedirect %s %s \n", httpRedirectId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &httpRedirectId, httpRedirectSweepWaitCondition, time.Duration(3*time.Minute),
 				httpRedirectSweepResponseFetchOperation, "waas", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getHttpRedirectIds(compartment string) ([]string, error) {
@@ -17,6 +17,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -1253,10 +1254,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("WaasWaasPolicy") {
-		resource.AddTestSweepers("WaasWaasPolicy", &resource.Sweeper{
-			Name:         "WaasWaasPolicy",
-			Dependencies: acctest.DependencyGraph["waasPolicy"],
-			F:            sweepWaasWaasPolicyResource,
+		acctest.AddTestSweepers("WaasWaasPolicy", &resource.Sweeper{
+			Name: "WaasWaasPolicy",
+			F:    sweepWaasWaasPolicyResource,
 		})
 	}
 }
@@ -1267,6 +1267,7 @@ func sweepWaasWaasPolicyResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, waasPolicyId := range waasPolicyIds {
 		if ok := acctest.SweeperDefaultResourceId[waasPolicyId]; !ok {
 			deleteWaasPolicyRequest := oci_waas.DeleteWaasPolicyRequest{}
@@ -1274,16 +1275,20 @@ func sweepWaasWaasPolicyResource(compartment string) error {
 			deleteWaasPolicyRequest.WaasPolicyId = &waasPolicyId
 
 			deleteWaasPolicyRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "waas")
-			_, error := waasClient.DeleteWaasPolicy(context.Background(), deleteWaasPolicyRequest)
+			error := acctest.DeleteSweeperResource(compartment, "WaasPolicyId", waasPolicyId, func() error {
+				_, err := waasClient.DeleteWaasPolicy(context.Background(), deleteWaasPolicyRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting WaasPolicy %s %s, It is possible that the resource is already deleted. Please verify manually \n", waasPolicyId, error)
+				fmt.Printf("Error deleting WaasPolicy %s %s \n", waasPolicyId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &waasPolicyId, waasPolicySweepWaitCondition, time.Duration(3*time.Minute),
 				waasPolicySweepResponseFetchOperation, "waas", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getWaasPolicyIds(compartment string) ([]string, error) {
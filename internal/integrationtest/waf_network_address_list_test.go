@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -479,10 +480,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("WafNetworkAddressList") {
-		resource.AddTestSweepers("WafNetworkAddressList", &resource.Sweeper{
-			Name:         "WafNetworkAddressList",
-			Dependencies: acctest.DependencyGraph["networkAddressList"],
-			F:            sweepWafNetworkAddressListResource,
+		acctest.AddTestSweepers("WafNetworkAddressList", &resource.Sweeper{
+			Name: "WafNetworkAddressList",
+			F:    sweepWafNetworkAddressListResource,
 		})
 	}
 }
@@ -493,6 +493,7 @@ func sweepWafNetworkAddressListResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, networkAddressListId := range networkAddressListIds {
 		if ok := acctest.SweeperDefaultResourceId[networkAddressListId]; !ok {
 			deleteNetworkAddressListRequest := oci_waf.DeleteNetworkAddressListRequest{}
@@ -500,16 +501,20 @@ func sweepWafNetworkAddressListResource(compartment string) error {
 			deleteNetworkAddressListRequest.NetworkAddressListId = &networkAddressListId
 
 			deleteNetworkAddressListRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "waf")
-			_, error := wafClient.DeleteNetworkAddressList(context.Background(), deleteNetworkAddressListRequest)
+			error := acctest.DeleteSweeperResource(compartment, "NetworkAddressListId", networkAddressListId, func() error {
+				_, err := wafClient.DeleteNetworkAddressList(context.Background(), deleteNetworkAddressListRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting NetworkAddressList %s %s, It is possible that the resource is already deleted. Please verify manually \n", networkAddressListId, error)
+				fmt.Printf("Error deleting NetworkAddressList %s %s \n", networkAddressListId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &networkAddressListId, networkAddressListSweepWaitCondition, time.Duration(3*time.Minute),
 				networkAddressListSweepResponseFetchOperation, "waf", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getNetworkAddressListIds(compartment string) ([]string, error) {
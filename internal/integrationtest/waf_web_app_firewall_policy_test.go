@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -755,10 +756,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("WafWebAppFirewallPolicy") {
-		resource.AddTestSweepers("WafWebAppFirewallPolicy", &resource.Sweeper{
-			Name:         "WafWebAppFirewallPolicy",
-			Dependencies: acctest.DependencyGraph["webAppFirewallPolicy"],
-			F:            sweepWafWebAppFirewallPolicyResource,
+		acctest.AddTestSweepers("WafWebAppFirewallPolicy", &resource.Sweeper{
+			Name: "WafWebAppFirewallPolicy",
+			F:    sweepWafWebAppFirewallPolicyResource,
 		})
 	}
 }
@@ -769,6 +769,7 @@ func sweepWafWebAppFirewallPolicyResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, webAppFirewallPolicyId := range webAppFirewallPolicyIds {
 		if ok := acctest.SweeperDefaultResourceId[webAppFirewallPolicyId]; !ok {
 			deleteWebAppFirewallPolicyRequest := oci_waf.DeleteWebAppFirewallPolicyRequest{}
@@ -776,16 +777,20 @@ func sweepWafWebAppFirewallPolicyResource(compartment string) error {
 			deleteWebAppFirewallPolicyRequest.WebAppFirewallPolicyId = &webAppFirewallPolicyId
 
 			deleteWebAppFirewallPolicyRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "waf")
-			_, error := wafClient.DeleteWebAppFirewallPolicy(context.Background(), deleteWebAppFirewallPolicyRequest)
+			error := acctest.DeleteSweeperResource(compartment, "WebAppFirewallPolicyId", webAppFirewallPolicyId, func() error {
+				_, err := wafClient.DeleteWebAppFirewallPolicy(context.Background(), deleteWebAppFirewallPolicyRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting WebAppFirewallPolicy %s %s, It is possible that the resource is already deleted. Please verify manually \n", webAppFirewallPolicyId, error)
+				fmt.Printf("Error deleting WebAppFirewallPolicy %s %s \n", webAppFirewallPolicyId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &webAppFirewallPolicyId, webAppFirewallPolicySweepWaitCondition, time.Duration(3*time.Minute),
 				webAppFirewallPolicySweepResponseFetchOperation, "waf", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getWebAppFirewallPolicyIds(compartment string) ([]string, error) {
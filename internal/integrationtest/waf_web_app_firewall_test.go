@@ -16,6 +16,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-oci/internal/tfresource"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -282,10 +283,9 @@ func init() {
 		acctest.InitDependencyGraph()
 	}
 	if !acctest.InSweeperExcludeList("WafWebAppFirewall") {
-		resource.AddTestSweepers("WafWebAppFirewall", &resource.Sweeper{
-			Name:         "WafWebAppFirewall",
-			Dependencies: acctest.DependencyGraph["webAppFirewall"],
-			F:            sweepWafWebAppFirewallResource,
+		acctest.AddTestSweepers("WafWebAppFirewall", &resource.Sweeper{
+			Name: "WafWebAppFirewall",
+			F:    sweepWafWebAppFirewallResource,
 		})
 	}
 }
@@ -296,6 +296,7 @@ func sweepWafWebAppFirewallResource(compartment string) error {
 	if err != nil {
 		return err
 	}
+	var deleteErrors *multierror.Error
 	for _, webAppFirewallId := range webAppFirewallIds {
 		if ok := acctest.SweeperDefaultResourceId[webAppFirewallId]; !ok {
 			deleteWebAppFirewallRequest := oci_waf.DeleteWebAppFirewallRequest{}
@@ -303,16 +304,20 @@ func sweepWafWebAppFirewallResource(compartment string) error {
 			deleteWebAppFirewallRequest.WebAppFirewallId = &webAppFirewallId
 
 			deleteWebAppFirewallRequest.RequestMetadata.RetryPolicy = tfresource.GetRetryPolicy(true, "waf")
-			_, error := wafClient.DeleteWebAppFirewall(context.Background(), deleteWebAppFirewallRequest)
+			error := acctest.DeleteSweeperResource(compartment, "WebAppFirewallId", webAppFirewallId, func() error {
+				_, err := wafClient.DeleteWebAppFirewall(context.Background(), deleteWebAppFirewallRequest)
+				return err
+			})
 			if error != nil {
-				fmt.Printf("Error deleting WebAppFirewall %s %s, It is possible that the resource is already deleted. Please verify manually \n", webAppFirewallId, error)
+				fmt.Printf("Error deleting WebAppFirewall %s %s \n", webAppFirewallId, error)
+				deleteErrors = multierror.Append(deleteErrors, error)
 				continue
 			}
 			acctest.WaitTillCondition(acctest.TestAccProvider, &webAppFirewallId, webAppFirewallSweepWaitCondition, time.Duration(3*time.Minute),
 				webAppFirewallSweepResponseFetchOperation, "waf", true)
 		}
 	}
-	return nil
+	return deleteErrors.ErrorOrNil()
 }
 
 func getWebAppFirewallIds(compartment string) ([]string, error) {
@@ -0,0 +1,661 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"sync"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+// checkpointVersion is the version of the checkpoint files, a checkpoint of another version can not be resumed
+const checkpointVersion = 1
+
+const (
+	checkpointFile         = "checkpoint.json"
+	checkpointStepsDir     = "steps"
+	checkpointStatesDir    = "states"
+	interpolationStringKey = "__interpolation_string__"
+)
+
+var checkpointKeyRegex = regexp.MustCompile(`[^a-zA-Z0-9._-]`)
+
+// checkpointArgs are the arguments of the export command that determine the discovered resources, a checkpoint is
+// only resumed by an export command with the same arguments
+type checkpointArgs struct {
+	CompartmentId     string   `json:"compartment_id"`
+	Services          []string `json:"services"`
+	IDs               []string `json:"ids"`
+	Regions           []string `json:"regions"`
+	IsRecursive       bool     `json:"recursive"`
+	Layout            string   `json:"layout"`
+	GenerateState     bool     `json:"generate_state"`
+	ExistingStateFile string   `json:"existing_state_file"`
+	IncludeTags       []string `json:"include_tags"`
+	ExcludeTags       []string `json:"exclude_tags"`
+	NameRegex         string   `json:"name_regex"`
+	LifecycleStates   []string `json:"lifecycle_states"`
+}
+
+type checkpointError struct {
+	ResourceType   string `json:"resource_type"`
+	ParentResource string `json:"parent_resource"`
+	Error          string `json:"error"`
+}
+
+/*
+discoveryCheckpoint is the progress of an export command, it is written under the checkpoint directory of the
+output_path so that an export that did not complete can be resumed with the resume argument
+
+The resources discovered by each step are written to a file under the steps directory once the step is discovered, along
+with the errors of the step and the references, variables and resource names added since the previous step was
+discovered. The state imported for each step by generateStateParallel is written to a file under the states directory.
+The checkpoint file lists the completed steps.
+*/
+type discoveryCheckpoint struct {
+	Version             int             `json:"version"`
+	Args                *checkpointArgs `json:"args"`
+	DiscoveredSteps     map[string]bool `json:"discovered_steps"` // checkpoint keys of the discovered steps
+	ImportedSteps       map[string]bool `json:"imported_steps"`   // checkpoint keys of the steps imported in parallel
+	ExpectedResourceIds map[string]bool `json:"expected_resource_ids"`
+
+	// references, variables and resource names already written to the step files
+	writtenReferenceMap      map[string]string
+	writtenVars              map[string]string
+	writtenResourceNameCount map[string]int
+
+	dir       string
+	isResumed bool
+	lock      sync.Mutex
+}
+
+type checkpointResource struct {
+	Id                         string                 `json:"id"`
+	ImportId                   string                 `json:"import_id,omitempty"`
+	TerraformClass             string                 `json:"terraform_class"`
+	TerraformName              string                 `json:"terraform_name"`
+	TerraformReferenceIdString string                 `json:"terraform_reference_id_string,omitempty"`
+	CompartmentId              string                 `json:"compartment_id"`
+	SourceAttributes           map[string]interface{} `json:"source_attributes"`
+	ParentId                   string                 `json:"parent_id,omitempty"`
+	ParentTerraformClass       string                 `json:"parent_terraform_class,omitempty"`
+	ParentTerraformName        string                 `json:"parent_terraform_name,omitempty"`
+	OmitFromExport             bool                   `json:"omit_from_export"`
+	IsSelected                 bool                   `json:"is_selected"`
+	Region                     string                 `json:"region,omitempty"`
+}
+
+type checkpointStep struct {
+	DiscoveredResources []*checkpointResource `json:"discovered_resources"`
+	OmittedResources    []*checkpointResource `json:"omitted_resources"`
+	ReferenceMap        map[string]string     `json:"reference_map"`      // references added or changed since the previous step
+	RemovedReferences   []string              `json:"removed_references"` // references removed since the previous step
+	Vars                map[string]string     `json:"vars"`
+	ResourceNameCount   map[string]int        `json:"resource_name_count"`
+	Errors              []*checkpointError    `json:"errors"`
+}
+
+type checkpointStepState struct {
+	State          interface{} `json:"state"`
+	ErrorResources []string    `json:"error_resources"` // Terraform references of the resources that failed to import
+}
+
+func (args *ExportCommandArgs) getCheckpointArgs() *checkpointArgs {
+	sortedCopy := func(values []string) []string {
+		result := append([]string{}, values...)
+		sort.Strings(result)
+		return result
+	}
+
+	result := &checkpointArgs{
+		Services:        sortedCopy(args.Services),
+		IDs:             sortedCopy(args.IDs),
+		Regions:         args.getRegions(),
+		IsRecursive:     args.IsRecursive,
+		Layout:          string(args.Layout),
+		GenerateState:   args.GenerateState,
+		IncludeTags:     sortedCopy(args.IncludeTags),
+		ExcludeTags:     sortedCopy(args.ExcludeTags),
+		LifecycleStates: sortedCopy(args.LifecycleStates),
+	}
+	if args.CompartmentId != nil {
+		result.CompartmentId = *args.CompartmentId
+	}
+	if args.ExistingStateFile != nil {
+		result.ExistingStateFile = *args.ExistingStateFile
+	}
+	if args.NameRegex != nil {
+		result.NameRegex = *args.NameRegex
+	}
+	return result
+}
+
+// getStepCheckpointKey returns the name of the checkpoint files of a step, it is unique among the steps of an export
+func getStepCheckpointKey(step resourceDiscoveryStep) string {
+	baseStep := step.getBaseStep()
+	key := baseStep.name
+	if graphStep, ok := step.(*resourceDiscoveryWithGraph); ok && graphStep.root != nil {
+		key = fmt.Sprintf("%s_%s", key, graphStep.root.id)
+	}
+	if baseStep.moduleName != "" {
+		key = fmt.Sprintf("%s_%s", baseStep.moduleName, key)
+	}
+	return checkpointKeyRegex.ReplaceAllString(key, "_")
+}
+
+func writeCheckpointFile(path string, value interface{}) error {
+	content, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	// Write to a temporary file first so that an interrupted export does not leave a partial checkpoint file
+	tmpPath := fmt.Sprintf("%s.tmp", path)
+	if err := ioutil.WriteFile(tmpPath, content, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+func readCheckpointFile(path string, value interface{}) error {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	// Numbers are decoded as json.Number so that integer attributes are restored as int
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	return decoder.Decode(value)
+}
+
+/*
+initCheckpoint loads the checkpoint of the output_path if the export is resumed, or discards it otherwise
+
+The expected resource ids are restored along with the checkpoint, the references, variables, resource names and errors
+of the discovered steps are restored by restoreDiscoveredSteps.
+*/
+func (ctx *resourceDiscoveryContext) initCheckpoint() error {
+	checkpoint := &discoveryCheckpoint{
+		Version:             checkpointVersion,
+		Args:                ctx.getCheckpointArgs(),
+		DiscoveredSteps:     map[string]bool{},
+		ImportedSteps:       map[string]bool{},
+		ExpectedResourceIds: map[string]bool{},
+		dir:                 filepath.Join(*ctx.OutputDir, globalvar.CheckpointDir),
+	}
+
+	checkpointPath := filepath.Join(checkpoint.dir, checkpointFile)
+	if _, err := os.Stat(checkpointPath); ctx.Resume && os.IsNotExist(err) {
+		utils.Logf("[INFO] no checkpoint found at %s, starting a new export", checkpointPath)
+	} else if ctx.Resume {
+		existingCheckpoint := &discoveryCheckpoint{}
+		if err := readCheckpointFile(checkpointPath, existingCheckpoint); err != nil {
+			return fmt.Errorf("[ERROR] unable to read checkpoint file %s: %s", checkpointPath, err.Error())
+		}
+		if existingCheckpoint.Version != checkpointVersion {
+			return fmt.Errorf("[ERROR] checkpoint file %s has unsupported version %d, only version %d is supported", checkpointPath, existingCheckpoint.Version, checkpointVersion)
+		}
+		existingArgs, _ := json.Marshal(existingCheckpoint.Args)
+		args, _ := json.Marshal(checkpoint.Args)
+		if !bytes.Equal(existingArgs, args) {
+			return fmt.Errorf("[ERROR] checkpoint file %s was created by an export with different arguments, run the export without resume to start over", checkpointPath)
+		}
+
+		existingCheckpoint.dir = checkpoint.dir
+		existingCheckpoint.isResumed = true
+		checkpoint = existingCheckpoint
+		ctx.restoreExpectedResourceIds(checkpoint)
+		utils.Logf("[INFO] resuming export from checkpoint %s, %d steps were discovered", checkpointPath, len(checkpoint.DiscoveredSteps))
+	}
+	checkpoint.writtenReferenceMap = map[string]string{}
+	checkpoint.writtenVars = map[string]string{}
+	checkpoint.writtenResourceNameCount = map[string]int{}
+
+	if !checkpoint.isResumed {
+		if err := os.RemoveAll(checkpoint.dir); err != nil {
+			return fmt.Errorf("[ERROR] unable to remove checkpoint directory %s: %s", checkpoint.dir, err.Error())
+		}
+	}
+	for _, dir := range []string{checkpointStepsDir, checkpointStatesDir} {
+		if err := os.MkdirAll(filepath.Join(checkpoint.dir, dir), os.ModePerm); err != nil {
+			return fmt.Errorf("[ERROR] unable to create checkpoint directory %s: %s", checkpoint.dir, err.Error())
+		}
+	}
+	ctx.checkpoint = checkpoint
+	return nil
+}
+
+func (ctx *resourceDiscoveryContext) restoreExpectedResourceIds(checkpoint *discoveryCheckpoint) {
+	for id, found := range checkpoint.ExpectedResourceIds {
+		if _, exists := ctx.expectedResourceIds[id]; exists && found {
+			ctx.expectedResourceIds[id] = true
+		}
+	}
+}
+
+// isResumed returns true if the export is resumed from a checkpoint
+func (ctx *resourceDiscoveryContext) isResumed() bool {
+	return ctx.checkpoint != nil && ctx.checkpoint.isResumed
+}
+
+// writeCheckpoint writes the checkpoint file with the steps completed by the export and the expected resource ids found
+func (ctx *resourceDiscoveryContext) writeCheckpoint() error {
+	checkpoint := ctx.checkpoint
+	lock := ctx.getExpectedResourceIdsLock()
+	lock.Lock()
+	for id, found := range ctx.expectedResourceIds {
+		checkpoint.ExpectedResourceIds[id] = found
+	}
+	lock.Unlock()
+	return writeCheckpointFile(filepath.Join(checkpoint.dir, checkpointFile), checkpoint)
+}
+
+/*
+newStepContext creates the context used to discover the resources of a step
+The step context shares the arguments, clients, selectors and expected resource ids of the context, along with the lock
+of the expected resource ids, only the errors differ so that the errors
+of the step can be written to its checkpoint. The errors are added to the context by discover once the step is
+discovered.
+*/
+func (ctx *resourceDiscoveryContext) newStepContext() *resourceDiscoveryContext {
+	return &resourceDiscoveryContext{
+		terraformProviderBinaryPath: ctx.terraformProviderBinaryPath,
+		terraformCLIPath:            ctx.terraformCLIPath,
+		terraform:                   ctx.terraform,
+		clients:                     ctx.clients,
+		expectedResourceIds:         ctx.expectedResourceIds,
+		expectedResourceIdsLock:     ctx.getExpectedResourceIdsLock(),
+		tenancyOcid:                 ctx.tenancyOcid,
+		targetSpecificResources:     ctx.targetSpecificResources,
+		resourceHintsLookup:         ctx.resourceHintsLookup,
+		ExportCommandArgs:           ctx.ExportCommandArgs,
+		errorList: ErrorList{
+			errors: []*ResourceDiscoveryError{},
+		},
+		existingState:      ctx.existingState,
+		selectors:          ctx.selectors,
+		checkpoint:         ctx.checkpoint,
+		compartmentModules: ctx.compartmentModules,
+		moduleReferences:   ctx.moduleReferences,
+	}
+}
+
+// getWrittenStringMapChanges returns the values of a map that were added or changed since it was written along with the
+// removed keys, the written map is updated with the changes
+func getWrittenStringMapChanges(values map[string]string, written map[string]string) (map[string]string, []string) {
+	changed := map[string]string{}
+	for key, value := range values {
+		if writtenValue, exists := written[key]; !exists || writtenValue != value {
+			changed[key] = value
+			written[key] = value
+		}
+	}
+	removed := []string{}
+	for key := range written {
+		if _, exists := values[key]; !exists {
+			removed = append(removed, key)
+			delete(written, key)
+		}
+	}
+	sort.Strings(removed)
+	return changed, removed
+}
+
+// setStepGlobals sets the references, variables and resource names that changed since the previous step was
+// checkpointed, the caller must hold the checkpoint lock
+func (checkpoint *discoveryCheckpoint) setStepGlobals(stepCheckpoint *checkpointStep) {
+	refMapLock.Lock()
+	stepCheckpoint.ReferenceMap, stepCheckpoint.RemovedReferences = getWrittenStringMapChanges(referenceMap, checkpoint.writtenReferenceMap)
+	// Variables are never removed
+	stepCheckpoint.Vars, _ = getWrittenStringMapChanges(vars, checkpoint.writtenVars)
+	refMapLock.Unlock()
+
+	resourceNameCountLock.Lock()
+	stepCheckpoint.ResourceNameCount = map[string]int{}
+	for key, value := range resourceNameCount {
+		if checkpoint.writtenResourceNameCount[key] != value {
+			stepCheckpoint.ResourceNameCount[key] = value
+			checkpoint.writtenResourceNameCount[key] = value
+		}
+	}
+	resourceNameCountLock.Unlock()
+}
+
+// checkpointDiscoveredStep writes the resources discovered by a step along with its errors, so that the step is not
+// discovered again when the export is resumed
+func (ctx *resourceDiscoveryContext) checkpointDiscoveredStep(step resourceDiscoveryStep, stepErrors []*ResourceDiscoveryError) error {
+	if ctx.checkpoint == nil {
+		return nil
+	}
+	key := getStepCheckpointKey(step)
+	stepCheckpoint := &checkpointStep{
+		DiscoveredResources: toCheckpointResources(step.getDiscoveredResources()),
+		OmittedResources:    toCheckpointResources(step.getOmittedResources()),
+		Errors:              []*checkpointError{},
+	}
+	for _, rdError := range stepErrors {
+		stepCheckpoint.Errors = append(stepCheckpoint.Errors, &checkpointError{
+			ResourceType:   rdError.resourceType,
+			ParentResource: rdError.parentResource,
+			Error:          rdError.error.Error(),
+		})
+	}
+
+	ctx.checkpoint.lock.Lock()
+	defer ctx.checkpoint.lock.Unlock()
+	ctx.checkpoint.setStepGlobals(stepCheckpoint)
+	if err := writeCheckpointFile(filepath.Join(ctx.checkpoint.dir, checkpointStepsDir, key+".json"), stepCheckpoint); err != nil {
+		return err
+	}
+	ctx.checkpoint.DiscoveredSteps[key] = true
+	return ctx.writeCheckpoint()
+}
+
+/*
+restoreDiscoveredSteps restores the resources of the steps discovered by the export that is resumed, it returns the
+indexes of the restored steps
+
+The references, variables, resource names and errors of the restored steps are restored as well. The references removed
+by a step are removed once the references of all the steps are restored, as a reference may be written by another step
+before it is removed.
+*/
+func (ctx *resourceDiscoveryContext) restoreDiscoveredSteps(steps []resourceDiscoveryStep) (map[int]bool, error) {
+	result := map[int]bool{}
+	if !ctx.isResumed() {
+		return result, nil
+	}
+	checkpoint := ctx.checkpoint
+	removedReferences := []string{}
+	for i, step := range steps {
+		key := getStepCheckpointKey(step)
+		if !checkpoint.DiscoveredSteps[key] {
+			continue
+		}
+		stepCheckpoint := &checkpointStep{}
+		stepPath := filepath.Join(checkpoint.dir, checkpointStepsDir, key+".json")
+		if err := readCheckpointFile(stepPath, stepCheckpoint); err != nil {
+			return nil, fmt.Errorf("[ERROR] unable to read checkpoint file %s: %s", stepPath, err.Error())
+		}
+
+		resources := ctx.fromCheckpointResources(append(stepCheckpoint.DiscoveredResources, stepCheckpoint.OmittedResources...))
+		baseStep := step.getBaseStep()
+		baseStep.discoveredResources = resources[:len(stepCheckpoint.DiscoveredResources)]
+		baseStep.omittedResources = resources[len(stepCheckpoint.DiscoveredResources):]
+
+		refMapLock.Lock()
+		for key, value := range stepCheckpoint.ReferenceMap {
+			referenceMap[key] = value
+			checkpoint.writtenReferenceMap[key] = value
+		}
+		for key, value := range stepCheckpoint.Vars {
+			vars[key] = value
+			checkpoint.writtenVars[key] = value
+		}
+		refMapLock.Unlock()
+		removedReferences = append(removedReferences, stepCheckpoint.RemovedReferences...)
+
+		resourceNameCountLock.Lock()
+		for key, value := range stepCheckpoint.ResourceNameCount {
+			// The resource names of a step are counted along with those of the steps discovered before it
+			if value > resourceNameCount[key] {
+				resourceNameCount[key] = value
+				checkpoint.writtenResourceNameCount[key] = value
+			}
+		}
+		resourceNameCountLock.Unlock()
+
+		for _, checkpointErr := range stepCheckpoint.Errors {
+			ctx.addErrorToList(&ResourceDiscoveryError{
+				resourceType:   checkpointErr.ResourceType,
+				parentResource: checkpointErr.ParentResource,
+				error:          errors.New(checkpointErr.Error),
+			})
+		}
+		utils.Logf("[INFO] restored %d resources of step %s from checkpoint", len(baseStep.discoveredResources), baseStep.name)
+		result[i] = true
+	}
+
+	refMapLock.Lock()
+	for _, key := range removedReferences {
+		delete(referenceMap, key)
+		delete(checkpoint.writtenReferenceMap, key)
+	}
+	refMapLock.Unlock()
+	return result, nil
+}
+
+// checkpointImportedStep writes the state imported for a step by generateStateParallel, so that the resources of the
+// step are not imported again when the export is resumed
+func (ctx *resourceDiscoveryContext) checkpointImportedStep(step resourceDiscoveryStep) error {
+	if ctx.checkpoint == nil {
+		return nil
+	}
+	key := getStepCheckpointKey(step)
+	stateCheckpoint := &checkpointStepState{
+		State:          step.getBaseStep().tempState,
+		ErrorResources: []string{},
+	}
+	for _, resource := range step.getDiscoveredResources() {
+		if resource.isErrorResource {
+			stateCheckpoint.ErrorResources = append(stateCheckpoint.ErrorResources, resource.getTerraformReference())
+		}
+	}
+	if err := writeCheckpointFile(filepath.Join(ctx.checkpoint.dir, checkpointStatesDir, key+".json"), stateCheckpoint); err != nil {
+		return err
+	}
+
+	ctx.checkpoint.lock.Lock()
+	defer ctx.checkpoint.lock.Unlock()
+	ctx.checkpoint.ImportedSteps[key] = true
+	return ctx.writeCheckpoint()
+}
+
+// restoreImportedStep restores the state imported for a step by the export that is resumed, it returns false if the
+// resources of the step were not imported
+func (ctx *resourceDiscoveryContext) restoreImportedStep(step resourceDiscoveryStep) (bool, error) {
+	key := getStepCheckpointKey(step)
+	if !ctx.isResumed() || !ctx.checkpoint.ImportedSteps[key] {
+		return false, nil
+	}
+	stateCheckpoint := &checkpointStepState{}
+	statePath := filepath.Join(ctx.checkpoint.dir, checkpointStatesDir, key+".json")
+	if err := readCheckpointFile(statePath, stateCheckpoint); err != nil {
+		return false, fmt.Errorf("[ERROR] unable to read checkpoint file %s: %s", statePath, err.Error())
+	}
+
+	errorResources := convertStringSliceToSet(stateCheckpoint.ErrorResources, true)
+	for _, resource := range step.getDiscoveredResources() {
+		if errorResources[resource.getTerraformReference()] {
+			resource.isErrorResource = true
+			ctx.ctxLock.Lock()
+			ctx.isImportError = true
+			ctx.ctxLock.Unlock()
+		}
+	}
+	step.getBaseStep().tempState = stateCheckpoint.State
+	utils.Logf("[INFO] restored the state of step %s from checkpoint", step.getBaseStep().name)
+	return true, nil
+}
+
+// removeCheckpoint removes the checkpoint once the export is complete
+func (ctx *resourceDiscoveryContext) removeCheckpoint() {
+	if ctx.checkpoint == nil {
+		return
+	}
+	if err := os.RemoveAll(ctx.checkpoint.dir); err != nil {
+		utils.Logf("[WARN] unable to remove checkpoint directory %s: %s", ctx.checkpoint.dir, err.Error())
+	}
+}
+
+// getStateResourceReferences returns the Terraform references of the managed resources in a state file, if it exists
+func getStateResourceReferences(stateFile string) (map[string]bool, error) {
+	result := map[string]bool{}
+	stateBytes, err := ioutil.ReadFile(stateFile)
+	if os.IsNotExist(err) {
+		return result, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var state terraformStateV4
+	if err := json.Unmarshal(stateBytes, &state); err != nil {
+		return nil, fmt.Errorf("[ERROR] unable to parse state file %s: %s", stateFile, err.Error())
+	}
+	for _, resource := range state.Resources {
+		if resource.Mode == "managed" && len(resource.Instances) > 0 {
+			result[fmt.Sprintf("%s.%s", resource.Type, resource.Name)] = true
+		}
+	}
+	return result, nil
+}
+
+func toCheckpointResources(resources []*OCIResource) []*checkpointResource {
+	result := make([]*checkpointResource, 0, len(resources))
+	for _, resource := range resources {
+		sourceAttributes, _ := toCheckpointValue(resource.sourceAttributes).(map[string]interface{})
+		checkpointRes := &checkpointResource{
+			Id:                         resource.id,
+			ImportId:                   resource.importId,
+			TerraformClass:             resource.terraformClass,
+			TerraformName:              resource.terraformName,
+			TerraformReferenceIdString: resource.terraformReferenceIdString,
+			CompartmentId:              resource.compartmentId,
+			SourceAttributes:           sourceAttributes,
+			OmitFromExport:             resource.omitFromExport,
+			IsSelected:                 resource.isSelected,
+			Region:                     resource.region,
+		}
+		if resource.parent != nil {
+			checkpointRes.ParentId = resource.parent.id
+			checkpointRes.ParentTerraformClass = resource.parent.terraformClass
+			checkpointRes.ParentTerraformName = resource.parent.terraformName
+		}
+		result = append(result, checkpointRes)
+	}
+	return result
+}
+
+/*
+fromCheckpointResources restores the resources of a step
+
+The functions of the resources are restored from the hints of their resource type. The parent of a resource is the
+restored resource with the parent id if any, the parents that were not discovered by the step, e.g. the compartment of
+the step, only have their id and Terraform name.
+*/
+func (ctx *resourceDiscoveryContext) fromCheckpointResources(checkpointResources []*checkpointResource) []*OCIResource {
+	result := make([]*OCIResource, 0, len(checkpointResources))
+	resourcesById := map[string]*OCIResource{}
+	for _, checkpointRes := range checkpointResources {
+		sourceAttributes, _ := fromCheckpointValue(checkpointRes.SourceAttributes).(map[string]interface{})
+		resource := &OCIResource{
+			compartmentId:    checkpointRes.CompartmentId,
+			sourceAttributes: sourceAttributes,
+			getHclStringFn:   getHclStringFromGenericMap,
+			isSelected:       checkpointRes.IsSelected,
+			region:           checkpointRes.Region,
+			TerraformResource: TerraformResource{
+				id:                         checkpointRes.Id,
+				importId:                   checkpointRes.ImportId,
+				terraformClass:             checkpointRes.TerraformClass,
+				terraformName:              checkpointRes.TerraformName,
+				terraformReferenceIdString: checkpointRes.TerraformReferenceIdString,
+				omitFromExport:             checkpointRes.OmitFromExport,
+			},
+		}
+		if hints, err := ctx.getResourceHint(checkpointRes.TerraformClass); err == nil {
+			resource.terraformTypeInfo = hints
+			if hints.getHCLStringOverrideFn != nil {
+				resource.getHclStringFn = hints.getHCLStringOverrideFn
+			}
+		}
+		result = append(result, resource)
+		resourcesById[getManagedResourceKey(resource.terraformClass, resource.id)] = resource
+	}
+
+	for i, checkpointRes := range checkpointResources {
+		if checkpointRes.ParentTerraformClass == "" {
+			continue
+		}
+		if parent, exists := resourcesById[getManagedResourceKey(checkpointRes.ParentTerraformClass, checkpointRes.ParentId)]; exists {
+			result[i].parent = parent
+			continue
+		}
+		result[i].parent = &OCIResource{
+			TerraformResource: TerraformResource{
+				id:             checkpointRes.ParentId,
+				terraformClass: checkpointRes.ParentTerraformClass,
+				terraformName:  checkpointRes.ParentTerraformName,
+			},
+		}
+	}
+	return result
+}
+
+// toCheckpointValue converts the interpolations in the attributes of a resource to maps that can be written to JSON
+func toCheckpointValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case InterpolationString:
+		return map[string]interface{}{
+			interpolationStringKey: map[string]interface{}{
+				"resource_reference": v.resourceReference,
+				"interpolation":      v.interpolation,
+				"value":              v.value,
+			},
+		}
+	case map[string]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[key] = toCheckpointValue(item)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = toCheckpointValue(item)
+		}
+		return result
+	}
+	return value
+}
+
+// fromCheckpointValue restores the interpolations and the numbers in the attributes of a resource
+func fromCheckpointValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case json.Number:
+		if intValue, err := v.Int64(); err == nil {
+			return int(intValue)
+		}
+		floatValue, _ := v.Float64()
+		return floatValue
+	case map[string]interface{}:
+		if interpolation, ok := v[interpolationStringKey].(map[string]interface{}); ok && len(v) == 1 {
+			result := InterpolationString{}
+			result.resourceReference, _ = interpolation["resource_reference"].(string)
+			result.interpolation, _ = interpolation["interpolation"].(string)
+			result.value, _ = interpolation["value"].(string)
+			return result
+		}
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			result[key] = fromCheckpointValue(item)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			result[i] = fromCheckpointValue(item)
+		}
+		return result
+	}
+	return value
+}
@@ -11,6 +11,7 @@ import (
 	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
 	"regexp"
 	"runtime"
 	"runtime/debug"
@@ -26,6 +27,7 @@ import (
 
 	"github.com/hashicorp/terraform-exec/tfinstall"
 
+	"github.com/hashicorp/hcl2/hclwrite"
 	"github.com/hashicorp/terraform-exec/tfexec"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
@@ -99,6 +101,7 @@ func elapsed(what string, step *resourceDiscoveryBaseStep, stage ResourceDiscove
 func init() {
 	resourceNameCount = map[string]int{}
 	vars = map[string]string{}
+	secretVars = map[string]secretVariable{}
 	referenceMap = map[string]string{}
 
 	compartmentScopeServices = make([]string, len(compartmentResourceGraphs))
@@ -219,11 +222,23 @@ type ExportCommandArgs struct {
 	Services                     []string
 	OutputDir                    *string
 	GenerateState                bool
+	GenerateImportBlocks         bool
+	ExistingStateFile            *string
+	Layout                       ExportLayoutEnum
 	TFVersion                    *TfHclVersion
 	RetryTimeout                 *string
 	ExcludeServices              []string
 	IsExportWithRelatedResources bool
 	Parallelism                  int
+	IncludeTags                  []string
+	ExcludeTags                  []string
+	NameRegex                    *string
+	LifecycleStates              []string
+	Regions                      []string
+	IsRecursive                  bool
+	Resume                       bool
+	GenerateInventory            bool
+	ExternalizeSecrets           bool
 }
 
 func RunExportCommand(args *ExportCommandArgs) (err error, status Status) {
@@ -243,6 +258,8 @@ func RunExportCommand(args *ExportCommandArgs) (err error, status Status) {
 	}
 
 	tfHclVersion = *args.TFVersion
+	externalizeSecrets = args.ExternalizeSecrets
+	secretVars = map[string]secretVariable{}
 
 	r := &schema.Resource{
 		Schema: tf_provider.SchemaMap(),
@@ -255,12 +272,25 @@ func RunExportCommand(args *ExportCommandArgs) (err error, status Status) {
 		return err, StatusFail
 	}
 
+	regions := args.getRegions()
+	if len(regions) > 0 {
+		if err := d.Set(globalvar.RegionAttrName, regions[0]); err != nil {
+			return err, StatusFail
+		}
+	}
+
 	clients, err := getExportConfigVar(d)
 	if err != nil {
 		utils.Logln(err.Error())
 		return err, StatusFail
 	}
 
+	regionClients, err := getRegionClients(d, regions)
+	if err != nil {
+		utils.Logln(err.Error())
+		return err, StatusFail
+	}
+
 	if args.CompartmentName != nil && *args.CompartmentName != "" {
 		var err error
 		args.CompartmentId, err = resolveCompartmentId(clients.(*tf_client.OracleClients), args.CompartmentName)
@@ -303,6 +333,7 @@ func RunExportCommand(args *ExportCommandArgs) (err error, status Status) {
 		return err, StatusFail
 	}
 	args.finalizeServices(ctx)
+	ctx.initRegionContexts(regionClients)
 
 	/*
 		Setting retry timeout to a lower value for resource discovery
@@ -380,6 +411,73 @@ func (args *ExportCommandArgs) validate() error {
 		return fmt.Errorf("[ERROR] output_path %s should be a directory", *args.OutputDir)
 	}
 
+	if args.GenerateImportBlocks {
+		if args.GenerateState {
+			return fmt.Errorf("[ERROR] generate_state and generate_import_blocks cannot be specified together")
+		}
+		if args.TFVersion != nil && *args.TFVersion != nil && (*args.TFVersion).toString() == string(TfVersion11) {
+			return fmt.Errorf("[ERROR] generate_import_blocks is not supported with tf_version %s", TfVersion11)
+		}
+	}
+
+	if args.ExternalizeSecrets && args.TFVersion != nil && *args.TFVersion != nil && (*args.TFVersion).toString() == string(TfVersion11) {
+		return fmt.Errorf("[ERROR] externalize_secrets is not supported with tf_version %s", TfVersion11)
+	}
+
+	if args.isMultiRegion() {
+		if args.GenerateState {
+			return fmt.Errorf("[ERROR] generate_state is not supported with more than one region")
+		}
+		if args.Layout == ExportLayoutCompartmentModules {
+			return fmt.Errorf("[ERROR] more than one region is not supported with layout %s", ExportLayoutCompartmentModules)
+		}
+		if len(args.IDs) > 0 {
+			return fmt.Errorf("[ERROR] ids is not supported with more than one region")
+		}
+	}
+	if args.IsRecursive && len(args.IDs) > 0 {
+		return fmt.Errorf("[ERROR] recursive and ids cannot be specified together")
+	}
+
+	if selectors, err := args.getResourceSelectors(); err != nil {
+		return err
+	} else if selectors != nil && len(args.IDs) > 0 {
+		return fmt.Errorf("[ERROR] include_tags, exclude_tags, name_regex and lifecycle_states cannot be specified along with ids")
+	}
+
+	switch args.Layout {
+	case "", ExportLayoutFlat:
+	case ExportLayoutCompartmentModules:
+		if args.GenerateState {
+			return fmt.Errorf("[ERROR] generate_state is not supported with layout %s", ExportLayoutCompartmentModules)
+		}
+		if len(args.IDs) > 0 {
+			return fmt.Errorf("[ERROR] ids is not supported with layout %s", ExportLayoutCompartmentModules)
+		}
+	default:
+		return fmt.Errorf("[ERROR] invalid layout '%s', supported values: %s, %s", args.Layout, ExportLayoutFlat, ExportLayoutCompartmentModules)
+	}
+
+	if args.ExistingStateFile != nil && *args.ExistingStateFile != "" {
+		stateFile, err := os.Stat(*args.ExistingStateFile)
+		if os.IsNotExist(err) {
+			return fmt.Errorf("[ERROR] existing_state_file does not exist: %s", err)
+		}
+		if err != nil {
+			return fmt.Errorf("[ERROR] cannot read existing_state_file %s: %s", *args.ExistingStateFile, err)
+		}
+
+		// Generated files such as vars.tf and provider.tf would overwrite the existing configuration
+		stateDir, _ := filepath.Abs(filepath.Dir(*args.ExistingStateFile))
+		if stateFile.IsDir() {
+			stateDir, _ = filepath.Abs(*args.ExistingStateFile)
+		}
+		outputDir, _ := filepath.Abs(*args.OutputDir)
+		if stateDir == outputDir {
+			return fmt.Errorf("[ERROR] output_path %s should be different from the directory of existing_state_file", *args.OutputDir)
+		}
+	}
+
 	return nil
 }
 
@@ -406,7 +504,7 @@ func getExportConfig(d *schema.ResourceData) (interface{}, error) {
 	}
 
 	// beware: global variable `configureClient` set here--used elsewhere outside this execution path
-	configureClientLocal, err := tf_provider.BuildConfigureClientFn(sdkConfigProvider, httpClient)
+	configureClientLocal, err := tf_provider.BuildConfigureClientFn(sdkConfigProvider, httpClient, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -434,14 +532,26 @@ func runExportCommand(ctx *resourceDiscoveryContext) error {
 	defer ctx.printSummary()
 	exportStart := time.Now()
 	defer elapsed("entire export command", nil, 0)()
+	if err := ctx.initCheckpoint(); err != nil {
+		return err
+	}
 	steps, err := getDiscoverResourceSteps(ctx)
 	if err != nil {
 		return err
 	}
+	// The steps discovered by the export that is resumed are not discovered again
+	restoredSteps, err := ctx.restoreDiscoveredSteps(steps)
+	if err != nil {
+		return err
+	}
 	discoveryStart := time.Now()
 	var discoverWg sync.WaitGroup
 	discoverWg.Add(len(steps))
 	for i, step := range steps {
+		if restoredSteps[i] {
+			discoverWg.Done()
+			continue
+		}
 
 		sem <- struct{}{}
 
@@ -458,13 +568,24 @@ func runExportCommand(ctx *resourceDiscoveryContext) error {
 				discoverWg.Done()
 			}()
 
+			// The step is discovered with a context of its own so that its errors are written to its checkpoint
+			baseStep := step.getBaseStep()
+			regionCtx := ctx.getRegionContext(baseStep.region)
+			stepCtx := regionCtx.newStepContext()
+			baseStep.ctx = stepCtx
 			err := step.discover()
+			baseStep.ctx = ctx
+			for _, rdError := range stepCtx.errorList.errors {
+				regionCtx.addErrorToList(rdError)
+			}
 			if err != nil {
 				// All errors in discover are added to the ctx.errorList
 				utils.Debugf("[ERROR] error occurred while discovering resources for step %d", i)
 				utils.Logf("[ERROR] error occurred while discovering resources: %s", err.Error())
 				return
 			}
+			// Omit the resources that are already managed in the existing state file, if any
+			step.getBaseStep().excludeManagedResources()
 			// Cull any references from the ref map that contain omitted resources
 			// This is to avoid omitted resources from being referenced in generated configs
 			for _, omittedResource := range step.getOmittedResources() {
@@ -478,6 +599,10 @@ func runExportCommand(ctx *resourceDiscoveryContext) error {
 				}
 			}
 
+			if err := ctx.checkpointDiscoveredStep(step, stepCtx.errorList.errors); err != nil {
+				utils.Logf("[WARN] unable to checkpoint the resources discovered for step %d: %s", i, err.Error())
+			}
+
 			utils.Debugf("[DEBUG] discover: Completed step %d", i)
 			utils.Debugf("[DEBUG] discovered %d resources for step %d", len(step.getDiscoveredResources()), i)
 			<-sem
@@ -492,6 +617,21 @@ func runExportCommand(ctx *resourceDiscoveryContext) error {
 	ctx.timeTakenToDiscover = totalDiscoveryTime
 	utils.Debug("[DEBUG] ~~~~~~ discover steps completed ~~~~~~")
 
+	ctx.mergeRegionErrors()
+	if !ctx.isCompartmentModulesLayout() {
+		steps = mergeDiscoverySteps(steps)
+	}
+
+	if ctx.existingState != nil {
+		if err := generateDriftReportFile(ctx, steps); err != nil {
+			return err
+		}
+	}
+
+	if ctx.isCompartmentModulesLayout() {
+		prepareCompartmentModules(ctx, steps)
+	}
+
 	if ctx.GenerateState {
 		stateStart := time.Now()
 		// Run import commands
@@ -575,7 +715,7 @@ func runExportCommand(ctx *resourceDiscoveryContext) error {
 	}
 	vars["region"] = fmt.Sprintf("\"%s\"", region)
 
-	if err := generateProviderFile(ctx.OutputDir); err != nil {
+	if err := generateProviderFile(ctx.OutputDir, ctx.getRegions()); err != nil {
 		return err
 	}
 
@@ -583,6 +723,24 @@ func runExportCommand(ctx *resourceDiscoveryContext) error {
 		return err
 	}
 
+	if ctx.ExternalizeSecrets && len(secretVars) > 0 {
+		if err := generateTfvarsExampleFile(ctx); err != nil {
+			return err
+		}
+	}
+
+	if ctx.isCompartmentModulesLayout() {
+		if err := generateCompartmentModuleFiles(ctx); err != nil {
+			return err
+		}
+	}
+
+	if ctx.GenerateImportBlocks {
+		if err := generateImportFile(ctx); err != nil {
+			return err
+		}
+	}
+
 	if isMissingRequiredAttributes {
 		ctx.summaryStatements = append(ctx.summaryStatements, "")
 		ctx.summaryStatements = append(ctx.summaryStatements, globalvar.MissingRequiredAttributeWarning)
@@ -593,6 +751,14 @@ func runExportCommand(ctx *resourceDiscoveryContext) error {
 	}
 	ctx.timeTakenForEntireExport = time.Since(exportStart)
 	ctx.postValidate()
+
+	// The inventory is generated last so that it has the missing attributes and the errors of the whole export
+	if ctx.GenerateInventory {
+		if err := generateInventoryFile(ctx, steps); err != nil {
+			return err
+		}
+	}
+	ctx.removeCheckpoint()
 	return nil
 }
 
@@ -644,9 +810,16 @@ func generateStateParallel(ctx *resourceDiscoveryContext, steps []resourceDiscov
 				errorChannel <- fmt.Errorf("[ERROR] error writing temp config for resources found: %s", err.Error())
 			}
 
-			// Write temp state file for each service, this step will import resources into a separate state file for each service in parallel
-			if err := step.writeTmpState(); err != nil {
-				errorChannel <- fmt.Errorf("[ERROR] error writing temp state for resources found: %s", err.Error())
+			// The state of the steps imported by the export that is resumed is restored from the checkpoint
+			if restored, err := ctx.restoreImportedStep(step); err != nil {
+				errorChannel <- err
+			} else if !restored {
+				// Write temp state file for each service, this step will import resources into a separate state file for each service in parallel
+				if err := step.writeTmpState(); err != nil {
+					errorChannel <- fmt.Errorf("[ERROR] error writing temp state for resources found: %s", err.Error())
+				} else if err := ctx.checkpointImportedStep(step); err != nil {
+					utils.Logf("[WARN] unable to checkpoint the state imported for step %d: %s", i, err.Error())
+				}
 			}
 
 			utils.Debugf("writing temp config and state: Completed step %d", i)
@@ -752,13 +925,24 @@ func generateState(ctx *resourceDiscoveryContext, steps []resourceDiscoveryStep)
 
 	stateOutputFile := fmt.Sprintf("%s%s%s", *ctx.OutputDir, string(os.PathSeparator), globalvar.DefaultStateFilename)
 	tmpStateOutputFile := fmt.Sprintf("%s%s%s", *ctx.OutputDir, string(os.PathSeparator), globalvar.DefaultTmpStateFile)
-	if err := os.RemoveAll(tmpStateOutputFile); err != nil {
+	// The resources imported by the export that is resumed are kept in the tmp state file
+	importedResources := map[string]bool{}
+	if ctx.isResumed() {
+		var err error
+		if importedResources, err = getStateResourceReferences(tmpStateOutputFile); err != nil {
+			return err
+		}
+	} else if err := os.RemoveAll(tmpStateOutputFile); err != nil {
 		utils.Logf("[WARN] unable to delete existing tmp state file %s", tmpStateOutputFile)
 		return err
 	}
 
 	// Run import for all resources
 	for _, resource := range ctx.discoveredResources {
+		if importedResources[resource.getTerraformReference()] {
+			utils.Logf("[INFO] skip importing '%s' since it was imported before the export was resumed", resource.getTerraformReference())
+			continue
+		}
 		importResource(ctx, resource, tmpStateOutputFile)
 	}
 
@@ -790,10 +974,7 @@ func importResource(ctx *resourceDiscoveryContext, resource *OCIResource, tmpSta
 		return
 	}
 
-	importId := resource.importId
-	if len(importId) == 0 {
-		importId = resource.id
-	}
+	importId := resource.getImportId()
 
 	importArgs := []tfexec.ImportOption{
 		tfexecConfigVar(*ctx.OutputDir),
@@ -854,50 +1035,102 @@ func getDiscoverResourceWithGraphSteps(ctx *resourceDiscoveryContext) ([]resourc
 	}
 	var result []resourceDiscoveryStep
 
-	// Discover tenancy scope resources only if compartmentId is tenancy ocid
-	if *ctx.CompartmentId == ctx.tenancyOcid {
-		tenancyResource := &OCIResource{
-			compartmentId: ctx.tenancyOcid,
-			TerraformResource: TerraformResource{
-				id:             ctx.tenancyOcid,
-				terraformClass: "oci_identity_tenancy",
-				terraformName:  "export",
-			},
+	if ctx.isCompartmentModulesLayout() {
+		compartmentModules, err := getCompartmentModules(ctx)
+		if err != nil {
+			return result, err
 		}
+		ctx.compartmentModules = compartmentModules
+	}
 
-		for _, mode := range ctx.Services {
-			if resourceGraph, exists := tenancyResourceGraphs[mode]; exists {
-				result = append(result, &resourceDiscoveryWithGraph{
-					root:                      tenancyResource,
-					resourceGraph:             resourceGraph,
-					resourceDiscoveryBaseStep: resourceDiscoveryBaseStep{name: mode, ctx: ctx},
-				})
-
-				vars["tenancy_ocid"] = fmt.Sprintf("\"%s\"", ctx.tenancyOcid)
-				referenceMap[ctx.tenancyOcid] = tfHclVersion.getVarHclString("tenancy_ocid")
-			}
+	// Compartment scope resources are discovered for every compartment in the tree when generating compartment modules,
+	// or when the export is recursive
+	compartments := []*compartmentModule{{compartmentId: *ctx.CompartmentId}}
+	if ctx.isCompartmentModulesLayout() {
+		compartments = ctx.compartmentModules
+	} else if ctx.IsRecursive {
+		compartmentTree, err := getCompartmentModules(ctx)
+		if err != nil {
+			return result, err
 		}
+		compartments = compartmentTree
+	}
+	ctx.discoveredCompartments = map[string]bool{}
+	for _, compartment := range compartments {
+		ctx.discoveredCompartments[compartment.compartmentId] = true
 	}
 
-	compartmentResource := &OCIResource{
-		compartmentId: *ctx.CompartmentId,
-		TerraformResource: TerraformResource{
-			id:             *ctx.CompartmentId,
-			terraformClass: "oci_identity_compartment",
-			terraformName:  "export",
-		},
+	// The steps of each region are named after the region when exporting several regions, so that the resources of a
+	// service are generated in a file per region
+	regions := []string{""}
+	if ctx.isMultiRegion() {
+		regions = ctx.getRegions()
 	}
 
-	for _, mode := range ctx.Services {
-		if resourceGraph, exists := compartmentResourceGraphs[mode]; exists {
-			result = append(result, &resourceDiscoveryWithGraph{
-				root:                      compartmentResource,
-				resourceGraph:             resourceGraph,
-				resourceDiscoveryBaseStep: resourceDiscoveryBaseStep{name: mode, ctx: ctx},
-			})
+	for idx, region := range regions {
+		stepName := func(mode string) string {
+			if region == "" {
+				return mode
+			}
+			return fmt.Sprintf("%s_%s", mode, region)
+		}
+
+		// Discover tenancy scope resources only if compartmentId is tenancy ocid, the tenancy scope resources are not
+		// regional and are only discovered in the first region
+		if *ctx.CompartmentId == ctx.tenancyOcid && idx == 0 {
+			tenancyResource := &OCIResource{
+				compartmentId: ctx.tenancyOcid,
+				TerraformResource: TerraformResource{
+					id:             ctx.tenancyOcid,
+					terraformClass: "oci_identity_tenancy",
+					terraformName:  "export",
+				},
+			}
+
+			for _, mode := range ctx.Services {
+				if resourceGraph, exists := tenancyResourceGraphs[mode]; exists {
+					result = append(result, &resourceDiscoveryWithGraph{
+						root:                      tenancyResource,
+						resourceGraph:             resourceGraph,
+						resourceDiscoveryBaseStep: resourceDiscoveryBaseStep{name: stepName(mode), ctx: ctx, moduleName: ctx.getRootModuleName(), region: region},
+					})
+
+					vars["tenancy_ocid"] = fmt.Sprintf("\"%s\"", ctx.tenancyOcid)
+					referenceMap[ctx.tenancyOcid] = tfHclVersion.getVarHclString("tenancy_ocid")
+				}
+			}
+		}
+
+		for _, compartment := range compartments {
+			compartmentResource := &OCIResource{
+				compartmentId: compartment.compartmentId,
+				TerraformResource: TerraformResource{
+					id:             compartment.compartmentId,
+					terraformClass: "oci_identity_compartment",
+					terraformName:  "export",
+				},
+			}
 
-			vars["compartment_ocid"] = fmt.Sprintf("\"%s\"", *ctx.CompartmentId)
-			referenceMap[*ctx.CompartmentId] = tfHclVersion.getVarHclString("compartment_ocid")
+			moduleName := ""
+			if ctx.isCompartmentModulesLayout() {
+				moduleName = compartment.name
+			}
+
+			for _, mode := range ctx.Services {
+				if globalServices[mode] && idx > 0 {
+					continue
+				}
+				if resourceGraph, exists := compartmentResourceGraphs[mode]; exists {
+					result = append(result, &resourceDiscoveryWithGraph{
+						root:                      compartmentResource,
+						resourceGraph:             resourceGraph,
+						resourceDiscoveryBaseStep: resourceDiscoveryBaseStep{name: stepName(mode), ctx: ctx, moduleName: moduleName, region: region},
+					})
+
+					vars["compartment_ocid"] = fmt.Sprintf("\"%s\"", *ctx.CompartmentId)
+					referenceMap[*ctx.CompartmentId] = tfHclVersion.getVarHclString("compartment_ocid")
+				}
+			}
 		}
 	}
 
@@ -956,20 +1189,19 @@ func findResources(ctx *resourceDiscoveryContext, root *OCIResource, resourceGra
 			for _, resource := range results {
 				//referenceMap[resource.id] = resource.getHclReferenceIdString()
 				if ctx.expectedResourceIds != nil && len(ctx.expectedResourceIds) > 0 {
-					if _, shouldExport := ctx.expectedResourceIds[resource.id]; shouldExport {
+					if shouldExport := ctx.setExpectedResourceIdFound(resource.id); shouldExport {
 						resource.omitFromExport = false
-						ctx.ctxLock.Lock()
-						ctx.expectedResourceIds[resource.id] = true
-						ctx.ctxLock.Unlock()
 					} else {
 						resource.omitFromExport = !childType.alwaysExportable
 					}
 				}
+				ctx.selectResource(resource, childType.alwaysExportable)
 
 				subResources, err := findResources(ctx, resource, resourceGraph)
 				if err != nil {
 					continue
 				}
+				ctx.selectParent(resource, subResources)
 				foundResources = append(foundResources, subResources...)
 			}
 		}()
@@ -987,7 +1219,9 @@ func generateVarsFile(vars map[string]string, outputDir *string) error {
 	}
 
 	for variable, defaultVal := range vars {
-		if defaultVal != "" {
+		if _, isSecret := secretVars[variable]; isSecret {
+			_, _ = file.WriteString(getSecretVariableHclString(variable, defaultVal))
+		} else if defaultVal != "" {
 			_, _ = file.WriteString(fmt.Sprintf("variable %s { default = %s }\n", variable, defaultVal))
 		} else {
 			_, _ = file.WriteString(fmt.Sprintf("variable %s {}\n", variable))
@@ -1005,7 +1239,72 @@ func generateVarsFile(vars map[string]string, outputDir *string) error {
 	return nil
 }
 
-func generateProviderFile(outputDir *string) error {
+/*
+generateImportFile writes Terraform 1.5+ `import` blocks for all the exported resources
+This allows the discovered resources to be adopted with a single `terraform plan` instead of importing them one by one
+*/
+func generateImportFile(ctx *resourceDiscoveryContext) error {
+	importTmpFile := fmt.Sprintf("%s%s%s.tmp", *ctx.OutputDir, string(os.PathSeparator), globalvar.ImportFile)
+	importOutputFile := fmt.Sprintf("%s%s%s", *ctx.OutputDir, string(os.PathSeparator), globalvar.ImportFile)
+	file, err := os.OpenFile(importTmpFile, os.O_TRUNC|os.O_CREATE|os.O_RDWR, 0666)
+	if err != nil {
+		return err
+	}
+
+	// Sort the resources so that the generated import blocks do not depend on the order in which steps completed
+	resources := make([]*OCIResource, len(ctx.discoveredResources))
+	copy(resources, ctx.discoveredResources)
+	sort.SliceStable(resources, func(i, j int) bool {
+		return resources[i].getTerraformAddress() < resources[j].getTerraformAddress()
+	})
+
+	builder := &strings.Builder{}
+	builder.WriteString("## This configuration was generated by terraform-provider-oci\n")
+	builder.WriteString("## Import blocks require Terraform v1.5.0 and above\n\n")
+	importCount := 0
+	for _, resource := range resources {
+		if resource.isErrorResource || (resource.terraformTypeInfo != nil && resource.terraformTypeInfo.isDataSource) {
+			continue
+		}
+
+		resourceDefinition, exists := resourcesMap[resource.terraformClass]
+		if !exists {
+			utils.Debugf("[DEBUG] skip writing import block for '%s' since it is not a Terraform OCI resource", resource.getTerraformReference())
+			continue
+		}
+
+		if resourceDefinition.Importer == nil {
+			utils.Logf("[WARN] unable to write import block for '%s' because import is not supported for '%s'", resource.getTerraformReference(), resource.terraformClass)
+			continue
+		}
+
+		if resource.region != "" {
+			builder.WriteString(fmt.Sprintf("import {\nto = %s\nid = %q\nprovider = oci.%s\n}\n\n", resource.getTerraformAddress(), resource.getImportId(), resource.region))
+		} else {
+			builder.WriteString(fmt.Sprintf("import {\nto = %s\nid = %q\n}\n\n", resource.getTerraformAddress(), resource.getImportId()))
+		}
+		importCount++
+	}
+
+	_, err = file.Write(hclwrite.Format([]byte(builder.String())))
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if fErr := file.Close(); fErr != nil {
+		return fErr
+	}
+
+	if err := os.Rename(importTmpFile, importOutputFile); err != nil {
+		return err
+	}
+
+	ctx.summaryStatements = append(ctx.summaryStatements, fmt.Sprintf("Generated %d import blocks under '%s'", importCount, importOutputFile))
+	return nil
+}
+
+func generateProviderFile(outputDir *string, regions []string) error {
 	providerTmpFile := fmt.Sprintf("%s%s%s.tmp", *outputDir, string(os.PathSeparator), globalvar.ProviderFile)
 	providerOutputFile := fmt.Sprintf("%s%s%s", *outputDir, string(os.PathSeparator), globalvar.ProviderFile)
 	file, err := os.OpenFile(providerTmpFile, os.O_CREATE|os.O_RDWR, 0666)
@@ -1013,7 +1312,7 @@ func generateProviderFile(outputDir *string) error {
 		return err
 	}
 
-	_, err = file.WriteString(fmt.Sprintf("provider oci {\n\tregion = %s\n}\n", tfHclVersion.getVarHclString("region")))
+	_, err = file.WriteString(getProviderHclString(regions))
 	if err != nil {
 		_ = file.Close()
 		return err
@@ -1038,6 +1337,9 @@ type OCIResource struct {
 	getHclStringFn   func(*strings.Builder, *OCIResource, map[string]string) error
 	parent           *OCIResource
 	isErrorResource  bool
+	isSelected       bool   // the resource matches the selectors of the export command, see resourceSelectors
+	region           string // region of the resource when exporting several regions, it is the alias of its provider
+	moduleName       string // name of the compartment module the resource is generated in, empty for the flat layout
 }
 
 type TerraformResource struct {
@@ -1057,10 +1359,26 @@ func (tr *TerraformResource) getHclReferenceIdString() string {
 	return tfHclVersion.getDoubleExpHclString(tr.getTerraformReference(), "id")
 }
 
+// getImportId returns the ID to be used to import the resource, composite import IDs take precedence over the resource ID
+func (tr *TerraformResource) getImportId() string {
+	if len(tr.importId) > 0 {
+		return tr.importId
+	}
+	return tr.id
+}
+
 func (tr *TerraformResource) getTerraformReference() string {
 	return fmt.Sprintf("%s.%s", tr.terraformClass, tr.terraformName)
 }
 
+// getTerraformAddress returns the address of the resource from the root module
+func (resource *OCIResource) getTerraformAddress() string {
+	if resource.moduleName != "" {
+		return fmt.Sprintf("module.%s.%s", resource.moduleName, resource.getTerraformReference())
+	}
+	return resource.getTerraformReference()
+}
+
 func getHCLStringFromMap(builder *strings.Builder, sourceAttributes map[string]interface{}, resourceSchema *schema.Resource, interpolationMap map[string]string, ociRes *OCIResource, attributePrefix string) error {
 	sortedKeys := make([]string, len(resourceSchema.Schema))
 	cnt := 0
@@ -1076,6 +1394,12 @@ func getHCLStringFromMap(builder *strings.Builder, sourceAttributes map[string]i
 			continue
 		}
 
+		if externalizeSecrets && isSensitiveAttribute(tfSchema) {
+			attributeVal, exists := sourceAttributes[tfAttribute]
+			writeSecretAttribute(builder, tfSchema, tfAttribute, exists && attributeVal != nil, ociRes, attributePrefix)
+			continue
+		}
+
 		if attributeVal, exists := sourceAttributes[tfAttribute]; exists {
 			switch v := attributeVal.(type) {
 			case InterpolationString:
@@ -1223,6 +1547,7 @@ func getHCLStringFromMap(builder *strings.Builder, sourceAttributes map[string]i
 			if ociRes.terraformTypeInfo.defaultValuesForMissingAttributes == nil {
 				ociRes.terraformTypeInfo.defaultValuesForMissingAttributes = make(map[string]interface{})
 			}
+
 			if tfAttributeVal, exists := ociRes.terraformTypeInfo.defaultValuesForMissingAttributes[tfAttribute]; exists {
 				builder.WriteString(fmt.Sprintf("%s = %q", tfAttribute, tfAttributeVal))
 			} else {
@@ -1293,6 +1618,9 @@ var getHclStringFromGenericMap = func(builder *strings.Builder, ociRes *OCIResou
 	resourceSchema := resourcesMap[ociRes.terraformClass]
 
 	builder.WriteString(fmt.Sprintf("resource %s %s {\n", ociRes.terraformClass, ociRes.terraformName))
+	if ociRes.region != "" {
+		builder.WriteString(fmt.Sprintf("provider = oci.%s\n", ociRes.region))
+	}
 	if err := getHCLStringFromMap(builder, ociRes.sourceAttributes, resourceSchema, interpolationMap, ociRes, ""); err != nil {
 		return err
 	}
@@ -1860,6 +2188,11 @@ func deleteInvalidReferences(referenceMap map[string]string, discoveredResources
 	Terraform struct will later be copied to each resource discovery step for parallel runs
 */
 func createTerraformStruct(args *ExportCommandArgs) (*tfexec.Terraform, string, error) {
+	return createTerraformStructInDir(args, *args.OutputDir)
+}
+
+// createTerraformStructInDir initializes the Terraform struct running the commands in the given working directory
+func createTerraformStructInDir(args *ExportCommandArgs, workingDir string) (*tfexec.Terraform, string, error) {
 
 	utils.Logln("[INFO] validating Terraform CLI")
 	var err error
@@ -1886,7 +2219,7 @@ func createTerraformStruct(args *ExportCommandArgs) (*tfexec.Terraform, string,
 
 	// Initialize Terraform struct from executable provided
 	// Setting the global var 'tf' here, will be later using while running terraform init and import
-	tf, err := tfexec.NewTerraform(*args.OutputDir, terraformBinPath)
+	tf, err := tfexec.NewTerraform(workingDir, terraformBinPath)
 	if err != nil {
 		return nil, terraformBinPath, err
 	}
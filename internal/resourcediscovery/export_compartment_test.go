@@ -5,8 +5,10 @@ package resourcediscovery
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"os"
 	"path"
@@ -20,6 +22,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-exec/tfexec"
+	tfjson "github.com/hashicorp/terraform-json"
 	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
 
 	tf_client "github.com/terraform-providers/terraform-provider-oci/internal/client"
@@ -885,6 +888,1261 @@ func TestUnitRunExportCommand_Parallel(t *testing.T) {
 	os.RemoveAll(outputDir)
 }
 
+// Test that RunExportCommand generates import blocks for the discovered resources
+// issue-routing-tag: terraform/default
+func TestUnitRunExportCommand_importBlocks(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	compartmentId := resourceDiscoveryTestCompartmentOcid
+	if err := os.Setenv("export_tenancy_id", resourceDiscoveryTestTenancyOcid); err != nil {
+		t.Logf("unable to set export_tenancy_id. err: %v", err)
+		t.Fail()
+	}
+	outputDir, err := os.Getwd()
+	outputDir = fmt.Sprintf("%s%sdiscoveryTest-%d", outputDir, string(os.PathSeparator), time.Now().Nanosecond())
+	if err = os.Mkdir(outputDir, os.ModePerm); err != nil {
+		t.Logf("unable to mkdir %s. err: %v", outputDir, err)
+		t.Fail()
+	}
+	defer os.RemoveAll(outputDir)
+
+	tfHclVersion = &TfHclVersion12{}
+	args := &ExportCommandArgs{
+		CompartmentId:        &compartmentId,
+		Services:             []string{"compartment_testing"},
+		OutputDir:            &outputDir,
+		GenerateImportBlocks: true,
+		TFVersion:            &tfHclVersion,
+		Parallelism:          1,
+	}
+	getProviderEnvSettingWithDefaultVar = func(varName string, defaultValue string) string {
+		return defaultValue
+	}
+	getEnvSettingWithBlankDefaultVar = func(varName string) string {
+		return resourceDiscoveryTestTenancyOcid
+	}
+	getExportConfigVar = func(d *schema.ResourceData) (interface{}, error) {
+		return getTestClients(), nil
+	}
+	exportConfigProvider = acctest.MockConfigurationProvider{}
+	if err, _ = RunExportCommand(args); err != nil {
+		t.Logf("export command failed due to err: %v", err)
+		t.Fail()
+	}
+
+	importFile := fmt.Sprintf("%s%s%s", outputDir, string(os.PathSeparator), globalvar.ImportFile)
+	importBytes, err := ioutil.ReadFile(importFile)
+	if err != nil {
+		t.Logf("no %s file generated. err: %v", globalvar.ImportFile, err)
+		t.FailNow()
+	}
+	importConfig := string(importBytes)
+
+	for i := 0; i < len(parentResources); i++ {
+		assert.Contains(t, importConfig, fmt.Sprintf("id = %q", getTestResourceId("parent", i)))
+	}
+	for i := 0; i < len(childrenResources); i++ {
+		assert.Contains(t, importConfig, fmt.Sprintf("id = %q", getTestResourceId("child", i)))
+	}
+	assert.Contains(t, importConfig, "to = oci_test_parent.")
+	assert.Contains(t, importConfig, "to = oci_test_child.")
+
+	if _, err = os.Stat(fmt.Sprintf("%s%sterraform.tfstate", outputDir, string(os.PathSeparator))); !os.IsNotExist(err) {
+		t.Logf("found terraform.tfstate even though it wasn't expected")
+		t.Fail()
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitExportCommandArgs_validateImportBlocks(t *testing.T) {
+	outputDir, err := createOutputDir()
+	if err != nil {
+		t.FailNow()
+	}
+	defer os.RemoveAll(outputDir)
+
+	var tfVersion11 TfHclVersion = &TfHclVersion11{}
+	var tfVersion12 TfHclVersion = &TfHclVersion12{}
+
+	args := &ExportCommandArgs{
+		OutputDir:            &outputDir,
+		GenerateImportBlocks: true,
+		TFVersion:            &tfVersion12,
+	}
+	assert.NoError(t, args.validate())
+
+	args.GenerateState = true
+	assert.EqualError(t, args.validate(), "[ERROR] generate_state and generate_import_blocks cannot be specified together")
+
+	args.GenerateState = false
+	args.TFVersion = &tfVersion11
+	assert.EqualError(t, args.validate(), "[ERROR] generate_import_blocks is not supported with tf_version 0.11")
+}
+
+func writeTestExistingStateFile(stateFile string) error {
+	state := fmt.Sprintf(`{
+	"version": 4,
+	"resources": [
+		{
+			"mode": "managed",
+			"type": "oci_test_parent",
+			"name": "managed_parent",
+			"instances": [
+				{"attributes": {"id": %q, "compartment_id": %q}},
+				{"index_key": 1, "attributes": {"id": "ocid1.parent.abcdefghiklmnop.99", "compartment_id": %q}},
+				{"index_key": 2, "attributes": {"id": "ocid1.parent.abcdefghiklmnop.98", "compartment_id": "ocid1.othercompartment.abc"}}
+			]
+		},
+		{
+			"module": "module.children",
+			"mode": "managed",
+			"type": "oci_test_child",
+			"name": "managed_child",
+			"instances": [
+				{"index_key": "first", "attributes": {"id": %q}}
+			]
+		},
+		{
+			"mode": "managed",
+			"type": "oci_core_vcn",
+			"name": "managed_vcn",
+			"instances": [
+				{"attributes": {"id": "ocid1.vcn.abcdefghiklmnop.0", "compartment_id": %q}}
+			]
+		},
+		{
+			"mode": "data",
+			"type": "oci_test_parents",
+			"name": "parents",
+			"instances": [
+				{"attributes": {"id": "parents"}}
+			]
+		}
+	]
+}`, getTestResourceId("parent", 0), resourceDiscoveryTestCompartmentOcid, resourceDiscoveryTestCompartmentOcid, getTestResourceId("child", 0), resourceDiscoveryTestCompartmentOcid)
+	return ioutil.WriteFile(stateFile, []byte(state), 0644)
+}
+
+// Test that RunExportCommand only exports resources that are not managed in the existing state file and reports the missing ones
+// issue-routing-tag: terraform/default
+func TestUnitRunExportCommand_existingState(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	compartmentId := resourceDiscoveryTestCompartmentOcid
+	if err := os.Setenv("export_tenancy_id", resourceDiscoveryTestTenancyOcid); err != nil {
+		t.Logf("unable to set export_tenancy_id. err: %v", err)
+		t.Fail()
+	}
+	outputDir, err := os.Getwd()
+	outputDir = fmt.Sprintf("%s%sdiscoveryTest-%d", outputDir, string(os.PathSeparator), time.Now().Nanosecond())
+	if err = os.Mkdir(outputDir, os.ModePerm); err != nil {
+		t.Logf("unable to mkdir %s. err: %v", outputDir, err)
+		t.Fail()
+	}
+	defer os.RemoveAll(outputDir)
+
+	stateDir, err := ioutil.TempDir("", "existingState")
+	if err != nil {
+		t.Logf("unable to create existing state directory. err: %v", err)
+		t.FailNow()
+	}
+	defer os.RemoveAll(stateDir)
+	stateFile := fmt.Sprintf("%s%s%s", stateDir, string(os.PathSeparator), globalvar.DefaultStateFilename)
+	if err = writeTestExistingStateFile(stateFile); err != nil {
+		t.Logf("unable to write existing state file. err: %v", err)
+		t.FailNow()
+	}
+
+	tfHclVersion = &TfHclVersion12{}
+	args := &ExportCommandArgs{
+		CompartmentId:        &compartmentId,
+		Services:             []string{"compartment_testing"},
+		OutputDir:            &outputDir,
+		GenerateImportBlocks: true,
+		ExistingStateFile:    &stateFile,
+		TFVersion:            &tfHclVersion,
+		Parallelism:          1,
+	}
+	getProviderEnvSettingWithDefaultVar = func(varName string, defaultValue string) string {
+		return defaultValue
+	}
+	getEnvSettingWithBlankDefaultVar = func(varName string) string {
+		return resourceDiscoveryTestTenancyOcid
+	}
+	getExportConfigVar = func(d *schema.ResourceData) (interface{}, error) {
+		return getTestClients(), nil
+	}
+	exportConfigProvider = acctest.MockConfigurationProvider{}
+	if err, _ = RunExportCommand(args); err != nil {
+		t.Logf("export command failed due to err: %v", err)
+		t.FailNow()
+	}
+
+	importBytes, err := ioutil.ReadFile(fmt.Sprintf("%s%s%s", outputDir, string(os.PathSeparator), globalvar.ImportFile))
+	if err != nil {
+		t.Logf("no %s file generated. err: %v", globalvar.ImportFile, err)
+		t.FailNow()
+	}
+	importConfig := string(importBytes)
+	assert.NotContains(t, importConfig, fmt.Sprintf("id = %q", getTestResourceId("parent", 0)))
+	assert.NotContains(t, importConfig, fmt.Sprintf("id = %q", getTestResourceId("child", 0)))
+	for i := 1; i < len(parentResources); i++ {
+		assert.Contains(t, importConfig, fmt.Sprintf("id = %q", getTestResourceId("parent", i)))
+	}
+	for i := 1; i < len(childrenResources); i++ {
+		assert.Contains(t, importConfig, fmt.Sprintf("id = %q", getTestResourceId("child", i)))
+	}
+
+	reportBytes, err := ioutil.ReadFile(fmt.Sprintf("%s%s%s", outputDir, string(os.PathSeparator), globalvar.DriftReportFile))
+	if err != nil {
+		t.Logf("no %s file generated. err: %v", globalvar.DriftReportFile, err)
+		t.FailNow()
+	}
+	var report DriftReport
+	if err = json.Unmarshal(reportBytes, &report); err != nil {
+		t.Logf("unable to parse drift report. err: %v", err)
+		t.FailNow()
+	}
+	assert.Equal(t, stateFile, report.StateFile)
+	assert.Equal(t, []*ManagedResource{
+		{Address: "oci_test_parent.managed_parent", Type: "oci_test_parent", Id: getTestResourceId("parent", 0)},
+		{Address: `module.children.oci_test_child.managed_child["first"]`, Type: "oci_test_child", Id: getTestResourceId("child", 0)},
+	}, report.ManagedResources)
+	assert.Equal(t, []*ManagedResource{
+		{Address: "oci_test_parent.managed_parent[1]", Type: "oci_test_parent", Id: "ocid1.parent.abcdefghiklmnop.99"},
+	}, report.MissingResources)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitExportCommandArgs_validateExistingState(t *testing.T) {
+	outputDir, err := createOutputDir()
+	if err != nil {
+		t.FailNow()
+	}
+	defer os.RemoveAll(outputDir)
+
+	missingStateFile := fmt.Sprintf("%s%smissing.tfstate", outputDir, string(os.PathSeparator))
+	args := &ExportCommandArgs{
+		OutputDir:         &outputDir,
+		ExistingStateFile: &missingStateFile,
+	}
+	assert.Error(t, args.validate())
+
+	// A configuration directory can not be the output_path
+	args.ExistingStateFile = &outputDir
+	assert.EqualError(t, args.validate(), fmt.Sprintf("[ERROR] output_path %s should be different from the directory of existing_state_file", outputDir))
+	configDir := fmt.Sprintf("%s%sconfig", outputDir, string(os.PathSeparator))
+	if err = os.Mkdir(configDir, os.ModePerm); err != nil {
+		t.FailNow()
+	}
+	args.ExistingStateFile = &configDir
+	assert.NoError(t, args.validate())
+
+	stateFile := fmt.Sprintf("%s%s%s", outputDir, string(os.PathSeparator), globalvar.DefaultStateFilename)
+	if err = writeTestExistingStateFile(stateFile); err != nil {
+		t.FailNow()
+	}
+	args.ExistingStateFile = &stateFile
+	assert.EqualError(t, args.validate(), fmt.Sprintf("[ERROR] output_path %s should be different from the directory of existing_state_file", outputDir))
+
+	// The path cannot be read when a parent is a file
+	nestedStateFile := fmt.Sprintf("%s%s%s", stateFile, string(os.PathSeparator), globalvar.DefaultStateFilename)
+	args.ExistingStateFile = &nestedStateFile
+	assert.Error(t, args.validate())
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitLoadExistingState(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	outputDir, err := createOutputDir()
+	if err != nil {
+		t.FailNow()
+	}
+	defer os.RemoveAll(outputDir)
+
+	stateFile := fmt.Sprintf("%s%s%s", outputDir, string(os.PathSeparator), globalvar.DefaultStateFilename)
+	if err = writeTestExistingStateFile(stateFile); err != nil {
+		t.FailNow()
+	}
+	state, err := loadExistingState(stateFile)
+	if err != nil {
+		t.Logf("unable to load existing state. err: %v", err)
+		t.FailNow()
+	}
+	// data sources are not managed resources
+	assert.Equal(t, 5, len(state.resources))
+
+	managedResource, isManaged := state.getManagedResource(&OCIResource{TerraformResource: TerraformResource{id: getTestResourceId("parent", 0), terraformClass: "oci_test_parent"}})
+	assert.True(t, isManaged)
+	assert.Equal(t, "oci_test_parent.managed_parent", managedResource.Address)
+
+	_, isManaged = state.getManagedResource(&OCIResource{TerraformResource: TerraformResource{id: getTestResourceId("parent", 0), terraformClass: "oci_test_child"}})
+	assert.False(t, isManaged)
+
+	if err = ioutil.WriteFile(stateFile, []byte(`{"version": 3, "modules": []}`), 0644); err != nil {
+		t.FailNow()
+	}
+	_, err = loadExistingState(stateFile)
+	assert.EqualError(t, err, fmt.Sprintf("[ERROR] existing state file %s has unsupported version 3, only version 4 is supported", stateFile))
+}
+
+// Test that the managed resources are read from the state of a configuration directory
+// issue-routing-tag: terraform/default
+func TestUnitGetExistingState_configurationDirectory(t *testing.T) {
+	configDir, err := createOutputDir()
+	if err != nil {
+		t.FailNow()
+	}
+	defer os.RemoveAll(configDir)
+
+	originalShowConfigurationState := showConfigurationStateVar
+	defer func() { showConfigurationStateVar = originalShowConfigurationState }()
+	var shownDir string
+	showConfigurationStateVar = func(args *ExportCommandArgs, dir string) (*tfjson.State, error) {
+		shownDir = dir
+		return &tfjson.State{
+			Values: &tfjson.StateValues{
+				RootModule: &tfjson.StateModule{
+					Resources: []*tfjson.StateResource{
+						{Address: "oci_test_parent.managed_parent", Mode: tfjson.ManagedResourceMode, Type: "oci_test_parent", AttributeValues: map[string]interface{}{"id": getTestResourceId("parent", 0), "compartment_id": resourceDiscoveryTestCompartmentOcid}},
+						{Address: "data.oci_test_parents.parents", Mode: tfjson.DataResourceMode, Type: "oci_test_parents", AttributeValues: map[string]interface{}{"id": "parents"}},
+					},
+					ChildModules: []*tfjson.StateModule{{
+						Address: "module.children",
+						Resources: []*tfjson.StateResource{
+							{Address: `module.children.oci_test_child.managed_child["first"]`, Mode: tfjson.ManagedResourceMode, Type: "oci_test_child", AttributeValues: map[string]interface{}{"id": getTestResourceId("child", 0)}},
+						},
+					}},
+				},
+			},
+		}, nil
+	}
+
+	state, err := getExistingState(&ExportCommandArgs{ExistingStateFile: &configDir})
+	if err != nil {
+		t.Logf("unable to load existing state. err: %v", err)
+		t.FailNow()
+	}
+	assert.Equal(t, configDir, shownDir)
+	assert.Equal(t, []*ManagedResource{
+		{Address: "oci_test_parent.managed_parent", Type: "oci_test_parent", Id: getTestResourceId("parent", 0), compartmentId: resourceDiscoveryTestCompartmentOcid},
+		{Address: `module.children.oci_test_child.managed_child["first"]`, Type: "oci_test_child", Id: getTestResourceId("child", 0)},
+	}, state.resources)
+
+	showConfigurationStateVar = func(args *ExportCommandArgs, dir string) (*tfjson.State, error) {
+		return nil, fmt.Errorf("backend initialization required, please run \"terraform init\"")
+	}
+	_, err = getExistingState(&ExportCommandArgs{ExistingStateFile: &configDir})
+	assert.EqualError(t, err, fmt.Sprintf("[ERROR] unable to read the state of the configuration directory %s: backend initialization required, please run \"terraform init\"", configDir))
+}
+
+// Test that the managed resources of every exported compartment of the tree are reported missing
+// issue-routing-tag: terraform/default
+func TestUnitGetDriftReport_compartmentTree(t *testing.T) {
+	childCompartmentId := "ocid1.testchildcompartment.abc"
+	otherCompartmentId := "ocid1.testothercompartment.abc"
+	stateFile := "terraform.tfstate"
+	compartmentId := resourceDiscoveryTestCompartmentOcid
+	missingRoot := &ManagedResource{Address: "oci_test_parent.root", Type: "oci_test_parent", Id: "ocid1.parent.root", compartmentId: compartmentId}
+	missingChild := &ManagedResource{Address: "oci_test_parent.child", Type: "oci_test_parent", Id: "ocid1.parent.child", compartmentId: childCompartmentId}
+	other := &ManagedResource{Address: "oci_test_parent.other", Type: "oci_test_parent", Id: "ocid1.parent.other", compartmentId: otherCompartmentId}
+
+	ctx := &resourceDiscoveryContext{
+		ExportCommandArgs: &ExportCommandArgs{
+			CompartmentId:     &compartmentId,
+			ExistingStateFile: &stateFile,
+		},
+		existingState: &existingState{resources: []*ManagedResource{missingChild, other, missingRoot}},
+	}
+	steps := []resourceDiscoveryStep{
+		&resourceDiscoveryWithGraph{
+			resourceGraph: TerraformResourceGraph{
+				"oci_identity_compartment": {{TerraformResourceHints: &TerraformResourceHints{resourceClass: "oci_test_parent"}}},
+			},
+			resourceDiscoveryBaseStep: resourceDiscoveryBaseStep{ctx: ctx},
+		},
+	}
+
+	// Only the exported compartment is discovered
+	assert.Equal(t, []*ManagedResource{missingRoot}, getDriftReport(ctx, steps).MissingResources)
+
+	// The compartments of the tree are discovered when the export is recursive
+	ctx.discoveredCompartments = map[string]bool{compartmentId: true, childCompartmentId: true}
+	assert.Equal(t, []*ManagedResource{missingChild, missingRoot}, getDriftReport(ctx, steps).MissingResources)
+}
+
+func listTestCompartmentParents(d *schema.ResourceData, m interface{}) error {
+	results := []interface{}{}
+	modifyParentLock.Lock()
+	for i := 0; i < len(parentResources); i++ {
+		id := getTestResourceId("parent", i)
+		resource := parentResources[id]
+		resource["id"] = id
+		if resource["compartment_id"] == d.Get("compartment_id") {
+			results = append(results, resource)
+		}
+	}
+	d.Set("items", results)
+	modifyParentLock.Unlock()
+	return nil
+}
+
+// Test that RunExportCommand generates a module for each compartment in the tree and wires cross-compartment references through the root module
+// issue-routing-tag: terraform/default
+func TestUnitRunExportCommand_compartmentModules(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+
+	childCompartmentId := "ocid1.testchildcompartment.abc"
+	rootCompartmentName := "root-compartment"
+	childCompartmentName := "child-compartment"
+
+	// Parents 0 and 1 are in the root compartment, parents 2 and 3 are in the child compartment
+	// Parent 2 references parent 0 which is in another compartment
+	originalParentResources := parentResources
+	parentResources = map[string]map[string]interface{}{}
+	for id, resource := range originalParentResources {
+		copyResource := map[string]interface{}{}
+		for key, value := range resource {
+			copyResource[key] = value
+		}
+		parentResources[id] = copyResource
+	}
+	defer func() { parentResources = originalParentResources }()
+	parentResources[getTestResourceId("parent", 0)]["compartment_id"] = resourceDiscoveryTestCompartmentOcid
+	parentResources[getTestResourceId("parent", 1)]["compartment_id"] = resourceDiscoveryTestCompartmentOcid
+	parentResources[getTestResourceId("parent", 2)]["compartment_id"] = childCompartmentId
+	parentResources[getTestResourceId("parent", 3)]["compartment_id"] = childCompartmentId
+	parentResources[getTestResourceId("parent", 2)]["a_string"] = getTestResourceId("parent", 0)
+	parentResources[getTestResourceId("parent", 3)]["a_string"] = resourceDiscoveryTestCompartmentOcid
+
+	datasourcesMap["oci_test_compartment_parents"] = &schema.Resource{
+		Read:   listTestCompartmentParents,
+		Schema: testParentsDatasource().Schema,
+	}
+	defer delete(datasourcesMap, "oci_test_compartment_parents")
+	compartmentResourceGraphs["compartment_modules_testing"] = TerraformResourceGraph{
+		"oci_identity_compartment": {
+			{
+				TerraformResourceHints: &TerraformResourceHints{
+					resourceClass:               "oci_test_parent",
+					datasourceClass:             "oci_test_compartment_parents",
+					resourceAbbreviation:        "parent",
+					datasourceItemsAttr:         "items",
+					discoverableLifecycleStates: []string{resourceDiscoveryTestActiveLifecycle},
+				},
+			},
+		},
+		"oci_test_parent": {
+			{
+				TerraformResourceHints: exportChildDefinition,
+				datasourceQueryParams:  map[string]string{"parent_id": "id"},
+			},
+		},
+	}
+	defer delete(compartmentResourceGraphs, "compartment_modules_testing")
+
+	originalListCompartments := identityClientListCompartmentsVar
+	originalGetCompartment := identityClientGetCompartmentVar
+	defer func() {
+		identityClientListCompartmentsVar = originalListCompartments
+		identityClientGetCompartmentVar = originalGetCompartment
+	}()
+	identityClientGetCompartmentVar = func(clients *tf_client.OracleClients, getCompartmentRequest oci_identity.GetCompartmentRequest) (oci_identity.GetCompartmentResponse, error) {
+		return oci_identity.GetCompartmentResponse{
+			Compartment: oci_identity.Compartment{
+				Id:   getCompartmentRequest.CompartmentId,
+				Name: &rootCompartmentName,
+			},
+		}, nil
+	}
+	identityClientListCompartmentsVar = func(clients *tf_client.OracleClients, req oci_identity.ListCompartmentsRequest) (oci_identity.ListCompartmentsResponse, error) {
+		if *req.CompartmentId != resourceDiscoveryTestCompartmentOcid {
+			return oci_identity.ListCompartmentsResponse{}, nil
+		}
+		return oci_identity.ListCompartmentsResponse{
+			Items: []oci_identity.Compartment{{
+				Id:   &childCompartmentId,
+				Name: &childCompartmentName,
+			}},
+		}, nil
+	}
+
+	compartmentId := resourceDiscoveryTestCompartmentOcid
+	if err := os.Setenv("export_tenancy_id", resourceDiscoveryTestTenancyOcid); err != nil {
+		t.Logf("unable to set export_tenancy_id. err: %v", err)
+		t.Fail()
+	}
+	outputDir, err := os.Getwd()
+	outputDir = fmt.Sprintf("%s%sdiscoveryTest-%d", outputDir, string(os.PathSeparator), time.Now().Nanosecond())
+	if err = os.Mkdir(outputDir, os.ModePerm); err != nil {
+		t.Logf("unable to mkdir %s. err: %v", outputDir, err)
+		t.Fail()
+	}
+	defer os.RemoveAll(outputDir)
+
+	tfHclVersion = &TfHclVersion12{}
+	args := &ExportCommandArgs{
+		CompartmentId:        &compartmentId,
+		Services:             []string{"compartment_modules_testing"},
+		OutputDir:            &outputDir,
+		GenerateImportBlocks: true,
+		Layout:               ExportLayoutCompartmentModules,
+		TFVersion:            &tfHclVersion,
+		Parallelism:          1,
+	}
+	getProviderEnvSettingWithDefaultVar = func(varName string, defaultValue string) string {
+		return defaultValue
+	}
+	getEnvSettingWithBlankDefaultVar = func(varName string) string {
+		return resourceDiscoveryTestTenancyOcid
+	}
+	getExportConfigVar = func(d *schema.ResourceData) (interface{}, error) {
+		return getTestClients(), nil
+	}
+	exportConfigProvider = acctest.MockConfigurationProvider{}
+	if err, _ = RunExportCommand(args); err != nil {
+		t.Logf("export command failed due to err: %v", err)
+		t.FailNow()
+	}
+
+	readOutputFile := func(pathElements ...string) string {
+		content, err := ioutil.ReadFile(path.Join(append([]string{outputDir}, pathElements...)...))
+		if err != nil {
+			t.Logf("unable to read generated file %v. err: %v", pathElements, err)
+			t.FailNow()
+		}
+		return string(content)
+	}
+
+	rootModule := "export_root-compartment"
+	childModule := "export_child-compartment"
+
+	rootConfig := readOutputFile(globalvar.ModulesDir, rootModule, "compartment_modules_testing.tf")
+	childConfig := readOutputFile(globalvar.ModulesDir, childModule, "compartment_modules_testing.tf")
+	assert.Contains(t, rootConfig, "compartment_id = var.compartment_ocid")
+	assert.Contains(t, childConfig, "compartment_id = var.compartment_ocid")
+	assert.NotContains(t, childConfig, getTestResourceId("parent", 0))
+	assert.Regexp(t, `a_string += var\.oci_test_parent_[^ ]+_id`, childConfig)
+	assert.Regexp(t, `a_string += var\.root_compartment_ocid`, childConfig)
+	assert.NotContains(t, rootConfig, "root_compartment_ocid")
+
+	childVars := readOutputFile(globalvar.ModulesDir, childModule, globalvar.VarsFile)
+	assert.Contains(t, childVars, "variable compartment_ocid {}")
+	assert.Regexp(t, `variable oci_test_parent_[^ ]+_id {}`, childVars)
+	assert.Contains(t, childVars, "variable root_compartment_ocid {}")
+
+	rootOutputs := readOutputFile(globalvar.ModulesDir, rootModule, globalvar.OutputsFile)
+	assert.Regexp(t, `output oci_test_parent_[^ ]+_id {\n\s+value = oci_test_parent\.[^ ]+\.id\n}`, rootOutputs)
+	assert.Equal(t, "", strings.TrimSpace(readOutputFile(globalvar.ModulesDir, childModule, globalvar.OutputsFile)))
+
+	modulesConfig := readOutputFile(globalvar.ModulesFile)
+	assert.Regexp(t, fmt.Sprintf(`source += "\./%s/%s"`, globalvar.ModulesDir, rootModule), modulesConfig)
+	assert.Regexp(t, `compartment_ocid += var\.compartment_ocid`, modulesConfig)
+	assert.Regexp(t, fmt.Sprintf(`compartment_ocid += "%s"`, childCompartmentId), modulesConfig)
+	assert.Regexp(t, `root_compartment_ocid += var\.compartment_ocid`, modulesConfig)
+	assert.Regexp(t, fmt.Sprintf(`oci_test_parent_[^ ]+_id += module\.%s\.oci_test_parent_[^ ]+_id`, rootModule), modulesConfig)
+
+	importConfig := readOutputFile(globalvar.ImportFile)
+	assert.Contains(t, importConfig, fmt.Sprintf("to = module.%s.oci_test_parent.", rootModule))
+	assert.Contains(t, importConfig, fmt.Sprintf("to = module.%s.oci_test_child.", childModule))
+
+	if _, err = os.Stat(path.Join(outputDir, "compartment_modules_testing.tf")); !os.IsNotExist(err) {
+		t.Logf("found compartment_modules_testing.tf in the output_path even though it wasn't expected")
+		t.Fail()
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitExportCommandArgs_validateLayout(t *testing.T) {
+	outputDir, err := createOutputDir()
+	if err != nil {
+		t.FailNow()
+	}
+	defer os.RemoveAll(outputDir)
+
+	args := &ExportCommandArgs{
+		OutputDir: &outputDir,
+		Layout:    ExportLayoutCompartmentModules,
+	}
+	assert.NoError(t, args.validate())
+
+	args.GenerateState = true
+	assert.EqualError(t, args.validate(), "[ERROR] generate_state is not supported with layout compartment_modules")
+
+	args.GenerateState = false
+	args.IDs = []string{"oci_test_parent:" + getTestResourceId("parent", 0)}
+	assert.EqualError(t, args.validate(), "[ERROR] ids is not supported with layout compartment_modules")
+
+	args.IDs = nil
+	args.Layout = "nested"
+	assert.EqualError(t, args.validate(), "[ERROR] invalid layout 'nested', supported values: flat, compartment_modules")
+}
+
+// Test that the resources of each region are generated with the provider alias of their region
+// issue-routing-tag: terraform/default
+func TestUnitRunExportCommand_regions(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+
+	compartmentId := resourceDiscoveryTestCompartmentOcid
+	if err := os.Setenv("export_tenancy_id", resourceDiscoveryTestTenancyOcid); err != nil {
+		t.Logf("unable to set export_tenancy_id. err: %v", err)
+		t.Fail()
+	}
+	outputDir, err := createOutputDir()
+	if err != nil {
+		t.FailNow()
+	}
+	defer os.RemoveAll(outputDir)
+
+	tfHclVersion = &TfHclVersion12{}
+	args := &ExportCommandArgs{
+		CompartmentId:        &compartmentId,
+		Services:             []string{"compartment_testing"},
+		OutputDir:            &outputDir,
+		GenerateImportBlocks: true,
+		TFVersion:            &tfHclVersion,
+		Parallelism:          2,
+		Regions:              []string{"us-phoenix-1", " us-ashburn-1", "us-phoenix-1"},
+	}
+	getProviderEnvSettingWithDefaultVar = func(varName string, defaultValue string) string {
+		return defaultValue
+	}
+	getEnvSettingWithBlankDefaultVar = func(varName string) string {
+		return resourceDiscoveryTestTenancyOcid
+	}
+	clientRegions := []string{}
+	getExportConfigVar = func(d *schema.ResourceData) (interface{}, error) {
+		clientRegions = append(clientRegions, d.Get(globalvar.RegionAttrName).(string))
+		return getTestClients(), nil
+	}
+	exportConfigProvider = acctest.MockConfigurationProvider{}
+	if err, _ = RunExportCommand(args); err != nil {
+		t.Logf("export command failed due to err: %v", err)
+		t.FailNow()
+	}
+	assert.Equal(t, []string{"us-phoenix-1", "us-ashburn-1"}, clientRegions)
+
+	for _, region := range []string{"us-phoenix-1", "us-ashburn-1"} {
+		config, err := ioutil.ReadFile(path.Join(outputDir, fmt.Sprintf("compartment_testing_%s.tf", region)))
+		if err != nil {
+			t.Logf("unable to read the configuration of region %s. err: %v", region, err)
+			t.FailNow()
+		}
+		assert.Contains(t, string(config), fmt.Sprintf("provider = oci.%s", region))
+	}
+	if _, err = os.Stat(path.Join(outputDir, "compartment_testing.tf")); !os.IsNotExist(err) {
+		t.Logf("found compartment_testing.tf in the output_path even though it wasn't expected")
+		t.Fail()
+	}
+
+	providerConfig, err := ioutil.ReadFile(path.Join(outputDir, globalvar.ProviderFile))
+	if err != nil {
+		t.FailNow()
+	}
+	assert.Regexp(t, `alias += "us-ashburn-1"`, string(providerConfig))
+
+	importConfig, err := ioutil.ReadFile(path.Join(outputDir, globalvar.ImportFile))
+	if err != nil {
+		t.FailNow()
+	}
+	assert.Contains(t, string(importConfig), "provider = oci.us-ashburn-1")
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitExportCommandArgs_validateRegions(t *testing.T) {
+	outputDir, err := createOutputDir()
+	if err != nil {
+		t.FailNow()
+	}
+	defer os.RemoveAll(outputDir)
+
+	args := &ExportCommandArgs{
+		OutputDir:     &outputDir,
+		Regions:       []string{"us-phoenix-1", "us-phoenix-1"},
+		GenerateState: true,
+	}
+	assert.NoError(t, args.validate())
+
+	args.Regions = []string{"us-phoenix-1", "us-ashburn-1"}
+	assert.EqualError(t, args.validate(), "[ERROR] generate_state is not supported with more than one region")
+
+	args.GenerateState = false
+	args.Layout = ExportLayoutCompartmentModules
+	assert.EqualError(t, args.validate(), "[ERROR] more than one region is not supported with layout compartment_modules")
+
+	args.Layout = ExportLayoutFlat
+	args.IDs = []string{"oci_test_parent:" + getTestResourceId("parent", 0)}
+	assert.EqualError(t, args.validate(), "[ERROR] ids is not supported with more than one region")
+
+	args.Regions = nil
+	args.IsRecursive = true
+	assert.EqualError(t, args.validate(), "[ERROR] recursive and ids cannot be specified together")
+}
+
+// Test that the steps discovering a service in several compartments are generated in a single file
+// issue-routing-tag: terraform/default
+func TestUnitMergeDiscoverySteps(t *testing.T) {
+	newStep := func(name string, moduleName string, resourceId string, timeTaken time.Duration) resourceDiscoveryStep {
+		return &resourceDiscoveryWithGraph{
+			resourceDiscoveryBaseStep: resourceDiscoveryBaseStep{
+				name:                  name,
+				moduleName:            moduleName,
+				discoveredResources:   []*OCIResource{{TerraformResource: TerraformResource{id: resourceId}}},
+				timeTakenForDiscovery: timeTaken,
+			},
+		}
+	}
+	steps := mergeDiscoverySteps([]resourceDiscoveryStep{
+		newStep("core", "", "vcn1", time.Second),
+		newStep("core", "", "vcn2", 2*time.Second),
+		newStep("core_us-ashburn-1", "", "vcn3", time.Second),
+		newStep("core", "child", "vcn4", time.Second),
+	})
+
+	assert.Len(t, steps, 3)
+	merged := steps[0].getBaseStep()
+	assert.Equal(t, "core", merged.name)
+	assert.Len(t, merged.discoveredResources, 2)
+	assert.Equal(t, 2*time.Second, merged.timeTakenForDiscovery)
+}
+
+// Test that the inventory lists the discovered resources with their parents
+// issue-routing-tag: terraform/default
+func TestUnitRunExportCommand_inventory(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+
+	compartmentId := resourceDiscoveryTestCompartmentOcid
+	if err := os.Setenv("export_tenancy_id", resourceDiscoveryTestTenancyOcid); err != nil {
+		t.Logf("unable to set export_tenancy_id. err: %v", err)
+		t.Fail()
+	}
+	outputDir, err := createOutputDir()
+	if err != nil {
+		t.FailNow()
+	}
+	defer os.RemoveAll(outputDir)
+
+	tfHclVersion = &TfHclVersion12{}
+	args := &ExportCommandArgs{
+		CompartmentId:     &compartmentId,
+		Services:          []string{"compartment_testing"},
+		OutputDir:         &outputDir,
+		GenerateInventory: true,
+		TFVersion:         &tfHclVersion,
+		Parallelism:       1,
+	}
+	getProviderEnvSettingWithDefaultVar = func(varName string, defaultValue string) string {
+		return defaultValue
+	}
+	getEnvSettingWithBlankDefaultVar = func(varName string) string {
+		return resourceDiscoveryTestTenancyOcid
+	}
+	getExportConfigVar = func(d *schema.ResourceData) (interface{}, error) {
+		return getTestClients(), nil
+	}
+	exportConfigProvider = acctest.MockConfigurationProvider{}
+	if err, _ = RunExportCommand(args); err != nil {
+		t.Logf("export command failed due to err: %v", err)
+		t.FailNow()
+	}
+
+	inventoryBytes, err := ioutil.ReadFile(path.Join(outputDir, globalvar.InventoryFile))
+	if err != nil {
+		t.Logf("no %s file generated. err: %v", globalvar.InventoryFile, err)
+		t.FailNow()
+	}
+	inventory := &Inventory{}
+	if err := json.Unmarshal(inventoryBytes, inventory); err != nil {
+		t.Fatalf("unable to parse inventory: %v", err)
+	}
+
+	assert.Equal(t, compartmentId, inventory.CompartmentId)
+	assert.Len(t, inventory.Resources, len(parentResources)+len(childrenResources))
+	assert.Empty(t, inventory.Errors)
+	parentIds := map[string]string{}
+	for _, resource := range inventory.Resources {
+		if resource.TerraformClass == "oci_test_parent" {
+			parentIds[resource.Id] = resource.ParentId
+		}
+	}
+	for _, resource := range inventory.Resources {
+		assert.Equal(t, fmt.Sprintf("%s.%s", resource.TerraformClass, resource.TerraformName), resource.Address)
+		if resource.TerraformClass == "oci_test_child" {
+			assert.Equal(t, "oci_test_parent", resource.ParentClass)
+			assert.Contains(t, parentIds, resource.ParentId)
+		}
+	}
+	assert.Equal(t, compartmentId, parentIds[getTestResourceId("parent", 0)])
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitGetInventory(t *testing.T) {
+	compartmentId := resourceDiscoveryTestCompartmentOcid
+	ctx := &resourceDiscoveryContext{
+		ExportCommandArgs: &ExportCommandArgs{CompartmentId: &compartmentId},
+		errorList: ErrorList{errors: []*ResourceDiscoveryError{
+			{resourceType: "oci_core_subnet", parentResource: "export", error: errors.New("subnet error")},
+		}},
+		missingAttributesPerResource: map[string][]string{"oci_core_vcn.vcn": {"cidr_block", "byoipv6cidr_details"}},
+	}
+	vcn := &OCIResource{
+		compartmentId:    compartmentId,
+		sourceAttributes: map[string]interface{}{"freeform_tags": map[string]interface{}{"Team": "payments"}, "defined_tags": map[string]interface{}{}},
+		TerraformResource: TerraformResource{
+			id:             "ocid1.vcn",
+			terraformClass: "oci_core_vcn",
+			terraformName:  "vcn",
+		},
+	}
+	instance := &OCIResource{
+		compartmentId:   compartmentId,
+		isErrorResource: true,
+		TerraformResource: TerraformResource{
+			id:             "ocid1.instance",
+			terraformClass: "oci_core_instance",
+			terraformName:  "instance",
+		},
+	}
+	routeTable := &OCIResource{
+		compartmentId: compartmentId,
+		parent:        vcn,
+		TerraformResource: TerraformResource{
+			id:             "ocid1.routetable",
+			terraformClass: "oci_core_route_table",
+			terraformName:  "route_table",
+			omitFromExport: true,
+		},
+	}
+	steps := []resourceDiscoveryStep{
+		&resourceDiscoveryWithGraph{resourceDiscoveryBaseStep: resourceDiscoveryBaseStep{
+			discoveredResources: []*OCIResource{vcn, instance},
+			omittedResources:    []*OCIResource{routeTable},
+		}},
+	}
+
+	inventory := getInventory(ctx, steps)
+	assert.Equal(t, []*InventoryResource{
+		{
+			TerraformClass:  "oci_core_instance",
+			TerraformName:   "instance",
+			Address:         "oci_core_instance.instance",
+			Id:              "ocid1.instance",
+			CompartmentId:   compartmentId,
+			IsErrorResource: true,
+		},
+		{
+			TerraformClass: "oci_core_route_table",
+			TerraformName:  "route_table",
+			Address:        "oci_core_route_table.route_table",
+			Id:             "ocid1.routetable",
+			CompartmentId:  compartmentId,
+			ParentClass:    "oci_core_vcn",
+			ParentId:       "ocid1.vcn",
+			IsOmitted:      true,
+		},
+		{
+			TerraformClass:            "oci_core_vcn",
+			TerraformName:             "vcn",
+			Address:                   "oci_core_vcn.vcn",
+			Id:                        "ocid1.vcn",
+			CompartmentId:             compartmentId,
+			FreeformTags:              map[string]interface{}{"Team": "payments"},
+			MissingRequiredAttributes: []string{"byoipv6cidr_details", "cidr_block"},
+		},
+	}, inventory.Resources)
+	assert.Equal(t, []*InventoryError{{ResourceType: "oci_core_subnet", ParentResource: "export", Error: "subnet error"}}, inventory.Errors)
+}
+
+// Test that secret attributes are set from sensitive variables instead of placeholder values
+// issue-routing-tag: terraform/default
+func TestUnitGetHCLString_externalizeSecrets(t *testing.T) {
+	tfHclVersion = &TfHclVersion12{}
+	externalizeSecrets = true
+	secretVars = map[string]secretVariable{}
+	defer func() {
+		externalizeSecrets = false
+		for variable := range secretVars {
+			delete(vars, variable)
+		}
+		secretVars = map[string]secretVariable{}
+	}()
+
+	resourceSchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"admin_password": {Type: schema.TypeString, Required: true, Sensitive: true},
+			"admin_pin":      {Type: schema.TypeInt, Required: true, Sensitive: true},
+			"cidr_block":     {Type: schema.TypeString, Required: true},
+			"db_password":    {Type: schema.TypeString, Optional: true, Sensitive: true},
+			"display_name":   {Type: schema.TypeString, Optional: true},
+			"secret_content": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"content": {Type: schema.TypeString, Required: true},
+						"stage":   {Type: schema.TypeString, Optional: true},
+					},
+				},
+			},
+		},
+	}
+	ociRes := &OCIResource{
+		TerraformResource: TerraformResource{
+			terraformClass: "oci_test_secret",
+			terraformName:  "adb",
+		},
+	}
+	sourceAttributes := map[string]interface{}{
+		"admin_password": "discovered-password",
+		"display_name":   "adb",
+	}
+
+	builder := &strings.Builder{}
+	assert.NoError(t, getHCLStringFromMap(builder, sourceAttributes, resourceSchema, nil, ociRes, ""))
+	// The missing required attributes that are not sensitive, including nested blocks, are still set to placeholder values
+	missingAttributeComment := "\t#Required attribute not found in discovery, placeholder value set to avoid plan failure\n"
+	assert.Equal(t, `admin_password = var.adb_admin_password
+admin_pin = var.adb_admin_pin
+cidr_block = "`+globalvar.PlaceholderValueForMissingAttribute+`"`+missingAttributeComment+`db_password = var.adb_db_password
+display_name = "adb"
+secret_content = "`+globalvar.PlaceholderValueForMissingAttribute+`"`+missingAttributeComment, builder.String())
+	assert.NotContains(t, builder.String(), "discovered-password")
+	assert.Equal(t, map[string]bool{"cidr_block": true, "secret_content": true}, ociRes.terraformTypeInfo.ignorableRequiredMissingAttributes)
+
+	assert.Equal(t, map[string]secretVariable{
+		"adb_admin_password": {attribute: "oci_test_secret.adb.admin_password", valueType: schema.TypeString},
+		"adb_admin_pin":      {attribute: "oci_test_secret.adb.admin_pin", valueType: schema.TypeInt},
+		"adb_db_password":    {attribute: "oci_test_secret.adb.db_password", valueType: schema.TypeString},
+	}, secretVars)
+	assert.Equal(t, "", vars["adb_admin_password"])
+	assert.Equal(t, "null", vars["adb_db_password"])
+
+	outputDir, err := createOutputDir()
+	if err != nil {
+		t.FailNow()
+	}
+	defer os.RemoveAll(outputDir)
+
+	assert.NoError(t, generateVarsFile(map[string]string{"adb_db_password": "null", "region": "\"us-phoenix-1\""}, &outputDir))
+	varsBytes, err := ioutil.ReadFile(path.Join(outputDir, globalvar.VarsFile))
+	assert.NoError(t, err)
+	assert.Contains(t, string(varsBytes), "variable adb_db_password {\nsensitive = true\ndefault = null\n}\n")
+	assert.Contains(t, string(varsBytes), "variable region { default = \"us-phoenix-1\" }\n")
+
+	ctx := &resourceDiscoveryContext{ExportCommandArgs: &ExportCommandArgs{OutputDir: &outputDir}}
+	assert.NoError(t, generateTfvarsExampleFile(ctx))
+	tfvarsBytes, err := ioutil.ReadFile(path.Join(outputDir, globalvar.TfvarsExampleFile))
+	assert.NoError(t, err)
+	assert.Contains(t, string(tfvarsBytes), "# oci_test_secret.adb.admin_password\nadb_admin_password = \"\"\n")
+	assert.Contains(t, string(tfvarsBytes), "# oci_test_secret.adb.db_password\n# Optional, the attribute is not set when the variable is null\nadb_db_password = \"\"\n")
+	assert.Contains(t, string(tfvarsBytes), "# oci_test_secret.adb.admin_pin\nadb_admin_pin = 0\n")
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitExportCommandArgs_validateExternalizeSecrets(t *testing.T) {
+	outputDir, err := createOutputDir()
+	if err != nil {
+		t.FailNow()
+	}
+	defer os.RemoveAll(outputDir)
+
+	var tfVersion11 TfHclVersion = &TfHclVersion11{}
+	var tfVersion12 TfHclVersion = &TfHclVersion12{}
+
+	args := &ExportCommandArgs{
+		OutputDir:          &outputDir,
+		ExternalizeSecrets: true,
+		TFVersion:          &tfVersion12,
+	}
+	assert.NoError(t, args.validate())
+
+	args.TFVersion = &tfVersion11
+	assert.EqualError(t, args.validate(), "[ERROR] externalize_secrets is not supported with tf_version 0.11")
+}
+
+// regionErrorConfigurationProvider fails the export once the configuration is written, after the discovery is checkpointed
+type regionErrorConfigurationProvider struct {
+	acctest.MockConfigurationProvider
+}
+
+func (p regionErrorConfigurationProvider) Region() (string, error) {
+	return "", errors.New("region error")
+}
+
+// Test that an export that did not complete is resumed without discovering the resources again
+// issue-routing-tag: terraform/default
+func TestUnitRunExportCommand_resume(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+
+	compartmentId := resourceDiscoveryTestCompartmentOcid
+	if err := os.Setenv("export_tenancy_id", resourceDiscoveryTestTenancyOcid); err != nil {
+		t.Logf("unable to set export_tenancy_id. err: %v", err)
+		t.Fail()
+	}
+	outputDir, err := os.Getwd()
+	outputDir = fmt.Sprintf("%s%sdiscoveryTest-%d", outputDir, string(os.PathSeparator), time.Now().Nanosecond())
+	if err = os.Mkdir(outputDir, os.ModePerm); err != nil {
+		t.Logf("unable to mkdir %s. err: %v", outputDir, err)
+		t.Fail()
+	}
+	defer os.RemoveAll(outputDir)
+
+	tfHclVersion = &TfHclVersion12{}
+	args := &ExportCommandArgs{
+		CompartmentId: &compartmentId,
+		Services:      []string{"compartment_testing"},
+		OutputDir:     &outputDir,
+		TFVersion:     &tfHclVersion,
+		Parallelism:   1,
+	}
+	getProviderEnvSettingWithDefaultVar = func(varName string, defaultValue string) string {
+		return defaultValue
+	}
+	getEnvSettingWithBlankDefaultVar = func(varName string) string {
+		return resourceDiscoveryTestTenancyOcid
+	}
+	getExportConfigVar = func(d *schema.ResourceData) (interface{}, error) {
+		return getTestClients(), nil
+	}
+	exportConfigProvider = regionErrorConfigurationProvider{}
+	if err, _ = RunExportCommand(args); err == nil {
+		t.Logf("export command succeeded even though it was expected to fail")
+		t.FailNow()
+	}
+	checkpointPath := path.Join(outputDir, globalvar.CheckpointDir, checkpointFile)
+	if _, err = os.Stat(checkpointPath); os.IsNotExist(err) {
+		t.Logf("no checkpoint written for the export that failed")
+		t.FailNow()
+	}
+
+	// The resources can not be discovered anymore, the resumed export generates them from the checkpoint
+	datasourcesMap["oci_test_parents"] = testParentsDatasourceWithError()
+	defer func() { datasourcesMap["oci_test_parents"] = testParentsDatasource() }()
+	resourceNameCount = map[string]int{}
+	referenceMap = map[string]string{}
+	exportConfigProvider = acctest.MockConfigurationProvider{}
+
+	args.IsRecursive = true
+	args.Resume = true
+	err, _ = RunExportCommand(args)
+	assert.EqualError(t, err, fmt.Sprintf("[ERROR] checkpoint file %s was created by an export with different arguments, run the export without resume to start over", checkpointPath))
+
+	args.IsRecursive = false
+	err, status := RunExportCommand(args)
+	if err != nil {
+		t.Logf("resumed export command failed due to err: %v", err)
+		t.FailNow()
+	}
+	assert.Equal(t, StatusSuccess, status)
+
+	config, err := ioutil.ReadFile(path.Join(outputDir, "compartment_testing.tf"))
+	if err != nil {
+		t.Logf("no compartment_testing.tf file generated. err: %v", err)
+		t.FailNow()
+	}
+	assert.Regexp(t, `resource oci_test_parent [^ ]+ {`, string(config))
+	assert.Regexp(t, `parent_id += oci_test_parent\.[^ ]+\.id`, string(config))
+	if _, err = os.Stat(path.Join(outputDir, globalvar.CheckpointDir)); !os.IsNotExist(err) {
+		t.Logf("found the checkpoint directory even though the export completed")
+		t.Fail()
+	}
+}
+
+// Test that the resources are restored from the checkpoint with their interpolations, numbers and parents
+// issue-routing-tag: terraform/default
+func TestUnitCheckpointResources(t *testing.T) {
+	outputDir, err := createOutputDir()
+	if err != nil {
+		t.FailNow()
+	}
+	defer os.RemoveAll(outputDir)
+
+	ctx := &resourceDiscoveryContext{resourceHintsLookup: map[string]*TerraformResourceHints{
+		"oci_identity_availability_domain": exportIdentityAvailabilityDomainHints,
+	}}
+	compartment := &OCIResource{TerraformResource: TerraformResource{id: "ocid1.compartment", terraformClass: "oci_identity_compartment", terraformName: "export"}}
+	ad := &OCIResource{
+		compartmentId:    "ocid1.compartment",
+		parent:           compartment,
+		sourceAttributes: map[string]interface{}{"index": 1, "name": "AD-1"},
+		TerraformResource: TerraformResource{
+			id:             "AD-1",
+			terraformClass: "oci_identity_availability_domain",
+			terraformName:  "export_AD-1",
+		},
+	}
+	backend := &OCIResource{
+		parent: ad,
+		sourceAttributes: map[string]interface{}{
+			"weight":       0.5,
+			"backend_sets": []interface{}{map[string]interface{}{"name": InterpolationString{resourceReference: "oci_load_balancer_backend_set.set", interpolation: "oci_load_balancer_backend_set.set.name", value: "set"}}},
+		},
+		TerraformResource: TerraformResource{id: "ocid1.backend", terraformClass: "oci_load_balancer_backend", terraformName: "backend", omitFromExport: true},
+	}
+
+	stepPath := path.Join(outputDir, "step.json")
+	if err := writeCheckpointFile(stepPath, toCheckpointResources([]*OCIResource{ad, backend})); err != nil {
+		t.Fatalf("got error writing checkpoint file: %v", err)
+	}
+	var checkpointResources []*checkpointResource
+	if err := readCheckpointFile(stepPath, &checkpointResources); err != nil {
+		t.Fatalf("got error reading checkpoint file: %v", err)
+	}
+	resources := ctx.fromCheckpointResources(checkpointResources)
+
+	assert.Len(t, resources, 2)
+	assert.Equal(t, ad.sourceAttributes, resources[0].sourceAttributes)
+	assert.Equal(t, compartment.terraformName, resources[0].parent.terraformName)
+	assert.Equal(t, exportIdentityAvailabilityDomainHints, resources[0].terraformTypeInfo)
+	builder := &strings.Builder{}
+	assert.NoError(t, resources[0].getHCLString(builder, map[string]string{}))
+	assert.Contains(t, builder.String(), `ad_number = "1"`)
+
+	assert.Equal(t, backend.sourceAttributes, resources[1].sourceAttributes)
+	assert.Same(t, resources[0], resources[1].parent)
+	assert.True(t, resources[1].omitFromExport)
+}
+
+// Test that the references, resource names and errors of a step are written to its checkpoint and only restored for the
+// discovered steps
+// issue-routing-tag: terraform/default
+func TestUnitCheckpointDiscoveredSteps(t *testing.T) {
+	outputDir, err := createOutputDir()
+	if err != nil {
+		t.FailNow()
+	}
+	defer os.RemoveAll(outputDir)
+	defer func() {
+		referenceMap = map[string]string{}
+		vars = map[string]string{}
+		resourceNameCount = map[string]int{}
+	}()
+	referenceMap = map[string]string{}
+	vars = map[string]string{}
+	resourceNameCount = map[string]int{}
+
+	newCtx := func(resume bool) *resourceDiscoveryContext {
+		return &resourceDiscoveryContext{
+			ExportCommandArgs:   &ExportCommandArgs{OutputDir: &outputDir, Resume: resume},
+			expectedResourceIds: map[string]bool{},
+			errorList:           ErrorList{errors: []*ResourceDiscoveryError{}},
+		}
+	}
+	newSteps := func(ctx *resourceDiscoveryContext) []resourceDiscoveryStep {
+		return []resourceDiscoveryStep{
+			&resourceDiscoveryWithGraph{resourceDiscoveryBaseStep: resourceDiscoveryBaseStep{ctx: ctx, name: "first"}},
+			&resourceDiscoveryWithGraph{resourceDiscoveryBaseStep: resourceDiscoveryBaseStep{ctx: ctx, name: "second"}},
+		}
+	}
+
+	ctx := newCtx(false)
+	assert.NoError(t, ctx.initCheckpoint())
+	steps := newSteps(ctx)
+	referenceMap["ocid1.first"] = "oci_test_parent.first.id"
+	vars["first"] = `"first"`
+	resourceNameCount["first"] = 1
+	firstError := &ResourceDiscoveryError{resourceType: "oci_test_parent", parentResource: "export", error: errors.New("first error")}
+	assert.NoError(t, ctx.checkpointDiscoveredStep(steps[0], []*ResourceDiscoveryError{firstError}))
+
+	// The second step is not discovered
+	referenceMap["ocid1.second"] = "oci_test_parent.second.id"
+	ctx.addErrorToList(&ResourceDiscoveryError{resourceType: "oci_test_parent", parentResource: "export", error: errors.New("second error")})
+
+	checkpoint := map[string]interface{}{}
+	assert.NoError(t, readCheckpointFile(path.Join(outputDir, globalvar.CheckpointDir, checkpointFile), &checkpoint))
+	assert.NotContains(t, checkpoint, "reference_map")
+	assert.NotContains(t, checkpoint, "errors")
+
+	referenceMap = map[string]string{}
+	vars = map[string]string{}
+	resourceNameCount = map[string]int{}
+	ctx = newCtx(true)
+	assert.NoError(t, ctx.initCheckpoint())
+	steps = newSteps(ctx)
+	restoredSteps, err := ctx.restoreDiscoveredSteps(steps)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]bool{0: true}, restoredSteps)
+	assert.Equal(t, map[string]string{"ocid1.first": "oci_test_parent.first.id"}, referenceMap)
+	assert.Equal(t, map[string]string{"first": `"first"`}, vars)
+	assert.Equal(t, map[string]int{"first": 1}, resourceNameCount)
+	if assert.Len(t, ctx.errorList.errors, 1) {
+		assert.Equal(t, "first error", ctx.errorList.errors[0].error.Error())
+	}
+
+	// Only the changes since the first step are written to the checkpoint of the second step
+	delete(referenceMap, "ocid1.first")
+	referenceMap["ocid1.second"] = "oci_test_parent.second.id"
+	resourceNameCount["first"] = 2
+	assert.NoError(t, ctx.checkpointDiscoveredStep(steps[1], nil))
+	stepCheckpoint := &checkpointStep{}
+	assert.NoError(t, readCheckpointFile(path.Join(outputDir, globalvar.CheckpointDir, checkpointStepsDir, "second.json"), stepCheckpoint))
+	assert.Equal(t, map[string]string{"ocid1.second": "oci_test_parent.second.id"}, stepCheckpoint.ReferenceMap)
+	assert.Equal(t, []string{"ocid1.first"}, stepCheckpoint.RemovedReferences)
+	assert.Empty(t, stepCheckpoint.Vars)
+	assert.Equal(t, map[string]int{"first": 2}, stepCheckpoint.ResourceNameCount)
+	assert.Empty(t, stepCheckpoint.Errors)
+}
+
+// Test that the step contexts share the lock of the expected resource ids, which are found by the steps in parallel while
+// they are written to the checkpoint
+// issue-routing-tag: terraform/default
+func TestUnitCheckpointExpectedResourceIds(t *testing.T) {
+	outputDir, err := createOutputDir()
+	if err != nil {
+		t.FailNow()
+	}
+	defer os.RemoveAll(outputDir)
+
+	ctx := &resourceDiscoveryContext{
+		ExportCommandArgs:   &ExportCommandArgs{OutputDir: &outputDir},
+		expectedResourceIds: map[string]bool{},
+		errorList:           ErrorList{errors: []*ResourceDiscoveryError{}},
+	}
+	for i := 0; i < 100; i++ {
+		ctx.expectedResourceIds[fmt.Sprintf("ocid1.resource%d", i)] = false
+	}
+	assert.NoError(t, ctx.initCheckpoint())
+
+	var wg sync.WaitGroup
+	for step := 0; step < 4; step++ {
+		stepCtx := ctx.newStepContext()
+		assert.Same(t, ctx.getExpectedResourceIdsLock(), stepCtx.getExpectedResourceIdsLock())
+		wg.Add(1)
+		go func(step int) {
+			defer wg.Done()
+			for i := step; i < 100; i += 4 {
+				assert.True(t, stepCtx.setExpectedResourceIdFound(fmt.Sprintf("ocid1.resource%d", i)))
+				ctx.checkpoint.lock.Lock()
+				assert.NoError(t, stepCtx.writeCheckpoint())
+				ctx.checkpoint.lock.Unlock()
+			}
+		}(step)
+	}
+	wg.Wait()
+
+	assert.False(t, ctx.setExpectedResourceIdFound("ocid1.unexpected"))
+	assert.NotContains(t, ctx.expectedResourceIds, "ocid1.unexpected")
+	for id, found := range ctx.checkpoint.ExpectedResourceIds {
+		assert.True(t, found, id)
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitGetProviderHclString(t *testing.T) {
+	tfHclVersion = &TfHclVersion12{}
+	assert.Equal(t, "provider oci {\n\tregion = var.region\n}\n", getProviderHclString([]string{"us-phoenix-1"}))
+
+	providerConfig := getProviderHclString([]string{"us-phoenix-1", "us-ashburn-1"})
+	assert.Contains(t, providerConfig, "provider oci {\n\talias  = \"us-phoenix-1\"\n\tregion = \"us-phoenix-1\"\n}\n")
+	assert.Contains(t, providerConfig, "provider oci {\n\talias  = \"us-ashburn-1\"\n\tregion = \"us-ashburn-1\"\n}\n")
+}
+
 // issue-routing-tag: terraform/default
 func TestUnitRunExportCommand_ParallelNegative(t *testing.T) {
 	initResourceDiscoveryTests()
@@ -2163,6 +3421,44 @@ func TestUnitGenerateState(test *testing.T) {
 	}
 }
 
+// Test that the resources imported before the export was resumed are not imported again
+func TestUnitGenerateState_resume(t *testing.T) {
+	defer os.RemoveAll(getOutputDir())
+	ctx := getTestCtx()
+	ctx.checkpoint = &discoveryCheckpoint{isResumed: true}
+	resourcesMap = tf_provider.ResourcesMap()
+	resourcesMap["oci_test_parent"] = testParentResource()
+	defer delete(resourcesMap, "oci_test_parent")
+
+	for i := 0; i < 2; i++ {
+		ctx.discoveredResources = append(ctx.discoveredResources, &OCIResource{
+			TerraformResource: TerraformResource{
+				id:             getTestResourceId("parent", i),
+				terraformClass: "oci_test_parent",
+				terraformName:  fmt.Sprintf("parent%d", i),
+			},
+		})
+	}
+	tmpState := `{"version": 4, "resources": [{"mode": "managed", "type": "oci_test_parent", "name": "parent0", "instances": [{"attributes": {"id": "parent0"}}]}]}`
+	if err := ioutil.WriteFile(path.Join(*ctx.OutputDir, globalvar.DefaultTmpStateFile), []byte(tmpState), 0644); err != nil {
+		t.Fatalf("got error writing tmp state file: %v", err)
+	}
+
+	originalImport, originalInit := ctxTerraformImportVar, terraformInitVar
+	defer func() { ctxTerraformImportVar, terraformInitVar = originalImport, originalInit }()
+	terraformInitVar = func(ctx *resourceDiscoveryContext, backgroundCtx context.Context, initArgs []tfexec.InitOption) error {
+		return nil
+	}
+	importedResources := []string{}
+	ctxTerraformImportVar = func(ctx *resourceDiscoveryContext, ctxBackground context.Context, address, id string, importArgs ...tfexec.ImportOption) error {
+		importedResources = append(importedResources, address)
+		return nil
+	}
+
+	assert.NoError(t, generateState(ctx, []resourceDiscoveryStep{}))
+	assert.Equal(t, []string{"oci_test_parent.parent1"}, importedResources)
+}
+
 func TestUnitGetOciResource(t *testing.T) {
 	childResource := testChildResource()
 	d := childResource.TestResourceData()
@@ -2196,6 +3492,103 @@ func TestUnitResolveCompartmentId(t *testing.T) {
 	assert.Equal(t, id, *compartmentId)
 }
 
+// Test that the modules of compartments with the same name are not given the name of another module
+// issue-routing-tag: terraform/default
+func TestUnitGetCompartmentModules_duplicateNames(t *testing.T) {
+	originalListCompartments := identityClientListCompartmentsVar
+	originalGetCompartment := identityClientGetCompartmentVar
+	defer func() {
+		identityClientListCompartmentsVar = originalListCompartments
+		identityClientGetCompartmentVar = originalGetCompartment
+	}()
+
+	rootId := "ocid1.compartment.root"
+	rootName := "network"
+	identityClientGetCompartmentVar = func(clients *tf_client.OracleClients, getCompartmentRequest oci_identity.GetCompartmentRequest) (oci_identity.GetCompartmentResponse, error) {
+		return oci_identity.GetCompartmentResponse{Compartment: oci_identity.Compartment{Id: &rootId, Name: &rootName}}, nil
+	}
+	identityClientListCompartmentsVar = func(clients *tf_client.OracleClients, req oci_identity.ListCompartmentsRequest) (oci_identity.ListCompartmentsResponse, error) {
+		if *req.CompartmentId != rootId {
+			return oci_identity.ListCompartmentsResponse{}, nil
+		}
+		compartment := func(id string, name string) oci_identity.Compartment {
+			return oci_identity.Compartment{Id: &id, Name: &name}
+		}
+		return oci_identity.ListCompartmentsResponse{
+			Items: []oci_identity.Compartment{
+				compartment("ocid1.compartment.one", "network_1"),
+				compartment("ocid1.compartment.two", "network"),
+				compartment("ocid1.compartment.three", "network"),
+			},
+		}, nil
+	}
+
+	compartmentId := rootId
+	ctx := &resourceDiscoveryContext{ExportCommandArgs: &ExportCommandArgs{CompartmentId: &compartmentId}}
+	modules, err := getCompartmentModules(ctx)
+	assert.NoError(t, err)
+	names := []string{}
+	for _, module := range modules {
+		names = append(names, module.name)
+	}
+	assert.Equal(t, []string{"export_network", "export_network_1", "export_network_2", "export_network_3"}, names)
+}
+
+func TestUnitPrepareCompartmentModules_rootCompartmentReference(t *testing.T) {
+	originalReferenceMap := referenceMap
+	originalTfHclVersion := tfHclVersion
+	defer func() {
+		referenceMap = originalReferenceMap
+		tfHclVersion = originalTfHclVersion
+	}()
+
+	rootId := "ocid1.compartment.root"
+	childId := "ocid1.compartment.child"
+	tfHclVersion = &TfHclVersion12{}
+	referenceMap = map[string]string{rootId: tfHclVersion.getVarHclString("compartment_ocid")}
+
+	root := &compartmentModule{name: "export_root", compartmentId: rootId, isRoot: true, variables: map[string]bool{}}
+	child := &compartmentModule{name: "export_child", compartmentId: childId, variables: map[string]bool{}}
+	ctx := &resourceDiscoveryContext{compartmentModules: []*compartmentModule{root, child}}
+	prepareCompartmentModules(ctx, nil)
+
+	// The compartment_ocid variable of the child module is the child compartment, the root compartment has its own variable
+	assert.Equal(t, "var.compartment_ocid", root.interpolationMap[rootId])
+	assert.Equal(t, "var.root_compartment_ocid", child.interpolationMap[rootId])
+	assert.Equal(t, "var.compartment_ocid", child.interpolationMap[childId])
+
+	ctx.addModuleVariables(root, "compartment_id = var.compartment_ocid\nparent_id = var.root_compartment_ocid\n")
+	ctx.addModuleVariables(child, "compartment_id = var.compartment_ocid\nparent_id = var.root_compartment_ocid\n")
+	assert.Equal(t, map[string]bool{"compartment_ocid": true}, root.variables)
+	assert.Equal(t, map[string]bool{"compartment_ocid": true, "root_compartment_ocid": true}, child.variables)
+}
+
+// Test that the resources a step discovers in another module are generated in a single file along with that module's own
+// issue-routing-tag: terraform/default
+func TestUnitAssignModuleConfigurations(t *testing.T) {
+	resource := func(name string, moduleName string) *OCIResource {
+		return &OCIResource{TerraformResource: TerraformResource{terraformName: name}, moduleName: moduleName}
+	}
+	rootVcn := resource("root_vcn", "export_root")
+	childSubnet := resource("child_subnet", "export_child")
+	childVcn := resource("child_vcn", "export_child")
+	rootStep := &resourceDiscoveryWithGraph{resourceDiscoveryBaseStep: resourceDiscoveryBaseStep{
+		name: "core", moduleName: "export_root", discoveredResources: []*OCIResource{rootVcn, childSubnet},
+	}}
+	childStep := &resourceDiscoveryWithGraph{resourceDiscoveryBaseStep: resourceDiscoveryBaseStep{
+		name: "core", moduleName: "export_child", discoveredResources: []*OCIResource{childVcn},
+	}}
+	emptyStep := &resourceDiscoveryWithGraph{resourceDiscoveryBaseStep: resourceDiscoveryBaseStep{
+		name: "identity", moduleName: "export_child",
+	}}
+
+	assignModuleConfigurations([]resourceDiscoveryStep{rootStep, childStep, emptyStep})
+
+	assert.Equal(t, map[string][]*OCIResource{"export_root": {rootVcn}}, rootStep.moduleConfigurations)
+	assert.Equal(t, map[string][]*OCIResource{"export_child": {childSubnet, childVcn}}, childStep.moduleConfigurations)
+	assert.Equal(t, map[string][]*OCIResource{"export_child": {}}, emptyStep.moduleConfigurations)
+}
+
 func TestUnitGetTenancyOcidFromCompartment(t *testing.T) {
 	compartmentId := "dummy_compartment_id"
 	identityClientGetCompartmentVar = func(clients *tf_client.OracleClients, getCompartmentRequest oci_identity.GetCompartmentRequest) (oci_identity.GetCompartmentResponse, error) {
@@ -2356,3 +3749,194 @@ func TestUnitDeleteInvalidReferencesWithReferenceResource(t *testing.T) {
 }
 
 */
+
+// Test that only the resources matching the selectors and their parents are exported
+// issue-routing-tag: terraform/default
+func TestUnitFindResources_selectors(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	rootResource := getRootCompartmentResource()
+
+	exportParentDefinition.alwaysExportable = false
+	exportParentDefinition.processDiscoveredResourcesFn = func(ctx *resourceDiscoveryContext, resources []*OCIResource) ([]*OCIResource, error) {
+		for _, resource := range resources {
+			resource.sourceAttributes["freeform_tags"] = map[string]interface{}{"Team": "other"}
+			if resource.id == getTestResourceId("parent", 0) {
+				resource.sourceAttributes["freeform_tags"] = map[string]interface{}{"team": "payments"}
+			}
+		}
+		return resources, nil
+	}
+	exportChildDefinition.processDiscoveredResourcesFn = func(ctx *resourceDiscoveryContext, resources []*OCIResource) ([]*OCIResource, error) {
+		for _, resource := range resources {
+			resource.sourceAttributes["freeform_tags"] = map[string]interface{}{"Team": "other"}
+			if resource.id == getTestResourceId("child", 3) {
+				resource.sourceAttributes["freeform_tags"] = map[string]interface{}{"Team": "payments"}
+			}
+		}
+		return resources, nil
+	}
+	defer func() {
+		exportParentDefinition.alwaysExportable = true
+		exportParentDefinition.processDiscoveredResourcesFn = nil
+		exportChildDefinition.processDiscoveredResourcesFn = nil
+	}()
+
+	args := &ExportCommandArgs{IncludeTags: []string{"Team:payments"}}
+	selectors, err := args.getResourceSelectors()
+	if err != nil {
+		t.Fatalf("got error from getResourceSelectors: %v", err)
+	}
+	ctx := &resourceDiscoveryContext{
+		errorList: ErrorList{},
+		selectors: selectors,
+	}
+
+	results, err := findResources(ctx, rootResource, compartmentTestingResourceGraph)
+	if err != nil {
+		t.Fatalf("got error from findResources: %v", err)
+	}
+
+	exportedIds := []string{}
+	for _, resource := range results {
+		if !resource.omitFromExport {
+			exportedIds = append(exportedIds, resource.id)
+		}
+	}
+	// parent 1 is exported because its child 3 matches the selectors
+	assert.ElementsMatch(t, []string{getTestResourceId("parent", 0), getTestResourceId("parent", 1), getTestResourceId("child", 3)}, exportedIds)
+}
+
+// Test that the VCN referenced by a selected subnet is exported, the parent of both in the core graph is the compartment
+// issue-routing-tag: terraform/default
+func TestUnitDiscover_selectorsReferencedResources(t *testing.T) {
+	initResourceDiscoveryTests()
+	defer cleanupResourceDiscoveryTests()
+	originalReferenceMap := referenceMap
+	defer func() { referenceMap = originalReferenceMap }()
+	referenceMap = map[string]string{}
+
+	vcnId := "ocid1.vcn.oc1..payments"
+	otherVcnId := "ocid1.vcn.oc1..other"
+	subnetId := "ocid1.subnet.oc1..payments"
+	items := map[string][]interface{}{
+		"oci_core_vcns": {
+			map[string]interface{}{"id": vcnId, "display_name": "network", "state": "AVAILABLE", "freeform_tags": map[string]interface{}{"Team": "network"}},
+			map[string]interface{}{"id": otherVcnId, "display_name": "other", "state": "AVAILABLE", "freeform_tags": map[string]interface{}{"Team": "network"}},
+		},
+		"oci_core_subnets": {
+			map[string]interface{}{"id": subnetId, "vcn_id": vcnId, "display_name": "payments", "state": "AVAILABLE", "freeform_tags": map[string]interface{}{"Team": "payments"}},
+		},
+	}
+
+	// The VCNs and subnets are discovered with the associations of the core graph, from the data sources listing the items above
+	resourceGraph := TerraformResourceGraph{"oci_identity_compartment": {}}
+	for _, association := range coreResourceGraph["oci_identity_compartment"] {
+		itemList, exists := items[association.datasourceClass]
+		if !exists {
+			continue
+		}
+		resourceGraph["oci_identity_compartment"] = append(resourceGraph["oci_identity_compartment"], association)
+
+		originalDatasource := datasourcesMap[association.datasourceClass]
+		defer func(datasourceClass string) { datasourcesMap[datasourceClass] = originalDatasource }(association.datasourceClass)
+		itemsAttr := association.datasourceItemsAttr
+		datasourcesMap[association.datasourceClass] = &schema.Resource{
+			Schema: originalDatasource.Schema,
+			Read: func(d *schema.ResourceData, m interface{}) error {
+				d.SetId("datasource")
+				return d.Set(itemsAttr, itemList)
+			},
+		}
+	}
+	assert.Len(t, resourceGraph["oci_identity_compartment"], 2)
+
+	selectors, err := (&ExportCommandArgs{IncludeTags: []string{"Team:payments"}}).getResourceSelectors()
+	if err != nil {
+		t.Fatalf("got error from getResourceSelectors: %v", err)
+	}
+	ctx := &resourceDiscoveryContext{errorList: ErrorList{}, selectors: selectors}
+	step := &resourceDiscoveryWithGraph{
+		root:                      getRootCompartmentResource(),
+		resourceGraph:             resourceGraph,
+		resourceDiscoveryBaseStep: resourceDiscoveryBaseStep{name: "core", ctx: ctx},
+	}
+	if err := step.discover(); err != nil {
+		t.Fatalf("got error from discover: %v", err)
+	}
+
+	discoveredIds := []string{}
+	for _, resource := range step.getDiscoveredResources() {
+		discoveredIds = append(discoveredIds, resource.id)
+	}
+	omittedIds := []string{}
+	for _, resource := range step.getOmittedResources() {
+		omittedIds = append(omittedIds, resource.id)
+	}
+	assert.ElementsMatch(t, []string{vcnId, subnetId}, discoveredIds)
+	assert.ElementsMatch(t, []string{otherVcnId}, omittedIds)
+	// The subnet references the exported VCN rather than a hard coded id
+	assert.Contains(t, referenceMap[vcnId], "oci_core_vcn.")
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitResourceSelectors_isSelected(t *testing.T) {
+	nameRegex := "^payments-"
+	args := &ExportCommandArgs{
+		IncludeTags:     []string{"Team:payments", "Operations.CostCenter"},
+		ExcludeTags:     []string{"Lifecycle:temporary"},
+		NameRegex:       &nameRegex,
+		LifecycleStates: []string{"active"},
+	}
+	selectors, err := args.getResourceSelectors()
+	if err != nil {
+		t.Fatalf("got error from getResourceSelectors: %v", err)
+	}
+
+	selectedParent := &OCIResource{isSelected: true}
+	tests := []struct {
+		name       string
+		attributes map[string]interface{}
+		parent     *OCIResource
+		want       bool
+	}{
+		{"freeform tag", map[string]interface{}{"display_name": "payments-vcn", "state": "ACTIVE", "freeform_tags": map[string]interface{}{"Team": "payments"}}, nil, true},
+		{"defined tag with any value", map[string]interface{}{"display_name": "payments-vcn", "state": "ACTIVE", "defined_tags": map[string]interface{}{"operations.costcenter": "42"}}, nil, true},
+		{"tag value mismatch", map[string]interface{}{"display_name": "payments-vcn", "state": "ACTIVE", "freeform_tags": map[string]interface{}{"Team": "billing"}}, nil, false},
+		{"excluded tag", map[string]interface{}{"display_name": "payments-vcn", "state": "ACTIVE", "freeform_tags": map[string]interface{}{"Team": "payments", "Lifecycle": "temporary"}}, nil, false},
+		{"name mismatch", map[string]interface{}{"display_name": "billing-vcn", "state": "ACTIVE", "freeform_tags": map[string]interface{}{"Team": "payments"}}, nil, false},
+		{"name attribute", map[string]interface{}{"name": "payments-bucket", "state": "ACTIVE", "freeform_tags": map[string]interface{}{"Team": "payments"}}, nil, true},
+		{"state mismatch", map[string]interface{}{"display_name": "payments-vcn", "state": "TERMINATED", "freeform_tags": map[string]interface{}{"Team": "payments"}}, nil, false},
+		{"no tags with selected parent", map[string]interface{}{"display_name": "payments-rule", "state": "ACTIVE"}, selectedParent, true},
+		{"no tags with parent not selected", map[string]interface{}{"display_name": "payments-rule", "state": "ACTIVE"}, &OCIResource{}, false},
+		{"no attributes without parent", map[string]interface{}{}, nil, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resource := &OCIResource{sourceAttributes: test.attributes, parent: test.parent}
+			assert.Equal(t, test.want, selectors.isSelected(resource))
+		})
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitExportCommandArgs_getResourceSelectors(t *testing.T) {
+	selectors, err := (&ExportCommandArgs{IncludeTags: []string{""}}).getResourceSelectors()
+	assert.NoError(t, err)
+	assert.Nil(t, selectors)
+
+	_, err = (&ExportCommandArgs{ExcludeTags: []string{":payments"}}).getResourceSelectors()
+	assert.EqualError(t, err, "[ERROR] invalid exclude_tags ':payments', expected <tag key>:<tag value> or <tag key>")
+
+	nameRegex := "payments-("
+	_, err = (&ExportCommandArgs{NameRegex: &nameRegex}).getResourceSelectors()
+	assert.Error(t, err)
+
+	outputDir, err := createOutputDir()
+	if err != nil {
+		t.Fatalf("got error creating output directory: %v", err)
+	}
+	defer os.RemoveAll(outputDir)
+	args := &ExportCommandArgs{OutputDir: &outputDir, IDs: []string{"oci_core_vcn:ocid1.vcn.oc1..a"}, LifecycleStates: []string{"ACTIVE"}}
+	assert.EqualError(t, args.validate(), "[ERROR] include_tags, exclude_tags, name_regex and lifecycle_states cannot be specified along with ids")
+}
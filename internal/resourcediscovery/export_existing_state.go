@@ -0,0 +1,330 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+
+	tfjson "github.com/hashicorp/terraform-json"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+// Only the v4 state format (Terraform v0.12 and above) can be read as an existing state
+const supportedExistingStateVersion = 4
+
+// showConfigurationStateVar reads the state of a configuration directory, tests replace it to avoid running Terraform
+var showConfigurationStateVar = showConfigurationState
+
+// ManagedResource is a resource instance found in the existing state file or in the state of the configuration directory
+type ManagedResource struct {
+	Address       string `json:"address"`
+	Type          string `json:"type"`
+	Id            string `json:"id"`
+	compartmentId string
+}
+
+// DriftReport lists the managed resources that were found by resource discovery
+// and the managed resources that no longer exist in the exported compartments
+type DriftReport struct {
+	StateFile        string             `json:"state_file"`
+	ManagedResources []*ManagedResource `json:"managed_resources"`
+	MissingResources []*ManagedResource `json:"missing_resources"`
+}
+
+type existingState struct {
+	resources []*ManagedResource
+	lookup    map[string]*ManagedResource // managed resources keyed by `<resource type>:<id>`
+}
+
+type terraformStateV4 struct {
+	Version   int `json:"version"`
+	Resources []struct {
+		Module    string `json:"module"`
+		Mode      string `json:"mode"`
+		Type      string `json:"type"`
+		Name      string `json:"name"`
+		Instances []struct {
+			IndexKey   interface{}            `json:"index_key"`
+			Attributes map[string]interface{} `json:"attributes"`
+		} `json:"instances"`
+	} `json:"resources"`
+}
+
+func getManagedResourceKey(resourceType string, id string) string {
+	return fmt.Sprintf("%s:%s", resourceType, id)
+}
+
+// getExistingState reads the managed resources from the existing state file, or from the state of the configuration
+// directory when existing_state_file is a directory
+func getExistingState(args *ExportCommandArgs) (*existingState, error) {
+	if stateFile, err := os.Stat(*args.ExistingStateFile); err == nil && stateFile.IsDir() {
+		return loadConfigurationState(args, *args.ExistingStateFile)
+	}
+	return loadExistingState(*args.ExistingStateFile)
+}
+
+/*
+loadExistingState reads the managed resources from an existing Terraform state file
+Data sources and resources without an id are ignored as they can not be matched with discovered resources
+*/
+func loadExistingState(stateFile string) (*existingState, error) {
+	stateBytes, err := ioutil.ReadFile(stateFile)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] unable to read existing state file %s: %s", stateFile, err.Error())
+	}
+
+	var state terraformStateV4
+	if err := json.Unmarshal(stateBytes, &state); err != nil {
+		return nil, fmt.Errorf("[ERROR] unable to parse existing state file %s: %s", stateFile, err.Error())
+	}
+
+	if state.Version != supportedExistingStateVersion {
+		return nil, fmt.Errorf("[ERROR] existing state file %s has unsupported version %d, only version %d is supported", stateFile, state.Version, supportedExistingStateVersion)
+	}
+
+	result := newExistingState()
+	for _, resource := range state.Resources {
+		if resource.Mode != "managed" {
+			continue
+		}
+
+		address := fmt.Sprintf("%s.%s", resource.Type, resource.Name)
+		if resource.Module != "" {
+			address = fmt.Sprintf("%s.%s", resource.Module, address)
+		}
+
+		for _, instance := range resource.Instances {
+			id, _ := instance.Attributes["id"].(string)
+			if id == "" {
+				continue
+			}
+
+			managedResource := &ManagedResource{
+				Address: address,
+				Type:    resource.Type,
+				Id:      id,
+			}
+			switch indexKey := instance.IndexKey.(type) {
+			case string:
+				managedResource.Address = fmt.Sprintf("%s[%q]", address, indexKey)
+			case float64:
+				managedResource.Address = fmt.Sprintf("%s[%d]", address, int(indexKey))
+			}
+			if compartmentId, ok := instance.Attributes["compartment_id"].(string); ok {
+				managedResource.compartmentId = compartmentId
+			}
+
+			result.addManagedResource(managedResource)
+		}
+	}
+	return result, nil
+}
+
+/*
+loadConfigurationState reads the managed resources from the state of a configuration directory
+The state is read with `terraform show -json` in the directory, from the backend of its current workspace, local or
+remote, so the directory must have been initialized with `terraform init`.
+*/
+func loadConfigurationState(args *ExportCommandArgs, configDir string) (*existingState, error) {
+	state, err := showConfigurationStateVar(args, configDir)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] unable to read the state of the configuration directory %s: %s", configDir, err.Error())
+	}
+
+	result := newExistingState()
+	if state.Values != nil {
+		result.addStateModule(state.Values.RootModule)
+	}
+	return result, nil
+}
+
+// showConfigurationState runs `terraform show -json` in a configuration directory
+func showConfigurationState(args *ExportCommandArgs, configDir string) (*tfjson.State, error) {
+	tf, _, err := createTerraformStructInDir(args, configDir)
+	if err != nil {
+		return nil, err
+	}
+	// The state may hold sensitive values, it is decoded without being written to the output
+	tf.SetStdout(ioutil.Discard)
+	return tf.Show(context.Background())
+}
+
+func newExistingState() *existingState {
+	return &existingState{
+		resources: []*ManagedResource{},
+		lookup:    map[string]*ManagedResource{},
+	}
+}
+
+func (s *existingState) addManagedResource(managedResource *ManagedResource) {
+	s.resources = append(s.resources, managedResource)
+	s.lookup[getManagedResourceKey(managedResource.Type, managedResource.Id)] = managedResource
+}
+
+// addStateModule adds the managed resources of a module of the state shown by Terraform and of its child modules
+func (s *existingState) addStateModule(module *tfjson.StateModule) {
+	if module == nil {
+		return
+	}
+	for _, resource := range module.Resources {
+		if resource.Mode != tfjson.ManagedResourceMode {
+			continue
+		}
+		id, _ := resource.AttributeValues["id"].(string)
+		if id == "" {
+			continue
+		}
+		compartmentId, _ := resource.AttributeValues["compartment_id"].(string)
+		s.addManagedResource(&ManagedResource{
+			Address:       resource.Address,
+			Type:          resource.Type,
+			Id:            id,
+			compartmentId: compartmentId,
+		})
+	}
+	for _, childModule := range module.ChildModules {
+		s.addStateModule(childModule)
+	}
+}
+
+func (s *existingState) getManagedResource(resource *OCIResource) (*ManagedResource, bool) {
+	managedResource, exists := s.lookup[getManagedResourceKey(resource.terraformClass, resource.id)]
+	return managedResource, exists
+}
+
+// excludeManagedResources moves the discovered resources that are already managed in the existing state to the omitted resources
+// References to omitted resources are removed from the referenceMap so that the generated configuration uses hard coded values for them
+func (r *resourceDiscoveryBaseStep) excludeManagedResources() {
+	if r.ctx.existingState == nil {
+		return
+	}
+
+	unmanagedResources := []*OCIResource{}
+	for _, resource := range r.discoveredResources {
+		// Data sources added by resource discovery are referenced by other resources and are never part of the state
+		if resource.terraformTypeInfo != nil && resource.terraformTypeInfo.isDataSource {
+			unmanagedResources = append(unmanagedResources, resource)
+			continue
+		}
+
+		if managedResource, isManaged := r.ctx.existingState.getManagedResource(resource); isManaged {
+			utils.Debugf("[DEBUG] skip exporting '%s' since it is managed as '%s' in the existing state", resource.getTerraformReference(), managedResource.Address)
+			resource.omitFromExport = true
+			r.omittedResources = append(r.omittedResources, resource)
+			continue
+		}
+		unmanagedResources = append(unmanagedResources, resource)
+	}
+	r.discoveredResources = unmanagedResources
+}
+
+/*
+getDriftReport compares the managed resources in the existing state with all the resources found by the discovery steps
+Managed resources are only reported missing if their resource type was discovered without errors in their compartment,
+which is any compartment of the tree when the export is recursive or generates compartment modules
+*/
+func getDriftReport(ctx *resourceDiscoveryContext, steps []resourceDiscoveryStep) *DriftReport {
+	report := &DriftReport{
+		StateFile:        *ctx.ExistingStateFile,
+		ManagedResources: []*ManagedResource{},
+		MissingResources: []*ManagedResource{},
+	}
+
+	foundResources := map[string]bool{}
+	discoverableTypes := map[string]bool{}
+	for _, step := range steps {
+		for _, resource := range step.getDiscoveredResources() {
+			foundResources[getManagedResourceKey(resource.terraformClass, resource.id)] = true
+		}
+		for _, resource := range step.getOmittedResources() {
+			foundResources[getManagedResourceKey(resource.terraformClass, resource.id)] = true
+		}
+
+		if graphStep, ok := step.(*resourceDiscoveryWithGraph); ok {
+			for _, associations := range graphStep.resourceGraph {
+				for _, association := range associations {
+					discoverableTypes[association.resourceClass] = true
+				}
+			}
+		}
+	}
+
+	// Resource types that failed discovery, along with their children, can not be reported as missing
+	for _, rdError := range ctx.errorList.errors {
+		if rdError.resourceType == "" {
+			continue
+		}
+		delete(discoverableTypes, rdError.resourceType)
+		if rdError.resourceGraph != nil {
+			var notFoundChildren []string
+			getNotFoundChildren(rdError.resourceType, rdError.resourceGraph, &notFoundChildren)
+			for _, child := range notFoundChildren {
+				delete(discoverableTypes, child)
+			}
+		}
+	}
+
+	for _, managedResource := range ctx.existingState.resources {
+		if foundResources[getManagedResourceKey(managedResource.Type, managedResource.Id)] {
+			report.ManagedResources = append(report.ManagedResources, managedResource)
+			continue
+		}
+
+		if ctx.targetSpecificResources || !discoverableTypes[managedResource.Type] {
+			continue
+		}
+
+		if managedResource.compartmentId != "" && !ctx.isDiscoveredCompartment(managedResource.compartmentId) {
+			continue
+		}
+		report.MissingResources = append(report.MissingResources, managedResource)
+	}
+
+	sort.SliceStable(report.MissingResources, func(i, j int) bool {
+		return report.MissingResources[i].Address < report.MissingResources[j].Address
+	})
+	return report
+}
+
+// isDiscoveredCompartment returns true if the resources of the compartment were discovered by the export
+func (ctx *resourceDiscoveryContext) isDiscoveredCompartment(compartmentId string) bool {
+	if ctx.discoveredCompartments == nil {
+		return compartmentId == *ctx.CompartmentId
+	}
+	return ctx.discoveredCompartments[compartmentId]
+}
+
+/*
+generateDriftReportFile writes the drift report for the existing state file under the output directory
+and adds the managed resources that no longer exist to the summary
+*/
+func generateDriftReportFile(ctx *resourceDiscoveryContext, steps []resourceDiscoveryStep) error {
+	report := getDriftReport(ctx, steps)
+
+	reportOutputFile := fmt.Sprintf("%s%s%s", *ctx.OutputDir, string(os.PathSeparator), globalvar.DriftReportFile)
+	reportBytes, err := json.MarshalIndent(report, "", "\t")
+	if err != nil {
+		return fmt.Errorf("[ERROR] error marshalling drift report to JSON: %v", err)
+	}
+	if err := ioutil.WriteFile(reportOutputFile, reportBytes, 0644); err != nil {
+		return fmt.Errorf("[ERROR] error writing drift report at %s: %s", reportOutputFile, err.Error())
+	}
+
+	ctx.summaryStatements = append(ctx.summaryStatements, fmt.Sprintf("Skipped %d resources already managed in '%s'. Drift report generated under '%s'", len(report.ManagedResources), report.StateFile, reportOutputFile))
+	if len(report.MissingResources) > 0 {
+		ctx.summaryStatements = append(ctx.summaryStatements, "")
+		ctx.summaryStatements = append(ctx.summaryStatements, "Warning: The following managed resources were not found.")
+		for _, managedResource := range report.MissingResources {
+			ctx.summaryStatements = append(ctx.summaryStatements, fmt.Sprintf("- %s (%s)", managedResource.Address, managedResource.Id))
+		}
+	}
+	return nil
+}
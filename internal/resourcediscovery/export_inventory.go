@@ -0,0 +1,126 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+)
+
+// InventoryResource is a resource found by resource discovery
+type InventoryResource struct {
+	TerraformClass            string                 `json:"terraform_class"`
+	TerraformName             string                 `json:"terraform_name"`
+	Address                   string                 `json:"address"`
+	Id                        string                 `json:"id"`
+	CompartmentId             string                 `json:"compartment_id,omitempty"`
+	ParentClass               string                 `json:"parent_terraform_class,omitempty"`
+	ParentId                  string                 `json:"parent_id,omitempty"`
+	Region                    string                 `json:"region,omitempty"`
+	FreeformTags              map[string]interface{} `json:"freeform_tags,omitempty"`
+	DefinedTags               map[string]interface{} `json:"defined_tags,omitempty"`
+	IsDataSource              bool                   `json:"is_data_source"`
+	IsOmitted                 bool                   `json:"is_omitted"`        // the resource is not exported, e.g. it does not match the selectors
+	IsErrorResource           bool                   `json:"is_error_resource"` // the resource failed to import into the state file and is not exported
+	MissingRequiredAttributes []string               `json:"missing_required_attributes,omitempty"`
+}
+
+// InventoryError is an error encountered by resource discovery
+type InventoryError struct {
+	ResourceType   string `json:"resource_type,omitempty"`
+	ParentResource string `json:"parent_resource,omitempty"`
+	Error          string `json:"error"`
+}
+
+// Inventory lists the resources found by resource discovery along with the discovery errors, so that the results of
+// an export can be consumed without parsing the generated configuration
+type Inventory struct {
+	CompartmentId string               `json:"compartment_id"`
+	Resources     []*InventoryResource `json:"resources"`
+	Errors        []*InventoryError    `json:"errors"`
+}
+
+func getInventoryResource(ctx *resourceDiscoveryContext, resource *OCIResource, isOmitted bool) *InventoryResource {
+	result := &InventoryResource{
+		TerraformClass:  resource.terraformClass,
+		TerraformName:   resource.terraformName,
+		Address:         resource.getTerraformAddress(),
+		Id:              resource.id,
+		CompartmentId:   resource.compartmentId,
+		Region:          resource.region,
+		IsDataSource:    resource.terraformTypeInfo != nil && resource.terraformTypeInfo.isDataSource,
+		IsOmitted:       isOmitted,
+		IsErrorResource: resource.isErrorResource,
+	}
+	if resource.parent != nil {
+		result.ParentClass = resource.parent.terraformClass
+		result.ParentId = resource.parent.id
+	}
+	if freeformTags, ok := resource.sourceAttributes["freeform_tags"].(map[string]interface{}); ok && len(freeformTags) > 0 {
+		result.FreeformTags = freeformTags
+	}
+	if definedTags, ok := resource.sourceAttributes["defined_tags"].(map[string]interface{}); ok && len(definedTags) > 0 {
+		result.DefinedTags = definedTags
+	}
+
+	missingAttributesPerResourceLock.Lock()
+	if missingAttributes, exists := ctx.missingAttributesPerResource[resource.getTerraformReference()]; exists {
+		result.MissingRequiredAttributes = append([]string{}, missingAttributes...)
+		sort.Strings(result.MissingRequiredAttributes)
+	}
+	missingAttributesPerResourceLock.Unlock()
+	return result
+}
+
+// getInventory returns the resources found by the discovery steps, sorted by address, and the discovery errors
+func getInventory(ctx *resourceDiscoveryContext, steps []resourceDiscoveryStep) *Inventory {
+	inventory := &Inventory{
+		CompartmentId: *ctx.CompartmentId,
+		Resources:     []*InventoryResource{},
+		Errors:        []*InventoryError{},
+	}
+
+	for _, step := range steps {
+		for _, resource := range step.getDiscoveredResources() {
+			inventory.Resources = append(inventory.Resources, getInventoryResource(ctx, resource, false))
+		}
+		for _, resource := range step.getOmittedResources() {
+			inventory.Resources = append(inventory.Resources, getInventoryResource(ctx, resource, true))
+		}
+	}
+	sort.SliceStable(inventory.Resources, func(i, j int) bool {
+		return inventory.Resources[i].Address < inventory.Resources[j].Address
+	})
+
+	for _, rdError := range ctx.errorList.errors {
+		inventory.Errors = append(inventory.Errors, &InventoryError{
+			ResourceType:   rdError.resourceType,
+			ParentResource: rdError.parentResource,
+			Error:          rdError.error.Error(),
+		})
+	}
+	return inventory
+}
+
+// generateInventoryFile writes the inventory of the discovered resources under the output directory
+func generateInventoryFile(ctx *resourceDiscoveryContext, steps []resourceDiscoveryStep) error {
+	inventory := getInventory(ctx, steps)
+
+	inventoryOutputFile := fmt.Sprintf("%s%s%s", *ctx.OutputDir, string(os.PathSeparator), globalvar.InventoryFile)
+	inventoryBytes, err := json.MarshalIndent(inventory, "", "\t")
+	if err != nil {
+		return fmt.Errorf("[ERROR] error marshalling inventory to JSON: %v", err)
+	}
+	if err := ioutil.WriteFile(inventoryOutputFile, inventoryBytes, 0644); err != nil {
+		return fmt.Errorf("[ERROR] error writing inventory at %s: %s", inventoryOutputFile, err.Error())
+	}
+
+	ctx.summaryStatements = append(ctx.summaryStatements, fmt.Sprintf("Inventory of %d resources generated under '%s'", len(inventory.Resources), inventoryOutputFile))
+	return nil
+}
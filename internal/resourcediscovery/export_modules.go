@@ -0,0 +1,370 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl2/hclwrite"
+	oci_identity "github.com/oracle/oci-go-sdk/v65/identity"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+type ExportLayoutEnum string
+
+// Set of constants representing the allowed values for ExportLayoutEnum
+const (
+	ExportLayoutFlat               ExportLayoutEnum = "flat"
+	ExportLayoutCompartmentModules ExportLayoutEnum = "compartment_modules"
+)
+
+var moduleVariableRegex = regexp.MustCompile(`var\.([a-zA-Z0-9_\-]+)`)
+
+// rootCompartmentVariable is the variable of the non-root modules with the exported compartment, their compartment_ocid
+// variable is the compartment of the module
+const rootCompartmentVariable = "root_compartment_ocid"
+
+// compartmentModule is the Terraform module generated for a compartment in the exported compartment tree
+type compartmentModule struct {
+	name             string
+	compartmentId    string
+	isRoot           bool
+	interpolationMap map[string]string // referenceMap with the references to resources in other modules replaced by module variables
+	variables        map[string]bool   // variables used by the configuration generated for this module
+	outputs          map[string]string // outputs of this module used by other modules, mapped to the exported expression
+}
+
+// moduleReference is a reference to a resource in another module, passed as an output of that module and as a variable of the referencing module
+type moduleReference struct {
+	moduleName string
+	expression string
+}
+
+func (args *ExportCommandArgs) isCompartmentModulesLayout() bool {
+	return args.Layout == ExportLayoutCompartmentModules
+}
+
+func (module *compartmentModule) getOutputDir(outputDir string) string {
+	return filepath.Join(outputDir, globalvar.ModulesDir, module.name)
+}
+
+/*
+getCompartmentModules walks the compartment tree under the exported compartment and returns a module for each active compartment
+The module for the exported compartment is always the first one
+*/
+func getCompartmentModules(ctx *resourceDiscoveryContext) ([]*compartmentModule, error) {
+	moduleNames := map[string]bool{}
+	newCompartmentModule := func(compartmentId string, compartmentName string, isRoot bool) *compartmentModule {
+		// The suffix is checked against the names already taken, e.g. a compartment named "network_1" before a second
+		// compartment named "network"
+		normalizedName := getNormalizedTerraformName(compartmentName)
+		name := normalizedName
+		for count := 1; moduleNames[name]; count++ {
+			name = fmt.Sprintf("%s_%d", normalizedName, count)
+		}
+		moduleNames[name] = true
+		return &compartmentModule{
+			name:          name,
+			compartmentId: compartmentId,
+			isRoot:        isRoot,
+			variables:     map[string]bool{},
+			outputs:       map[string]string{},
+		}
+	}
+
+	response, err := identityClientGetCompartmentVar(ctx.clients, oci_identity.GetCompartmentRequest{CompartmentId: ctx.CompartmentId})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] unable to get compartment %s: %v", *ctx.CompartmentId, err)
+	}
+	rootName := "root"
+	if response.Name != nil {
+		rootName = *response.Name
+	}
+	modules := []*compartmentModule{newCompartmentModule(*ctx.CompartmentId, rootName, true)}
+
+	// modules grows as sub-compartments are found, so that the whole tree is visited
+	for idx := 0; idx < len(modules); idx++ {
+		parentId := modules[idx].compartmentId
+		req := oci_identity.ListCompartmentsRequest{
+			CompartmentId:  &parentId,
+			LifecycleState: oci_identity.CompartmentLifecycleStateActive,
+		}
+		for {
+			resp, err := identityClientListCompartmentsVar(ctx.clients, req)
+			if err != nil {
+				return nil, fmt.Errorf("[ERROR] unable to list compartments under %s: %v", parentId, err)
+			}
+
+			for _, compartment := range resp.Items {
+				if compartment.Id == nil || compartment.Name == nil {
+					continue
+				}
+				utils.Logf("[INFO] found compartment '%s' under '%s'", *compartment.Name, parentId)
+				modules = append(modules, newCompartmentModule(*compartment.Id, *compartment.Name, false))
+			}
+
+			if resp.OpcNextPage == nil {
+				break
+			}
+			req.Page = resp.OpcNextPage
+		}
+	}
+	return modules, nil
+}
+
+func (ctx *resourceDiscoveryContext) getCompartmentModule(compartmentId string) (*compartmentModule, bool) {
+	for _, module := range ctx.compartmentModules {
+		if module.compartmentId == compartmentId {
+			return module, true
+		}
+	}
+	return nil, false
+}
+
+func (ctx *resourceDiscoveryContext) getRootModuleName() string {
+	if len(ctx.compartmentModules) == 0 {
+		return ""
+	}
+	return ctx.compartmentModules[0].name
+}
+
+// getResourceModuleName returns the module of the closest resource in the parent chain whose compartment is part of the exported tree
+func (ctx *resourceDiscoveryContext) getResourceModuleName(resource *OCIResource, defaultModuleName string) string {
+	for current := resource; current != nil; current = current.parent {
+		if compartmentId, ok := current.sourceAttributes["compartment_id"].(string); ok {
+			if module, exists := ctx.getCompartmentModule(compartmentId); exists {
+				return module.name
+			}
+		}
+	}
+	return defaultModuleName
+}
+
+// getReferencedResource returns the resource reference and expression from an interpolation in the referenceMap
+// e.g. `oci_core_vcn.vcn1` and `oci_core_vcn.vcn1.id` for the interpolation `oci_core_vcn.vcn1.id`
+func getReferencedResource(interpolation string) (string, string, bool) {
+	expression := strings.TrimSuffix(strings.TrimPrefix(interpolation, "\"${"), "}\"")
+	parts := strings.Split(expression, ".")
+
+	if parts[0] == "data" && len(parts) > 3 {
+		return strings.Join(parts[:3], "."), expression, true
+	}
+	if strings.HasPrefix(parts[0], "oci_") && len(parts) > 2 {
+		return strings.Join(parts[:2], "."), expression, true
+	}
+	return "", expression, false
+}
+
+func getResourceModuleReference(resource *OCIResource) string {
+	if resource.terraformTypeInfo != nil && resource.terraformTypeInfo.isDataSource {
+		return fmt.Sprintf("data.%s", resource.getTerraformReference())
+	}
+	return resource.getTerraformReference()
+}
+
+/*
+prepareCompartmentModules assigns the discovered resources to the modules of their compartments
+and builds an interpolation map for each module, where references to resources in other modules are replaced by module variables
+*/
+func prepareCompartmentModules(ctx *resourceDiscoveryContext, steps []resourceDiscoveryStep) {
+	resourceModules := map[string]string{}
+	for _, step := range steps {
+		for _, resource := range step.getDiscoveredResources() {
+			resource.moduleName = ctx.getResourceModuleName(resource, step.getBaseStep().moduleName)
+			resourceModules[getResourceModuleReference(resource)] = resource.moduleName
+		}
+	}
+	assignModuleConfigurations(steps)
+
+	compartmentVariable := tfHclVersion.getVarHclString("compartment_ocid")
+	ctx.moduleReferences = map[string]*moduleReference{}
+	for _, module := range ctx.compartmentModules {
+		module.interpolationMap = map[string]string{}
+		for value, interpolation := range referenceMap {
+			module.interpolationMap[value] = interpolation
+			if interpolation == compartmentVariable && !module.isRoot {
+				module.interpolationMap[value] = tfHclVersion.getVarHclString(rootCompartmentVariable)
+			}
+
+			reference, expression, isResourceReference := getReferencedResource(interpolation)
+			if !isResourceReference {
+				continue
+			}
+			if ownerModuleName, exists := resourceModules[reference]; exists && ownerModuleName != module.name {
+				variableName := strings.ReplaceAll(expression, ".", "_")
+				ctx.moduleReferences[variableName] = &moduleReference{
+					moduleName: ownerModuleName,
+					expression: expression,
+				}
+				module.interpolationMap[value] = tfHclVersion.getVarHclString(variableName)
+			}
+		}
+
+		// Compartments that are not discovered as resources are passed to their module as a variable
+		if _, exists := module.interpolationMap[module.compartmentId]; !exists {
+			module.interpolationMap[module.compartmentId] = compartmentVariable
+		}
+	}
+}
+
+/*
+assignModuleConfigurations assigns the configuration file of each module and service to a single step, along with the
+resources discovered in that module by all the steps of the service, so that the file is not overwritten by another step
+A step discovers the resources of its module as well as of the modules of the child compartments, and the steps of a
+module first get its files, so that a file is written for them even without resources and stale resources from a
+previous run are overwritten
+*/
+func assignModuleConfigurations(steps []resourceDiscoveryStep) {
+	owners := map[string]*resourceDiscoveryBaseStep{}
+	for _, step := range steps {
+		baseStep := step.getBaseStep()
+		baseStep.moduleConfigurations = map[string][]*OCIResource{}
+		key := fmt.Sprintf("%s/%s", baseStep.moduleName, baseStep.name)
+		if _, exists := owners[key]; !exists {
+			owners[key] = baseStep
+			baseStep.moduleConfigurations[baseStep.moduleName] = []*OCIResource{}
+		}
+	}
+
+	for _, step := range steps {
+		baseStep := step.getBaseStep()
+		for _, resource := range baseStep.discoveredResources {
+			key := fmt.Sprintf("%s/%s", resource.moduleName, baseStep.name)
+			owner, exists := owners[key]
+			if !exists {
+				owner = baseStep
+				owners[key] = owner
+			}
+			owner.moduleConfigurations[resource.moduleName] = append(owner.moduleConfigurations[resource.moduleName], resource)
+		}
+	}
+}
+
+// addModuleVariables records the variables referenced in the configuration generated for the module
+// Only references to other modules and the variables exported in the root module can be passed to the module
+func (ctx *resourceDiscoveryContext) addModuleVariables(module *compartmentModule, config string) {
+	ctx.ctxLock.Lock()
+	defer ctx.ctxLock.Unlock()
+	for _, match := range moduleVariableRegex.FindAllStringSubmatch(config, -1) {
+		variable := match[1]
+		_, isModuleReference := ctx.moduleReferences[variable]
+		refMapLock.Lock()
+		_, isRootVariable := vars[variable]
+		refMapLock.Unlock()
+		isCompartmentVariable := variable == "compartment_ocid" || (variable == rootCompartmentVariable && !module.isRoot)
+		if isModuleReference || isRootVariable || isCompartmentVariable {
+			module.variables[variable] = true
+		}
+	}
+}
+
+func writeFormattedFile(outputFile string, builder *strings.Builder) error {
+	tmpOutputFile := fmt.Sprintf("%s.tmp", outputFile)
+	file, err := os.OpenFile(tmpOutputFile, os.O_TRUNC|os.O_CREATE|os.O_RDWR, 0666)
+	if err != nil {
+		return err
+	}
+
+	_, err = file.Write(hclwrite.Format([]byte(builder.String())))
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if fErr := file.Close(); fErr != nil {
+		return fErr
+	}
+
+	return os.Rename(tmpOutputFile, outputFile)
+}
+
+/*
+generateCompartmentModuleFiles writes the variables and outputs of every compartment module
+and the root module that passes the exported variables and the outputs of other modules to each module
+*/
+func generateCompartmentModuleFiles(ctx *resourceDiscoveryContext) error {
+	modulesByName := map[string]*compartmentModule{}
+	for _, module := range ctx.compartmentModules {
+		modulesByName[module.name] = module
+	}
+
+	// Outputs are only generated for the references that are used by other modules
+	for _, module := range ctx.compartmentModules {
+		for variable := range module.variables {
+			if reference, exists := ctx.moduleReferences[variable]; exists {
+				modulesByName[reference.moduleName].outputs[variable] = reference.expression
+			}
+		}
+	}
+
+	rootBuilder := &strings.Builder{}
+	rootBuilder.WriteString("## This configuration was generated by terraform-provider-oci\n\n")
+	for _, module := range ctx.compartmentModules {
+		moduleOutputDir := module.getOutputDir(*ctx.OutputDir)
+		if err := os.MkdirAll(moduleOutputDir, os.ModePerm); err != nil {
+			return err
+		}
+
+		variables := make([]string, 0, len(module.variables))
+		for variable := range module.variables {
+			variables = append(variables, variable)
+		}
+		sort.Strings(variables)
+
+		varsBuilder := &strings.Builder{}
+		for _, variable := range variables {
+			if _, isSecret := secretVars[variable]; isSecret {
+				varsBuilder.WriteString(getSecretVariableHclString(variable, vars[variable]))
+			} else {
+				varsBuilder.WriteString(fmt.Sprintf("variable %s {}\n", variable))
+			}
+		}
+		if err := writeFormattedFile(filepath.Join(moduleOutputDir, globalvar.VarsFile), varsBuilder); err != nil {
+			return err
+		}
+
+		outputs := make([]string, 0, len(module.outputs))
+		for output := range module.outputs {
+			outputs = append(outputs, output)
+		}
+		sort.Strings(outputs)
+
+		outputsBuilder := &strings.Builder{}
+		for _, output := range outputs {
+			outputsBuilder.WriteString(fmt.Sprintf("output %s {\nvalue = %s\n}\n\n", output, tfHclVersion.getSingleExpHclString(module.outputs[output])))
+		}
+		if err := writeFormattedFile(filepath.Join(moduleOutputDir, globalvar.OutputsFile), outputsBuilder); err != nil {
+			return err
+		}
+
+		rootBuilder.WriteString(fmt.Sprintf("module %s {\nsource = \"./%s/%s\"\n", module.name, globalvar.ModulesDir, module.name))
+		for _, variable := range variables {
+			if reference, exists := ctx.moduleReferences[variable]; exists {
+				rootBuilder.WriteString(fmt.Sprintf("%s = %s\n", variable, tfHclVersion.getSingleExpHclString(fmt.Sprintf("module.%s.%s", reference.moduleName, variable))))
+			} else if variable == "compartment_ocid" && !module.isRoot {
+				rootBuilder.WriteString(fmt.Sprintf("%s = %q\n", variable, module.compartmentId))
+			} else if variable == rootCompartmentVariable {
+				rootBuilder.WriteString(fmt.Sprintf("%s = %s\n", variable, tfHclVersion.getVarHclString("compartment_ocid")))
+			} else {
+				rootBuilder.WriteString(fmt.Sprintf("%s = %s\n", variable, tfHclVersion.getVarHclString(variable)))
+			}
+		}
+		rootBuilder.WriteString("}\n\n")
+	}
+
+	modulesOutputFile := filepath.Join(*ctx.OutputDir, globalvar.ModulesFile)
+	if err := writeFormattedFile(modulesOutputFile, rootBuilder); err != nil {
+		return err
+	}
+
+	ctx.summaryStatements = append(ctx.summaryStatements, fmt.Sprintf("Generated %d compartment modules under '%s'", len(ctx.compartmentModules), modulesOutputFile))
+	return nil
+}
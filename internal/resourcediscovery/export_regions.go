@@ -0,0 +1,156 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	tf_client "github.com/terraform-providers/terraform-provider-oci/internal/client"
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+// globalServices are the compartment-scope services whose resources are not regional, they are only discovered in the
+// first exported region along with the tenancy-scope services
+var globalServices = map[string]bool{
+	"tagging": true,
+}
+
+// getRegions returns the regions to export without duplicates, in the order of the regions argument
+func (args *ExportCommandArgs) getRegions() []string {
+	regions := []string{}
+	regionSet := map[string]bool{}
+	for _, region := range args.Regions {
+		region = strings.TrimSpace(region)
+		if region == "" || regionSet[region] {
+			continue
+		}
+		regionSet[region] = true
+		regions = append(regions, region)
+	}
+	return regions
+}
+
+// isMultiRegion returns true if several regions are exported, in which case the resources are generated with the
+// provider alias of their region
+func (args *ExportCommandArgs) isMultiRegion() bool {
+	return len(args.getRegions()) > 1
+}
+
+/*
+getRegionClients creates the clients of the exported regions other than the first one, keyed by region
+The clients of the first region are the clients of the export command, they are created from the provider
+configuration with the region set to the first region.
+*/
+func getRegionClients(d *schema.ResourceData, regions []string) (map[string]*tf_client.OracleClients, error) {
+	result := map[string]*tf_client.OracleClients{}
+	if len(regions) < 2 {
+		return result, nil
+	}
+
+	// getExportConfig sets the configuration provider and client configuration of the export command, they are kept
+	// for the first region
+	configProvider, configureClient := exportConfigProvider, tf_client.ConfigureClientVar
+	defer func() {
+		exportConfigProvider, tf_client.ConfigureClientVar = configProvider, configureClient
+		_ = d.Set(globalvar.RegionAttrName, regions[0])
+	}()
+
+	for _, region := range regions[1:] {
+		if err := d.Set(globalvar.RegionAttrName, region); err != nil {
+			return nil, err
+		}
+		clients, err := getExportConfigVar(d)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] unable to create the clients of region %s: %v", region, err)
+		}
+		result[region] = clients.(*tf_client.OracleClients)
+	}
+	return result, nil
+}
+
+// initRegionContexts creates the contexts used to discover the resources of the regions other than the first one
+// The region contexts share the arguments and the selectors of the export command, only the clients and the errors
+// differ. The errors are added to the export command context by mergeRegionErrors once the discovery is complete.
+func (ctx *resourceDiscoveryContext) initRegionContexts(regionClients map[string]*tf_client.OracleClients) {
+	ctx.regionContexts = map[string]*resourceDiscoveryContext{}
+	for region, clients := range regionClients {
+		ctx.regionContexts[region] = &resourceDiscoveryContext{
+			clients:           clients,
+			ExportCommandArgs: ctx.ExportCommandArgs,
+			tenancyOcid:       ctx.tenancyOcid,
+			errorList: ErrorList{
+				errors: []*ResourceDiscoveryError{},
+			},
+			expectedResourceIds: map[string]bool{},
+			resourceHintsLookup: ctx.resourceHintsLookup,
+			existingState:       ctx.existingState,
+			selectors:           ctx.selectors,
+		}
+	}
+}
+
+// getRegionContext returns the context used to discover the resources of a region
+func (ctx *resourceDiscoveryContext) getRegionContext(region string) *resourceDiscoveryContext {
+	if regionCtx, exists := ctx.regionContexts[region]; exists {
+		return regionCtx
+	}
+	return ctx
+}
+
+// mergeRegionErrors adds the errors encountered while discovering the resources of the other regions to the errors of
+// the export command
+func (ctx *resourceDiscoveryContext) mergeRegionErrors() {
+	for _, region := range ctx.getRegions() {
+		if regionCtx, exists := ctx.regionContexts[region]; exists {
+			ctx.errorList.errors = append(ctx.errorList.errors, regionCtx.errorList.errors...)
+			regionCtx.errorList.errors = []*ResourceDiscoveryError{}
+		}
+	}
+}
+
+/*
+mergeDiscoverySteps merges the steps that discovered a service in several compartments of the flat layout, so that the
+resources of a service are generated in a single file
+The steps of the compartment modules layout are not merged as their resources are generated in different modules.
+*/
+func mergeDiscoverySteps(steps []resourceDiscoveryStep) []resourceDiscoveryStep {
+	result := []resourceDiscoveryStep{}
+	stepsByName := map[string]*resourceDiscoveryBaseStep{}
+	for _, step := range steps {
+		baseStep := step.getBaseStep()
+		key := fmt.Sprintf("%s/%s", baseStep.moduleName, baseStep.name)
+		mergedStep, exists := stepsByName[key]
+		if !exists {
+			stepsByName[key] = baseStep
+			result = append(result, step)
+			continue
+		}
+
+		utils.Debugf("[DEBUG] merging the resources discovered by step %s", baseStep.name)
+		mergedStep.discoveredResources = append(mergedStep.discoveredResources, baseStep.discoveredResources...)
+		mergedStep.omittedResources = append(mergedStep.omittedResources, baseStep.omittedResources...)
+		// The steps are discovered in parallel
+		if baseStep.timeTakenForDiscovery > mergedStep.timeTakenForDiscovery {
+			mergedStep.timeTakenForDiscovery = baseStep.timeTakenForDiscovery
+		}
+	}
+	return result
+}
+
+// getProviderHclString returns the provider configurations, with an aliased provider for each region when exporting
+// several regions
+func getProviderHclString(regions []string) string {
+	builder := &strings.Builder{}
+	builder.WriteString(fmt.Sprintf("provider oci {\n\tregion = %s\n}\n", tfHclVersion.getVarHclString("region")))
+	if len(regions) > 1 {
+		for _, region := range regions {
+			builder.WriteString(fmt.Sprintf("\nprovider oci {\n\talias  = %q\n\tregion = %q\n}\n", region, region))
+		}
+	}
+	return builder.String()
+}
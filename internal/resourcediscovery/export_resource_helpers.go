@@ -103,6 +103,7 @@ type resourceDiscoveryContext struct {
 	terraform                   *tfexec.Terraform
 	clients                     *tf_client.OracleClients
 	expectedResourceIds         map[string]bool
+	expectedResourceIdsLock     *sync.Mutex // guards expectedResourceIds when it is shared with the step contexts, see getExpectedResourceIdsLock
 	tenancyOcid                 string
 	discoveredResources         []*OCIResource
 	summaryStatements           []string
@@ -113,6 +114,13 @@ type resourceDiscoveryContext struct {
 	missingAttributesPerResource map[string][]string
 	isImportError                bool // flag indicates if there was an import failure and if reference map needs to be updated
 	state                        interface{}
+	existingState                *existingState                       // managed resources from the existing state file, if provided
+	selectors                    *resourceSelectors                   // resources to export when selected by tags, name or lifecycle state
+	regionContexts               map[string]*resourceDiscoveryContext // contexts of the regions other than the first one, keyed by region
+	checkpoint                   *discoveryCheckpoint                 // progress of the export written to the output_path, see initCheckpoint
+	compartmentModules           []*compartmentModule
+	discoveredCompartments       map[string]bool             // compartments whose resources are discovered, see getDiscoverResourceWithGraphSteps
+	moduleReferences             map[string]*moduleReference // references between compartment modules, keyed by module variable name
 	timeTakenToDiscover          time.Duration
 	timeTakenToGenerateState     time.Duration
 	timeTakenForEntireExport     time.Duration
@@ -146,6 +154,36 @@ func (ctx *resourceDiscoveryContext) addErrorToList(error *ResourceDiscoveryErro
 
 }
 
+// getExpectedResourceIdsLock returns the lock of the expected resource ids, the step contexts share the lock of the
+// context they were created from
+func (ctx *resourceDiscoveryContext) getExpectedResourceIdsLock() *sync.Mutex {
+	if ctx.expectedResourceIdsLock != nil {
+		return ctx.expectedResourceIdsLock
+	}
+	return &ctx.ctxLock
+}
+
+// isExpectedResourceId returns true if the resource id was given to export
+func (ctx *resourceDiscoveryContext) isExpectedResourceId(id string) bool {
+	lock := ctx.getExpectedResourceIdsLock()
+	lock.Lock()
+	defer lock.Unlock()
+	_, expected := ctx.expectedResourceIds[id]
+	return expected
+}
+
+// setExpectedResourceIdFound marks the resource id as found, it returns false if the resource id was not given to export
+func (ctx *resourceDiscoveryContext) setExpectedResourceIdFound(id string) bool {
+	lock := ctx.getExpectedResourceIdsLock()
+	lock.Lock()
+	defer lock.Unlock()
+	if _, expected := ctx.expectedResourceIds[id]; !expected {
+		return false
+	}
+	ctx.expectedResourceIds[id] = true
+	return true
+}
+
 func (ctx *resourceDiscoveryContext) postValidate() {
 	// Check that all expected resource IDs were found, if any were given
 	var missingResourceIds []string
@@ -261,6 +299,20 @@ func createResourceDiscoveryContext(clients *tf_client.OracleClients, args *Expo
 			break
 		}
 	}
+	selectors, err := args.getResourceSelectors()
+	if err != nil {
+		return result, err
+	}
+	result.selectors = selectors
+
+	if args.ExistingStateFile != nil && *args.ExistingStateFile != "" {
+		existingState, err := getExistingState(args)
+		if err != nil {
+			return result, err
+		}
+		result.existingState = existingState
+	}
+
 	// validate terraform version and initialize terraform for import - only required if generating state file
 	if args.GenerateState {
 		if tf, terraformCLIPath, err := createTerraformStruct(args); err != nil {
@@ -293,6 +345,9 @@ type resourceDiscoveryBaseStep struct {
 	discoveredResources         []*OCIResource
 	omittedResources            []*OCIResource
 	tempState                   interface{}
+	moduleName                  string                    // module of the root compartment for the compartment modules layout
+	moduleConfigurations        map[string][]*OCIResource // resources generated by this step in each module, see assignModuleConfigurations
+	region                      string                    // region of the discovered resources when exporting several regions
 	timeTakenForDiscovery       time.Duration
 	timeTakenForGeneratingState time.Duration
 }
@@ -464,12 +519,58 @@ func (r *resourceDiscoveryBaseStep) writeTmpConfigurationForImport() error {
 
 func (r *resourceDiscoveryBaseStep) writeConfiguration() error {
 	defer elapsed(fmt.Sprintf("writing actual configuration for %d %s resources", len(r.getDiscoveredResources()), r.name), nil, 0)()
+	if r.ctx.isCompartmentModulesLayout() {
+		return r.writeModuleConfigurations()
+	}
+
 	configOutputFile := fmt.Sprintf("%s%s%s.tf", *r.ctx.OutputDir, string(os.PathSeparator), r.name)
-	tmpConfigOutputFile := fmt.Sprintf("%s%s%s.tf.tmp", *r.ctx.OutputDir, string(os.PathSeparator), r.name)
+	exportedResourceCount, _, err := r.writeConfigurationFile(configOutputFile, r.discoveredResources, referenceMap)
+	if err != nil {
+		return err
+	}
+
+	if r.ctx.targetSpecificResources {
+		r.ctx.summaryStatements = append(r.ctx.summaryStatements, fmt.Sprintf("Found %d resources. Generated under '%s'", exportedResourceCount, configOutputFile))
+	} else {
+		r.ctx.summaryStatements = append(r.ctx.summaryStatements, fmt.Sprintf("Found %d '%s' resources. Generated under '%s'", exportedResourceCount, r.name, configOutputFile))
+	}
+	r.ctx.summaryStatements = append(r.ctx.summaryStatements, fmt.Sprintf("Time taken for discovery: %v, generating state: %v", r.timeTakenForDiscovery, r.timeTakenForGeneratingState))
+	return nil
+}
+
+// writeModuleConfigurations writes the configuration for the resources assigned to the step under the directory of their compartment module
+func (r *resourceDiscoveryBaseStep) writeModuleConfigurations() error {
+	for _, module := range r.ctx.compartmentModules {
+		resources, exists := r.moduleConfigurations[module.name]
+		if !exists {
+			continue
+		}
+
+		moduleOutputDir := module.getOutputDir(*r.ctx.OutputDir)
+		if err := os.MkdirAll(moduleOutputDir, os.ModePerm); err != nil {
+			return err
+		}
+
+		configOutputFile := filepath.Join(moduleOutputDir, fmt.Sprintf("%s.tf", r.name))
+		exportedResourceCount, config, err := r.writeConfigurationFile(configOutputFile, resources, module.interpolationMap)
+		if err != nil {
+			return err
+		}
+		r.ctx.addModuleVariables(module, config)
+
+		r.ctx.summaryStatements = append(r.ctx.summaryStatements, fmt.Sprintf("Found %d '%s' resources. Generated under '%s'", exportedResourceCount, r.name, configOutputFile))
+	}
+	r.ctx.summaryStatements = append(r.ctx.summaryStatements, fmt.Sprintf("Time taken for discovery: %v, generating state: %v", r.timeTakenForDiscovery, r.timeTakenForGeneratingState))
+	return nil
+}
+
+// writeConfigurationFile writes the HCL configuration for the given resources and returns the number of exported resources along with the configuration
+func (r *resourceDiscoveryBaseStep) writeConfigurationFile(configOutputFile string, resources []*OCIResource, interpolationMap map[string]string) (int, string, error) {
+	tmpConfigOutputFile := fmt.Sprintf("%s.tmp", configOutputFile)
 
 	file, err := os.OpenFile(tmpConfigOutputFile, os.O_CREATE|os.O_RDWR, 0666)
 	if err != nil {
-		return err
+		return 0, "", err
 	}
 
 	// Build the HCL config
@@ -480,14 +581,14 @@ func (r *resourceDiscoveryBaseStep) writeConfiguration() error {
 	builder.WriteString("## This configuration was generated by terraform-provider-oci\n\n")
 
 	exportedResourceCount := 0
-	for _, resource := range r.discoveredResources {
+	for _, resource := range resources {
 
 		// Skip writing the config for resources for which import command failed
 		if !resource.isErrorResource {
 			utils.Logf("[INFO] ===> Generating resource '%s'", resource.getTerraformReference())
-			if err := resource.getHCLString(builder, referenceMap); err != nil {
+			if err := resource.getHCLString(builder, interpolationMap); err != nil {
 				_ = file.Close()
-				return err
+				return 0, "", err
 			}
 
 			if resource.terraformTypeInfo != nil && len(resource.terraformTypeInfo.ignorableRequiredMissingAttributes) > 0 {
@@ -521,24 +622,17 @@ func (r *resourceDiscoveryBaseStep) writeConfiguration() error {
 	_, err = file.WriteString(string(formattedString))
 	if err != nil {
 		_ = file.Close()
-		return err
+		return 0, "", err
 	}
 
 	if fErr := file.Close(); fErr != nil {
-		return fErr
+		return 0, "", fErr
 	}
 
 	if err := os.Rename(tmpConfigOutputFile, configOutputFile); err != nil {
-		return err
+		return 0, "", err
 	}
-
-	if r.ctx.targetSpecificResources {
-		r.ctx.summaryStatements = append(r.ctx.summaryStatements, fmt.Sprintf("Found %d resources. Generated under '%s'", exportedResourceCount, configOutputFile))
-	} else {
-		r.ctx.summaryStatements = append(r.ctx.summaryStatements, fmt.Sprintf("Found %d '%s' resources. Generated under '%s'", exportedResourceCount, r.name, configOutputFile))
-	}
-	r.ctx.summaryStatements = append(r.ctx.summaryStatements, fmt.Sprintf("Time taken for discovery: %v, generating state: %v", r.timeTakenForDiscovery, r.timeTakenForGeneratingState))
-	return nil
+	return exportedResourceCount, string(formattedString), nil
 }
 
 func (r *resourceDiscoveryBaseStep) getOmittedResources() []*OCIResource {
@@ -570,15 +664,18 @@ func (r *resourceDiscoveryWithGraph) discover() error {
 	var err error
 	var ociResources []*OCIResource
 
-	ociResources, err = findResources(r.ctx, r.root, r.resourceGraph)
+	ociResources, err = findResources(r.ctx.getRegionContext(r.region), r.root, r.resourceGraph)
 	if err != nil {
 		return err
 	}
+	// The resources referenced by the selected resources are exported before their references are culled
+	r.ctx.selectReferencedResources(ociResources)
 
 	// Filter out omitted resources from export
 	r.discoveredResources = []*OCIResource{}
 	r.omittedResources = []*OCIResource{}
 	for _, resource := range ociResources {
+		resource.region = r.region
 		if !resource.omitFromExport {
 
 			refMapLock.Lock()
@@ -0,0 +1,119 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+)
+
+var (
+	externalizeSecrets bool                      // set from the externalize_secrets argument of the export command
+	secretVars         map[string]secretVariable // sensitive variables generated for the secret attributes, guarded by refMapLock
+)
+
+// secretVariable is a sensitive variable and the secret attribute it is set for
+type secretVariable struct {
+	attribute string // address of the attribute, e.g. oci_database_autonomous_database.adb.admin_password
+	valueType schema.ValueType
+}
+
+// isSensitiveAttribute returns true if the attribute has a sensitive primitive value, e.g. a password
+// When secrets are externalized, such attributes are set from a sensitive variable whether they are found in discovery or not
+func isSensitiveAttribute(tfSchema *schema.Schema) bool {
+	if !tfSchema.Sensitive {
+		return false
+	}
+	switch tfSchema.Type {
+	case schema.TypeString, schema.TypeInt, schema.TypeFloat, schema.TypeBool:
+		return true
+	}
+	return false
+}
+
+// getSecretVariableName returns the name of the variable of a secret attribute, e.g. admin_password of the autonomous database adb is adb_admin_password
+func getSecretVariableName(ociRes *OCIResource, attribute string) string {
+	words := strings.FieldsFunc(fmt.Sprintf("%s.%s", ociRes.terraformName, attribute), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	})
+	return strings.Join(words, "_")
+}
+
+// writeSecretAttribute writes a reference to a sensitive variable for the secret attribute instead of its value
+func writeSecretAttribute(builder *strings.Builder, tfSchema *schema.Schema, tfAttribute string, isSet bool, ociRes *OCIResource, attributePrefix string) {
+	attribute := tfAttribute
+	if attributePrefix != "" {
+		attribute = fmt.Sprintf("%s.%s", attributePrefix, tfAttribute)
+	}
+
+	variable := getSecretVariableName(ociRes, attribute)
+	refMapLock.Lock()
+	// Optional attributes that are not set default to null so that the variable does not need a value
+	if tfSchema.Required || isSet {
+		vars[variable] = ""
+	} else {
+		vars[variable] = "null"
+	}
+	secretVars[variable] = secretVariable{attribute: fmt.Sprintf("%s.%s", ociRes.getTerraformAddress(), attribute), valueType: tfSchema.Type}
+	refMapLock.Unlock()
+
+	builder.WriteString(fmt.Sprintf("%s = %s\n", tfAttribute, tfHclVersion.getVarHclString(variable)))
+}
+
+// getSecretVariableHclString returns the declaration of a sensitive variable, the value of the variable is not shown in the plan output
+func getSecretVariableHclString(variable string, defaultVal string) string {
+	if defaultVal != "" {
+		return fmt.Sprintf("variable %s {\nsensitive = true\ndefault = %s\n}\n", variable, defaultVal)
+	}
+	return fmt.Sprintf("variable %s {\nsensitive = true\n}\n", variable)
+}
+
+// getSecretVariableExampleValue returns the empty value of the type of a secret attribute, so that the example values are valid for their attributes
+func getSecretVariableExampleValue(valueType schema.ValueType) string {
+	switch valueType {
+	case schema.TypeInt, schema.TypeFloat:
+		return "0"
+	case schema.TypeBool:
+		return "false"
+	}
+	return `""`
+}
+
+/*
+generateTfvarsExampleFile lists the sensitive variables of the externalized secret attributes under the output directory
+The file is an example to be copied to terraform.tfvars, or its variables can be set with TF_VAR_<name> environment variables from a secret manager
+*/
+func generateTfvarsExampleFile(ctx *resourceDiscoveryContext) error {
+	variables := make([]string, 0, len(secretVars))
+	for variable := range secretVars {
+		variables = append(variables, variable)
+	}
+	sort.Strings(variables)
+
+	builder := &strings.Builder{}
+	builder.WriteString("## Values of the secret attributes of the configuration generated by terraform-provider-oci\n")
+	builder.WriteString("## Copy this file to terraform.tfvars or set the TF_VAR_<variable> environment variables from your secret manager\n\n")
+	for _, variable := range variables {
+		builder.WriteString(fmt.Sprintf("# %s\n", secretVars[variable].attribute))
+		if vars[variable] == "null" {
+			builder.WriteString("# Optional, the attribute is not set when the variable is null\n")
+		}
+		builder.WriteString(fmt.Sprintf("%s = %s\n\n", variable, getSecretVariableExampleValue(secretVars[variable].valueType)))
+	}
+
+	tfvarsOutputFile := fmt.Sprintf("%s%s%s", *ctx.OutputDir, string(os.PathSeparator), globalvar.TfvarsExampleFile)
+	if err := writeFormattedFile(tfvarsOutputFile, builder); err != nil {
+		return err
+	}
+
+	ctx.summaryStatements = append(ctx.summaryStatements, fmt.Sprintf("%d secret attributes are set from sensitive variables. Provide their values listed in '%s'", len(variables), tfvarsOutputFile))
+	return nil
+}
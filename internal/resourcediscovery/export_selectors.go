@@ -0,0 +1,263 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package resourcediscovery
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+// tagSelector selects the resources with a free-form or defined tag, `Team:payments` selects the resources whose
+// `Team` tag is `payments`, `Team` selects the resources with a `Team` tag regardless of its value
+// Defined tags are selected with their namespace, e.g. `Operations.CostCenter:42`
+type tagSelector struct {
+	key      string
+	value    string
+	hasValue bool
+}
+
+/*
+resourceSelectors restricts the export to the resources matching the include_tags, exclude_tags, name_regex and
+lifecycle_states arguments
+
+A resource is selected when it matches all the selectors. A resource that does not have the attribute of a selector,
+e.g. a child resource without tags, is selected along with its parent. The parents of a selected resource in the
+discovery graph and the resources it references through its `_id` and `_ids` attributes are always selected, so that the
+exported configuration has the resources it references.
+*/
+type resourceSelectors struct {
+	includeTags     []tagSelector
+	excludeTags     []tagSelector
+	nameRegex       *regexp.Regexp
+	lifecycleStates map[string]bool
+}
+
+func parseTagSelectors(argumentName string, tags []string) ([]tagSelector, error) {
+	var result []tagSelector
+	for _, tag := range tags {
+		if strings.TrimSpace(tag) == "" {
+			continue
+		}
+		selector := tagSelector{key: tag}
+		if idx := strings.Index(tag, ":"); idx >= 0 {
+			selector = tagSelector{key: tag[:idx], value: tag[idx+1:], hasValue: true}
+		}
+		selector.key = strings.TrimSpace(selector.key)
+		if selector.key == "" {
+			return nil, fmt.Errorf("[ERROR] invalid %s '%s', expected <tag key>:<tag value> or <tag key>", argumentName, tag)
+		}
+		result = append(result, selector)
+	}
+	return result, nil
+}
+
+// getResourceSelectors returns the selectors of the export command arguments, or nil if no selector is specified
+func (args *ExportCommandArgs) getResourceSelectors() (*resourceSelectors, error) {
+	includeTags, err := parseTagSelectors("include_tags", args.IncludeTags)
+	if err != nil {
+		return nil, err
+	}
+	excludeTags, err := parseTagSelectors("exclude_tags", args.ExcludeTags)
+	if err != nil {
+		return nil, err
+	}
+
+	var nameRegex *regexp.Regexp
+	if args.NameRegex != nil && *args.NameRegex != "" {
+		if nameRegex, err = regexp.Compile(*args.NameRegex); err != nil {
+			return nil, fmt.Errorf("[ERROR] invalid name_regex '%s': %v", *args.NameRegex, err)
+		}
+	}
+
+	lifecycleStates := map[string]bool{}
+	for _, state := range args.LifecycleStates {
+		if state = strings.TrimSpace(state); state != "" {
+			lifecycleStates[strings.ToUpper(state)] = true
+		}
+	}
+
+	if len(includeTags) == 0 && len(excludeTags) == 0 && nameRegex == nil && len(lifecycleStates) == 0 {
+		return nil, nil
+	}
+	return &resourceSelectors{
+		includeTags:     includeTags,
+		excludeTags:     excludeTags,
+		nameRegex:       nameRegex,
+		lifecycleStates: lifecycleStates,
+	}, nil
+}
+
+// hasTag returns true if the resource has a free-form or defined tag matching the selector, the tag keys are compared
+// regardless of case
+func (resource *OCIResource) hasTag(selector tagSelector) bool {
+	for _, tagsAttribute := range []string{"freeform_tags", "defined_tags"} {
+		tags, ok := resource.sourceAttributes[tagsAttribute].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for key, value := range tags {
+			if strings.EqualFold(key, selector.key) && (!selector.hasValue || fmt.Sprintf("%v", value) == selector.value) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (resource *OCIResource) hasTags() bool {
+	_, hasFreeformTags := resource.sourceAttributes["freeform_tags"].(map[string]interface{})
+	_, hasDefinedTags := resource.sourceAttributes["defined_tags"].(map[string]interface{})
+	return hasFreeformTags || hasDefinedTags
+}
+
+// getName returns the display name or the name of the resource
+func (resource *OCIResource) getName() (string, bool) {
+	for _, nameAttribute := range []string{"display_name", "name"} {
+		if name, ok := resource.sourceAttributes[nameAttribute].(string); ok {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// isSelected returns true if the resource matches the selectors, the selectors for attributes that the resource does
+// not have are matched by its parent
+func (s *resourceSelectors) isSelected(resource *OCIResource) bool {
+	inheritsSelection := false
+
+	if len(s.includeTags) > 0 || len(s.excludeTags) > 0 {
+		if !resource.hasTags() {
+			inheritsSelection = true
+		} else {
+			included := len(s.includeTags) == 0
+			for _, selector := range s.includeTags {
+				if resource.hasTag(selector) {
+					included = true
+					break
+				}
+			}
+			if !included {
+				return false
+			}
+			for _, selector := range s.excludeTags {
+				if resource.hasTag(selector) {
+					return false
+				}
+			}
+		}
+	}
+
+	if s.nameRegex != nil {
+		if name, ok := resource.getName(); !ok {
+			inheritsSelection = true
+		} else if !s.nameRegex.MatchString(name) {
+			return false
+		}
+	}
+
+	if len(s.lifecycleStates) > 0 {
+		if state, ok := resource.sourceAttributes["state"].(string); !ok {
+			inheritsSelection = true
+		} else if !s.lifecycleStates[strings.ToUpper(state)] {
+			return false
+		}
+	}
+
+	if inheritsSelection {
+		return resource.parent != nil && resource.parent.isSelected
+	}
+	return true
+}
+
+// selectResource omits a discovered resource from the export if it does not match the selectors
+func (ctx *resourceDiscoveryContext) selectResource(resource *OCIResource, alwaysExportable bool) {
+	if ctx.selectors == nil {
+		return
+	}
+	resource.isSelected = ctx.selectors.isSelected(resource)
+	if !resource.isSelected && !alwaysExportable {
+		utils.Debugf("[DEBUG] skip exporting '%s' since it does not match the selectors", resource.getTerraformReference())
+		resource.omitFromExport = true
+	}
+}
+
+// selectParent exports a resource that does not match the selectors if one of the resources discovered under it does
+func (ctx *resourceDiscoveryContext) selectParent(resource *OCIResource, subResources []*OCIResource) {
+	if ctx.selectors == nil || resource.isSelected {
+		return
+	}
+	for _, subResource := range subResources {
+		if subResource.isSelected {
+			utils.Debugf("[DEBUG] exporting '%s' since '%s' under it matches the selectors", resource.getTerraformReference(), subResource.getTerraformReference())
+			resource.isSelected = true
+			resource.omitFromExport = false
+			return
+		}
+	}
+}
+
+/*
+selectReferencedResources exports the resources referenced by the exported resources through their `_id` and `_ids`
+attributes, e.g. the VCN of a selected subnet, as the parent of most resources in the discovery graph is their compartment
+The referenced resources are selected transitively, e.g. the DHCP options of that VCN. Only the resources discovered
+by the same step, i.e. of the same service and compartment, can be selected, references to the resources of other
+steps keep hard coded values.
+*/
+func (ctx *resourceDiscoveryContext) selectReferencedResources(resources []*OCIResource) {
+	if ctx.selectors == nil {
+		return
+	}
+	resourcesById := map[string]*OCIResource{}
+	var pending []*OCIResource
+	for _, resource := range resources {
+		if resource.id != "" {
+			resourcesById[resource.id] = resource
+		}
+		if !resource.omitFromExport {
+			pending = append(pending, resource)
+		}
+	}
+
+	for len(pending) > 0 {
+		resource := pending[0]
+		pending = pending[1:]
+		for _, id := range getReferencedIds(resource.sourceAttributes) {
+			referencedResource, exists := resourcesById[id]
+			if !exists || !referencedResource.omitFromExport {
+				continue
+			}
+			utils.Debugf("[DEBUG] exporting '%s' since '%s' references it", referencedResource.getTerraformReference(), resource.getTerraformReference())
+			referencedResource.isSelected = true
+			referencedResource.omitFromExport = false
+			pending = append(pending, referencedResource)
+		}
+	}
+}
+
+// getReferencedIds returns the values of the `_id` and `_ids` attributes, including the attributes of nested blocks
+func getReferencedIds(attributes map[string]interface{}) []string {
+	var result []string
+	for name, value := range attributes {
+		switch v := value.(type) {
+		case string:
+			if strings.HasSuffix(name, "_id") && v != "" {
+				result = append(result, v)
+			}
+		case map[string]interface{}:
+			result = append(result, getReferencedIds(v)...)
+		case []interface{}:
+			for _, item := range v {
+				if id, ok := item.(string); ok && strings.HasSuffix(name, "_ids") && id != "" {
+					result = append(result, id)
+				} else if nested, ok := item.(map[string]interface{}); ok {
+					result = append(result, getReferencedIds(nested)...)
+				}
+			}
+		}
+	}
+	return result
+}
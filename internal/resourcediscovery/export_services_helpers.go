@@ -256,8 +256,8 @@ func processInstances(ctx *resourceDiscoveryContext, resources []*OCIResource) (
 
 						// The image OCID may be different if it's in a different tenancy or region, add a variable for users to specify
 						imageVarName := fmt.Sprintf("%s_source_image_id", instance.terraformName)
-						vars[imageVarName] = fmt.Sprintf("\"%s\"", imageId)
 						refMapLock.Lock()
+						vars[imageVarName] = fmt.Sprintf("\"%s\"", imageId)
 						referenceMap[imageId] = tfHclVersion.getVarHclString(imageVarName)
 						refMapLock.Unlock()
 					}
@@ -964,7 +964,7 @@ func processLoadBalancerListeners(ctx *resourceDiscoveryContext, resources []*OC
 					// check if we have expected ResourceIds set, is load balancer certificate id expected
 					if ctx.expectedResourceIds != nil && len(ctx.expectedResourceIds) > 0 {
 						certificateId := tf_load_balancer.GetCertificateCompositeId(certificateName.(string), resource.sourceAttributes["load_balancer_id"].(string))
-						if _, ok = ctx.expectedResourceIds[certificateId]; !ok {
+						if !ctx.isExpectedResourceId(certificateId) {
 							continue
 						}
 					}
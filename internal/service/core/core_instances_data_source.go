@@ -48,6 +48,11 @@ func CoreInstancesDataSource() *schema.Resource {
 	}
 }
 
+// CoreInstancesFilterPushDownEnums are the values of the enum arguments the filters are pushed down into, see tfresource.WithFilterPushDown
+var CoreInstancesFilterPushDownEnums = map[string][]string{
+	"state": oci_core.GetInstanceLifecycleStateEnumStringValues(),
+}
+
 func readCoreInstances(d *schema.ResourceData, m interface{}) error {
 	sync := &CoreInstancesDataSourceCrud{}
 	sync.D = d
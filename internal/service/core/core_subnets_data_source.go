@@ -43,6 +43,11 @@ func CoreSubnetsDataSource() *schema.Resource {
 	}
 }
 
+// CoreSubnetsFilterPushDownEnums are the values of the enum arguments the filters are pushed down into, see tfresource.WithFilterPushDown
+var CoreSubnetsFilterPushDownEnums = map[string][]string{
+	"state": oci_core.GetSubnetLifecycleStateEnumStringValues(),
+}
+
 func readCoreSubnets(d *schema.ResourceData, m interface{}) error {
 	sync := &CoreSubnetsDataSourceCrud{}
 	sync.D = d
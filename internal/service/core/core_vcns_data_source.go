@@ -39,6 +39,11 @@ func CoreVcnsDataSource() *schema.Resource {
 	}
 }
 
+// CoreVcnsFilterPushDownEnums are the values of the enum arguments the filters are pushed down into, see tfresource.WithFilterPushDown
+var CoreVcnsFilterPushDownEnums = map[string][]string{
+	"state": oci_core.GetVcnLifecycleStateEnumStringValues(),
+}
+
 func readCoreVcns(d *schema.ResourceData, m interface{}) error {
 	sync := &CoreVcnsDataSourceCrud{}
 	sync.D = d
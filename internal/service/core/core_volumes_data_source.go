@@ -48,6 +48,11 @@ func CoreVolumesDataSource() *schema.Resource {
 	}
 }
 
+// CoreVolumesFilterPushDownEnums are the values of the enum arguments the filters are pushed down into, see tfresource.WithFilterPushDown
+var CoreVolumesFilterPushDownEnums = map[string][]string{
+	"state": oci_core.GetVolumeLifecycleStateEnumStringValues(),
+}
+
 func readCoreVolumes(d *schema.ResourceData, m interface{}) error {
 	sync := &CoreVolumesDataSourceCrud{}
 	sync.D = d
@@ -48,6 +48,12 @@ func IdentityCompartmentsDataSource() *schema.Resource {
 	}
 }
 
+// IdentityCompartmentsFilterPushDownEnums are the values of the enum arguments the filters are pushed down into, see tfresource.WithFilterPushDown
+var IdentityCompartmentsFilterPushDownEnums = map[string][]string{
+	"access_level": oci_identity.GetListCompartmentsAccessLevelEnumStringValues(),
+	"state":        oci_identity.GetCompartmentLifecycleStateEnumStringValues(),
+}
+
 func readIdentityCompartments(d *schema.ResourceData, m interface{}) error {
 	sync := &IdentityCompartmentsDataSourceCrud{}
 	sync.D = d
@@ -21,6 +21,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/terraform-providers/terraform-provider-oci/internal/tracing"
 	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 
 	"sync"
@@ -144,7 +145,7 @@ func waitForStateRefreshForHybridPolling(workRequestClient workReqClient, workRe
 	stateConf := &resource.StateChangeConf{
 		Pending: pending,
 		Target:  target,
-		Refresh: stateRefreshFuncVar(sync),
+		Refresh: withActiveSpan(stateRefreshFuncVar(sync)),
 		Timeout: timeout,
 	}
 
@@ -218,7 +219,10 @@ func CreateResourceUsingHybridPolling(sync ResourceCreator) error {
 	return nil
 }
 
-func CreateResource(d schemaResourceData, sync ResourceCreator) error {
+func CreateResource(d schemaResourceData, sync ResourceCreator) (err error) {
+	_, endSpan := startResourceSpan(sync, "CreateResource")
+	defer func() { endSpan(err) }()
+
 	if synchronizedResource, ok := sync.(SynchronizedResource); ok {
 		if mutex := synchronizedResource.GetMutex(); mutex != nil {
 			mutex.Lock()
@@ -261,7 +265,10 @@ func CreateResource(d schemaResourceData, sync ResourceCreator) error {
 	return nil
 }
 
-func ReadResource(sync ResourceReader) error {
+func ReadResource(sync ResourceReader) (err error) {
+	_, endSpan := startResourceSpan(sync, "ReadResource")
+	defer func() { endSpan(err) }()
+
 	if e := sync.Get(); e != nil {
 		log.Printf("ERROR IN GET: %v\n", e.Error())
 		handleMissingResourceError(sync, &e)
@@ -285,7 +292,10 @@ func ReadResource(sync ResourceReader) error {
 	return nil
 }
 
-func UpdateResource(d schemaResourceData, sync ResourceUpdater) error {
+func UpdateResource(d schemaResourceData, sync ResourceUpdater) (err error) {
+	_, endSpan := startResourceSpan(sync, "UpdateResource")
+	defer func() { endSpan(err) }()
+
 	if synchronizedResource, ok := sync.(SynchronizedResource); ok {
 		if mutex := synchronizedResource.GetMutex(); mutex != nil {
 			mutex.Lock()
@@ -318,7 +328,10 @@ func UpdateResource(d schemaResourceData, sync ResourceUpdater) error {
 // statefully (not immediately), poll State to ensure:
 // () -> Pending -> Deleted.
 // Finally, sets the ResourceData state to empty.
-func DeleteResource(d schemaResourceData, sync ResourceDeleter) error {
+func DeleteResource(d schemaResourceData, sync ResourceDeleter) (err error) {
+	_, endSpan := startResourceSpan(sync, "DeleteResource")
+	defer func() { endSpan(err) }()
+
 	if synchronizedResource, ok := sync.(SynchronizedResource); ok {
 		if mutex := synchronizedResource.GetMutex(); mutex != nil {
 			mutex.Lock()
@@ -394,12 +407,15 @@ func WaitForCreatedState(d schemaResourceData, sync ResourceCreator) error {
 //
 // sync.D.Id must be set.
 // It does not set state from that refreshed state.
-func WaitForStateRefresh(sync StatefulResource, timeout time.Duration, operationName string, pending, target []string) error {
+func WaitForStateRefresh(sync StatefulResource, timeout time.Duration, operationName string, pending, target []string) (err error) {
+	span, endSpan := startResourceSpan(sync, "WaitForStateRefresh")
+	span.SetAttribute(tracing.AttrOperation, operationName)
+	defer func() { endSpan(err) }()
 	// TODO: try to move this onto sync
 	stateConf := &resource.StateChangeConf{
 		Pending: pending,
 		Target:  target,
-		Refresh: stateRefreshFuncVar(sync),
+		Refresh: withActiveSpan(stateRefreshFuncVar(sync)),
 		Timeout: timeout,
 	}
 
@@ -820,7 +836,20 @@ func WaitForWorkRequestWithErrorHandling(workRequestClient workReqClient, workRe
 }
 
 func WaitForWorkRequest(workRequestClient workReqClient, workRequestId *string, entityType string, action oci_work_requests.WorkRequestResourceActionTypeEnum,
-	timeout time.Duration, disableFoundRetries bool, expectIdentifier bool) (*string, error) {
+	timeout time.Duration, disableFoundRetries bool, expectIdentifier bool) (identifier *string, err error) {
+	span, endSpan := startResourceSpan(nil, "WaitForWorkRequest")
+	if workRequestId != nil {
+		span.SetAttribute(tracing.AttrWorkRequestID, *workRequestId)
+	}
+	span.SetAttribute(tracing.AttrEntityType, entityType)
+	span.SetAttribute(tracing.AttrAction, string(action))
+	defer func() {
+		if identifier != nil {
+			span.SetAttribute(tracing.AttrResourceOCID, *identifier)
+		}
+		endSpan(err)
+	}()
+
 	retryPolicy := GetRetryPolicy(disableFoundRetries, "work_request")
 	retryPolicy.ShouldRetryOperation = workRequestShouldRetryFunc(timeout)
 
@@ -836,7 +865,7 @@ func WaitForWorkRequest(workRequestClient workReqClient, workRequestId *string,
 			string(oci_work_requests.WorkRequestStatusFailed),
 			string(oci_work_requests.WorkRequestStatusCanceled),
 		},
-		Refresh: func() (interface{}, string, error) {
+		Refresh: withActiveSpan(func() (interface{}, string, error) {
 			var err error
 			response, err = workRequestClient.GetWorkRequest(context.Background(),
 				oci_work_requests.GetWorkRequestRequest{
@@ -847,12 +876,10 @@ func WaitForWorkRequest(workRequestClient workReqClient, workRequestId *string,
 				})
 			wr := &response.WorkRequest
 			return wr, string(wr.Status), err
-		},
+		}),
 		Timeout: timeout,
 	}
 
-	var identifier *string
-
 	if _, e := stateConf.WaitForState(); e != nil {
 		for _, res := range response.Resources {
 			if strings.Contains(strings.ToLower(*res.EntityType), strings.ToLower(entityType)) {
@@ -4,6 +4,8 @@
 package tfresource
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"reflect"
@@ -14,12 +16,15 @@ import (
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
 )
 
 type errorTypeEnum string
 
 var serviceErrorCheck = func(err error) (failure oci_common.ServiceError, ok bool) { return oci_common.IsServiceError(err) }
 
+var workRequestIdRegex = regexp.MustCompile(`workId: ([^\s,]+)`)
+
 const (
 	ServiceError         errorTypeEnum = "ServiceError"
 	TimeoutError         errorTypeEnum = "TimeoutError"
@@ -27,16 +32,59 @@ const (
 	WorkRequestError     errorTypeEnum = "WorkRequestError"
 )
 
+// When the error format is set to `json`, errors are returned as a JSON payload that can be parsed by tools
+const structuredErrorFormat = "json"
+
+// customError holds the fields of an error of the provider
+// The provider is not given the name and module of a resource in the configuration, so the ResourceAddress identifies
+// the resource by its type and id, e.g. `oci_core_vcn:ocid1.vcn.oc1..aaaa`, rather than by its Terraform address.
 type customError struct {
-	TypeOfError   errorTypeEnum
-	ErrorCode     int
-	ErrorCodeName string
-	Service       string
-	Message       string
-	OpcRequestID  string
-	ResourceOCID  string
-	Suggestion    string
-	VersionError  string
+	TypeOfError     errorTypeEnum `json:"type"`
+	ErrorCode       int           `json:"error_code,omitempty"`
+	ErrorCodeName   string        `json:"error_code_name"`
+	Service         string        `json:"service"`
+	Message         string        `json:"message"`
+	OpcRequestID    string        `json:"opc_request_id,omitempty"`
+	OperationName   string        `json:"operation_name,omitempty"`
+	ResourceOCID    string        `json:"resource_ocid,omitempty"`
+	ResourceType    string        `json:"resource_type,omitempty"`
+	ResourceAddress string        `json:"resource_address,omitempty"`
+	WorkRequestID   string        `json:"work_request_id,omitempty"`
+	Suggestion      string        `json:"suggestion"`
+	VersionError    string        `json:"version_error"`
+	ProviderVersion string        `json:"provider_version"`
+	ReleaseDate     string        `json:"provider_release_date"`
+}
+
+// structuredError is returned instead of the free text error when the structured error format is enabled
+// The payload is rendered when the error is reported, so that the resource type can be attached to it by the provider
+type structuredError struct {
+	customError
+}
+
+func (e *structuredError) Error() string {
+	payload, err := json.Marshal(e.customError)
+	if err != nil {
+		return e.Message
+	}
+	return string(payload)
+}
+
+func isStructuredErrorFormat() bool {
+	return strings.ToLower(utils.GetEnvSettingWithBlankDefault(globalvar.ErrorFormatEnv)) == structuredErrorFormat
+}
+
+// AddResourceToError attaches the Terraform resource type, and the address of the resource when it has an id, to a
+// structured error, other errors are returned as is
+func AddResourceToError(resourceType string, id string, err error) error {
+	var tfError *structuredError
+	if errors.As(err, &tfError) && tfError.ResourceType == "" {
+		tfError.ResourceType = resourceType
+		if id != "" {
+			tfError.ResourceAddress = fmt.Sprintf("%s:%s", resourceType, id)
+		}
+	}
+	return err
 }
 
 // Create new error format for Terraform output
@@ -54,6 +102,9 @@ func newCustomError(sync interface{}, err error) error {
 			OpcRequestID:  failure.GetOpcRequestID(),
 			Service:       getServiceName(sync),
 		}
+		if richFailure, ok := failure.(oci_common.ServiceErrorRichInfo); ok {
+			tfError.OperationName = richFailure.GetOperationName()
+		}
 	} else if strings.Contains(errorMessage, "timeout while waiting for state") {
 		// Timeout error
 		tfError = customError{
@@ -86,6 +137,12 @@ func newCustomError(sync interface{}, err error) error {
 
 	tfError.VersionError = GetVersionAndDateError()
 	tfError.Suggestion = getSuggestionFromError(tfError)
+	if isStructuredErrorFormat() {
+		tfError.ProviderVersion = globalvar.Version
+		tfError.ReleaseDate = globalvar.ReleaseDate
+		tfError.WorkRequestID = getWorkRequestID(errorMessage)
+		return &structuredError{tfError}
+	}
 	return tfError.Error()
 }
 
@@ -202,6 +259,14 @@ func getResourceOCID(sync interface{}) string {
 	return ""
 }
 
+// Use to get the work request OCID from the errors returned while waiting for a work request
+func getWorkRequestID(errorMessage string) string {
+	if match := workRequestIdRegex.FindStringSubmatch(errorMessage); match != nil {
+		return strings.TrimSuffix(match[1], ".")
+	}
+	return ""
+}
+
 func GetVersionAndDateError() string {
 	return getVersionAndDateErrorImpl(globalvar.Version, globalvar.ReleaseDate)
 }
@@ -4,6 +4,8 @@
 package tfresource
 
 import (
+	"encoding/json"
+	"os"
 	"testing"
 
 	oci_common "github.com/oracle/oci-go-sdk/v65/common"
@@ -168,3 +170,61 @@ func TestUnitHandleError(t *testing.T) {
 	assert.Contains(t, response.Error(), "Request a service limit increase for this resource")
 
 }
+
+// issue-routing-tag: terraform/default
+func TestUnitHandleError_structuredFormat(t *testing.T) {
+	os.Setenv("TF_VAR_"+globalvar.ErrorFormatEnv, "json")
+	defer os.Unsetenv("TF_VAR_" + globalvar.ErrorFormatEnv)
+	defer func() {
+		serviceErrorCheck = func(err error) (failure oci_common.ServiceError, ok bool) { return oci_common.IsServiceError(err) }
+	}()
+	temp := &MockStatefulResource{false}
+
+	// Work request error case
+	serviceErrorCheck = func(err error) (failure oci_common.ServiceError, ok bool) { return nil, false }
+	mockError := &MockError{"work request did not succeed, workId: ocid1.workrequest.oc1.phx.abc, entity: vcn, action: CREATED. Message: failed"}
+	response := HandleError(temp, mockError)
+	response = AddResourceToError("oci_core_vcn", "ocid1.vcn.oc1.phx.abc", response)
+
+	var payload map[string]interface{}
+	assert.NoError(t, json.Unmarshal([]byte(response.Error()), &payload))
+	assert.Equal(t, string(WorkRequestError), payload["type"])
+	assert.Equal(t, "Work Request error", payload["error_code_name"])
+	assert.Equal(t, "dummyId", payload["resource_ocid"])
+	assert.Equal(t, "ocid1.workrequest.oc1.phx.abc", payload["work_request_id"])
+	assert.Equal(t, "oci_core_vcn", payload["resource_type"])
+	assert.Equal(t, "oci_core_vcn:ocid1.vcn.oc1.phx.abc", payload["resource_address"])
+	assert.Equal(t, globalvar.Version, payload["provider_version"])
+	assert.Equal(t, globalvar.ReleaseDate, payload["provider_release_date"])
+	assert.Contains(t, payload, "suggestion")
+
+	// Service error case
+	mockServiceFailure := &MockServiceFailure{
+		StatusCode:   400,
+		Code:         "LimitExceeded",
+		Message:      "LimitExceeded",
+		OpcRequestID: "opcRequestId",
+	}
+	serviceErrorCheck = func(err error) (failure oci_common.ServiceError, ok bool) { return mockServiceFailure, true }
+	response = HandleError(temp, mockServiceFailure)
+
+	payload = map[string]interface{}{}
+	assert.NoError(t, json.Unmarshal([]byte(response.Error()), &payload))
+	assert.Equal(t, string(ServiceError), payload["type"])
+	assert.Equal(t, float64(400), payload["error_code"])
+	assert.Equal(t, "LimitExceeded", payload["error_code_name"])
+	assert.Equal(t, "opcRequestId", payload["opc_request_id"])
+	assert.Contains(t, payload["suggestion"], "Request a service limit increase for this resource")
+	assert.NotContains(t, payload, "work_request_id")
+
+	// Terraform errors are returned as is
+	serviceErrorCheck = func(err error) (failure oci_common.ServiceError, ok bool) { return nil, false }
+	mockError = &MockError{"Unexpected Error"}
+	assert.Equal(t, mockError, AddResourceToError("oci_core_vcn", "ocid1.vcn.oc1.phx.abc", HandleError(temp, mockError)))
+}
+
+func TestUnitGetWorkRequestID(t *testing.T) {
+	assert.Equal(t, "ocid1.workrequest.oc1.phx.abc", getWorkRequestID("work request did not succeed, workId: ocid1.workrequest.oc1.phx.abc, entity: vcn"))
+	assert.Equal(t, "ocid1.workrequest.oc1.phx.abc", getWorkRequestID("Timeout while waiting for workId: ocid1.workrequest.oc1.phx.abc."))
+	assert.Equal(t, "", getWorkRequestID("work request error"))
+}
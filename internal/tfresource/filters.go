@@ -8,13 +8,42 @@ import (
 	"reflect"
 	"regexp"
 	"strconv"
+	"time"
 
 	"fmt"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// Operators of the data source filters, the values of a filter are ORed
+const (
+	FilterOperatorEquals              = "equals"
+	FilterOperatorRegex               = "regex"
+	FilterOperatorContains            = "contains"
+	FilterOperatorStartsWith          = "starts_with"
+	FilterOperatorEndsWith            = "ends_with"
+	FilterOperatorGreaterThan         = "greater_than"
+	FilterOperatorGreaterThanOrEquals = "greater_than_or_equals"
+	FilterOperatorLessThan            = "less_than"
+	FilterOperatorLessThanOrEquals    = "less_than_or_equals"
+	FilterOperatorExists              = "exists"
+)
+
+var FilterOperators = []string{
+	FilterOperatorEquals,
+	FilterOperatorRegex,
+	FilterOperatorContains,
+	FilterOperatorStartsWith,
+	FilterOperatorEndsWith,
+	FilterOperatorGreaterThan,
+	FilterOperatorGreaterThanOrEquals,
+	FilterOperatorLessThan,
+	FilterOperatorLessThanOrEquals,
+	FilterOperatorExists,
+}
+
 func DataSourceFiltersSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeSet,
@@ -29,7 +58,7 @@ func DataSourceFiltersSchema() *schema.Schema {
 
 				"values": {
 					Type:     schema.TypeList,
-					Required: true,
+					Optional: true,
 					Elem:     &schema.Schema{Type: schema.TypeString},
 				},
 
@@ -38,11 +67,50 @@ func DataSourceFiltersSchema() *schema.Schema {
 					Optional: true,
 					Default:  false,
 				},
+
+				"operator": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice(FilterOperators, false),
+				},
+
+				"case_insensitive": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+
+				"not": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
 			},
 		},
 	}
 }
 
+// ValidateFilters returns an error for the filters that set `regex = true` with another operator than regex, as the
+// operator would otherwise replace the regular expression match, and for the filters without values unless their
+// operator is exists, which does not use the values
+func ValidateFilters(filters *schema.Set) error {
+	if filters == nil {
+		return nil
+	}
+	for _, f := range filters.List() {
+		fSet := f.(map[string]interface{})
+		regex, _ := fSet["regex"].(bool)
+		operator, _ := fSet["operator"].(string)
+		if regex && operator != "" && operator != FilterOperatorRegex {
+			return fmt.Errorf("regex cannot be set with operator %s for filter name %s, use operator %s instead", operator, fSet["name"], FilterOperatorRegex)
+		}
+		if values, _ := fSet["values"].([]interface{}); len(values) == 0 && operator != FilterOperatorExists {
+			return fmt.Errorf("values must be set for filter name %s, only operator %s can be used without values", fSet["name"], FilterOperatorExists)
+		}
+	}
+	return nil
+}
+
 var PrimitiveDataTypes = map[schema.ValueType]bool{
 	schema.TypeString: true,
 	schema.TypeBool:   true,
@@ -58,41 +126,12 @@ func ApplyFilters(filters *schema.Set, items []map[string]interface{}, resourceS
 	}
 
 	for _, f := range filters.List() {
-		fSet := f.(map[string]interface{})
-		keyword := fSet["name"].(string)
-		var pathElements []string
-		var err error
-		if pathElements, err = getFieldPathElements(resourceSchema, keyword); err != nil {
-			log.Printf(err.Error())
-			pathElements = []string{keyword}
-		}
-
-		isReg := false
-		if regex, regexOk := fSet["regex"]; regexOk {
-			isReg = regex.(bool)
-		}
-
-		// Create a string equality check strategy based on this filters "regex" flag
-		stringsEqual := func(propertyVal string, filterVal string) bool {
-			if isReg {
-				re, err := regexp.Compile(filterVal)
-				if err != nil {
-					// todo: when all SetData() fns are refactored to return a possible error, these log statements should
-					// be converted to errors for return propagation
-					log.Printf(`[WARN] Invalid regular expression "%s" for "%s" filter\n`, filterVal, keyword)
-					return false
-				}
-				return re.MatchString(propertyVal)
-			}
-
-			return filterVal == propertyVal
-		}
+		filter := newDataSourceFilter(f.(map[string]interface{}), resourceSchema)
 
 		// build a collection of items from matches against the set of filters
 		res := make([]map[string]interface{}, 0)
 		for _, item := range items {
-			targetVal, targetValOk := getValueFromPath(item, pathElements)
-			if targetValOk && orComparator(targetVal, fSet["values"].([]interface{}), stringsEqual) {
+			if filter.matches(item) {
 				res = append(res, item)
 			}
 		}
@@ -108,35 +147,7 @@ func ApplyFiltersInCollection(filters *schema.Set, items []interface{}, resource
 	}
 
 	for _, f := range filters.List() {
-		fSet := f.(map[string]interface{})
-		keyword := fSet["name"].(string)
-		var pathElements []string
-		var err error
-		if pathElements, err = getFieldPathElements(resourceSchema, keyword); err != nil {
-			log.Printf(err.Error())
-			pathElements = []string{keyword}
-		}
-
-		isReg := false
-		if regex, regexOk := fSet["regex"]; regexOk {
-			isReg = regex.(bool)
-		}
-
-		// Create a string equality check strategy based on this filters "regex" flag
-		stringsEqual := func(propertyVal string, filterVal string) bool {
-			if isReg {
-				re, err := regexp.Compile(filterVal)
-				if err != nil {
-					// todo: when all SetData() fns are refactored to return a possible error, these log statements should
-					// be converted to errors for return propagation
-					log.Printf(`[WARN] Invalid regular expression "%s" for "%s" filter\n`, filterVal, keyword)
-					return false
-				}
-				return re.MatchString(propertyVal)
-			}
-
-			return filterVal == propertyVal
-		}
+		filter := newDataSourceFilter(f.(map[string]interface{}), resourceSchema)
 
 		// build a collection of items from matches against the set of filters
 		res := make([]interface{}, 0)
@@ -145,8 +156,7 @@ func ApplyFiltersInCollection(filters *schema.Set, items []interface{}, resource
 			if !ok {
 				continue
 			}
-			targetVal, targetValOk := getValueFromPath(itemMap, pathElements)
-			if targetValOk && orComparator(targetVal, fSet["values"].([]interface{}), stringsEqual) {
+			if filter.matches(itemMap) {
 				res = append(res, itemMap)
 			}
 		}
@@ -215,10 +225,18 @@ func checkAndConvertNestedStructure(element interface{}) (map[string]interface{}
 // e.g. for core_instance: source_details.source_type -> ["source_details", "source_type"], nil
 // e.g. for core_instance: source_details.source_type.xyz -> nil, error
 func getFieldPathElements(resourceSchema map[string]*schema.Schema, filterName string) ([]string, error) {
+	pathElements, _, err := getFieldPathElementsAndType(resourceSchema, filterName)
+	return pathElements, err
+}
+
+// getFieldPathElementsAndType is getFieldPathElements, it also returns the schema type of the values of the field
+// e.g. for core_volume: size_in_gbs -> ["size_in_gbs"], TypeString, nil
+// e.g. for core_instance: freeform_tags.com.oracle.department -> ["freeform_tags", "com.oracle.department"], TypeString, nil
+func getFieldPathElementsAndType(resourceSchema map[string]*schema.Schema, filterName string) ([]string, schema.ValueType, error) {
 
 	if resourceSchema == nil {
 		log.Printf(`[WARN] schema is nil for filter name %s \n`, filterName)
-		return nil, fmt.Errorf("schema is nil for filter name %s", filterName)
+		return nil, schema.TypeInvalid, fmt.Errorf("schema is nil for filter name %s", filterName)
 	}
 
 	tokenizedFields := strings.Split(filterName, ".")
@@ -226,15 +244,16 @@ func getFieldPathElements(resourceSchema map[string]*schema.Schema, filterName s
 	//validate tokens
 	if len(tokenizedFields) == 0 {
 		log.Printf(`[WARN] Invalid filter name "%s"  \n`, filterName)
-		return nil, fmt.Errorf("invalid filter name %s", filterName)
+		return nil, schema.TypeInvalid, fmt.Errorf("invalid filter name %s", filterName)
 	}
 
 	if resourceSchema[tokenizedFields[0]] == nil {
 		log.Printf(`[WARN] Schema is nil for token %s for filter name "%s"\n`, tokenizedFields[0], filterName)
-		return nil, fmt.Errorf("schema is nil for token %s for filter name %s", tokenizedFields[0], filterName)
+		return nil, schema.TypeInvalid, fmt.Errorf("schema is nil for token %s for filter name %s", tokenizedFields[0], filterName)
 	}
 
 	var pathElements []string
+	valueType := schema.TypeInvalid
 	currentSchema := resourceSchema
 	for index, tokenizedField := range tokenizedFields {
 		if fieldSchema, ok := currentSchema[tokenizedField]; ok && isValidSchemaType(fieldSchema) {
@@ -243,10 +262,17 @@ func getFieldPathElements(resourceSchema map[string]*schema.Schema, filterName s
 			//check if nested
 			convertedElementSchema, conversionOk := fieldSchema.Elem.(*schema.Resource)
 			if !conversionOk { // No nested structure
+				valueType = fieldSchema.Type
+				if elemSchema, ok := fieldSchema.Elem.(*schema.Schema); ok {
+					// lists and sets of strings, and maps
+					valueType = elemSchema.Type
+				} else if fieldSchema.Type == schema.TypeMap {
+					valueType = schema.TypeString
+				}
 				if len(tokenizedFields) > index+1 { // have more tokens to handle
 					// if we have more tokens the schema type has to be map else error condition
 					if fieldSchema.Type != schema.TypeMap {
-						return nil, fmt.Errorf("invalid filter name format found %s", filterName)
+						return nil, schema.TypeInvalid, fmt.Errorf("invalid filter name format found %s", filterName)
 
 					}
 					pathElement := strings.Join(tokenizedFields[index+1:], ".")
@@ -258,15 +284,15 @@ func getFieldPathElements(resourceSchema map[string]*schema.Schema, filterName s
 				currentSchema = convertedElementSchema.Schema
 			}
 		} else {
-			return nil, fmt.Errorf("invalid schema found for filter name %s", filterName)
+			return nil, schema.TypeInvalid, fmt.Errorf("invalid schema found for filter name %s", filterName)
 		}
 	}
 
 	if len(pathElements) == 0 {
-		return nil, fmt.Errorf("path elements were not initialized properly")
+		return nil, schema.TypeInvalid, fmt.Errorf("path elements were not initialized properly")
 	}
 
-	return pathElements, nil
+	return pathElements, valueType, nil
 }
 
 func isValidSchemaType(fieldSchema *schema.Schema) bool {
@@ -340,3 +366,245 @@ func orComparator(target interface{}, filters []interface{}, stringsEqual String
 	}
 	return false
 }
+
+// dataSourceFilter is a filter set of a data source, it matches the items whose property matches any of its values
+type dataSourceFilter struct {
+	keyword         string
+	pathElements    []string
+	valueType       schema.ValueType
+	operator        string
+	caseInsensitive bool
+	not             bool
+	values          []interface{}
+}
+
+func newDataSourceFilter(fSet map[string]interface{}, resourceSchema map[string]*schema.Schema) *dataSourceFilter {
+	filter := &dataSourceFilter{
+		keyword:  fSet["name"].(string),
+		operator: FilterOperatorEquals,
+	}
+
+	var err error
+	if filter.pathElements, filter.valueType, err = getFieldPathElementsAndType(resourceSchema, filter.keyword); err != nil {
+		log.Printf(err.Error())
+		filter.pathElements = []string{filter.keyword}
+	}
+
+	if values, ok := fSet["values"].([]interface{}); ok {
+		filter.values = values
+	}
+	if regex, regexOk := fSet["regex"].(bool); regexOk && regex {
+		filter.operator = FilterOperatorRegex
+	}
+	if operator, ok := fSet["operator"].(string); ok && operator != "" {
+		filter.operator = operator
+	}
+	if caseInsensitive, ok := fSet["case_insensitive"].(bool); ok {
+		filter.caseInsensitive = caseInsensitive
+	}
+	if not, ok := fSet["not"].(bool); ok {
+		filter.not = not
+	}
+	return filter
+}
+
+// matches returns true if the property of the item matches the filter, a missing property only matches the negated filters
+func (f *dataSourceFilter) matches(item map[string]interface{}) bool {
+	targetVal, targetValOk := getValueFromPath(item, f.pathElements)
+	if targetValOk && targetVal != nil && reflect.ValueOf(targetVal).Kind() == reflect.Ptr {
+		if reflect.ValueOf(targetVal).IsNil() {
+			targetValOk = false
+		} else {
+			targetVal = reflect.ValueOf(targetVal).Elem().Interface()
+		}
+	}
+	targetValOk = targetValOk && targetVal != nil
+
+	var matched bool
+	switch f.operator {
+	case FilterOperatorExists:
+		matched = targetValOk && !isEmptyValue(targetVal)
+	case FilterOperatorGreaterThan, FilterOperatorGreaterThanOrEquals, FilterOperatorLessThan, FilterOperatorLessThanOrEquals:
+		matched = targetValOk && f.anyCompared(targetVal)
+	default:
+		matched = targetValOk && orComparator(targetVal, f.values, f.stringCheck())
+	}
+	return matched != f.not
+}
+
+// stringCheck returns the string check strategy of the operator
+func (f *dataSourceFilter) stringCheck() StringCheck {
+	fold := func(value string) string {
+		if f.caseInsensitive {
+			return strings.ToLower(value)
+		}
+		return value
+	}
+
+	switch f.operator {
+	case FilterOperatorRegex:
+		return func(propertyVal string, filterVal string) bool {
+			if f.caseInsensitive {
+				filterVal = "(?i)" + filterVal
+			}
+			re, err := regexp.Compile(filterVal)
+			if err != nil {
+				// todo: when all SetData() fns are refactored to return a possible error, these log statements should
+				// be converted to errors for return propagation
+				log.Printf(`[WARN] Invalid regular expression "%s" for "%s" filter\n`, filterVal, f.keyword)
+				return false
+			}
+			return re.MatchString(propertyVal)
+		}
+	case FilterOperatorContains:
+		return func(propertyVal string, filterVal string) bool {
+			return strings.Contains(fold(propertyVal), fold(filterVal))
+		}
+	case FilterOperatorStartsWith:
+		return func(propertyVal string, filterVal string) bool {
+			return strings.HasPrefix(fold(propertyVal), fold(filterVal))
+		}
+	case FilterOperatorEndsWith:
+		return func(propertyVal string, filterVal string) bool {
+			return strings.HasSuffix(fold(propertyVal), fold(filterVal))
+		}
+	}
+	return func(propertyVal string, filterVal string) bool {
+		if f.caseInsensitive {
+			return strings.EqualFold(filterVal, propertyVal)
+		}
+		return filterVal == propertyVal
+	}
+}
+
+// anyCompared returns true if the target property, or any of its elements, satisfies the comparison operator for any
+// of the filter values
+func (f *dataSourceFilter) anyCompared(target interface{}) bool {
+	val := reflect.ValueOf(target)
+	var targets []reflect.Value
+	if val.Kind() == reflect.Slice || val.Kind() == reflect.Array {
+		for i := 0; i < val.Len(); i++ {
+			targets = append(targets, val.Index(i))
+		}
+	} else {
+		targets = append(targets, val)
+	}
+
+	for _, fVal := range f.values {
+		for _, target := range targets {
+			result, ok := f.compare(target, fVal.(string))
+			if !ok {
+				continue
+			}
+			switch f.operator {
+			case FilterOperatorGreaterThan:
+				ok = result > 0
+			case FilterOperatorGreaterThanOrEquals:
+				ok = result >= 0
+			case FilterOperatorLessThan:
+				ok = result < 0
+			case FilterOperatorLessThanOrEquals:
+				ok = result <= 0
+			}
+			if ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Layouts of the timestamps compared by the filters, the SDK times are set as strings in the time.Time format
+var filterTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02 15:04:05.999999999 -0700 MST",
+	"2006-01-02",
+}
+
+// compare returns -1, 0 or 1 if the property is lower than, equal to or greater than the filter value
+// The properties are compared as numbers if their schema type is numeric, or if they are strings holding numbers like
+// `size_in_gbs`, then as timestamps if they are strings holding timestamps like `time_created`, then as strings.
+// It returns false if the property and the filter value cannot be compared.
+func (f *dataSourceFilter) compare(target reflect.Value, filterVal string) (int, bool) {
+	var propertyVal string
+	switch target.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return compareFloat(float64(target.Int()), filterVal, f.keyword)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return compareFloat(float64(target.Uint()), filterVal, f.keyword)
+	case reflect.Float32, reflect.Float64:
+		return compareFloat(target.Float(), filterVal, f.keyword)
+	case reflect.String:
+		propertyVal = target.String()
+	case reflect.Interface:
+		if target.IsNil() {
+			return 0, false
+		}
+		return f.compare(target.Elem(), filterVal)
+	default:
+		log.Printf(`[WARN] Comparing "%s" filter against unsupported type %s`, f.keyword, target.Kind())
+		return 0, false
+	}
+
+	if f.valueType == schema.TypeInt || f.valueType == schema.TypeFloat {
+		property, err := strconv.ParseFloat(propertyVal, 64)
+		if err != nil {
+			return 0, false
+		}
+		return compareFloat(property, filterVal, f.keyword)
+	}
+	if property, err := strconv.ParseFloat(propertyVal, 64); err == nil {
+		if _, err := strconv.ParseFloat(filterVal, 64); err == nil {
+			return compareFloat(property, filterVal, f.keyword)
+		}
+	}
+	if propertyTime, ok := parseFilterTime(propertyVal); ok {
+		if filterTime, ok := parseFilterTime(filterVal); ok {
+			switch {
+			case propertyTime.Before(filterTime):
+				return -1, true
+			case propertyTime.After(filterTime):
+				return 1, true
+			}
+			return 0, true
+		}
+	}
+	if f.caseInsensitive {
+		return strings.Compare(strings.ToLower(propertyVal), strings.ToLower(filterVal)), true
+	}
+	return strings.Compare(propertyVal, filterVal), true
+}
+
+func compareFloat(property float64, filterVal string, keyword string) (int, bool) {
+	value, err := strconv.ParseFloat(filterVal, 64)
+	if err != nil {
+		log.Printf(`[WARN] Comparing numeric "%s" filter with non-numeric filter value "%s"`, keyword, filterVal)
+		return 0, false
+	}
+	switch {
+	case property < value:
+		return -1, true
+	case property > value:
+		return 1, true
+	}
+	return 0, true
+}
+
+func parseFilterTime(value string) (time.Time, bool) {
+	for _, layout := range filterTimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// isEmptyValue returns true for the empty strings and collections, which the exists operator does not match
+func isEmptyValue(value interface{}) bool {
+	val := reflect.ValueOf(value)
+	switch val.Kind() {
+	case reflect.String, reflect.Slice, reflect.Array, reflect.Map:
+		return val.Len() == 0
+	}
+	return false
+}
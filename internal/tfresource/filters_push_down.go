@@ -0,0 +1,140 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// WithFilterPushDown pushes the filters of a list data source down into its arguments before it is read, so that the
+// services only return the items the filters select rather than all the items of the compartment
+// A filter is pushed down into the optional string argument of the same name, the enums map the arguments taking an
+// enum to the values of the enum. The data source is read from a copy of its data holding the pushed down values, so
+// that they are only used to build the list request and never saved in the state of the data source
+// The provider applies it to every data source with a filter argument when the data source is registered.
+func WithFilterPushDown(dataSource *schema.Resource, enums map[string][]string) *schema.Resource {
+	read := dataSource.Read
+	if read == nil {
+		return dataSource
+	}
+	dataSource.Read = func(d *schema.ResourceData, m interface{}) error {
+		listData, pushedDown := PushDownFilters(dataSource, d, enums)
+		if len(pushedDown) == 0 {
+			return read(d, m)
+		}
+		if err := read(listData, m); err != nil {
+			return err
+		}
+		return copyReadData(dataSource, listData, d)
+	}
+	return dataSource
+}
+
+// PushDownFilters returns a copy of the data of a data source with its arguments set from its filters
+// Only the filters selecting a single value with the equals operator are pushed down, only into the arguments that
+// are not set in the configuration, and only if the value is one of the values of the enum of the argument if it has
+// one. The filters are still applied to the listed items, the pushed down filters only reduce the number of pages to list.
+// It returns the names of the filters that were pushed down, the data is nil if none was.
+func PushDownFilters(dataSource *schema.Resource, d *schema.ResourceData, enums map[string][]string) (*schema.ResourceData, []string) {
+	filters, ok := d.Get("filter").(*schema.Set)
+	if !ok || filters.Len() == 0 {
+		return nil, nil
+	}
+
+	values := map[string]string{}
+	var pushedDown []string
+	for _, f := range filters.List() {
+		fSet := f.(map[string]interface{})
+		name := fSet["name"].(string)
+		if !isPushDownArgument(dataSource, name) {
+			continue
+		}
+		value, ok := pushDownValue(fSet)
+		if !ok || (enums[name] != nil && !containsString(enums[name], value)) {
+			continue
+		}
+		if _, ok := d.GetOkExists(name); ok {
+			continue
+		}
+		if _, ok := values[name]; ok {
+			// Several filters on the same argument, the first one is pushed down and the others applied to its items
+			continue
+		}
+		values[name] = value
+		pushedDown = append(pushedDown, name)
+	}
+	if len(pushedDown) == 0 {
+		return nil, nil
+	}
+
+	listData := dataSource.Data(nil)
+	listData.SetId(d.Id())
+	for name := range dataSource.Schema {
+		if value, ok := d.GetOkExists(name); ok {
+			if err := listData.Set(name, value); err != nil {
+				log.Printf("[WARN] cannot copy argument %s to push the filters down: %v", name, err)
+				return nil, nil
+			}
+		}
+	}
+	for _, name := range pushedDown {
+		if err := listData.Set(name, values[name]); err != nil {
+			log.Printf("[WARN] cannot push filter %s down into its argument: %v", name, err)
+			return nil, nil
+		}
+		log.Printf("[DEBUG] pushed filter %s down into its argument", name)
+	}
+	return listData, pushedDown
+}
+
+// isPushDownArgument returns true if a filter of the given name selects the same items as the argument of that name
+func isPushDownArgument(dataSource *schema.Resource, name string) bool {
+	argument, ok := dataSource.Schema[name]
+	return ok && name != "filter" && argument.Type == schema.TypeString && argument.Optional && !argument.Computed
+}
+
+// copyReadData copies the id and the computed attributes read into the copy of the data of a data source back to its data
+func copyReadData(dataSource *schema.Resource, listData *schema.ResourceData, d *schema.ResourceData) error {
+	d.SetId(listData.Id())
+	for name, attribute := range dataSource.Schema {
+		if !attribute.Computed {
+			continue
+		}
+		if value, ok := listData.GetOkExists(name); ok {
+			if err := d.Set(name, value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// pushDownValue returns the value selected by a filter, or false if the filter selects anything else than a single value
+func pushDownValue(fSet map[string]interface{}) (string, bool) {
+	if operator, ok := fSet["operator"].(string); ok && operator != "" && operator != FilterOperatorEquals {
+		return "", false
+	}
+	for _, option := range []string{"regex", "not", "case_insensitive"} {
+		if enabled, ok := fSet[option].(bool); ok && enabled {
+			return "", false
+		}
+	}
+	values, ok := fSet["values"].([]interface{})
+	if !ok || len(values) != 1 {
+		return "", false
+	}
+	value, ok := values[0].(string)
+	return value, ok && value != ""
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
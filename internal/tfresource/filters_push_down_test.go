@@ -0,0 +1,121 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	oci_core "github.com/oracle/oci-go-sdk/v65/core"
+	"github.com/stretchr/testify/assert"
+)
+
+var pushDownEnums = map[string][]string{
+	"state": oci_core.GetVcnLifecycleStateEnumStringValues(),
+}
+
+func newPushDownDataSource(read func(d *schema.ResourceData, m interface{}) error) *schema.Resource {
+	return WithFilterPushDown(&schema.Resource{
+		Read: read,
+		Schema: map[string]*schema.Schema{
+			"filter":       DataSourceFiltersSchema(),
+			"display_name": {Type: schema.TypeString, Optional: true},
+			"state":        {Type: schema.TypeString, Optional: true},
+			"cidr_block":   {Type: schema.TypeString, Computed: true},
+			"vcn_id":       {Type: schema.TypeString, Optional: true, Computed: true},
+			"is_ipv6":      {Type: schema.TypeBool, Optional: true},
+			"virtual_networks": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}, pushDownEnums)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitWithFilterPushDown(t *testing.T) {
+	var displayName, state, isIpv6 interface{}
+	dataSource := newPushDownDataSource(func(d *schema.ResourceData, m interface{}) error {
+		displayName, _ = d.GetOkExists("display_name")
+		state, _ = d.GetOkExists("state")
+		isIpv6, _ = d.GetOkExists("is_ipv6")
+		d.SetId("vcns")
+		return d.Set("virtual_networks", []interface{}{"vcn"})
+	})
+	d := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{
+		"is_ipv6": true,
+		"filter": []interface{}{
+			map[string]interface{}{"name": "display_name", "values": []interface{}{"web-vcn"}},
+			map[string]interface{}{"name": "state", "values": []interface{}{"AVAILABLE"}, "operator": FilterOperatorEquals},
+		},
+	})
+
+	assert.NoError(t, dataSource.Read(d, nil))
+	assert.Equal(t, "web-vcn", displayName)
+	assert.Equal(t, "AVAILABLE", state)
+	assert.Equal(t, true, isIpv6)
+
+	// The filters are only pushed down into the list request, not into the state of the data source
+	assert.Equal(t, "vcns", d.Id())
+	assert.Equal(t, []interface{}{"vcn"}, d.Get("virtual_networks"))
+	for _, argument := range []string{"display_name", "state"} {
+		_, ok := d.GetOkExists(argument)
+		assert.False(t, ok, argument)
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitPushDownFilters_clientSideOnly(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter map[string]interface{}
+	}{
+		{"several values", map[string]interface{}{"name": "display_name", "values": []interface{}{"a", "b"}}},
+		{"regex", map[string]interface{}{"name": "display_name", "values": []interface{}{"^a"}, "regex": true}},
+		{"operator", map[string]interface{}{"name": "display_name", "values": []interface{}{"a"}, "operator": FilterOperatorStartsWith}},
+		{"not", map[string]interface{}{"name": "display_name", "values": []interface{}{"a"}, "not": true}},
+		{"case insensitive", map[string]interface{}{"name": "display_name", "values": []interface{}{"a"}, "case_insensitive": true}},
+		{"invalid state", map[string]interface{}{"name": "state", "values": []interface{}{"running"}}},
+		{"state in another case", map[string]interface{}{"name": "state", "values": []interface{}{"available"}}},
+		{"state of another resource", map[string]interface{}{"name": "state", "values": []interface{}{"RUNNING"}}},
+		{"computed attribute", map[string]interface{}{"name": "cidr_block", "values": []interface{}{"10.0.0.0/16"}}},
+		{"optional computed argument", map[string]interface{}{"name": "vcn_id", "values": []interface{}{"ocid1.vcn"}}},
+		{"not a string argument", map[string]interface{}{"name": "is_ipv6", "values": []interface{}{"true"}}},
+		{"not an argument", map[string]interface{}{"name": "id", "values": []interface{}{"ocid1.vcn"}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dataSource := newPushDownDataSource(nil)
+			d := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{
+				"filter": []interface{}{test.filter},
+			})
+			listData, pushedDown := PushDownFilters(dataSource, d, pushDownEnums)
+			assert.Nil(t, listData)
+			assert.Empty(t, pushedDown)
+		})
+	}
+}
+
+// The arguments of the data source are not overridden by the filters
+// issue-routing-tag: terraform/default
+func TestUnitPushDownFilters_argumentSet(t *testing.T) {
+	dataSource := newPushDownDataSource(nil)
+	d := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{
+		"display_name": "argument",
+		"filter": []interface{}{
+			map[string]interface{}{"name": "display_name", "values": []interface{}{"filter"}},
+		},
+	})
+
+	listData, pushedDown := PushDownFilters(dataSource, d, pushDownEnums)
+	assert.Nil(t, listData)
+	assert.Empty(t, pushedDown)
+	assert.Equal(t, "argument", d.Get("display_name"))
+
+	d = schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{})
+	listData, pushedDown = PushDownFilters(dataSource, d, pushDownEnums)
+	assert.Nil(t, listData)
+	assert.Empty(t, pushedDown)
+}
@@ -1189,3 +1189,138 @@ func CoreInstanceResource() *schema.Resource {
 		},
 	}
 }
+
+func newOperatorFilters(filter map[string]interface{}) *schema.Set {
+	filters := &schema.Set{F: func(v interface{}) int {
+		return schema.HashString(v.(map[string]interface{})["name"])
+	}}
+	filters.Add(filter)
+	return filters
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitApplyFilters_operators(t *testing.T) {
+	items := []map[string]interface{}{
+		{"display_name": "Web-Server-1", "size_in_gbs": "50", "vcpus": 2, "time_created": "2021-03-01 10:00:00 +0000 UTC", "tags": []string{"prod", "web"}},
+		{"display_name": "web-server-2", "size_in_gbs": "1024", "vcpus": 8, "time_created": "2021-06-15 10:00:00 +0000 UTC", "tags": []string{"dev"}},
+		{"display_name": "db-server", "size_in_gbs": "256", "vcpus": 16, "time_created": "2022-01-01 00:00:00 +0000 UTC"},
+	}
+	testSchema := map[string]*schema.Schema{
+		"display_name": {Type: schema.TypeString},
+		"size_in_gbs":  {Type: schema.TypeString},
+		"vcpus":        {Type: schema.TypeInt},
+		"time_created": {Type: schema.TypeString},
+		"tags":         {Type: schema.TypeList, Elem: &schema.Schema{Type: schema.TypeString}},
+	}
+
+	tests := []struct {
+		name   string
+		filter map[string]interface{}
+		want   []string
+	}{
+		{"equals by default", map[string]interface{}{"name": "display_name", "values": []interface{}{"db-server"}}, []string{"db-server"}},
+		{"case insensitive equals", map[string]interface{}{"name": "display_name", "values": []interface{}{"WEB-SERVER-2"}, "case_insensitive": true}, []string{"web-server-2"}},
+		{"not equals", map[string]interface{}{"name": "display_name", "values": []interface{}{"db-server", "web-server-2"}, "not": true}, []string{"Web-Server-1"}},
+		{"regex flag", map[string]interface{}{"name": "display_name", "values": []interface{}{"^web"}, "regex": true}, []string{"web-server-2"}},
+		{"case insensitive regex", map[string]interface{}{"name": "display_name", "values": []interface{}{"^web"}, "operator": FilterOperatorRegex, "case_insensitive": true}, []string{"Web-Server-1", "web-server-2"}},
+		{"starts with", map[string]interface{}{"name": "display_name", "values": []interface{}{"db-", "Web"}, "operator": FilterOperatorStartsWith}, []string{"Web-Server-1", "db-server"}},
+		{"contains", map[string]interface{}{"name": "display_name", "values": []interface{}{"SERVER-"}, "operator": FilterOperatorContains, "case_insensitive": true}, []string{"Web-Server-1", "web-server-2"}},
+		{"ends with", map[string]interface{}{"name": "display_name", "values": []interface{}{"-2"}, "operator": FilterOperatorEndsWith}, []string{"web-server-2"}},
+		{"numeric string greater than", map[string]interface{}{"name": "size_in_gbs", "values": []interface{}{"100"}, "operator": FilterOperatorGreaterThan}, []string{"web-server-2", "db-server"}},
+		{"numeric string less than or equals", map[string]interface{}{"name": "size_in_gbs", "values": []interface{}{"256"}, "operator": FilterOperatorLessThanOrEquals}, []string{"Web-Server-1", "db-server"}},
+		{"int less than", map[string]interface{}{"name": "vcpus", "values": []interface{}{"8"}, "operator": FilterOperatorLessThan}, []string{"Web-Server-1"}},
+		{"int greater than or equals", map[string]interface{}{"name": "vcpus", "values": []interface{}{"8"}, "operator": FilterOperatorGreaterThanOrEquals}, []string{"web-server-2", "db-server"}},
+		{"time greater than", map[string]interface{}{"name": "time_created", "values": []interface{}{"2021-05-01T00:00:00Z"}, "operator": FilterOperatorGreaterThan}, []string{"web-server-2", "db-server"}},
+		{"time less than", map[string]interface{}{"name": "time_created", "values": []interface{}{"2021-06-15"}, "operator": FilterOperatorLessThan}, []string{"Web-Server-1"}},
+		{"exists", map[string]interface{}{"name": "tags", "operator": FilterOperatorExists}, []string{"Web-Server-1", "web-server-2"}},
+		{"not exists", map[string]interface{}{"name": "tags", "operator": FilterOperatorExists, "not": true}, []string{"db-server"}},
+		{"array element contains", map[string]interface{}{"name": "tags", "values": []interface{}{"pro"}, "operator": FilterOperatorStartsWith}, []string{"Web-Server-1"}},
+		{"invalid comparison value", map[string]interface{}{"name": "vcpus", "values": []interface{}{"many"}, "operator": FilterOperatorGreaterThan}, []string{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res := ApplyFilters(newOperatorFilters(test.filter), items, testSchema)
+			got := []string{}
+			for _, item := range res {
+				got = append(got, item["display_name"].(string))
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Expected %v, got %v", test.want, got)
+			}
+
+			collection := make([]interface{}, len(items))
+			for i, item := range items {
+				collection[i] = item
+			}
+			if resCollection := ApplyFiltersInCollection(newOperatorFilters(test.filter), collection, testSchema); len(resCollection) != len(test.want) {
+				t.Errorf("Expected %d results in collection, got %d", len(test.want), len(resCollection))
+			}
+		})
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitValidateFilters(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  map[string]interface{}
+		wantErr bool
+	}{
+		{"operator", map[string]interface{}{"name": "display_name", "values": []interface{}{"db-"}, "operator": FilterOperatorStartsWith}, false},
+		{"regex", map[string]interface{}{"name": "display_name", "values": []interface{}{"^db-"}, "regex": true}, false},
+		{"regex and regex operator", map[string]interface{}{"name": "display_name", "values": []interface{}{"^db-"}, "regex": true, "operator": FilterOperatorRegex}, false},
+		{"regex and operator", map[string]interface{}{"name": "display_name", "values": []interface{}{"db-"}, "regex": true, "operator": FilterOperatorStartsWith}, true},
+		{"regex and equals operator", map[string]interface{}{"name": "display_name", "values": []interface{}{"db-"}, "regex": true, "operator": FilterOperatorEquals}, true},
+		{"exists without values", map[string]interface{}{"name": "tags", "operator": FilterOperatorExists}, false},
+		{"exists with values", map[string]interface{}{"name": "tags", "values": []interface{}{"web"}, "operator": FilterOperatorExists}, false},
+		{"no values", map[string]interface{}{"name": "display_name"}, true},
+		{"empty values", map[string]interface{}{"name": "display_name", "values": []interface{}{}, "operator": FilterOperatorEquals}, true},
+		{"regex without values", map[string]interface{}{"name": "display_name", "regex": true}, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := ValidateFilters(newOperatorFilters(test.filter)); (err != nil) != test.wantErr {
+				t.Errorf("Expected error %v, got %v", test.wantErr, err)
+			}
+		})
+	}
+	if err := ValidateFilters(nil); err != nil {
+		t.Errorf("Expected no error without filters, got %v", err)
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitGetFieldPathElementsAndType(t *testing.T) {
+	testSchema := map[string]*schema.Schema{
+		"size_in_gbs":   {Type: schema.TypeString},
+		"vcpus":         {Type: schema.TypeInt},
+		"freeform_tags": {Type: schema.TypeMap, Elem: schema.TypeString},
+		"shape_config": {
+			Type:     schema.TypeList,
+			MinItems: 1,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"ocpus": {Type: schema.TypeFloat},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		filterName string
+		path       []string
+		valueType  schema.ValueType
+	}{
+		{"size_in_gbs", []string{"size_in_gbs"}, schema.TypeString},
+		{"vcpus", []string{"vcpus"}, schema.TypeInt},
+		{"freeform_tags.com.oracle.department", []string{"freeform_tags", "com.oracle.department"}, schema.TypeString},
+		{"shape_config.ocpus", []string{"shape_config", "ocpus"}, schema.TypeFloat},
+	}
+	for _, test := range tests {
+		path, valueType, err := getFieldPathElementsAndType(testSchema, test.filterName)
+		assert.NoError(t, err)
+		assert.Equal(t, test.path, path, test.filterName)
+		assert.Equal(t, test.valueType, valueType, test.filterName)
+	}
+}
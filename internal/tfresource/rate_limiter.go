@@ -0,0 +1,126 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+// The first label of the service endpoint is used as the service name, except for the following endpoints
+// e.g. `iaas.us-phoenix-1.oraclecloud.com` is the endpoint of the `core` service
+var serviceEndpointNames = map[string]string{
+	"iaas":          coreService,
+	"objectstorage": objectstorageService,
+	"loganalytics":  logAnalyticsService,
+}
+
+// RateLimit is the rate of the token bucket used for the requests of a service
+type RateLimit struct {
+	RequestsPerSecond float64
+	Burst             int
+}
+
+type tokenBucket struct {
+	mutex      sync.Mutex
+	rate       float64 // tokens added per second
+	capacity   float64
+	tokens     float64
+	lastRefill time.Time
+}
+
+func newTokenBucket(limit RateLimit, now time.Time) *tokenBucket {
+	return &tokenBucket{
+		rate:       limit.RequestsPerSecond,
+		capacity:   float64(limit.Burst),
+		tokens:     float64(limit.Burst),
+		lastRefill: now,
+	}
+}
+
+// reserve takes a token from the bucket and returns how long to wait before the token is available
+// The tokens can go negative so that concurrent requests are queued in the order they made a reservation
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if elapsed := now.Sub(b.lastRefill); elapsed > 0 {
+		b.tokens = math.Min(b.capacity, b.tokens+elapsed.Seconds()*b.rate)
+		b.lastRefill = now
+	}
+
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// ServiceRateLimiter keeps a token bucket for each service with a configured rate limit
+type ServiceRateLimiter struct {
+	buckets map[string]*tokenBucket
+}
+
+func NewServiceRateLimiter(limits map[string]RateLimit) (*ServiceRateLimiter, error) {
+	now := time.Now()
+	limiter := &ServiceRateLimiter{buckets: map[string]*tokenBucket{}}
+	for service, limit := range limits {
+		if err := ValidateServiceName(service); err != nil {
+			return nil, err
+		}
+		if limit.RequestsPerSecond <= 0 {
+			return nil, fmt.Errorf("the requests per second of service '%s' must be greater than 0", service)
+		}
+		if limit.Burst < 1 {
+			return nil, fmt.Errorf("the burst of service '%s' must be at least 1", service)
+		}
+		limiter.buckets[service] = newTokenBucket(limit, now)
+	}
+	return limiter, nil
+}
+
+// Wait blocks until a request to the service is allowed by its rate limit, or the context is done
+func (l *ServiceRateLimiter) Wait(ctx context.Context, service string) error {
+	bucket, ok := l.buckets[service]
+	if !ok {
+		return nil
+	}
+
+	delay := bucket.reserve(time.Now())
+	if delay <= 0 {
+		return nil
+	}
+
+	utils.Debugf("[DEBUG] rate limit of service '%s' reached, waiting %v before sending the request", service, delay)
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// GetServiceNameFromHost returns the service name of an endpoint, using the same service names as GetRetryPolicy
+func GetServiceNameFromHost(host string) string {
+	labels := strings.Split(strings.ToLower(host), ".")
+	for _, label := range labels {
+		// Vault endpoints are prefixed by the vault, e.g. `<vault>-crypto.kms.us-phoenix-1.oraclecloud.com`
+		if label == kmsService {
+			return kmsService
+		}
+	}
+
+	if service, ok := serviceEndpointNames[labels[0]]; ok {
+		return service
+	}
+	return strings.ReplaceAll(labels[0], "-", "_")
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// issue-routing-tag: terraform/default
+func TestUnitTokenBucket_reserve(t *testing.T) {
+	now := time.Now()
+	bucket := newTokenBucket(RateLimit{RequestsPerSecond: 2, Burst: 2}, now)
+
+	// The burst is allowed without waiting
+	assert.Equal(t, time.Duration(0), bucket.reserve(now))
+	assert.Equal(t, time.Duration(0), bucket.reserve(now))
+
+	// Further requests are queued at the configured rate
+	assert.Equal(t, 500*time.Millisecond, bucket.reserve(now))
+	assert.Equal(t, 1*time.Second, bucket.reserve(now))
+
+	// Tokens are refilled over time, up to the burst
+	assert.Equal(t, time.Duration(0), bucket.reserve(now.Add(2*time.Second)))
+	assert.Equal(t, time.Duration(0), bucket.reserve(now.Add(10*time.Second)))
+	assert.Equal(t, time.Duration(0), bucket.reserve(now.Add(10*time.Second)))
+	assert.Equal(t, 500*time.Millisecond, bucket.reserve(now.Add(10*time.Second)))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitNewServiceRateLimiter(t *testing.T) {
+	_, err := NewServiceRateLimiter(map[string]RateLimit{coreService: {RequestsPerSecond: 0, Burst: 1}})
+	assert.Error(t, err)
+
+	_, err = NewServiceRateLimiter(map[string]RateLimit{coreService: {RequestsPerSecond: 1, Burst: 0}})
+	assert.Error(t, err)
+
+	_, err = NewServiceRateLimiter(map[string]RateLimit{"compute": {RequestsPerSecond: 1, Burst: 1}})
+	assert.Error(t, err)
+
+	limiter, err := NewServiceRateLimiter(map[string]RateLimit{coreService: {RequestsPerSecond: 10, Burst: 5}})
+	assert.NoError(t, err)
+	assert.Contains(t, limiter.buckets, coreService)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitValidateServiceName(t *testing.T) {
+	// The service names are the services of the retry maps
+	assert.Equal(t, []string{"core", "database", "identity", "kms", "log_analytics", "object_storage", "waas"}, ServiceNames())
+	for _, service := range ServiceNames() {
+		assert.NoError(t, ValidateServiceName(service))
+	}
+
+	assert.EqualError(t, ValidateServiceName("compute"),
+		"'compute' is not the name of a service, the names of the services are: core, database, identity, kms, log_analytics, object_storage, waas")
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitServiceRateLimiter_Wait(t *testing.T) {
+	limiter, err := NewServiceRateLimiter(map[string]RateLimit{coreService: {RequestsPerSecond: 1, Burst: 1}})
+	assert.NoError(t, err)
+
+	// Services without a rate limit are never throttled
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, limiter.Wait(context.Background(), databaseService))
+	}
+
+	assert.NoError(t, limiter.Wait(context.Background(), coreService))
+
+	// The next request has to wait a second, so it is cancelled by the context
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, limiter.Wait(ctx, coreService))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitGetServiceNameFromHost(t *testing.T) {
+	tests := map[string]string{
+		"iaas.us-phoenix-1.oraclecloud.com":                    coreService,
+		"database.us-phoenix-1.oraclecloud.com":                databaseService,
+		"identity.us-phoenix-1.oci.oraclecloud.com":            identityService,
+		"objectstorage.us-phoenix-1.oraclecloud.com":           objectstorageService,
+		"loganalytics.us-phoenix-1.oci.oraclecloud.com":        logAnalyticsService,
+		"waas.us-phoenix-1.oraclecloud.com":                    WaasService,
+		"kms.us-phoenix-1.oraclecloud.com":                     kmsService,
+		"abcdefgh-crypto.kms.us-phoenix-1.oraclecloud.com":     kmsService,
+		"container-instances.us-phoenix-1.oci.oraclecloud.com": "container_instances",
+		"IAAS.US-PHOENIX-1.ORACLECLOUD.COM":                    coreService,
+	}
+	for host, expected := range tests {
+		assert.Equal(t, expected, GetServiceNameFromHost(host), host)
+	}
+}
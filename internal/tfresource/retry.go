@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 	"math/rand"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -53,6 +54,32 @@ var serviceRetryPolicyFnMap = map[string]getRetryPolicyFunc{
 	kmsService: kmsGetRetryPolicy,
 }
 
+// ServiceNames returns the sorted names of the services with a service-specific retry behavior, they are the services
+// that can be configured in the `rate_limit` and `retry_policy` blocks of the provider
+func ServiceNames() []string {
+	names := make([]string, 0, len(serviceExpectedRetryDurationMap)+len(serviceRetryPolicyFnMap))
+	for service := range serviceExpectedRetryDurationMap {
+		names = append(names, service)
+	}
+	for service := range serviceRetryPolicyFnMap {
+		if _, ok := serviceExpectedRetryDurationMap[service]; !ok {
+			names = append(names, service)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ValidateServiceName returns an error if the service is not one of the ServiceNames
+func ValidateServiceName(service string) error {
+	_, hasRetryDuration := serviceExpectedRetryDurationMap[service]
+	_, hasRetryPolicy := serviceRetryPolicyFnMap[service]
+	if !hasRetryDuration && !hasRetryPolicy {
+		return fmt.Errorf("'%s' is not the name of a service, the names of the services are: %s", service, strings.Join(ServiceNames(), ", "))
+	}
+	return nil
+}
+
 var ShortRetryTime = 2 * time.Minute
 var LongRetryTime = 10 * time.Minute
 var ConfiguredRetryDuration *time.Duration
@@ -102,6 +129,11 @@ func GetElapsedRetryDuration(firstAttemptTime time.Time) time.Duration {
 }
 
 func getExpectedRetryDuration(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, service string, optionals ...interface{}) time.Duration {
+	// The retry_policy configured for the service in the provider block is merged with the service specific retry duration
+	return applyRetryOverride(response, service, getServiceExpectedRetryDuration(response, disableNotFoundRetries, service, optionals...))
+}
+
+func getServiceExpectedRetryDuration(response oci_common.OCIOperationResponse, disableNotFoundRetries bool, service string, optionals ...interface{}) time.Duration {
 	// Get the override retry duration function if it exists. This gives the most granular control over what value to return, and is passed
 	// into GetRetryPolicy function as an optional argument to override retry durations on a per API basis.
 	if len(optionals) > 0 {
@@ -0,0 +1,70 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"context"
+	"time"
+
+	oci_common "github.com/oracle/oci-go-sdk/v65/common"
+)
+
+// RetryOverrides are the retry settings of the `retry_policy` blocks of a provider, keyed by service name
+// They are merged with the default retry behavior of the service; no override is applied if a service is not configured
+type RetryOverrides map[string]RetryOverride
+
+// RetryOverride overrides the default retry behavior of the requests to a service
+type RetryOverride struct {
+	RetryDuration        *time.Duration // the retry duration of the retriable errors, 0 disables the retries
+	MaxAttempts          uint           // the maximum number of attempts of a request, 0 means no limit
+	RetriableStatusCodes map[int]bool   // status codes retried in addition to the default retriable errors of the service
+}
+
+type retryOverridesContextKey struct{}
+
+// WithRetryOverrides returns a context with the retry overrides of the provider sending the requests, the clients of each
+// provider set it on their requests so that the provider aliases have their own retry overrides
+func WithRetryOverrides(ctx context.Context, overrides RetryOverrides) context.Context {
+	return context.WithValue(ctx, retryOverridesContextKey{}, overrides)
+}
+
+// getRetryOverride returns the retry override of the service from the context of the request of the response
+// The responses without an HTTP response, e.g. the network errors, get the default retry behavior of the service
+func getRetryOverride(response oci_common.OCIOperationResponse, service string) (RetryOverride, bool) {
+	if response.Response == nil || response.Response.HTTPResponse() == nil || response.Response.HTTPResponse().Request == nil {
+		return RetryOverride{}, false
+	}
+	overrides, _ := response.Response.HTTPResponse().Request.Context().Value(retryOverridesContextKey{}).(RetryOverrides)
+	override, ok := overrides[service]
+	return override, ok
+}
+
+func (override RetryOverride) isRetriableStatusCode(response oci_common.OCIOperationResponse) bool {
+	if len(override.RetriableStatusCodes) == 0 || response.Response == nil || response.Response.HTTPResponse() == nil {
+		return false
+	}
+	return override.RetriableStatusCodes[response.Response.HTTPResponse().StatusCode]
+}
+
+// applyRetryOverride merges the retry override of the service with the expected retry duration computed by the default retry behavior
+func applyRetryOverride(response oci_common.OCIOperationResponse, service string, expectedRetryDuration time.Duration) time.Duration {
+	override, ok := getRetryOverride(response, service)
+	if !ok {
+		return expectedRetryDuration
+	}
+
+	if override.MaxAttempts > 0 && response.AttemptNumber >= override.MaxAttempts {
+		return 0
+	}
+	if expectedRetryDuration == 0 {
+		if !override.isRetriableStatusCode(response) {
+			return 0
+		}
+		expectedRetryDuration = ShortRetryTime
+	}
+	if override.RetryDuration != nil {
+		expectedRetryDuration = *override.RetryDuration
+	}
+	return expectedRetryDuration
+}
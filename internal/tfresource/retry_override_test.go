@@ -0,0 +1,78 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/oracle/oci-go-sdk/v65/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// retryOverrideResponse returns a response to a request sent by the clients of a provider with the retry overrides
+func retryOverrideResponse(overrides RetryOverrides, statusCode int, attempt uint) common.OCIOperationResponse {
+	ctx := WithRetryOverrides(context.Background(), overrides)
+	return common.NewOCIOperationResponse(TestOCIResponse{statusCode: statusCode, requestContext: ctx}, nil, attempt)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitGetExpectedRetryDuration_retryOverride(t *testing.T) {
+	retryDuration := 30 * time.Minute
+	noRetry := time.Duration(0)
+	overrides := RetryOverrides{
+		databaseService: {RetryDuration: &retryDuration},
+		identityService: {RetriableStatusCodes: map[int]bool{404: true}},
+		coreService:     {RetryDuration: &noRetry},
+	}
+
+	response := func(statusCode int) common.OCIOperationResponse {
+		return retryOverrideResponse(overrides, statusCode, 1)
+	}
+
+	// The retry duration of the retriable errors is overridden
+	assert.Equal(t, retryDuration, getExpectedRetryDuration(response(409), false, databaseService))
+	assert.Equal(t, retryDuration, getExpectedRetryDuration(response(500), false, databaseService))
+	// The errors that are not retriable by default are still not retried
+	assert.Equal(t, time.Duration(0), getExpectedRetryDuration(response(400), false, databaseService))
+	assert.Equal(t, time.Duration(0), getExpectedRetryDuration(response(200), false, databaseService))
+
+	// The additional retriable status codes are retried for the default retry duration
+	assert.Equal(t, ShortRetryTime, getExpectedRetryDuration(response(404), false, identityService))
+	assert.Equal(t, LongRetryTime, getExpectedRetryDuration(response(429), false, identityService))
+	assert.Equal(t, time.Duration(0), getExpectedRetryDuration(response(400), false, identityService))
+
+	// A retry duration of 0 disables the retries
+	assert.Equal(t, time.Duration(0), getExpectedRetryDuration(response(429), false, coreService))
+
+	// Services without override keep their default retry duration
+	assert.Equal(t, LongRetryTime, getExpectedRetryDuration(response(429), false, objectstorageService))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitGetExpectedRetryDuration_retryOverridesOfProvider(t *testing.T) {
+	noRetry := time.Duration(0)
+	overrides := RetryOverrides{coreService: {RetryDuration: &noRetry}}
+
+	// The requests of the providers without retry overrides keep the default retry duration
+	assert.Equal(t, time.Duration(0), getExpectedRetryDuration(retryOverrideResponse(overrides, 429, 1), false, coreService))
+	assert.Equal(t, LongRetryTime, getExpectedRetryDuration(retryOverrideResponse(nil, 429, 1), false, coreService))
+	assert.Equal(t, LongRetryTime, getExpectedRetryDuration(common.NewOCIOperationResponse(TestOCIResponse{statusCode: 429}, nil, 1), false, coreService))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitGetExpectedRetryDuration_maxAttempts(t *testing.T) {
+	overrides := RetryOverrides{
+		databaseService: {MaxAttempts: 3},
+		kmsService:      {MaxAttempts: 5},
+	}
+
+	// The request is not retried once it was sent the maximum number of attempts
+	assert.Equal(t, LongRetryTime, getExpectedRetryDuration(retryOverrideResponse(overrides, 429, 2), false, databaseService))
+	assert.Equal(t, time.Duration(0), getExpectedRetryDuration(retryOverrideResponse(overrides, 429, 3), false, databaseService))
+	assert.Equal(t, LongRetryTime, getExpectedRetryDuration(retryOverrideResponse(overrides, 429, 4), false, kmsService))
+	assert.Equal(t, time.Duration(0), getExpectedRetryDuration(retryOverrideResponse(overrides, 429, 5), false, kmsService))
+	assert.Equal(t, LongRetryTime, getExpectedRetryDuration(retryOverrideResponse(overrides, 429, 10), false, identityService))
+}
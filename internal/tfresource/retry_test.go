@@ -4,6 +4,7 @@
 package tfresource
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"net/http"
@@ -21,8 +22,9 @@ import (
 )
 
 type TestOCIResponse struct {
-	statusCode int
-	header     map[string][]string
+	statusCode     int
+	header         map[string][]string
+	requestContext context.Context
 }
 
 type retryTestInput struct {
@@ -86,6 +88,9 @@ type TestServiceError interface {
 func (response TestOCIResponse) HTTPResponse() *http.Response {
 	result := http.Response{}
 	result.Request = &http.Request{}
+	if response.requestContext != nil {
+		result.Request = result.Request.WithContext(response.requestContext)
+	}
 	result.StatusCode = response.statusCode
 	result.Header = http.Header(response.header)
 	return &result
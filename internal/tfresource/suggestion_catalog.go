@@ -0,0 +1,235 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+/*
+suggestionRule is an entry of the suggestion catalog. All the conditions that are set must match the error for the rule to apply
+The suggestion is a text/template rendered with suggestionTemplateData, e.g. `Request a limit increase for {{.Matches.limits}}`
+*/
+type suggestionRule struct {
+	Service          string `json:"service"`           // service name, e.g. `core` matches the errors of all the core resources
+	ErrorCode        int    `json:"error_code"`        // HTTP status code of service errors
+	ErrorCodeName    string `json:"error_code_name"`   // e.g. `NotAuthorizedOrNotFound`
+	OperationPattern string `json:"operation_pattern"` // regular expression matched against the name of the failed operation, e.g. `^Create`
+	MessagePattern   string `json:"message_pattern"`   // regular expression matched against the error message, its named groups can be used in the suggestion
+	Suggestion       string `json:"suggestion"`
+
+	operationRegex *regexp.Regexp
+	messageRegex   *regexp.Regexp
+	template       *template.Template
+}
+
+type suggestionTemplateData struct {
+	Service       string
+	ErrorCode     int
+	ErrorCodeName string
+	Message       string
+	OperationName string
+	PolicyVerb    string            // IAM policy verb required by the failed operation
+	Matches       map[string]string // named groups matched by the message pattern
+}
+
+const policyReferenceLink = "https://docs.oracle.com/en-us/iaas/Content/Identity/Reference/policyreference.htm"
+
+// Built-in rules of the suggestion catalog, the rules from the user suggestions file are evaluated first
+var defaultSuggestionRules = mustCompileSuggestionRules([]*suggestionRule{
+	{
+		ErrorCode:        404,
+		ErrorCodeName:    "NotAuthorizedOrNotFound",
+		OperationPattern: ".+",
+		Suggestion: "Either the resource has been deleted or the user is missing the policy to {{.PolicyVerb}} the resources of operation {{.OperationName}}, " +
+			"e.g. `Allow group <group> to {{.PolicyVerb}} <resource-type> in compartment <compartment>`. Policy reference: " + policyReferenceLink,
+	},
+	{
+		ErrorCode:      400,
+		ErrorCodeName:  "LimitExceeded",
+		MessagePattern: `(?i)limits? (?:were |was |is )?exceeded:?\s*(?P<limits>[\w\-]+(?:,\s*[\w\-]+)*)`,
+		Suggestion:     "Request a service limit increase for {{.Matches.limits}} of service {{.Service}}: https://docs.oracle.com/en-us/iaas/Content/General/Concepts/servicelimits.htm",
+	},
+	{
+		ErrorCode:     409,
+		ErrorCodeName: "IncorrectState",
+		Suggestion: "The resource is not in a state that allows this operation. " +
+			"Wait for the pending operations on the resource to complete and re-apply your Terraform config, or contact support for help with service: {{.Service}}",
+	},
+	{
+		ErrorCode:     401,
+		ErrorCodeName: "NotAuthenticated",
+		Suggestion: "Verify the authentication configured for the provider, e.g. the user, fingerprint, private key and tenancy of API key authentication. " +
+			"Reference: https://docs.oracle.com/en-us/iaas/Content/API/SDKDocs/terraformproviderconfiguration.htm",
+	},
+})
+
+var userSuggestionRules struct {
+	sync.Mutex
+	file  string
+	rules []*suggestionRule
+}
+
+func (rule *suggestionRule) compile() error {
+	var err error
+	if rule.Suggestion == "" {
+		return fmt.Errorf("the suggestion is empty")
+	}
+	if rule.OperationPattern != "" {
+		if rule.operationRegex, err = regexp.Compile(rule.OperationPattern); err != nil {
+			return fmt.Errorf("invalid operation_pattern: %v", err)
+		}
+	}
+	if rule.MessagePattern != "" {
+		if rule.messageRegex, err = regexp.Compile(rule.MessagePattern); err != nil {
+			return fmt.Errorf("invalid message_pattern: %v", err)
+		}
+	}
+	if rule.template, err = template.New("suggestion").Option("missingkey=zero").Parse(rule.Suggestion); err != nil {
+		return fmt.Errorf("invalid suggestion: %v", err)
+	}
+	return nil
+}
+
+func mustCompileSuggestionRules(rules []*suggestionRule) []*suggestionRule {
+	for _, rule := range rules {
+		if err := rule.compile(); err != nil {
+			panic(fmt.Sprintf("invalid suggestion rule %+v: %v", rule, err))
+		}
+	}
+	return rules
+}
+
+// loadSuggestionRules reads the rules of a suggestions file, a JSON list of suggestion rules
+func loadSuggestionRules(file string) ([]*suggestionRule, error) {
+	content, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read suggestions file %s: %v", file, err)
+	}
+
+	var rules []*suggestionRule
+	if err := json.Unmarshal(content, &rules); err != nil {
+		return nil, fmt.Errorf("unable to parse suggestions file %s: %v", file, err)
+	}
+
+	for idx, rule := range rules {
+		if err := rule.compile(); err != nil {
+			return nil, fmt.Errorf("invalid rule %d in suggestions file %s: %v", idx, file, err)
+		}
+	}
+	return rules, nil
+}
+
+// getUserSuggestionRules returns the rules of the suggestions file set in the environment, the file is only read once
+// Errors in the suggestions file are logged and the file is ignored, so that they do not hide the actual error
+func getUserSuggestionRules() []*suggestionRule {
+	file := utils.GetEnvSettingWithBlankDefault(globalvar.SuggestionsFileEnv)
+	if file == "" {
+		return nil
+	}
+
+	userSuggestionRules.Lock()
+	defer userSuggestionRules.Unlock()
+	if userSuggestionRules.file != file {
+		rules, err := loadSuggestionRules(utils.ExpandPath(file))
+		if err != nil {
+			utils.Logf("[WARN] ignoring the suggestions file: %v", err)
+		}
+		userSuggestionRules.file = file
+		userSuggestionRules.rules = rules
+	}
+	return userSuggestionRules.rules
+}
+
+// matchesService checks the service of the rule against the service name of the error, e.g. `core` matches `Core Vcn`
+func (rule *suggestionRule) matchesService(service string) bool {
+	if rule.Service == "" {
+		return true
+	}
+	ruleService := strings.ToLower(rule.Service)
+	errorService := strings.ToLower(strings.ReplaceAll(service, " ", "_"))
+	return errorService == ruleService || strings.HasPrefix(errorService, ruleService+"_")
+}
+
+func (rule *suggestionRule) match(tfError customError) (map[string]string, bool) {
+	if !rule.matchesService(tfError.Service) {
+		return nil, false
+	}
+	if rule.ErrorCode != 0 && rule.ErrorCode != tfError.ErrorCode {
+		return nil, false
+	}
+	if rule.ErrorCodeName != "" && rule.ErrorCodeName != tfError.ErrorCodeName {
+		return nil, false
+	}
+	if rule.operationRegex != nil && !rule.operationRegex.MatchString(tfError.OperationName) {
+		return nil, false
+	}
+
+	matches := map[string]string{}
+	if rule.messageRegex != nil {
+		submatches := rule.messageRegex.FindStringSubmatch(tfError.Message)
+		if submatches == nil {
+			return nil, false
+		}
+		for idx, name := range rule.messageRegex.SubexpNames() {
+			if name != "" {
+				matches[name] = submatches[idx]
+			}
+		}
+	}
+	return matches, true
+}
+
+// getPolicyVerb returns the IAM policy verb required by an operation, based on the operation name
+func getPolicyVerb(operationName string) string {
+	switch {
+	case strings.HasPrefix(operationName, "List"):
+		return "inspect"
+	case strings.HasPrefix(operationName, "Get"):
+		return "read"
+	case strings.HasPrefix(operationName, "Update"), strings.HasPrefix(operationName, "Attach"), strings.HasPrefix(operationName, "Detach"):
+		return "use"
+	default:
+		return "manage"
+	}
+}
+
+// getSuggestionFromCatalog returns the suggestion of the first rule of the catalog that matches the error
+func getSuggestionFromCatalog(tfError customError) (string, bool) {
+	userRules := getUserSuggestionRules()
+	rules := make([]*suggestionRule, 0, len(userRules)+len(defaultSuggestionRules))
+	rules = append(append(rules, userRules...), defaultSuggestionRules...)
+	for _, rule := range rules {
+		matches, ok := rule.match(tfError)
+		if !ok {
+			continue
+		}
+
+		data := suggestionTemplateData{
+			Service:       tfError.Service,
+			ErrorCode:     tfError.ErrorCode,
+			ErrorCodeName: tfError.ErrorCodeName,
+			Message:       tfError.Message,
+			OperationName: tfError.OperationName,
+			PolicyVerb:    getPolicyVerb(tfError.OperationName),
+			Matches:       matches,
+		}
+		suggestion := &strings.Builder{}
+		if err := rule.template.Execute(suggestion, data); err != nil {
+			utils.Logf("[WARN] unable to render suggestion '%s': %v", rule.Suggestion, err)
+			continue
+		}
+		return suggestion.String(), true
+	}
+	return "", false
+}
@@ -0,0 +1,157 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+)
+
+// issue-routing-tag: terraform/default
+func TestUnitGetSuggestionFromCatalog_defaultRules(t *testing.T) {
+	tests := []struct {
+		name    string
+		tfError customError
+		want    string
+		found   bool
+	}{
+		{
+			name: "Test missing policy for the operation",
+			tfError: customError{
+				TypeOfError:   ServiceError,
+				ErrorCode:     404,
+				ErrorCodeName: "NotAuthorizedOrNotFound",
+				Service:       "Core Vcn",
+				OperationName: "CreateVcn",
+			},
+			want:  "Either the resource has been deleted or the user is missing the policy to manage the resources of operation CreateVcn, e.g. `Allow group <group> to manage <resource-type> in compartment <compartment>`. Policy reference: " + policyReferenceLink,
+			found: true,
+		},
+		{
+			name: "Test limit name from the message",
+			tfError: customError{
+				TypeOfError:   ServiceError,
+				ErrorCode:     400,
+				ErrorCodeName: "LimitExceeded",
+				Service:       "Core Vcn",
+				Message:       "The following service limits were exceeded: vcn-count. Request a service limit increase from the service limits page in the console.",
+			},
+			want:  "Request a service limit increase for vcn-count of service Core Vcn: https://docs.oracle.com/en-us/iaas/Content/General/Concepts/servicelimits.htm",
+			found: true,
+		},
+		{
+			name: "Test limit exceeded without limit name",
+			tfError: customError{
+				TypeOfError:   ServiceError,
+				ErrorCode:     400,
+				ErrorCodeName: "LimitExceeded",
+				Service:       "Core Vcn",
+				Message:       "LimitExceeded",
+			},
+			found: false,
+		},
+		{
+			name: "Test missing policy without operation",
+			tfError: customError{
+				TypeOfError:   ServiceError,
+				ErrorCode:     404,
+				ErrorCodeName: "NotAuthorizedOrNotFound",
+				Service:       "Core Vcn",
+			},
+			found: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := getSuggestionFromCatalog(tt.tfError)
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitGetSuggestionFromCatalog_userRules(t *testing.T) {
+	suggestionsFile := filepath.Join(t.TempDir(), "suggestions.json")
+	rules := `[
+	{
+		"service": "database",
+		"error_code_name": "IncorrectState",
+		"message_pattern": "(?P<database>ocid1\\.database\\.[\\w.]+)",
+		"suggestion": "Wait for the maintenance of {{.Matches.database}} to complete"
+	},
+	{
+		"error_code": 404,
+		"error_code_name": "NotAuthorizedOrNotFound",
+		"operation_pattern": "^Get",
+		"suggestion": "Ask the tenancy administrator for the {{.PolicyVerb}} policy of {{.OperationName}}"
+	}
+]`
+	assert.NoError(t, ioutil.WriteFile(suggestionsFile, []byte(rules), 0644))
+	os.Setenv("TF_VAR_"+globalvar.SuggestionsFileEnv, suggestionsFile)
+	defer os.Unsetenv("TF_VAR_" + globalvar.SuggestionsFileEnv)
+
+	// User rules take precedence over the default rules
+	suggestion, found := getSuggestionFromCatalog(customError{
+		ErrorCode:     404,
+		ErrorCodeName: "NotAuthorizedOrNotFound",
+		Service:       "Core Instance",
+		OperationName: "GetInstance",
+	})
+	assert.True(t, found)
+	assert.Equal(t, "Ask the tenancy administrator for the read policy of GetInstance", suggestion)
+
+	suggestion, found = getSuggestionFromCatalog(customError{
+		ErrorCode:     409,
+		ErrorCodeName: "IncorrectState",
+		Service:       "Database Db Home",
+		Message:       "Database ocid1.database.oc1.phx.abc is under maintenance",
+	})
+	assert.True(t, found)
+	assert.Equal(t, "Wait for the maintenance of ocid1.database.oc1.phx.abc to complete", suggestion)
+
+	// Rules of other services do not apply
+	suggestion, found = getSuggestionFromCatalog(customError{
+		ErrorCode:     409,
+		ErrorCodeName: "IncorrectState",
+		Service:       "Data Safe Target",
+		Message:       "Database ocid1.database.oc1.phx.abc is under maintenance",
+	})
+	assert.True(t, found)
+	assert.Contains(t, suggestion, "The resource is not in a state that allows this operation")
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitLoadSuggestionRules(t *testing.T) {
+	dir := t.TempDir()
+
+	_, err := loadSuggestionRules(filepath.Join(dir, "missing.json"))
+	assert.Error(t, err)
+
+	invalidPattern := filepath.Join(dir, "invalid_pattern.json")
+	assert.NoError(t, ioutil.WriteFile(invalidPattern, []byte(`[{"message_pattern": "(", "suggestion": "retry"}]`), 0644))
+	_, err = loadSuggestionRules(invalidPattern)
+	assert.Error(t, err)
+
+	emptySuggestion := filepath.Join(dir, "empty_suggestion.json")
+	assert.NoError(t, ioutil.WriteFile(emptySuggestion, []byte(`[{"error_code": 500}]`), 0644))
+	_, err = loadSuggestionRules(emptySuggestion)
+	assert.Error(t, err)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitGetPolicyVerb(t *testing.T) {
+	assert.Equal(t, "inspect", getPolicyVerb("ListVcns"))
+	assert.Equal(t, "read", getPolicyVerb("GetVcn"))
+	assert.Equal(t, "use", getPolicyVerb("UpdateVcn"))
+	assert.Equal(t, "use", getPolicyVerb("AttachVnic"))
+	assert.Equal(t, "manage", getPolicyVerb("CreateVcn"))
+	assert.Equal(t, "manage", getPolicyVerb("DeleteVcn"))
+}
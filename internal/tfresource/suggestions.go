@@ -6,6 +6,11 @@ package tfresource
 import "fmt"
 
 func getSuggestionFromError(tfError customError) string {
+	// Specific remediation from the suggestion catalog takes precedence over the generic suggestions
+	if suggestion, found := getSuggestionFromCatalog(tfError); found {
+		return suggestion
+	}
+
 	switch tfError.TypeOfError {
 	case ServiceError:
 		return getSuggestionForServiceError(tfError)
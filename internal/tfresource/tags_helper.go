@@ -4,11 +4,14 @@
 package tfresource
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	tf_client "github.com/terraform-providers/terraform-provider-oci/internal/client"
 )
 
 var DefinedTagsToSuppress []string
@@ -114,3 +117,86 @@ func MapToSystemTags(rawMap map[string]interface{}) (map[string]map[string]inter
 	}
 	return systemTags, nil
 }
+
+// MergeDefaultTags returns the tags along with the default tags whose key is not set, the keys are compared regardless
+// of case and the tags take precedence over the default tags
+func MergeDefaultTags(tags map[string]interface{}, defaultTags map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(tags)+len(defaultTags))
+	lowerCaseKeys := make(map[string]bool, len(tags))
+	for key, value := range tags {
+		merged[key] = value
+		lowerCaseKeys[strings.ToLower(key)] = true
+	}
+	for key, value := range defaultTags {
+		if !lowerCaseKeys[strings.ToLower(key)] {
+			merged[key] = value
+		}
+	}
+	return merged
+}
+
+// DefaultTagsCustomizeDiff returns the function adding the default tags to the planned `freeform_tags` and
+// `defined_tags` of a resource, or nil if the resource has no tags set by the configuration
+// The tags of a ForceNew attribute, or of a resource that cannot be updated, are only planned on creation, so that
+// changing the default tags does not replace existing resources.
+func DefaultTagsCustomizeDiff(resourceSchema *schema.Resource) schema.CustomizeDiffFunc {
+	var attributes []string
+	for _, attribute := range []string{"freeform_tags", "defined_tags"} {
+		if attributeSchema, ok := resourceSchema.Schema[attribute]; ok && attributeSchema.Type == schema.TypeMap && attributeSchema.Optional && attributeSchema.Computed {
+			attributes = append(attributes, attribute)
+		}
+	}
+	if len(attributes) == 0 {
+		return nil
+	}
+	updatable := resourceSchema.Update != nil
+
+	return func(_ context.Context, d *schema.ResourceDiff, m interface{}) error {
+		// The default tags are those of the provider of the resource, each alias of the provider has its own
+		clients, ok := m.(*tf_client.OracleClients)
+		if !ok || clients == nil {
+			return nil
+		}
+		for _, attribute := range attributes {
+			defaultTags := clients.DefaultFreeformTags
+			if attribute == "defined_tags" {
+				defaultTags = clients.DefaultDefinedTags
+			}
+			if len(defaultTags) == 0 || !d.NewValueKnown(attribute) || (d.Id() != "" && (resourceSchema.Schema[attribute].ForceNew || !updatable)) {
+				continue
+			}
+			if err := planDefaultTags(d, attribute, defaultTags); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// planDefaultTags adds the default tags to the planned tags of a resource if some of their keys are missing
+// The planned tags are the tags of the configuration, or the tags of the state if the configuration does not set them.
+func planDefaultTags(d *schema.ResourceDiff, attribute string, defaultTags map[string]interface{}) error {
+	planned, _ := d.Get(attribute).(map[string]interface{})
+	merged := MergeDefaultTags(planned, defaultTags)
+	if len(merged) == len(planned) {
+		return nil
+	}
+
+	// The diff suppression of the defined tags does not apply to the planned value set here, so the ignored defined
+	// tags and the keys that only differ by case are kept as they are in the state
+	oldRaw, _ := d.GetChange(attribute)
+	old, _ := oldRaw.(map[string]interface{})
+	if attribute == "defined_tags" {
+		for key, value := range old {
+			for _, ignored := range DefinedTagsToSuppress {
+				if strings.EqualFold(key, ignored) {
+					merged = MergeDefaultTags(merged, map[string]interface{}{key: value})
+				}
+			}
+		}
+	}
+	if reflect.DeepEqual(ToLowerCaseKeyMap(merged), ToLowerCaseKeyMap(old)) {
+		merged = old
+	}
+	return d.SetNew(attribute, merged)
+}
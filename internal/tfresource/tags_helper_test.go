@@ -1,10 +1,14 @@
 package tfresource
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	tf_client "github.com/terraform-providers/terraform-provider-oci/internal/client"
 )
 
 func TestUnitDefinedTagsToMap(t *testing.T) {
@@ -268,3 +272,175 @@ func TestUnitDefinedTagsDiffSuppressFunction(t *testing.T) {
 		})
 	}
 }
+
+func TestUnitMergeDefaultTags(t *testing.T) {
+	tags := map[string]interface{}{"costcenter": "1", "Department": "Finance"}
+	defaultTags := map[string]interface{}{"CostCenter": "42", "Owner": "team"}
+	want := map[string]interface{}{"costcenter": "1", "Department": "Finance", "Owner": "team"}
+
+	if got := MergeDefaultTags(tags, defaultTags); !reflect.DeepEqual(got, want) {
+		t.Errorf("MergeDefaultTags() = %v, want %v", got, want)
+	}
+	if got := MergeDefaultTags(nil, defaultTags); !reflect.DeepEqual(got, defaultTags) {
+		t.Errorf("MergeDefaultTags() = %v, want %v", got, defaultTags)
+	}
+	if len(tags) != 2 {
+		t.Errorf("MergeDefaultTags() modified the tags: %v", tags)
+	}
+}
+
+func defaultTagsTestResource(forceNew bool) *schema.Resource {
+	tagsSchema := func() *schema.Schema {
+		return &schema.Schema{Type: schema.TypeMap, Optional: true, Computed: true, ForceNew: forceNew, Elem: schema.TypeString}
+	}
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"display_name":  {Type: schema.TypeString, Optional: true},
+			"freeform_tags": tagsSchema(),
+			"defined_tags":  tagsSchema(),
+		},
+		Update: func(*schema.ResourceData, interface{}) error { return nil },
+	}
+	resource.CustomizeDiff = DefaultTagsCustomizeDiff(resource)
+	return resource
+}
+
+func TestUnitDefaultTagsCustomizeDiff(t *testing.T) {
+	defer func() { DefinedTagsToSuppress = nil }()
+	clients := &tf_client.OracleClients{
+		DefaultFreeformTags: map[string]interface{}{"CostCenter": "42"},
+		DefaultDefinedTags:  map[string]interface{}{"Operations.Owner": "team"},
+	}
+	DefinedTagsToSuppress = []string{"Oracle-Tags.CreatedBy"}
+
+	tests := []struct {
+		name     string
+		forceNew bool
+		state    map[string]string
+		config   map[string]interface{}
+		want     map[string]string
+	}{
+		{
+			name:   "Test the default tags are added on creation",
+			config: map[string]interface{}{"freeform_tags": map[string]interface{}{"Department": "Finance"}},
+			want: map[string]string{
+				"freeform_tags.%": "2", "freeform_tags.CostCenter": "42", "freeform_tags.Department": "Finance",
+				"defined_tags.%": "1", "defined_tags.Operations.Owner": "team",
+			},
+		},
+		{
+			name:   "Test the tags of the resource take precedence",
+			config: map[string]interface{}{"freeform_tags": map[string]interface{}{"costcenter": "1"}, "defined_tags": map[string]interface{}{"Operations.Owner": "me"}},
+			want: map[string]string{
+				"freeform_tags.%": "1", "freeform_tags.costcenter": "1",
+				"defined_tags.%": "1", "defined_tags.Operations.Owner": "me",
+			},
+		},
+		{
+			name: "Test no diff once the default tags are applied",
+			state: map[string]string{
+				"id":              "ocid1.test",
+				"freeform_tags.%": "2", "freeform_tags.CostCenter": "42", "freeform_tags.Department": "Finance",
+				"defined_tags.%": "2", "defined_tags.Operations.Owner": "team", "defined_tags.Oracle-Tags.CreatedBy": "user",
+			},
+			config: map[string]interface{}{"freeform_tags": map[string]interface{}{"Department": "Finance"}},
+		},
+		{
+			name: "Test the missing default tags are added to the existing resources",
+			state: map[string]string{
+				"id":              "ocid1.test",
+				"freeform_tags.%": "1", "freeform_tags.Department": "Finance",
+				"defined_tags.%": "1", "defined_tags.Oracle-Tags.CreatedBy": "user",
+			},
+			config: map[string]interface{}{},
+			want: map[string]string{
+				"freeform_tags.%": "2", "freeform_tags.CostCenter": "42",
+				"defined_tags.%": "2", "defined_tags.Operations.Owner": "team",
+			},
+		},
+		{
+			name:     "Test the existing resources are not replaced",
+			forceNew: true,
+			state:    map[string]string{"id": "ocid1.test", "freeform_tags.%": "0", "defined_tags.%": "0"},
+			config:   map[string]interface{}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var state *terraform.InstanceState
+			if tt.state != nil {
+				state = &terraform.InstanceState{ID: tt.state["id"], Attributes: tt.state}
+			}
+			diff, err := defaultTagsTestResource(tt.forceNew).Diff(context.Background(), state, terraform.NewResourceConfigRaw(tt.config), clients)
+			if err != nil {
+				t.Fatalf("Diff() error = %v", err)
+			}
+
+			got := map[string]string{}
+			if diff != nil {
+				for key, attributeDiff := range diff.Attributes {
+					if attributeDiff.Old != attributeDiff.New {
+						got[key] = attributeDiff.New
+					}
+				}
+			}
+			if len(tt.want) == 0 && len(got) == 0 {
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Diff() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnitDefaultTagsCustomizeDiff_providerAlias(t *testing.T) {
+	// Each alias of the provider adds its own default tags to its resources
+	for _, costCenter := range []string{"42", "43"} {
+		clients := &tf_client.OracleClients{DefaultFreeformTags: map[string]interface{}{"CostCenter": costCenter}}
+		diff, err := defaultTagsTestResource(false).Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{}), clients)
+		if err != nil {
+			t.Fatalf("Diff() error = %v", err)
+		}
+		if got := diff.Attributes["freeform_tags.CostCenter"]; got == nil || got.New != costCenter {
+			t.Errorf("Expected the default tag CostCenter=%s, got %v", costCenter, got)
+		}
+	}
+}
+
+func TestUnitDefaultTagsCustomizeDiff_notTaggable(t *testing.T) {
+	computedTags := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"freeform_tags": {Type: schema.TypeMap, Computed: true, Elem: schema.TypeString},
+		},
+		Update: func(*schema.ResourceData, interface{}) error { return nil },
+	}
+	if DefaultTagsCustomizeDiff(computedTags) != nil {
+		t.Errorf("Expected no default tags for computed tags")
+	}
+}
+
+func TestUnitDefaultTagsCustomizeDiff_notUpdatable(t *testing.T) {
+	clients := &tf_client.OracleClients{DefaultFreeformTags: map[string]interface{}{"CostCenter": "42"}}
+	resource := &schema.Resource{Schema: defaultTagsTestResource(true).Schema}
+	resource.CustomizeDiff = DefaultTagsCustomizeDiff(resource)
+
+	// The default tags are added on creation
+	diff, err := resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{}), clients)
+	if err != nil {
+		t.Fatalf("Diff() error = %v", err)
+	}
+	if got := diff.Attributes["freeform_tags.CostCenter"]; got == nil || got.New != "42" {
+		t.Errorf("Expected the default tag CostCenter=42 on creation, got %v", got)
+	}
+
+	// The existing resources are not replaced
+	state := &terraform.InstanceState{ID: "ocid1.test", Attributes: map[string]string{"id": "ocid1.test", "freeform_tags.%": "0", "defined_tags.%": "0"}}
+	diff, err = resource.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{}), clients)
+	if err != nil {
+		t.Fatalf("Diff() error = %v", err)
+	}
+	if diff != nil && diff.RequiresNew() {
+		t.Errorf("Expected the existing resource not to be replaced, got %v", diff)
+	}
+}
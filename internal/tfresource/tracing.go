@@ -0,0 +1,44 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/tracing"
+)
+
+// startResourceSpan starts the span of a CRUD helper as a child of the active span of the goroutine, e.g. the span of the
+// CRUD function of the resource, and activates it so that the spans of the requests it sends are its children
+// The span is ended and deactivated when the returned function is called with the result, in the same goroutine.
+func startResourceSpan(sync interface{}, name string) (*tracing.Span, func(err error)) {
+	_, span := tracing.StartSpan(context.Background(), name, tracing.SpanKindInternal)
+	if span == nil {
+		return nil, func(error) {}
+	}
+	if sync != nil {
+		span.SetAttribute(tracing.AttrService, getServiceName(sync))
+	}
+	deactivate := tracing.Activate(span)
+	return span, func(err error) {
+		deactivate()
+		span.SetAttribute(tracing.AttrResourceOCID, getResourceOCID(sync))
+		span.End(err)
+	}
+}
+
+// withActiveSpan returns a refresh function for a StateChangeConf, which calls it in a goroutine of its own, running with
+// the span active in the current goroutine so that the spans of the requests it sends are children of that span
+func withActiveSpan(refresh resource.StateRefreshFunc) resource.StateRefreshFunc {
+	span := tracing.ActiveSpan()
+	if span == nil {
+		return refresh
+	}
+	return func() (interface{}, string, error) {
+		defer tracing.Activate(span)()
+		return refresh()
+	}
+}
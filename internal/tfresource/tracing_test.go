@@ -0,0 +1,29 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tfresource
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// issue-routing-tag: terraform/default
+func TestUnitStartResourceSpan(t *testing.T) {
+	// The spans of the CRUD helpers are not started when tracing is disabled
+	s := &ResourceCrud{}
+	s.D = &mockResourceData{}
+	span, endSpan := startResourceSpan(s, "CreateResource")
+	assert.Nil(t, span)
+	endSpan(nil)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitWithActiveSpan(t *testing.T) {
+	// The refresh functions are not wrapped when no span is active
+	refresh := func() (interface{}, string, error) { return nil, "AVAILABLE", nil }
+	_, state, err := withActiveSpan(refresh)()
+	assert.NoError(t, err)
+	assert.Equal(t, "AVAILABLE", state)
+}
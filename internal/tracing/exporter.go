@@ -0,0 +1,337 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tracing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-oci/internal/globalvar"
+	"github.com/terraform-providers/terraform-provider-oci/internal/utils"
+)
+
+const (
+	serviceName = "terraform-provider-oci"
+
+	// Status codes of the OTLP specification
+	statusCodeOk    = 1
+	statusCodeError = 2
+
+	collectorBatchSize     = 100
+	collectorQueueSize     = 2048
+	collectorFlushInterval = 2 * time.Second
+	collectorTimeout       = 10 * time.Second
+	shutdownTimeout        = 5 * time.Second
+)
+
+type spanExporter interface {
+	export(span *Span)
+	shutdown()
+}
+
+var exporterState struct {
+	once     sync.Once
+	exporter spanExporter
+}
+
+func getExporter() spanExporter {
+	exporterState.once.Do(func() {
+		exporterState.exporter = newExporterFromEnv()
+	})
+	return exporterState.exporter
+}
+
+// Enabled returns true if an exporter of the spans is configured
+func Enabled() bool {
+	return getExporter() != nil
+}
+
+// Shutdown exports the spans that are still queued, it must be called before the process exits
+func Shutdown() {
+	if exporter := getExporter(); exporter != nil {
+		exporter.shutdown()
+	}
+}
+
+func newExporterFromEnv() spanExporter {
+	var exporters multiExporter
+	if file := utils.GetEnvSettingWithBlankDefault(globalvar.OtlpTracesFileEnv); file != "" {
+		exporter, err := newFileExporter(utils.ExpandPath(file))
+		if err != nil {
+			utils.Logf("[WARN] unable to export the traces to file %s: %v", file, err)
+		} else {
+			exporters = append(exporters, exporter)
+		}
+	}
+	if endpoint := utils.GetEnvSettingWithBlankDefault(globalvar.OtlpTracesEndpointEnv); endpoint != "" {
+		exporters = append(exporters, newCollectorExporter(endpoint))
+	}
+
+	if len(exporters) == 0 {
+		return nil
+	}
+	return exporters
+}
+
+type multiExporter []spanExporter
+
+func (m multiExporter) export(span *Span) {
+	for _, exporter := range m {
+		exporter.export(span)
+	}
+}
+
+func (m multiExporter) shutdown() {
+	for _, exporter := range m {
+		exporter.shutdown()
+	}
+}
+
+// fileExporter appends each span to the file as an ExportTraceServiceRequest, one request per line
+type fileExporter struct {
+	mutex sync.Mutex
+	file  *os.File
+}
+
+func newFileExporter(path string) (*fileExporter, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return &fileExporter{file: file}, nil
+}
+
+func (e *fileExporter) export(span *Span) {
+	payload, err := encodeSpans([]*Span{span})
+	if err != nil {
+		utils.Logf("[WARN] unable to encode span %s: %v", span.name, err)
+		return
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if _, err := e.file.Write(append(payload, '\n')); err != nil {
+		utils.Logf("[WARN] unable to write span %s: %v", span.name, err)
+	}
+}
+
+func (e *fileExporter) shutdown() {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.file.Sync()
+}
+
+// collectorExporter sends the spans in batches to the OTLP/HTTP endpoint of a collector, in the background
+type collectorExporter struct {
+	endpoint   string
+	httpClient *http.Client
+	queue      chan *Span
+	done       chan struct{}
+	closeOnce  sync.Once
+}
+
+func newCollectorExporter(endpoint string) *collectorExporter {
+	e := &collectorExporter{
+		endpoint: endpoint,
+		// The spans are not sent with the client of the provider, so that they are not traced nor recorded themselves
+		httpClient: &http.Client{Timeout: collectorTimeout},
+		queue:      make(chan *Span, collectorQueueSize),
+		done:       make(chan struct{}),
+	}
+	go e.run()
+	return e
+}
+
+func (e *collectorExporter) export(span *Span) {
+	select {
+	case e.queue <- span:
+	default:
+		utils.Debugf("[DEBUG] the queue of spans is full, dropping span %s", span.name)
+	}
+}
+
+func (e *collectorExporter) run() {
+	defer close(e.done)
+	ticker := time.NewTicker(collectorFlushInterval)
+	defer ticker.Stop()
+
+	var batch []*Span
+	for {
+		select {
+		case span, ok := <-e.queue:
+			if !ok {
+				e.send(batch)
+				return
+			}
+			if batch = append(batch, span); len(batch) >= collectorBatchSize {
+				e.send(batch)
+				batch = nil
+			}
+		case <-ticker.C:
+			e.send(batch)
+			batch = nil
+		}
+	}
+}
+
+func (e *collectorExporter) send(spans []*Span) {
+	if len(spans) == 0 {
+		return
+	}
+	payload, err := encodeSpans(spans)
+	if err != nil {
+		utils.Logf("[WARN] unable to encode %d spans: %v", len(spans), err)
+		return
+	}
+
+	response, err := e.httpClient.Post(e.endpoint, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		utils.Logf("[WARN] unable to send %d spans to %s: %v", len(spans), e.endpoint, err)
+		return
+	}
+	defer response.Body.Close()
+	if response.StatusCode/100 != 2 {
+		utils.Logf("[WARN] unable to send %d spans to %s: %s", len(spans), e.endpoint, response.Status)
+	}
+}
+
+func (e *collectorExporter) shutdown() {
+	e.closeOnce.Do(func() {
+		close(e.queue)
+	})
+	select {
+	case <-e.done:
+	case <-time.After(shutdownTimeout):
+		utils.Logf("[WARN] timed out sending the spans to %s", e.endpoint)
+	}
+}
+
+// Types of the OTLP/JSON encoding of an ExportTraceServiceRequest
+type otlpTraceRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type otlpSpan struct {
+	TraceID           string          `json:"traceId"`
+	SpanID            string          `json:"spanId"`
+	ParentSpanID      string          `json:"parentSpanId,omitempty"`
+	Name              string          `json:"name"`
+	Kind              SpanKind        `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	Status            otlpStatus      `json:"status"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
+
+func newOtlpAttribute(key string, value interface{}) otlpAttribute {
+	attribute := otlpAttribute{Key: key}
+	switch v := value.(type) {
+	case string:
+		attribute.Value.StringValue = &v
+	case int:
+		intValue := strconv.FormatInt(int64(v), 10)
+		attribute.Value.IntValue = &intValue
+	case int64:
+		intValue := strconv.FormatInt(v, 10)
+		attribute.Value.IntValue = &intValue
+	case bool:
+		attribute.Value.BoolValue = &v
+	case float64:
+		attribute.Value.DoubleValue = &v
+	default:
+		str := fmt.Sprintf("%v", v)
+		attribute.Value.StringValue = &str
+	}
+	return attribute
+}
+
+func (s *Span) toOtlp() otlpSpan {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	span := otlpSpan{
+		TraceID:           s.traceID,
+		SpanID:            s.spanID,
+		ParentSpanID:      s.parentSpanID,
+		Name:              s.name,
+		Kind:              s.kind,
+		StartTimeUnixNano: strconv.FormatInt(s.start.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(s.end.UnixNano(), 10),
+		Status:            otlpStatus{Code: statusCodeOk},
+	}
+	for key, value := range s.attributes {
+		span.Attributes = append(span.Attributes, newOtlpAttribute(key, value))
+	}
+	if s.err != nil {
+		span.Status = otlpStatus{Code: statusCodeError, Message: s.err.Error()}
+	}
+	return span
+}
+
+// encodeSpans returns the OTLP/JSON encoding of an ExportTraceServiceRequest with the spans
+func encodeSpans(spans []*Span) ([]byte, error) {
+	scopeSpans := otlpScopeSpans{
+		Scope: otlpScope{Name: serviceName, Version: globalvar.Version},
+	}
+	for _, span := range spans {
+		scopeSpans.Spans = append(scopeSpans.Spans, span.toOtlp())
+	}
+
+	request := otlpTraceRequest{
+		ResourceSpans: []otlpResourceSpans{
+			{
+				Resource: otlpResource{
+					Attributes: []otlpAttribute{
+						newOtlpAttribute("service.name", serviceName),
+						newOtlpAttribute("service.version", globalvar.Version),
+					},
+				},
+				ScopeSpans: []otlpScopeSpans{scopeSpans},
+			},
+		},
+	}
+	return json.Marshal(request)
+}
@@ -0,0 +1,225 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+/*
+Package tracing records the time spent in the CRUD operations of the resources and in the calls to the OCI APIs as
+OpenTelemetry spans. The spans are exported in the OTLP/JSON format to a file and/or to an OTLP/HTTP collector,
+tracing is disabled unless one of them is configured in the environment, like the other provider settings with the
+TF_VAR_ or OCI_ prefix:
+
+	TF_VAR_otlp_traces_file=/tmp/traces.jsonl             one ExportTraceServiceRequest per line
+	TF_VAR_otlp_traces_endpoint=http://localhost:4318/v1/traces
+
+The CRUD functions of the resources and the CRUD helpers do not take a context, so their spans are activated in the
+goroutine running them: the spans started in that goroutine with a context that does not carry a span, e.g. the spans of
+the requests sent by the SDK clients with context.Background(), are children of the active span and inherit its
+resource type. The spans started with a context carrying a span are children of that span.
+*/
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"runtime"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type SpanKind int
+
+// Span kinds of the OTLP specification
+const (
+	SpanKindInternal SpanKind = 1
+	SpanKindClient   SpanKind = 3
+)
+
+// Attribute keys of the spans
+const (
+	AttrResourceType  = "oci.resource.type"
+	AttrResourceOCID  = "oci.resource.ocid"
+	AttrService       = "oci.service"
+	AttrOperation     = "oci.operation"
+	AttrOpcRequestID  = "oci.opc_request_id"
+	AttrRetryCount    = "oci.retry_count"
+	AttrWorkRequestID = "oci.work_request.id"
+	AttrEntityType    = "oci.work_request.entity_type"
+	AttrAction        = "oci.work_request.action"
+	AttrHttpMethod    = "http.method"
+	AttrHttpUrl       = "http.url"
+	AttrHttpStatus    = "http.status_code"
+)
+
+// Span is an operation being traced, all its methods are no-ops on a nil span so that callers do not need to check whether tracing is enabled
+type Span struct {
+	mutex        sync.Mutex
+	traceID      string
+	spanID       string
+	parentSpanID string
+	name         string
+	kind         SpanKind
+	resourceType string
+	start        time.Time
+	end          time.Time
+	attributes   map[string]interface{}
+	err          error
+	ended        bool
+}
+
+type spanContextKey struct{}
+
+// StartSpan starts a span as a child of the span of the context, or of the active span of the goroutine if the context
+// does not carry one, and returns a copy of the context with the span
+// The child spans inherit the trace and the resource type of their parent. The span is nil if tracing is disabled.
+func StartSpan(ctx context.Context, name string, kind SpanKind) (context.Context, *Span) {
+	if !Enabled() {
+		return ctx, nil
+	}
+
+	span := &Span{
+		traceID:    newID(16),
+		spanID:     newID(8),
+		name:       name,
+		kind:       kind,
+		start:      time.Now(),
+		attributes: map[string]interface{}{},
+	}
+	parent := SpanFromContext(ctx)
+	if parent == nil {
+		parent = ActiveSpan()
+	}
+	if parent != nil {
+		span.traceID = parent.traceID
+		span.parentSpanID = parent.spanID
+		span.resourceType = parent.resourceType
+	}
+	return ContextWithSpan(ctx, span), span
+}
+
+// StartResourceSpan starts the span of an operation of a Terraform resource or data source, the spans started from the
+// returned context are its children
+func StartResourceSpan(ctx context.Context, resourceType string, operation string) (context.Context, *Span) {
+	ctx, span := StartSpan(ctx, resourceType+" "+operation, SpanKindInternal)
+	if span != nil {
+		span.resourceType = resourceType
+		span.SetAttribute(AttrOperation, operation)
+	}
+	return ctx, span
+}
+
+// ContextWithSpan returns a copy of the context with the span, the spans started from the context are its children
+func ContextWithSpan(ctx context.Context, span *Span) context.Context {
+	if span == nil {
+		return ctx
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, spanContextKey{}, span)
+}
+
+// SpanFromContext returns the span of the context, or nil
+func SpanFromContext(ctx context.Context) *Span {
+	if ctx == nil {
+		return nil
+	}
+	span, _ := ctx.Value(spanContextKey{}).(*Span)
+	return span
+}
+
+// activeSpans are the spans activated in the goroutines, keyed by goroutine id
+var activeSpans = struct {
+	sync.Mutex
+	spans map[uint64]*Span
+}{spans: map[uint64]*Span{}}
+
+// Activate makes the span the parent of the spans started in the current goroutine without a span in their context,
+// until the returned function is called in the same goroutine to restore the span that was active before
+func Activate(span *Span) func() {
+	if span == nil {
+		return func() {}
+	}
+	id := goroutineID()
+	activeSpans.Lock()
+	previous := activeSpans.spans[id]
+	activeSpans.spans[id] = span
+	activeSpans.Unlock()
+	return func() {
+		activeSpans.Lock()
+		defer activeSpans.Unlock()
+		if previous == nil {
+			delete(activeSpans.spans, id)
+		} else {
+			activeSpans.spans[id] = previous
+		}
+	}
+}
+
+// ActiveSpan returns the span activated in the current goroutine, or nil
+func ActiveSpan() *Span {
+	activeSpans.Lock()
+	defer activeSpans.Unlock()
+	if len(activeSpans.spans) == 0 {
+		return nil
+	}
+	return activeSpans.spans[goroutineID()]
+}
+
+// goroutineID returns the id of the current goroutine from the header of its stack trace, "goroutine <id> [running]:"
+func goroutineID() uint64 {
+	buf := make([]byte, 64)
+	buf = bytes.TrimPrefix(buf[:runtime.Stack(buf, false)], []byte("goroutine "))
+	if idx := bytes.IndexByte(buf, ' '); idx >= 0 {
+		buf = buf[:idx]
+	}
+	id, _ := strconv.ParseUint(string(buf), 10, 64)
+	return id
+}
+
+// SetAttribute sets an attribute of the span, empty string values are ignored
+func (s *Span) SetAttribute(key string, value interface{}) {
+	if s == nil {
+		return
+	}
+	if str, ok := value.(string); ok && str == "" {
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.attributes[key] = value
+}
+
+// End ends the span with the error of the operation, if any, and exports it
+func (s *Span) End(err error) {
+	if s == nil {
+		return
+	}
+	s.mutex.Lock()
+	if s.ended {
+		s.mutex.Unlock()
+		return
+	}
+	s.ended = true
+	s.end = time.Now()
+	s.err = err
+	if s.resourceType != "" {
+		s.attributes[AttrResourceType] = s.resourceType
+	}
+	s.mutex.Unlock()
+
+	getExporter().export(s)
+}
+
+func newID(size int) string {
+	id := make([]byte, size)
+	if _, err := rand.Read(id); err != nil {
+		// The time is unique enough for an ID when the random generator fails
+		now := time.Now().UnixNano()
+		for i := range id {
+			id[i] = byte(now >> (8 * (i % 8)))
+		}
+	}
+	return hex.EncodeToString(id)
+}
@@ -0,0 +1,308 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tracing
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type memoryExporter struct {
+	mutex sync.Mutex
+	spans []*Span
+}
+
+func (e *memoryExporter) export(span *Span) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.spans = append(e.spans, span)
+}
+
+func (e *memoryExporter) shutdown() {}
+
+func useExporter(t *testing.T, exporter spanExporter) {
+	exporterState.once.Do(func() {})
+	previous := exporterState.exporter
+	exporterState.exporter = exporter
+	t.Cleanup(func() { exporterState.exporter = previous })
+}
+
+func attributes(span otlpSpan) map[string]otlpValue {
+	result := map[string]otlpValue{}
+	for _, attribute := range span.Attributes {
+		result[attribute.Key] = attribute.Value
+	}
+	return result
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitStartSpan_disabled(t *testing.T) {
+	useExporter(t, nil)
+
+	ctx := context.Background()
+	spanCtx, span := StartResourceSpan(ctx, "oci_core_vcn", "Create")
+	assert.Nil(t, span)
+	assert.Equal(t, ctx, spanCtx)
+
+	// The methods of a nil span are no-ops
+	span.SetAttribute(AttrResourceOCID, "ocid1.vcn.oc1..a")
+	span.End(nil)
+	assert.Equal(t, ctx, ContextWithSpan(ctx, span))
+	assert.Nil(t, SpanFromContext(ctx))
+	assert.Nil(t, (*Transport)(nil).StartHTTPSpan(httptest.NewRequest(http.MethodGet, "/20160918/vcns", nil), "core"))
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitStartSpan_children(t *testing.T) {
+	exporter := &memoryExporter{}
+	useExporter(t, exporter)
+
+	ctx, root := StartResourceSpan(context.Background(), "oci_core_vcn", "Create")
+	assert.Equal(t, root, SpanFromContext(ctx))
+	childCtx, child := StartSpan(ctx, "CreateResource", SpanKindInternal)
+	assert.Equal(t, child, SpanFromContext(childCtx))
+	assert.Equal(t, root, SpanFromContext(ctx))
+
+	child.SetAttribute(AttrResourceOCID, "ocid1.vcn.oc1..a")
+	child.SetAttribute(AttrService, "")
+	child.End(fmt.Errorf("failed"))
+	child.End(nil)
+	root.End(nil)
+
+	// A span started from a context without a span is the root of a new trace
+	_, other := StartSpan(context.Background(), "WaitForWorkRequest", SpanKindInternal)
+	other.End(nil)
+
+	if assert.Len(t, exporter.spans, 3) {
+		childSpan := exporter.spans[0].toOtlp()
+		rootSpan := exporter.spans[1].toOtlp()
+		otherSpan := exporter.spans[2].toOtlp()
+		assert.Equal(t, rootSpan.TraceID, childSpan.TraceID)
+		assert.Equal(t, rootSpan.SpanID, childSpan.ParentSpanID)
+		assert.Empty(t, rootSpan.ParentSpanID)
+		assert.Empty(t, otherSpan.ParentSpanID)
+		assert.NotEqual(t, rootSpan.TraceID, otherSpan.TraceID)
+		assert.Len(t, rootSpan.TraceID, 32)
+		assert.Len(t, rootSpan.SpanID, 16)
+		assert.Equal(t, "oci_core_vcn Create", rootSpan.Name)
+
+		childAttributes := attributes(childSpan)
+		assert.Equal(t, "oci_core_vcn", *childAttributes[AttrResourceType].StringValue)
+		assert.Equal(t, "ocid1.vcn.oc1..a", *childAttributes[AttrResourceOCID].StringValue)
+		assert.NotContains(t, childAttributes, AttrService)
+		assert.NotContains(t, attributes(otherSpan), AttrResourceType)
+		assert.Equal(t, otlpStatus{Code: statusCodeError, Message: "failed"}, childSpan.Status)
+		assert.Equal(t, otlpStatus{Code: statusCodeOk}, rootSpan.Status)
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitEncodeSpans(t *testing.T) {
+	useExporter(t, &memoryExporter{})
+
+	_, span := StartSpan(context.Background(), "GET /20160918/vcns", SpanKindClient)
+	span.SetAttribute(AttrHttpStatus, 200)
+	span.SetAttribute(AttrHttpMethod, "GET")
+	span.SetAttribute("flag", true)
+	span.End(nil)
+
+	payload, err := encodeSpans([]*Span{span})
+	assert.NoError(t, err)
+
+	var request otlpTraceRequest
+	assert.NoError(t, json.Unmarshal(payload, &request))
+	if assert.Len(t, request.ResourceSpans, 1) && assert.Len(t, request.ResourceSpans[0].ScopeSpans, 1) {
+		assert.Equal(t, serviceName, *request.ResourceSpans[0].Resource.Attributes[0].Value.StringValue)
+		spans := request.ResourceSpans[0].ScopeSpans[0].Spans
+		if assert.Len(t, spans, 1) {
+			assert.Equal(t, SpanKindClient, spans[0].Kind)
+			assert.NotEmpty(t, spans[0].StartTimeUnixNano)
+			spanAttributes := attributes(spans[0])
+			assert.Equal(t, "200", *spanAttributes[AttrHttpStatus].IntValue)
+			assert.Equal(t, "GET", *spanAttributes[AttrHttpMethod].StringValue)
+			assert.True(t, *spanAttributes["flag"].BoolValue)
+		}
+	}
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitFileExporter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.jsonl")
+	exporter, err := newFileExporter(path)
+	assert.NoError(t, err)
+	useExporter(t, exporter)
+
+	for _, name := range []string{"first", "second"} {
+		_, span := StartSpan(context.Background(), name, SpanKindInternal)
+		span.End(nil)
+	}
+	exporter.shutdown()
+
+	file, err := os.Open(path)
+	assert.NoError(t, err)
+	defer file.Close()
+
+	var names []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var request otlpTraceRequest
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), &request))
+		names = append(names, request.ResourceSpans[0].ScopeSpans[0].Spans[0].Name)
+	}
+	assert.Equal(t, []string{"first", "second"}, names)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitCollectorExporter(t *testing.T) {
+	var mutex sync.Mutex
+	var names []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		var request otlpTraceRequest
+		assert.NoError(t, json.Unmarshal(body, &request))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		for _, span := range request.ResourceSpans[0].ScopeSpans[0].Spans {
+			names = append(names, span.Name)
+		}
+	}))
+	defer server.Close()
+
+	exporter := newCollectorExporter(server.URL)
+	useExporter(t, exporter)
+
+	for _, name := range []string{"first", "second"} {
+		_, span := StartSpan(context.Background(), name, SpanKindInternal)
+		span.End(nil)
+	}
+	exporter.shutdown()
+
+	// The queued spans are sent on shutdown
+	mutex.Lock()
+	defer mutex.Unlock()
+	assert.Equal(t, []string{"first", "second"}, names)
+}
+
+// issue-routing-tag: terraform/default
+func TestUnitTransport(t *testing.T) {
+	exporter := &memoryExporter{}
+	useExporter(t, exporter)
+
+	statusCodes := []int{http.StatusTooManyRequests, http.StatusOK}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(opcRequestIdHeader, "response-id")
+		w.WriteHeader(statusCodes[0])
+		statusCodes = statusCodes[1:]
+	}))
+	defer server.Close()
+
+	ctx, root := StartResourceSpan(context.Background(), "oci_core_vcn", "Read")
+	transport := NewTransport(nil)
+	client := &http.Client{Transport: transport}
+	for i := 0; i < 2; i++ {
+		request, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/20160918/vcns", nil)
+		request.Header.Set(opcRequestIdHeader, "request-id")
+		span := transport.StartHTTPSpan(request, "core")
+		assert.Equal(t, span, SpanFromContext(request.Context()))
+
+		response, err := client.Do(request)
+		assert.NoError(t, err)
+		response.Body.Close()
+	}
+	root.End(nil)
+
+	// The retry count is reset once the request succeeds
+	request, _ := http.NewRequest(http.MethodGet, server.URL+"/20160918/vcns", nil)
+	assert.Equal(t, 0, transport.attempts.retryCount(request))
+
+	if assert.Len(t, exporter.spans, 3) {
+		throttled := exporter.spans[0].toOtlp()
+		retried := exporter.spans[1].toOtlp()
+		rootSpan := exporter.spans[2].toOtlp()
+		assert.Equal(t, "GET /20160918/vcns", throttled.Name)
+		assert.Equal(t, SpanKindClient, throttled.Kind)
+		assert.Equal(t, statusCodeError, throttled.Status.Code)
+		assert.Equal(t, statusCodeOk, retried.Status.Code)
+		assert.Equal(t, rootSpan.SpanID, throttled.ParentSpanID)
+		assert.Equal(t, rootSpan.SpanID, retried.ParentSpanID)
+
+		throttledAttributes := attributes(throttled)
+		assert.Equal(t, "core", *throttledAttributes[AttrService].StringValue)
+		assert.Equal(t, "oci_core_vcn", *throttledAttributes[AttrResourceType].StringValue)
+		assert.Equal(t, "response-id", *throttledAttributes[AttrOpcRequestID].StringValue)
+		assert.Equal(t, "429", *throttledAttributes[AttrHttpStatus].IntValue)
+		assert.Equal(t, "0", *throttledAttributes[AttrRetryCount].IntValue)
+		assert.Equal(t, "1", *attributes(retried)[AttrRetryCount].IntValue)
+	}
+}
+
+// Test that the spans of the CRUD helpers and of the requests sent with context.Background() are children of the active
+// span of their goroutine, and that the spans of the other goroutines are not
+// issue-routing-tag: terraform/default
+func TestUnitActivate(t *testing.T) {
+	exporter := &memoryExporter{}
+	useExporter(t, exporter)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	transport := NewTransport(nil)
+	client := &http.Client{Transport: transport}
+
+	_, root := StartResourceSpan(context.Background(), "oci_core_vcn", "Create")
+	deactivateRoot := Activate(root)
+	_, helper := StartSpan(context.Background(), "CreateResource", SpanKindInternal)
+	deactivateHelper := Activate(helper)
+	assert.Equal(t, helper, ActiveSpan())
+
+	request, _ := http.NewRequest(http.MethodPost, server.URL+"/20160918/vcns", nil)
+	transport.StartHTTPSpan(request, "core")
+	response, err := client.Do(request)
+	assert.NoError(t, err)
+	response.Body.Close()
+
+	var otherGoroutineSpan *Span
+	done := make(chan bool)
+	go func() {
+		_, otherGoroutineSpan = StartSpan(context.Background(), "WaitForWorkRequest", SpanKindInternal)
+		otherGoroutineSpan.End(nil)
+		close(done)
+	}()
+	<-done
+
+	deactivateHelper()
+	assert.Equal(t, root, ActiveSpan())
+	helper.End(nil)
+	deactivateRoot()
+	assert.Nil(t, ActiveSpan())
+	root.End(nil)
+
+	if assert.Len(t, exporter.spans, 4) {
+		httpSpan := exporter.spans[0].toOtlp()
+		otherSpan := exporter.spans[1].toOtlp()
+		helperSpan := exporter.spans[2].toOtlp()
+		rootSpan := exporter.spans[3].toOtlp()
+		assert.Equal(t, rootSpan.SpanID, helperSpan.ParentSpanID)
+		assert.Equal(t, helperSpan.SpanID, httpSpan.ParentSpanID)
+		assert.Equal(t, rootSpan.TraceID, helperSpan.TraceID)
+		assert.Equal(t, rootSpan.TraceID, httpSpan.TraceID)
+		assert.Empty(t, otherSpan.ParentSpanID)
+		assert.NotEqual(t, rootSpan.TraceID, otherSpan.TraceID)
+		assert.Equal(t, "oci_core_vcn", *attributes(helperSpan)[AttrResourceType].StringValue)
+		assert.Equal(t, "oci_core_vcn", *attributes(httpSpan)[AttrResourceType].StringValue)
+		assert.NotContains(t, attributes(otherSpan), AttrResourceType)
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright (c) 2017, 2021, Oracle and/or its affiliates. All rights reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+)
+
+const opcRequestIdHeader = "opc-request-id"
+
+// httpSpanContextKey is the key of the span of the request in its context, the Transport must not end the parent span of the request
+type httpSpanContextKey struct{}
+
+/*
+requestAttempts counts the failed attempts of the requests sent through a Transport
+The SDK does not expose the attempt number of a request, a request is counted as a retry when the previous request
+with the same method and URL failed with an error that the SDK retries
+The count of a request is deleted once it succeeds or fails with an error that is not retried
+*/
+type requestAttempts struct {
+	sync.Mutex
+	attempts map[string]int
+}
+
+func (a *requestAttempts) retryCount(r *http.Request) int {
+	a.Lock()
+	defer a.Unlock()
+	return a.attempts[requestKey(r)]
+}
+
+func (a *requestAttempts) record(r *http.Request, retriable bool) {
+	a.Lock()
+	defer a.Unlock()
+	if !retriable {
+		delete(a.attempts, requestKey(r))
+		return
+	}
+	if a.attempts == nil {
+		a.attempts = map[string]int{}
+	}
+	a.attempts[requestKey(r)]++
+}
+
+func requestKey(r *http.Request) string {
+	return r.Method + " " + r.URL.String()
+}
+
+func isRetriableFailure(response *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	return response.StatusCode == http.StatusConflict || response.StatusCode == http.StatusTooManyRequests || response.StatusCode >= http.StatusInternalServerError
+}
+
+// Transport traces the requests sent to the OCI APIs, the spans started by StartHTTPSpan are ended with the status and
+// the opc-request-id of their response
+type Transport struct {
+	Base     http.RoundTripper
+	attempts requestAttempts
+}
+
+func NewTransport(base http.RoundTripper) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &Transport{Base: base}
+}
+
+// StartHTTPSpan starts the span of a request to an OCI API as a child of the span of the context of the request, or of
+// the active span of the goroutine sending it, e.g. the span of the CRUD helper of a resource
+// The span is attached to the context of the request, the request is updated in place because the SDK interceptors cannot replace it
+// It returns nil if the transport is nil, e.g. when tracing is disabled.
+func (t *Transport) StartHTTPSpan(r *http.Request, service string) *Span {
+	if t == nil {
+		return nil
+	}
+	ctx, span := StartSpan(r.Context(), fmt.Sprintf("%s %s", r.Method, r.URL.Path), SpanKindClient)
+	if span == nil {
+		return nil
+	}
+
+	span.SetAttribute(AttrService, service)
+	span.SetAttribute(AttrHttpMethod, r.Method)
+	span.SetAttribute(AttrHttpUrl, r.URL.String())
+	span.SetAttribute(AttrRetryCount, t.attempts.retryCount(r))
+	*r = *r.WithContext(context.WithValue(ctx, httpSpanContextKey{}, span))
+	return span
+}
+
+func (t *Transport) RoundTrip(r *http.Request) (*http.Response, error) {
+	response, err := t.Base.RoundTrip(r)
+
+	span, _ := r.Context().Value(httpSpanContextKey{}).(*Span)
+	if span == nil {
+		return response, err
+	}
+	t.attempts.record(r, isRetriableFailure(response, err))
+
+	spanErr := err
+	opcRequestId := r.Header.Get(opcRequestIdHeader)
+	if response != nil {
+		span.SetAttribute(AttrHttpStatus, response.StatusCode)
+		if id := response.Header.Get(opcRequestIdHeader); id != "" {
+			opcRequestId = id
+		}
+		if err == nil && response.StatusCode >= http.StatusBadRequest {
+			spanErr = fmt.Errorf("%s", response.Status)
+		}
+	}
+	span.SetAttribute(AttrOpcRequestID, opcRequestId)
+	span.End(spanErr)
+	return response, err
+}
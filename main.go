@@ -28,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
 
 	"github.com/terraform-providers/terraform-provider-oci/internal/provider"
+	"github.com/terraform-providers/terraform-provider-oci/internal/tracing"
 )
 
 func main() {
@@ -41,10 +42,22 @@ func main() {
 	var excludeServices = flag.String("exclude_services", "", "[export] [experimental] Comma-separated list of service resources to exclude from export. If a service is present in both 'services' and 'exclude_services' argument, it will be excluded.")
 	var ids = flag.String("ids", "", "[export] Comma-separated list of tuples <resource Type:resource ID> for resources to export. The ID could either be an OCID or a Terraform import ID. By default, all resources are exported.")
 	var generateStateFile = flag.Bool("generate_state", false, "[export][experimental] Set this to import the discovered resources into a state file along with the Terraform configuration")
+	var generateImportBlocks = flag.Bool("generate_import_blocks", false, "[export][experimental] Set this to generate Terraform v1.5+ `import` blocks for the discovered resources along with the Terraform configuration. Cannot be used with 'generate_state'")
+	var existingStateFile = flag.String("existing_state_file", "", "[export][experimental] Path to an existing Terraform state file, or to an initialized configuration directory whose local or remote state is read with terraform show. Only the discovered resources that are not managed in this state are exported, and a report of the managed resources that no longer exist is generated under the output_path")
+	var layout = flag.String("layout", "flat", "[export][experimental] The layout of the generated configuration. The allowed values are :\n * flat - one file per service under output_path\n * compartment_modules - one module per compartment in the compartment tree, wired together by a root module")
 	var help = flag.Bool("help", false, "Prints usage options")
 	var tfVersion = flag.String("tf_version", "0.12", "The version of terraform syntax to generate for configurations. The state file will be written in v0.12 only. The allowed values are :\n * 0.11\n * 0.12")
 	var retryTimeout = flag.String("retry_timeout", "15s", "[export] The time duration for which API calls will wait and retry operation in case of API errors. By default, the retry timeout duration is 15s")
 	var parallelism = flag.Int("parallelism", 1, "The number of threads to use for resource discovery. By default the value is 1")
+	var includeTags = flag.String("include_tags", "", "[export][experimental] Comma-separated list of tags <tag key>:<tag value> or <tag key> of the resources to export, e.g. 'Team:payments,Operations.CostCenter:42'. Defined tag keys include the tag namespace. The resources of the same service and compartment they reference are exported too.")
+	var excludeTags = flag.String("exclude_tags", "", "[export][experimental] Comma-separated list of tags <tag key>:<tag value> or <tag key> of the resources to exclude from export.")
+	var nameRegex = flag.String("name_regex", "", "[export][experimental] Regular expression matching the display name or name of the resources to export. The resources of the same service and compartment they reference are exported too.")
+	var lifecycleStates = flag.String("lifecycle_states", "", "[export][experimental] Comma-separated list of lifecycle states of the resources to export, e.g. 'ACTIVE,AVAILABLE'.")
+	var regions = flag.String("regions", "", "[export][experimental] Comma-separated list of regions to export. The resources of each region are generated with the provider alias of the region. By default, the region of the provider configuration is exported.")
+	var recursive = flag.Bool("recursive", false, "[export][experimental] Set this flag to export the resources of the compartments under the exported compartment in the compartment tree.")
+	var resume = flag.Bool("resume", false, "[export][experimental] Set this flag to resume an export that did not complete from the checkpoint under the output_path. The export must be run with the same arguments.")
+	var generateInventory = flag.Bool("generate_inventory", false, "[export][experimental] Set this to generate a JSON inventory of the discovered resources and of the discovery errors under the output_path along with the Terraform configuration")
+	var externalizeSecrets = flag.Bool("externalize_secrets", false, "[export][experimental] Set this to set the sensitive attributes of the resources, e.g. passwords, from sensitive variables instead of placeholder values. The variables are listed in a terraform.tfvars.example file under the output_path. Requires Terraform v0.14.0 and above")
 
 	flag.Parse()
 	globalvar.PrintVersion()
@@ -61,6 +74,7 @@ func main() {
 				return provider.Provider()
 			},
 		})
+		tracing.Shutdown()
 	} else {
 		switch *command {
 		case "export":
@@ -85,10 +99,17 @@ func main() {
 				CompartmentName:              compartmentName,
 				OutputDir:                    outputPath,
 				GenerateState:                *generateStateFile,
+				GenerateImportBlocks:         *generateImportBlocks,
+				ExistingStateFile:            existingStateFile,
+				Layout:                       resourcediscovery.ExportLayoutEnum(*layout),
 				TFVersion:                    &terraformVersion,
 				RetryTimeout:                 retryTimeout,
 				IsExportWithRelatedResources: *includeRelatedResources,
 				Parallelism:                  *parallelism,
+				IsRecursive:                  *recursive,
+				Resume:                       *resume,
+				GenerateInventory:            *generateInventory,
+				ExternalizeSecrets:           *externalizeSecrets,
 			}
 
 			if services != nil && *services != "" {
@@ -102,10 +123,31 @@ func main() {
 			if ids != nil && *ids != "" {
 				args.IDs = strings.Split(*ids, ",")
 			}
+
+			if includeTags != nil && *includeTags != "" {
+				args.IncludeTags = strings.Split(*includeTags, ",")
+			}
+
+			if excludeTags != nil && *excludeTags != "" {
+				args.ExcludeTags = strings.Split(*excludeTags, ",")
+			}
+
+			if lifecycleStates != nil && *lifecycleStates != "" {
+				args.LifecycleStates = strings.Split(*lifecycleStates, ",")
+			}
+
+			if nameRegex != nil && *nameRegex != "" {
+				args.NameRegex = nameRegex
+			}
+
+			if regions != nil && *regions != "" {
+				args.Regions = strings.Split(*regions, ",")
+			}
 			err, status := resourcediscovery.RunExportCommand(args)
 			if err != nil {
 				color.Red("%v", err)
 			}
+			tracing.Shutdown()
 			os.Exit(int(status))
 
 		case "list_export_resources":